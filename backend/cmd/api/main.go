@@ -6,7 +6,9 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -15,7 +17,10 @@ import (
 
 	"github.com/mafia-night/backend/ent"
 	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/events"
 	"github.com/mafia-night/backend/internal/handler"
+	"github.com/mafia-night/backend/internal/media"
 	"github.com/mafia-night/backend/internal/service"
 )
 
@@ -34,15 +39,39 @@ func main() {
 
 	// Run migrations
 	ctx := context.Background()
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := database.CreateSchema(ctx, client); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	// Services publish domain events after each change
+	bus := events.NewBus()
+	bus.Subscribe(events.AuditLog(log.Default()))
+	if urls := getWebhookURLs(); len(urls) > 0 {
+		webhooks := events.NewWebhooks(urls, os.Getenv("WEBHOOK_SECRET"))
+		bus.Subscribe(webhooks.Handle)
+		log.Printf("Sending game events to %d webhook(s)", len(urls))
+	}
+
+	// Role and template texts are stored in the default locale, with translations for others
+	defaultLocale, err := getDefaultLocale()
+	if err != nil {
+		log.Fatalf("invalid DEFAULT_LOCALE: %v", err)
+	}
+
+	// Uploaded role videos and images
+	mediaLibrary, err := newMediaLibrary()
+	if err != nil {
+		log.Fatalf("failed setting up media storage: %v", err)
+	}
+
 	// Initialize services
-	gameService := service.NewGameService(client)
-	roleService := service.NewRoleService(client)
-	roleTemplateService := service.NewRoleTemplateService(client)
+	gameService := service.NewGameService(client).WithEvents(bus)
+	roleService := service.NewRoleService(client).WithDefaultLocale(defaultLocale).WithMedia(mediaLibrary)
+	roleTemplateService := service.NewRoleTemplateService(client).WithDefaultLocale(defaultLocale)
+	catalogService := service.NewCatalogService(client).WithDefaultLocale(defaultLocale)
 	adminService := service.NewAdminService(client)
+	moderatorService := service.NewModeratorService(client).WithEvents(bus)
+	playService := service.NewPlayService(client).WithEvents(bus)
 
 	// Initialize JWT service
 	jwtSecret := os.Getenv("JWT_SECRET")
@@ -52,12 +81,31 @@ func main() {
 	}
 	jwtService := auth.NewJWTService(jwtSecret, "mafia-night")
 
+	// Initialize the WebSocket hub; the player list reports presence from it
+	broadcaster, err := newBroadcaster(dbURL)
+	if err != nil {
+		log.Fatalf("failed setting up WebSocket broadcasting: %v", err)
+	}
+	defer broadcaster.Close()
+	// WebSocket handshakes are held to the same origins as CORS requests
+	allowedOrigins := getAllowedOrigins()
+	hubConfig, err := getHubConfig()
+	if err != nil {
+		log.Fatalf("invalid WebSocket settings: %v", err)
+	}
+	hubConfig.AllowedOrigins = allowedOrigins
+	wsHandler := handler.NewWebSocketHandler(gameService, playService, jwtService, broadcaster, hubConfig)
+	bus.Subscribe(wsHandler.GetHub().HandleEvent)
+
 	// Initialize handlers
-	gameHandler := handler.NewGameHandler(gameService)
+	gameHandler := handler.NewGameHandler(gameService, jwtService).WithPresence(wsHandler.GetHub())
 	roleHandler := handler.NewRoleHandler(roleService)
 	roleTemplateHandler := handler.NewRoleTemplateHandler(roleTemplateService)
+	catalogHandler := handler.NewCatalogHandler(catalogService)
 	adminHandler := handler.NewAdminHandler(adminService, jwtService)
-	wsHandler := handler.NewWebSocketHandler(gameService)
+	moderatorHandler := handler.NewModeratorHandler(moderatorService, jwtService)
+	playHandler := handler.NewPlayHandler(playService, jwtService)
+	mediaHandler := handler.NewMediaHandler(mediaLibrary)
 
 	// Setup router
 	r := chi.NewRouter()
@@ -69,11 +117,10 @@ func main() {
 	r.Use(middleware.Recoverer)
 
 	// CORS middleware
-	allowedOrigins := getAllowedOrigins()
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   allowedOrigins,
-		AllowedMethods:   []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Moderator-ID"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Moderator-ID", "X-Spectator-ID"},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: true,
 		MaxAge:           300,
@@ -88,19 +135,55 @@ func main() {
 	r.Route("/api", func(r chi.Router) {
 		// WebSocket stats endpoint (for monitoring)
 		r.Get("/ws-stats", wsHandler.HandleWebSocketStats)
+		// Message schema versions and encodings for WebSocket and SSE clients
+		r.Get("/ws-protocol", wsHandler.HandleProtocol)
+		// Uploaded role videos and images
+		r.Get("/media/*", mediaHandler.ServeMedia)
 
 		r.Route("/games", func(r chi.Router) {
 			r.Post("/", gameHandler.CreateGame)
 			r.Get("/{id}", gameHandler.GetGame)
 			r.Patch("/{id}", gameHandler.UpdateGameStatus)
-			r.Delete("/{id}", handler.NotifyPlayerUpdate(gameHandler.DeleteGame, wsHandler, handler.GameDeleted))
-			r.Post("/{id}/join", handler.NotifyPlayerUpdate(gameHandler.JoinGame, wsHandler, handler.PlayerJoined))
+			r.Delete("/{id}", gameHandler.DeleteGame)
+			r.Post("/{id}/join", gameHandler.JoinGame)
+			r.Post("/{id}/token", gameHandler.RefreshToken)
 			r.Get("/{id}/players", gameHandler.GetPlayers)
-			r.Delete("/{id}/players/{player_id}", handler.NotifyPlayerUpdate(gameHandler.RemovePlayer, wsHandler, handler.PlayerLeft))
-			r.Post("/{id}/distribute-roles", handler.NotifyPlayerUpdate(gameHandler.DistributeRoles, wsHandler, handler.RolesDistributed))
+			r.Put("/{id}/seats", gameHandler.ArrangeSeats)
+			r.Post("/{id}/seats/randomize", gameHandler.RandomizeSeats)
+			r.Get("/{id}/players/{player_id}/neighbors", gameHandler.GetNeighbors)
+			r.Patch("/{id}/players/{player_id}", gameHandler.UpdatePlayer)
+			r.Delete("/{id}/players/{player_id}", gameHandler.RemovePlayer)
+			r.Post("/{id}/distribute-roles", gameHandler.DistributeRoles)
 			r.Get("/{id}/roles", gameHandler.GetGameRoles)
 			r.Get("/{id}/players/{player_id}/role", gameHandler.GetPlayerRole)
 			r.Get("/{id}/ws", wsHandler.HandleGameWebSocket)
+			r.Get("/{id}/events", wsHandler.HandleGameEvents) // Server-Sent Events fallback for the WebSocket
+
+			// Spectators
+			r.Post("/{id}/spectate", gameHandler.JoinAsSpectator)
+			r.Get("/{id}/spectators", gameHandler.GetSpectators)
+			r.Delete("/{id}/spectators/{spectator_id}", gameHandler.RemoveSpectator)
+			r.Patch("/{id}/spectator-settings", gameHandler.UpdateSpectatorSettings)
+
+			// Playing the game; the same actions are available as WebSocket commands
+			r.Post("/{id}/players/{player_id}/ready", playHandler.SetReady)
+			r.Post("/{id}/phase", playHandler.AdvancePhase)
+			r.Get("/{id}/votes", playHandler.GetVotes)
+			r.Post("/{id}/votes", playHandler.CastVote)
+			r.Get("/{id}/night-actions", playHandler.GetNightActions)
+			r.Post("/{id}/night-actions", playHandler.SubmitNightAction)
+			r.Get("/{id}/chat", playHandler.GetChat)
+			r.Post("/{id}/chat", playHandler.SendChat)
+			r.Post("/{id}/announcements", playHandler.SendAnnouncement)
+			r.Post("/{id}/players/{player_id}/mute", playHandler.SetMuted)
+
+			// Co-moderators and moderator audit log
+			r.Get("/{id}/moderators", moderatorHandler.ListModerators)
+			r.Post("/{id}/moderators", moderatorHandler.AddModerator)
+			r.Patch("/{id}/moderators/{moderator_id}", moderatorHandler.UpdateModerator)
+			r.Delete("/{id}/moderators/{moderator_id}", moderatorHandler.RemoveModerator)
+			r.Post("/{id}/transfer", moderatorHandler.TransferOwnership)
+			r.Get("/{id}/actions", moderatorHandler.GetActions)
 		})
 
 		r.Route("/roles", func(r chi.Router) {
@@ -110,9 +193,21 @@ func main() {
 
 		r.Route("/role-templates", func(r chi.Router) {
 			r.Get("/", roleTemplateHandler.GetRoleTemplates)
+			r.Get("/shared/{code}", roleTemplateHandler.GetSharedTemplate)
 			r.Get("/{id}", roleTemplateHandler.GetRoleTemplateByID)
 		})
 
+		// Templates moderators keep for themselves, share and submit for review
+		r.Route("/moderator/role-templates", func(r chi.Router) {
+			r.Get("/", roleTemplateHandler.GetModeratorTemplates)
+			r.Post("/", roleTemplateHandler.CreateModeratorTemplate)
+			r.Patch("/{id}", roleTemplateHandler.UpdateModeratorTemplate)
+			r.Delete("/{id}", roleTemplateHandler.DeleteModeratorTemplate)
+			r.Post("/{id}/share", roleTemplateHandler.ShareModeratorTemplate)
+			r.Delete("/{id}/share", roleTemplateHandler.UnshareModeratorTemplate)
+			r.Post("/{id}/submit", roleTemplateHandler.SubmitModeratorTemplate)
+		})
+
 		// Admin routes
 		r.Route("/admin", func(r chi.Router) {
 			// Public admin routes
@@ -138,14 +233,41 @@ func main() {
 					r.Post("/", roleHandler.CreateRole)
 					r.Patch("/{id}", roleHandler.UpdateRole)
 					r.Delete("/{id}", roleHandler.DeleteRole)
+					r.Get("/{id}/usage", roleHandler.GetRoleUsage)
+					r.Post("/{id}/archive", roleHandler.ArchiveRole)
+					r.Delete("/{id}/archive", roleHandler.RestoreRole)
+					r.Put("/{id}/video", roleHandler.UploadRoleVideo)
+					r.Put("/{id}/image", roleHandler.UploadRoleImage)
+					r.Delete("/{id}/image", roleHandler.RemoveRoleImage)
+					r.Get("/{id}/rules", roleHandler.GetRoleRules)
+					r.Post("/{id}/rules", roleHandler.AddRoleRule)
+					r.Delete("/{id}/rules/{rule_id}", roleHandler.DeleteRoleRule)
+					r.Get("/{id}/translations", roleHandler.GetRoleTranslations)
+					r.Put("/{id}/translations/{locale}", roleHandler.SetRoleTranslation)
+					r.Delete("/{id}/translations/{locale}", roleHandler.DeleteRoleTranslation)
 				})
 
 				// Role template management
 				r.Route("/role-templates", func(r chi.Router) {
 					r.Post("/", roleTemplateHandler.CreateRoleTemplate)
+					r.Get("/reviews", roleTemplateHandler.GetPendingTemplates)
+					r.Post("/{id}/approve", roleTemplateHandler.ApproveTemplate)
+					r.Post("/{id}/reject", roleTemplateHandler.RejectTemplate)
+					r.Post("/{id}/clone", roleTemplateHandler.CloneTemplate)
+					r.Get("/{id}/revisions", roleTemplateHandler.GetTemplateRevisions)
+					r.Get("/{id}/revisions/diff", roleTemplateHandler.DiffTemplateRevisions)
+					r.Get("/{id}/revisions/{number}", roleTemplateHandler.GetTemplateRevision)
+					r.Post("/{id}/revisions/{number}/rollback", roleTemplateHandler.RollbackTemplate)
 					r.Patch("/{id}", roleTemplateHandler.UpdateRoleTemplate)
 					r.Delete("/{id}", roleTemplateHandler.DeleteRoleTemplate)
+					r.Get("/{id}/translations", roleTemplateHandler.GetTemplateTranslations)
+					r.Put("/{id}/translations/{locale}", roleTemplateHandler.SetTemplateTranslation)
+					r.Delete("/{id}/translations/{locale}", roleTemplateHandler.DeleteTemplateTranslation)
 				})
+
+				// Catalog export and import, for moving roles and templates between servers
+				r.Get("/catalog", catalogHandler.ExportCatalog)
+				r.Post("/catalog/import", catalogHandler.ImportCatalog)
 			})
 		})
 	})
@@ -167,6 +289,155 @@ func healthHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(`{"status":"healthy"}`))
 }
 
+// newBroadcaster returns how WebSocket updates reach the other server instances
+// Reads WS_BROADCAST: "memory" (default) for a single instance, or "postgres"
+// to fan updates out to every instance using the database with LISTEN/NOTIFY
+func newBroadcaster(dbURL string) (handler.Broadcaster, error) {
+	switch backend := os.Getenv("WS_BROADCAST"); backend {
+	case "", "memory":
+		return handler.NewMemoryBroadcaster(), nil
+	case "postgres":
+		log.Println("Broadcasting WebSocket updates through Postgres LISTEN/NOTIFY")
+		return handler.NewPostgresBroadcaster(dbURL)
+	default:
+		return nil, fmt.Errorf("unknown WS_BROADCAST backend %q", backend)
+	}
+}
+
+// newMediaLibrary returns where uploaded role videos and images are stored
+// Reads MEDIA_STORAGE: "local" (default) keeps files in MEDIA_DIR, "s3" puts
+// them in the S3-compatible bucket set by S3_ENDPOINT, S3_REGION, S3_BUCKET,
+// S3_ACCESS_KEY_ID, S3_SECRET_ACCESS_KEY, S3_PATH_STYLE and S3_URL_EXPIRY.
+// MEDIA_PUBLIC_URL is where clients reach /api/media, and MEDIA_MAX_VIDEO_SIZE
+// and MEDIA_MAX_IMAGE_SIZE (bytes) limit uploads.
+func newMediaLibrary() (*media.Library, error) {
+	var store media.Store
+	switch backend := os.Getenv("MEDIA_STORAGE"); backend {
+	case "", "local":
+		dir := os.Getenv("MEDIA_DIR")
+		if dir == "" {
+			dir = "media"
+		}
+		local, err := media.NewLocalStore(dir)
+		if err != nil {
+			return nil, err
+		}
+		store = local
+	case "s3":
+		cfg := media.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			PathStyle: os.Getenv("S3_PATH_STYLE") == "true",
+		}
+		if raw := os.Getenv("S3_URL_EXPIRY"); raw != "" {
+			expiry, err := time.ParseDuration(raw)
+			if err != nil || expiry <= 0 {
+				return nil, fmt.Errorf("S3_URL_EXPIRY must be a positive duration, got %q", raw)
+			}
+			cfg.URLExpiry = expiry
+		}
+		s3, err := media.NewS3Store(cfg)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Storing uploaded media in S3 bucket %s", cfg.Bucket)
+		store = s3
+	default:
+		return nil, fmt.Errorf("unknown MEDIA_STORAGE backend %q", backend)
+	}
+
+	var limits media.Limits
+	sizes := []struct {
+		env   string
+		value *int64
+	}{
+		{"MEDIA_MAX_VIDEO_SIZE", &limits.MaxVideoSize},
+		{"MEDIA_MAX_IMAGE_SIZE", &limits.MaxImageSize},
+	}
+	for _, setting := range sizes {
+		if raw := os.Getenv(setting.env); raw != "" {
+			n, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("%s must be a positive number, got %q", setting.env, raw)
+			}
+			*setting.value = n
+		}
+	}
+
+	publicURL := os.Getenv("MEDIA_PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = "http://localhost:8080/api/media"
+	}
+
+	return media.NewLibrary(store, publicURL, limits), nil
+}
+
+// getHubConfig returns the WebSocket hub limits
+// Reads WS_MAX_CONNECTIONS_PER_GAME, WS_MAX_TOTAL_CONNECTIONS, WS_MAX_MESSAGE_SIZE
+// (bytes), WS_SEND_BUFFER_SIZE (messages) and WS_SLOW_CONSUMER_POLICY
+// (disconnect, drop_oldest or coalesce); unset values keep the hub defaults
+func getHubConfig() (handler.HubConfig, error) {
+	var config handler.HubConfig
+	ints := []struct {
+		env   string
+		value *int
+	}{
+		{"WS_MAX_CONNECTIONS_PER_GAME", &config.MaxConnectionsPerGame},
+		{"WS_MAX_TOTAL_CONNECTIONS", &config.MaxTotalConnections},
+		{"WS_SEND_BUFFER_SIZE", &config.SendBufferSize},
+	}
+	for _, setting := range ints {
+		if raw := os.Getenv(setting.env); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n <= 0 {
+				return config, fmt.Errorf("%s must be a positive number, got %q", setting.env, raw)
+			}
+			*setting.value = n
+		}
+	}
+
+	if raw := os.Getenv("WS_MAX_MESSAGE_SIZE"); raw != "" {
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || n <= 0 {
+			return config, fmt.Errorf("WS_MAX_MESSAGE_SIZE must be a positive number, got %q", raw)
+		}
+		config.MaxMessageSize = n
+	}
+
+	if raw := os.Getenv("WS_SLOW_CONSUMER_POLICY"); raw != "" {
+		config.SlowConsumerPolicy = handler.SlowConsumerPolicy(raw)
+		if !config.SlowConsumerPolicy.Valid() {
+			return config, fmt.Errorf("unknown WS_SLOW_CONSUMER_POLICY %q", raw)
+		}
+	}
+
+	return config, nil
+}
+
+// getDefaultLocale returns the locale of the texts stored on roles and templates
+// Reads from DEFAULT_LOCALE environment variable; defaults to English
+func getDefaultLocale() (string, error) {
+	if raw := os.Getenv("DEFAULT_LOCALE"); raw != "" {
+		return service.NormalizeLocale(raw)
+	}
+	return service.DefaultLocale, nil
+}
+
+// getWebhookURLs returns the URLs game events are posted to
+// Reads from WEBHOOK_URLS environment variable (comma-separated); empty disables webhooks
+func getWebhookURLs() []string {
+	var urls []string
+	for _, url := range strings.Split(os.Getenv("WEBHOOK_URLS"), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
 // getAllowedOrigins returns the list of allowed CORS origins
 // Reads from ALLOWED_ORIGINS environment variable (comma-separated)
 // Falls back to localhost origins for development
@@ -1,13 +1,26 @@
+// Command seed fills the database with the predefined roles, and exports or
+// imports the role catalog.
+//
+//	go run ./cmd/seed                                  # seed the predefined roles
+//	go run ./cmd/seed export -o catalog.yaml           # write every role and template
+//	go run ./cmd/seed import -dry-run catalog.yaml     # show what an import would change
+//	go run ./cmd/seed import -prune catalog.yaml       # import, deleting what the file leaves out
 package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strings"
 
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/internal/catalog"
 	"github.com/mafia-night/backend/internal/database"
 	"github.com/mafia-night/backend/internal/seed"
+	"github.com/mafia-night/backend/internal/service"
 )
 
 func main() {
@@ -20,6 +33,15 @@ func main() {
 		SSLMode:  getEnv("DB_SSLMODE", "disable"),
 	}
 
+	command := "roles"
+	args := os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+	if command != "roles" && command != "export" && command != "import" {
+		log.Fatalf("Unknown command %q; use roles, export or import", command)
+	}
+
 	client, err := database.NewEntClient(cfg)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
@@ -28,13 +50,158 @@ func main() {
 
 	ctx := context.Background()
 
-	// Run seed
+	switch command {
+	case "export":
+		err = exportCatalog(ctx, client, args)
+	case "import":
+		err = importCatalog(ctx, client, args)
+	default:
+		err = seedRoles(ctx, client)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func seedRoles(ctx context.Context, client *ent.Client) error {
 	fmt.Println("Seeding database with roles...")
-	if err := seed.SeedRoles(ctx, client); err != nil {
-		log.Fatalf("Failed to seed roles: %v", err)
+	// SEED_VIDEO_BASE_URL serves the predefined videos from your own host;
+	// videos uploaded below MEDIA_PUBLIC_URL are never overwritten
+	opts := seed.Options{
+		VideoBaseURL: os.Getenv("SEED_VIDEO_BASE_URL"),
+		MediaURL:     getEnv("MEDIA_PUBLIC_URL", "http://localhost:8080/api/media"),
+	}
+	if err := seed.SeedRoles(ctx, client, opts); err != nil {
+		return fmt.Errorf("failed to seed roles: %w", err)
 	}
 
 	fmt.Println("✅ Database seeded successfully!")
+	return nil
+}
+
+// exportCatalog writes the catalog to a file, or to stdout by default
+func exportCatalog(ctx context.Context, client *ent.Client, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	output := flags.String("o", "-", "file to write, or - for stdout")
+	formatName := flags.String("format", "", "yaml or json; defaults to the file extension, or yaml")
+	flags.Parse(args)
+
+	format, err := catalogFormat(*formatName, *output)
+	if err != nil {
+		return err
+	}
+
+	doc, err := newCatalogService(client).Export(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export catalog: %w", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	if err := catalog.Encode(w, doc, format); err != nil {
+		return fmt.Errorf("failed to write catalog: %w", err)
+	}
+
+	if *output != "-" {
+		fmt.Printf("✅ Exported %d roles and %d templates to %s\n", len(doc.Roles), len(doc.Templates), *output)
+	}
+	return nil
+}
+
+// importCatalog reads the catalog from a file, or from stdin for -
+func importCatalog(ctx context.Context, client *ent.Client, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "show the changes without making them")
+	prune := flags.Bool("prune", false, "delete roles and templates missing from the file, archiving roles still in use")
+	formatName := flags.String("format", "", "yaml or json; defaults to the file extension, or yaml")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: seed import [-dry-run] [-prune] [-format yaml|json] FILE")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	input := flags.Arg(0)
+
+	format, err := catalogFormat(*formatName, input)
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if input != "-" {
+		file, err := os.Open(input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	doc, err := catalog.Decode(r, format)
+	if err != nil {
+		return err
+	}
+
+	report, err := newCatalogService(client).Import(ctx, doc, service.ImportOptions{DryRun: *dryRun, Prune: *prune})
+	if err != nil {
+		return fmt.Errorf("failed to import catalog: %w", err)
+	}
+
+	printReport(report)
+	return nil
+}
+
+// catalogFormat picks the format named by the flag, or the one of the file
+func catalogFormat(name, path string) (catalog.Format, error) {
+	if name != "" {
+		return catalog.ParseFormat(name)
+	}
+	return catalog.FormatOf(path), nil
+}
+
+func newCatalogService(client *ent.Client) *service.CatalogService {
+	catalogService := service.NewCatalogService(client)
+	if raw := os.Getenv("DEFAULT_LOCALE"); raw != "" {
+		locale, err := service.NormalizeLocale(raw)
+		if err != nil {
+			log.Fatalf("invalid DEFAULT_LOCALE: %v", err)
+		}
+		catalogService.WithDefaultLocale(locale)
+	}
+	return catalogService
+}
+
+// printReport lists the changes of an import as a diff
+func printReport(report *service.ImportReport) {
+	signs := map[service.CatalogAction]string{
+		service.CatalogCreate: "+",
+		service.CatalogUpdate: "~",
+		service.CatalogDelete: "-",
+	}
+	for _, c := range report.Changes {
+		line := fmt.Sprintf("%s %s %s", signs[c.Action], c.Kind, c.Key)
+		if len(c.Fields) > 0 {
+			line += " (" + strings.Join(c.Fields, ", ") + ")"
+		}
+		fmt.Println(line)
+	}
+
+	summary := fmt.Sprintf("%d changes, %d unchanged", len(report.Changes), report.Unchanged)
+	if report.DryRun {
+		fmt.Printf("Dry run: %s; nothing was written\n", summary)
+		return
+	}
+	fmt.Printf("✅ Catalog imported: %s\n", summary)
 }
 
 func getEnv(key, fallback string) string {
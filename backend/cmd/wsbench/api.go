@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// apiClient calls the REST API of the server under test
+type apiClient struct {
+	baseURL string
+	http    *http.Client
+}
+
+func newAPIClient(baseURL string) *apiClient {
+	return &apiClient{
+		baseURL: baseURL,
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// createdGame is the part of a created game the benchmark needs
+type createdGame struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
+
+// joinedPlayer is the part of a joined player the benchmark needs
+type joinedPlayer struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Token string `json:"token"`
+}
+
+func (c *apiClient) createGame(ctx context.Context, moderatorID string) (createdGame, error) {
+	var g createdGame
+	err := c.do(ctx, http.MethodPost, "/api/games", moderatorID, nil, http.StatusCreated, &g)
+	return g, err
+}
+
+func (c *apiClient) deleteGame(ctx context.Context, gameID, moderatorID string) error {
+	return c.do(ctx, http.MethodDelete, "/api/games/"+gameID, moderatorID, nil, http.StatusNoContent, nil)
+}
+
+func (c *apiClient) joinGame(ctx context.Context, gameID, name string) (joinedPlayer, error) {
+	var p joinedPlayer
+	err := c.do(ctx, http.MethodPost, "/api/games/"+gameID+"/join", "", map[string]string{"name": name}, http.StatusOK, &p)
+	return p, err
+}
+
+func (c *apiClient) removePlayer(ctx context.Context, gameID, playerID string) error {
+	return c.do(ctx, http.MethodDelete, "/api/games/"+gameID+"/players/"+playerID, "", nil, http.StatusNoContent, nil)
+}
+
+// distributeRoles hands every player the same role, which is all the hub needs to fan out
+func (c *apiClient) distributeRoles(ctx context.Context, gameID, moderatorID, roleID string, players int) error {
+	body := map[string]any{"roles": []map[string]any{{"role_id": roleID, "count": players}}}
+	return c.do(ctx, http.MethodPost, "/api/games/"+gameID+"/distribute-roles", moderatorID, body, http.StatusOK, nil)
+}
+
+// firstRoleID returns the ID of any role, or "" if the server has none
+func (c *apiClient) firstRoleID(ctx context.Context) (string, error) {
+	var roles []struct {
+		ID string `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/roles", "", nil, http.StatusOK, &roles); err != nil {
+		return "", err
+	}
+	if len(roles) == 0 {
+		return "", nil
+	}
+	return roles[0].ID, nil
+}
+
+// hubStats returns the hub's connection statistics
+func (c *apiClient) hubStats(ctx context.Context) (map[string]any, error) {
+	var stats map[string]any
+	err := c.do(ctx, http.MethodGet, "/api/ws-stats", "", nil, http.StatusOK, &stats)
+	return stats, err
+}
+
+// do sends a request, optionally as a moderator, and decodes the response into out
+func (c *apiClient) do(ctx context.Context, method, path, moderatorID string, body any, wantStatus int, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if moderatorID != "" {
+		req.Header.Set("X-Moderator-ID", moderatorID)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, bytes.TrimSpace(message))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gorilla/websocket"
+)
+
+// simClient is one simulated player or moderator connection
+type simClient struct {
+	gameID   string
+	playerID string
+	name     string
+	conn     *websocket.Conn
+	rec      *recorder
+	closing  atomic.Bool
+}
+
+// message is the part of a server message the benchmark reads, in any protocol version
+type message struct {
+	Type    string          `json:"type"`
+	GameID  string          `json:"game_id"`
+	Payload json.RawMessage `json:"payload"`
+	// Data replaces Payload from protocol version 2
+	Data json.RawMessage `json:"data"`
+}
+
+var cborDecMode, _ = cbor.DecOptions{
+	DefaultMapType: reflect.TypeOf(map[string]any(nil)),
+}.DecMode()
+
+// wsURL turns the API base URL into the WebSocket URL of a game
+func wsURL(baseURL, gameID, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/api/games/" + gameID + "/ws"
+	u.RawQuery = url.Values{"token": {token}}.Encode()
+	return u.String(), nil
+}
+
+// connect opens a connection and waits for its initial state, recording how long that took
+func connect(ctx context.Context, baseURL, gameID, token, protocol string, rec *recorder) (*simClient, error) {
+	target, err := wsURL(baseURL, gameID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := websocket.Dialer{HandshakeTimeout: 10 * time.Second}
+	if protocol != "" {
+		dialer.Subprotocols = []string{protocol}
+	}
+
+	start := time.Now()
+	conn, _, err := dialer.DialContext(ctx, target, nil)
+	if err != nil {
+		rec.connectFailed(false)
+		return nil, err
+	}
+
+	c := &simClient{gameID: gameID, conn: conn, rec: rec}
+
+	// The hub closes connections over its limits right after the upgrade
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	for {
+		messages, err := c.read()
+		if err != nil {
+			conn.Close()
+			rec.connectFailed(true)
+			return nil, err
+		}
+		if hasType(messages, "initial_state") {
+			break
+		}
+		c.observe(messages)
+	}
+	conn.SetReadDeadline(time.Time{})
+	rec.connected(time.Since(start))
+
+	go c.readLoop()
+	return c, nil
+}
+
+// close disconnects the client on purpose, so it no longer counts towards broadcasts
+func (c *simClient) close() {
+	c.closing.Store(true)
+	c.rec.forget(c)
+	c.conn.Close()
+}
+
+func (c *simClient) readLoop() {
+	for {
+		messages, err := c.read()
+		if err != nil {
+			if !c.closing.Load() {
+				// The server dropped us; pending broadcasts count as dropped
+				c.rec.disconnect()
+			}
+			return
+		}
+		c.observe(messages)
+	}
+}
+
+// read returns the messages of the next frame. Version 1 may put several
+// JSON messages into one frame; binary frames hold a single CBOR message.
+func (c *simClient) read() ([]message, error) {
+	frameType, data, err := c.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	if frameType == websocket.BinaryMessage {
+		var v any
+		if err := cborDecMode.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		if data, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	var messages []message
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var m message
+		if err := decoder.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				return messages, nil
+			}
+			return messages, err
+		}
+		if m.Payload == nil {
+			m.Payload = m.Data
+		}
+		messages = append(messages, m)
+	}
+}
+
+// observe tells the recorder about the broadcasts the benchmark waits for
+func (c *simClient) observe(messages []message) {
+	for _, m := range messages {
+		var payload struct {
+			Name     string `json:"name"`
+			PlayerID string `json:"player_id"`
+		}
+		json.Unmarshal(m.Payload, &payload)
+
+		key := eventKey{gameID: m.GameID, eventType: m.Type}
+		switch m.Type {
+		case "player_joined":
+			key.key = payload.Name
+		case "player_left":
+			key.key = payload.PlayerID
+		case "roles_distributed":
+		default:
+			continue
+		}
+		c.rec.received(key, c)
+	}
+}
+
+func hasType(messages []message, messageType string) bool {
+	for _, m := range messages {
+		if m.Type == messageType {
+			return true
+		}
+	}
+	return false
+}
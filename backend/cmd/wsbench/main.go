@@ -0,0 +1,280 @@
+// Command wsbench load-tests the WebSocket hub of a running server.
+//
+// It creates games through the API, joins simulated players and opens their
+// connections, then keeps players joining and leaving. At the end of each
+// round it distributes roles and deletes the game, and the slot starts over
+// with a new game. It reports how long connections took to receive their
+// initial state, how long broadcasts took to reach every connection, and how
+// many never arrived.
+//
+//	go run ./cmd/wsbench -url http://localhost:8080 -games 10 -players 200
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+)
+
+// config holds the command line settings
+type config struct {
+	baseURL  string
+	games    int
+	players  int
+	duration time.Duration
+	round    time.Duration
+	churn    time.Duration
+	timeout  time.Duration
+	protocol string
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.baseURL, "url", "http://localhost:8080", "base URL of the server under test")
+	flag.IntVar(&cfg.games, "games", 10, "number of games played at the same time")
+	flag.IntVar(&cfg.players, "players", 20, "players joined to each game")
+	flag.DurationVar(&cfg.duration, "duration", 30*time.Second, "how long to run")
+	flag.DurationVar(&cfg.round, "round", 10*time.Second, "how long a game churns before its roles are distributed and it is replaced")
+	flag.DurationVar(&cfg.churn, "churn", 500*time.Millisecond, "how often a player of each game leaves or joins")
+	flag.DurationVar(&cfg.timeout, "timeout", 5*time.Second, "how long a broadcast may take before it counts as dropped")
+	flag.StringVar(&cfg.protocol, "protocol", "", "WebSocket subprotocol to negotiate, such as mafia-night.v2.cbor")
+	flag.Parse()
+
+	cfg.baseURL = strings.TrimSuffix(cfg.baseURL, "/")
+	if cfg.games <= 0 || cfg.players <= 0 {
+		log.Fatal("-games and -players must be positive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	api := newAPIClient(cfg.baseURL)
+	rec := newRecorder()
+
+	before, err := api.hubStats(ctx)
+	if err != nil {
+		log.Fatalf("failed to reach %s: %v", cfg.baseURL, err)
+	}
+
+	roleID, err := api.firstRoleID(ctx)
+	if err != nil {
+		log.Fatalf("failed to list roles: %v", err)
+	}
+	if roleID == "" {
+		log.Println("No roles on the server; skipping role distribution")
+	}
+
+	fmt.Printf("Running %d games of %d players against %s for %v\n", cfg.games, cfg.players, cfg.baseURL, cfg.duration)
+
+	runCtx, cancel := context.WithTimeout(ctx, cfg.duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.games; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slot := &gameSlot{cfg: cfg, api: api, rec: rec, roleID: roleID, index: i}
+			slot.run(runCtx)
+		}()
+	}
+	wg.Wait()
+
+	// Give the last broadcasts their chance to arrive
+	time.Sleep(cfg.timeout)
+	rec.expireAll()
+
+	after, err := api.hubStats(context.Background())
+	if err != nil {
+		log.Printf("failed to read hub stats: %v", err)
+	}
+	report(rec, before, after)
+}
+
+// gameSlot plays one game after another for the length of the benchmark
+type gameSlot struct {
+	cfg    config
+	api    *apiClient
+	rec    *recorder
+	roleID string
+	index  int
+
+	round       int
+	gameID      string
+	moderatorID string
+	moderator   *simClient
+	players     []*simClient
+	// members counts the players in the game, including those whose connection failed
+	members int
+	joined  int
+}
+
+func (s *gameSlot) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := s.play(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("game slot %d: %v", s.index, err)
+			// Avoid hammering a server that is refusing us
+			time.Sleep(time.Second)
+		}
+		s.round++
+	}
+}
+
+// play runs one round: create and fill a game, churn players, distribute roles, delete it
+func (s *gameSlot) play(ctx context.Context) error {
+	s.moderatorID = fmt.Sprintf("wsbench-mod-%d-%d-%d", s.index, s.round, rand.IntN(1_000_000))
+	s.players, s.members, s.joined, s.moderator = nil, 0, 0, nil
+
+	g, err := s.api.createGame(ctx, s.moderatorID)
+	s.rec.operation("create", err)
+	if err != nil {
+		return err
+	}
+	s.gameID = g.ID
+	defer s.teardown()
+
+	if s.moderator, err = connect(ctx, s.cfg.baseURL, g.ID, g.Token, s.cfg.protocol, s.rec); err != nil {
+		return fmt.Errorf("moderator connection: %w", err)
+	}
+
+	for i := 0; i < s.cfg.players && ctx.Err() == nil; i++ {
+		s.join(ctx)
+	}
+
+	roundCtx, cancel := context.WithTimeout(ctx, s.cfg.round)
+	defer cancel()
+
+	ticker := time.NewTicker(s.cfg.churn)
+	defer ticker.Stop()
+	for leave := true; ; leave = !leave {
+		select {
+		case <-roundCtx.Done():
+			if ctx.Err() == nil {
+				s.distribute(ctx)
+			}
+			return nil
+		case <-ticker.C:
+			if leave && len(s.players) > 0 {
+				s.leave(ctx)
+			} else {
+				s.join(ctx)
+			}
+		}
+	}
+}
+
+// connected returns the connections that should hear about the game's broadcasts
+func (s *gameSlot) connected() []*simClient {
+	clients := append([]*simClient{}, s.players...)
+	if s.moderator != nil {
+		clients = append(clients, s.moderator)
+	}
+	return clients
+}
+
+func (s *gameSlot) join(ctx context.Context) {
+	name := fmt.Sprintf("bench-%d-%d-%d", s.index, s.round, s.joined)
+	s.joined++
+
+	key := eventKey{gameID: s.gameID, eventType: "player_joined", key: name}
+	s.rec.expect(key, s.connected(), s.cfg.timeout)
+
+	p, err := s.api.joinGame(ctx, s.gameID, name)
+	s.rec.operation("join", err)
+	if err != nil {
+		s.rec.cancel(key)
+		return
+	}
+	s.members++
+
+	client, err := connect(ctx, s.cfg.baseURL, s.gameID, p.Token, s.cfg.protocol, s.rec)
+	if err != nil {
+		return
+	}
+	client.playerID, client.name = p.ID, p.Name
+	s.players = append(s.players, client)
+}
+
+func (s *gameSlot) leave(ctx context.Context) {
+	i := rand.IntN(len(s.players))
+	client := s.players[i]
+	s.players = append(s.players[:i], s.players[i+1:]...)
+	client.close()
+
+	key := eventKey{gameID: s.gameID, eventType: "player_left", key: client.playerID}
+	s.rec.expect(key, s.connected(), s.cfg.timeout)
+
+	err := s.api.removePlayer(ctx, s.gameID, client.playerID)
+	s.rec.operation("leave", err)
+	if err != nil {
+		s.rec.cancel(key)
+		return
+	}
+	s.members--
+}
+
+func (s *gameSlot) distribute(ctx context.Context) {
+	if s.roleID == "" || s.members == 0 {
+		return
+	}
+
+	key := eventKey{gameID: s.gameID, eventType: "roles_distributed"}
+	s.rec.expect(key, s.connected(), s.cfg.timeout)
+
+	err := s.api.distributeRoles(ctx, s.gameID, s.moderatorID, s.roleID, s.members)
+	s.rec.operation("distribute", err)
+	if err != nil {
+		s.rec.cancel(key)
+	}
+}
+
+// teardown disconnects everyone and deletes the game
+func (s *gameSlot) teardown() {
+	for _, c := range s.connected() {
+		c.close()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s.rec.operation("delete", s.api.deleteGame(ctx, s.gameID, s.moderatorID))
+}
+
+// report prints the results and the hub's slow consumer counters over the run
+func report(rec *recorder, before, after map[string]any) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	fmt.Println()
+	fmt.Printf("Connections:     %d connected, %d failed, %d rejected and %d dropped by the hub\n",
+		len(rec.connect), rec.connectErrors, rec.rejected, rec.disconnected)
+	fmt.Printf("Connect latency: %s\n", percentiles(rec.connect))
+	fmt.Printf("Fan-out latency: %s\n", percentiles(rec.fanout))
+	fmt.Printf("Dropped:         %d broadcasts never reached a connection\n", rec.dropped)
+
+	fmt.Print("Operations:     ")
+	for _, name := range []string{"create", "join", "leave", "distribute", "delete"} {
+		if rec.operations[name] > 0 {
+			fmt.Printf(" %s=%d (failed %d)", name, rec.operations[name], rec.failures[name])
+		}
+	}
+	fmt.Println()
+
+	if after != nil {
+		fmt.Print("Hub:            ")
+		for _, name := range []string{"dropped_messages", "coalesced_messages", "slow_consumer_disconnects"} {
+			delta, _ := after[name].(float64)
+			if start, ok := before[name].(float64); ok {
+				delta -= start
+			}
+			fmt.Printf(" %s=+%.0f", name, delta)
+		}
+		fmt.Println()
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// eventKey identifies a broadcast the benchmark caused
+type eventKey struct {
+	gameID    string
+	eventType string
+	// key tells apart updates of the same type, such as the name of a joining player
+	key string
+}
+
+// expectation is a broadcast that the connections of a game are waiting for
+type expectation struct {
+	start   time.Time
+	waiting map[*simClient]bool
+}
+
+// recorder collects latencies and counts dropped broadcasts.
+// It is safe for concurrent use.
+type recorder struct {
+	mu      sync.Mutex
+	pending map[eventKey]*expectation
+
+	connect       []time.Duration
+	fanout        []time.Duration
+	connectErrors int
+	rejected      int
+	disconnected  int
+	dropped       int
+	operations    map[string]int
+	failures      map[string]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		pending:    make(map[eventKey]*expectation),
+		operations: make(map[string]int),
+		failures:   make(map[string]int),
+	}
+}
+
+// connected records how long a connection took to receive its initial state
+func (r *recorder) connected(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.connect = append(r.connect, d)
+}
+
+// connectFailed records a connection that failed. Rejected connections were
+// upgraded and then closed by the hub, as happens at its connection limits.
+func (r *recorder) connectFailed(rejected bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if rejected {
+		r.rejected++
+	} else {
+		r.connectErrors++
+	}
+}
+
+// disconnect records the server closing a connection the benchmark meant to keep
+func (r *recorder) disconnect() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.disconnected++
+}
+
+// operation counts an API call, and whether it failed
+func (r *recorder) operation(name string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operations[name]++
+	if err != nil {
+		r.failures[name]++
+	}
+}
+
+// expect starts waiting for a broadcast to reach clients. Clients that have
+// not received it by the timeout count as dropped.
+func (r *recorder) expect(key eventKey, clients []*simClient, timeout time.Duration) {
+	waiting := make(map[*simClient]bool, len(clients))
+	for _, c := range clients {
+		waiting[c] = true
+	}
+
+	r.mu.Lock()
+	r.pending[key] = &expectation{start: time.Now(), waiting: waiting}
+	r.mu.Unlock()
+
+	time.AfterFunc(timeout, func() { r.expire(key) })
+}
+
+// cancel stops waiting for a broadcast whose operation failed
+func (r *recorder) cancel(key eventKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.pending, key)
+}
+
+// received records a broadcast reaching a client
+func (r *recorder) received(key eventKey, c *simClient) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.pending[key]
+	if !ok || !e.waiting[c] {
+		return
+	}
+	delete(e.waiting, c)
+	r.fanout = append(r.fanout, time.Since(e.start))
+	if len(e.waiting) == 0 {
+		delete(r.pending, key)
+	}
+}
+
+// forget stops waiting on a client the benchmark disconnected on purpose
+func (r *recorder) forget(c *simClient) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.pending {
+		delete(e.waiting, c)
+	}
+}
+
+// expire counts the clients still waiting for a broadcast as dropped
+func (r *recorder) expire(key eventKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.pending[key]; ok {
+		r.dropped += len(e.waiting)
+		delete(r.pending, key)
+	}
+}
+
+// expireAll counts every broadcast still outstanding as dropped
+func (r *recorder) expireAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, e := range r.pending {
+		r.dropped += len(e.waiting)
+		delete(r.pending, key)
+	}
+}
+
+// percentiles summarizes latencies as p50, p90, p99 and the maximum
+func percentiles(latencies []time.Duration) string {
+	if len(latencies) == 0 {
+		return "no samples"
+	}
+
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	at := func(p float64) time.Duration {
+		i := int(p*float64(len(sorted))+0.5) - 1
+		return sorted[max(0, min(i, len(sorted)-1))]
+	}
+
+	return fmt.Sprintf("n=%d p50=%v p90=%v p99=%v max=%v",
+		len(sorted), at(0.50), at(0.90), at(0.99), sorted[len(sorted)-1])
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentiles(t *testing.T) {
+	assert.Equal(t, "no samples", percentiles(nil))
+
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		// Reverse order, to check the samples get sorted
+		latencies[i] = time.Duration(100-i) * time.Millisecond
+	}
+	assert.Equal(t, "n=100 p50=50ms p90=90ms p99=99ms max=100ms", percentiles(latencies))
+	assert.Equal(t, time.Duration(100)*time.Millisecond, latencies[0], "the samples are left alone")
+
+	assert.Equal(t, "n=1 p50=7ms p90=7ms p99=7ms max=7ms", percentiles([]time.Duration{7 * time.Millisecond}))
+}
+
+func TestRecorder(t *testing.T) {
+	rec := newRecorder()
+	alice, bob, carol := &simClient{rec: rec}, &simClient{rec: rec}, &simClient{rec: rec}
+	key := eventKey{gameID: "ABC123", eventType: "player_joined", key: "dave"}
+
+	rec.expect(key, []*simClient{alice, bob, carol}, time.Hour)
+	rec.received(key, alice)
+	rec.received(key, alice)
+	rec.received(eventKey{gameID: "ABC123", eventType: "player_joined", key: "erin"}, bob)
+	rec.forget(carol)
+
+	assert.Len(t, rec.fanout, 1, "each client counts once, and only for the update it waits for")
+
+	rec.expireAll()
+	assert.Equal(t, 1, rec.dropped, "bob never received the update; carol left")
+
+	t.Run("expires after the timeout", func(t *testing.T) {
+		rec := newRecorder()
+		rec.expect(key, []*simClient{alice}, 10*time.Millisecond)
+
+		require.Eventually(t, func() bool {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			return rec.dropped == 1
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("cancelled operations drop nothing", func(t *testing.T) {
+		rec := newRecorder()
+		rec.expect(key, []*simClient{alice}, time.Hour)
+		rec.cancel(key)
+		rec.expireAll()
+		assert.Zero(t, rec.dropped)
+	})
+}
+
+func TestWSURL(t *testing.T) {
+	got, err := wsURL("http://localhost:8080", "ABC123", "tok")
+	require.NoError(t, err)
+	assert.Equal(t, "ws://localhost:8080/api/games/ABC123/ws?token=tok", got)
+
+	got, err = wsURL("https://mafia.example.com/backend/", "ABC123", "tok")
+	require.NoError(t, err)
+	assert.Equal(t, "wss://mafia.example.com/backend/api/games/ABC123/ws?token=tok", got)
+}
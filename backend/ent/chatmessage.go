@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/game"
+)
+
+// ChatMessage is the model entity for the ChatMessage schema.
+type ChatMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// GameID holds the value of the "game_id" field.
+	GameID string `json:"game_id,omitempty"`
+	// Channel the message was sent to, which decides who may read it
+	Channel chatmessage.Channel `json:"channel,omitempty"`
+	// Round of the game the message was sent in
+	Round int `json:"round,omitempty"`
+	// Player who sent the message; empty for moderator announcements
+	PlayerID *uuid.UUID `json:"player_id,omitempty"`
+	// Moderator who sent an announcement
+	ModeratorID string `json:"moderator_id,omitempty"`
+	// SenderName holds the value of the "sender_name" field.
+	SenderName string `json:"sender_name,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChatMessageQuery when eager-loading is set.
+	Edges        ChatMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChatMessageEdges holds the relations/edges for other nodes in the graph.
+type ChatMessageEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChatMessageEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChatMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldPlayerID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case chatmessage.FieldRound:
+			values[i] = new(sql.NullInt64)
+		case chatmessage.FieldGameID, chatmessage.FieldChannel, chatmessage.FieldModeratorID, chatmessage.FieldSenderName, chatmessage.FieldText:
+			values[i] = new(sql.NullString)
+		case chatmessage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case chatmessage.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChatMessage fields.
+func (_m *ChatMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case chatmessage.FieldGameID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field game_id", values[i])
+			} else if value.Valid {
+				_m.GameID = value.String
+			}
+		case chatmessage.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = chatmessage.Channel(value.String)
+			}
+		case chatmessage.FieldRound:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field round", values[i])
+			} else if value.Valid {
+				_m.Round = int(value.Int64)
+			}
+		case chatmessage.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				_m.PlayerID = new(uuid.UUID)
+				*_m.PlayerID = *value.S.(*uuid.UUID)
+			}
+		case chatmessage.FieldModeratorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderator_id", values[i])
+			} else if value.Valid {
+				_m.ModeratorID = value.String
+			}
+		case chatmessage.FieldSenderName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_name", values[i])
+			} else if value.Valid {
+				_m.SenderName = value.String
+			}
+		case chatmessage.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case chatmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChatMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChatMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGame queries the "game" edge of the ChatMessage entity.
+func (_m *ChatMessage) QueryGame() *GameQuery {
+	return NewChatMessageClient(_m.config).QueryGame(_m)
+}
+
+// Update returns a builder for updating this ChatMessage.
+// Note that you need to call ChatMessage.Unwrap() before calling this method if this ChatMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChatMessage) Update() *ChatMessageUpdateOne {
+	return NewChatMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChatMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChatMessage) Unwrap() *ChatMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChatMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChatMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChatMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("game_id=")
+	builder.WriteString(_m.GameID)
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Channel))
+	builder.WriteString(", ")
+	builder.WriteString("round=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Round))
+	builder.WriteString(", ")
+	if v := _m.PlayerID; v != nil {
+		builder.WriteString("player_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("moderator_id=")
+	builder.WriteString(_m.ModeratorID)
+	builder.WriteString(", ")
+	builder.WriteString("sender_name=")
+	builder.WriteString(_m.SenderName)
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChatMessages is a parsable slice of ChatMessage.
+type ChatMessages []*ChatMessage
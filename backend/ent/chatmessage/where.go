@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chatmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldID, id))
+}
+
+// GameID applies equality check predicate on the "game_id" field. It's identical to GameIDEQ.
+func GameID(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldGameID, v))
+}
+
+// Round applies equality check predicate on the "round" field. It's identical to RoundEQ.
+func Round(v int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldRound, v))
+}
+
+// PlayerID applies equality check predicate on the "player_id" field. It's identical to PlayerIDEQ.
+func PlayerID(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// ModeratorID applies equality check predicate on the "moderator_id" field. It's identical to ModeratorIDEQ.
+func ModeratorID(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// SenderName applies equality check predicate on the "sender_name" field. It's identical to SenderNameEQ.
+func SenderName(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldSenderName, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldText, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GameIDEQ applies the EQ predicate on the "game_id" field.
+func GameIDEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldGameID, v))
+}
+
+// GameIDNEQ applies the NEQ predicate on the "game_id" field.
+func GameIDNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldGameID, v))
+}
+
+// GameIDIn applies the In predicate on the "game_id" field.
+func GameIDIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldGameID, vs...))
+}
+
+// GameIDNotIn applies the NotIn predicate on the "game_id" field.
+func GameIDNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldGameID, vs...))
+}
+
+// GameIDGT applies the GT predicate on the "game_id" field.
+func GameIDGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldGameID, v))
+}
+
+// GameIDGTE applies the GTE predicate on the "game_id" field.
+func GameIDGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldGameID, v))
+}
+
+// GameIDLT applies the LT predicate on the "game_id" field.
+func GameIDLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldGameID, v))
+}
+
+// GameIDLTE applies the LTE predicate on the "game_id" field.
+func GameIDLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldGameID, v))
+}
+
+// GameIDContains applies the Contains predicate on the "game_id" field.
+func GameIDContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldGameID, v))
+}
+
+// GameIDHasPrefix applies the HasPrefix predicate on the "game_id" field.
+func GameIDHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldGameID, v))
+}
+
+// GameIDHasSuffix applies the HasSuffix predicate on the "game_id" field.
+func GameIDHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldGameID, v))
+}
+
+// GameIDEqualFold applies the EqualFold predicate on the "game_id" field.
+func GameIDEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldGameID, v))
+}
+
+// GameIDContainsFold applies the ContainsFold predicate on the "game_id" field.
+func GameIDContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldGameID, v))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v Channel) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v Channel) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...Channel) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...Channel) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// RoundEQ applies the EQ predicate on the "round" field.
+func RoundEQ(v int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldRound, v))
+}
+
+// RoundNEQ applies the NEQ predicate on the "round" field.
+func RoundNEQ(v int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldRound, v))
+}
+
+// RoundIn applies the In predicate on the "round" field.
+func RoundIn(vs ...int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldRound, vs...))
+}
+
+// RoundNotIn applies the NotIn predicate on the "round" field.
+func RoundNotIn(vs ...int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldRound, vs...))
+}
+
+// RoundGT applies the GT predicate on the "round" field.
+func RoundGT(v int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldRound, v))
+}
+
+// RoundGTE applies the GTE predicate on the "round" field.
+func RoundGTE(v int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldRound, v))
+}
+
+// RoundLT applies the LT predicate on the "round" field.
+func RoundLT(v int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldRound, v))
+}
+
+// RoundLTE applies the LTE predicate on the "round" field.
+func RoundLTE(v int) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldRound, v))
+}
+
+// PlayerIDEQ applies the EQ predicate on the "player_id" field.
+func PlayerIDEQ(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// PlayerIDNEQ applies the NEQ predicate on the "player_id" field.
+func PlayerIDNEQ(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldPlayerID, v))
+}
+
+// PlayerIDIn applies the In predicate on the "player_id" field.
+func PlayerIDIn(vs ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDNotIn applies the NotIn predicate on the "player_id" field.
+func PlayerIDNotIn(vs ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDGT applies the GT predicate on the "player_id" field.
+func PlayerIDGT(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldPlayerID, v))
+}
+
+// PlayerIDGTE applies the GTE predicate on the "player_id" field.
+func PlayerIDGTE(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldPlayerID, v))
+}
+
+// PlayerIDLT applies the LT predicate on the "player_id" field.
+func PlayerIDLT(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldPlayerID, v))
+}
+
+// PlayerIDLTE applies the LTE predicate on the "player_id" field.
+func PlayerIDLTE(v uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldPlayerID, v))
+}
+
+// PlayerIDIsNil applies the IsNil predicate on the "player_id" field.
+func PlayerIDIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldPlayerID))
+}
+
+// PlayerIDNotNil applies the NotNil predicate on the "player_id" field.
+func PlayerIDNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldPlayerID))
+}
+
+// ModeratorIDEQ applies the EQ predicate on the "moderator_id" field.
+func ModeratorIDEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDNEQ applies the NEQ predicate on the "moderator_id" field.
+func ModeratorIDNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDIn applies the In predicate on the "moderator_id" field.
+func ModeratorIDIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDNotIn applies the NotIn predicate on the "moderator_id" field.
+func ModeratorIDNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDGT applies the GT predicate on the "moderator_id" field.
+func ModeratorIDGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldModeratorID, v))
+}
+
+// ModeratorIDGTE applies the GTE predicate on the "moderator_id" field.
+func ModeratorIDGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldModeratorID, v))
+}
+
+// ModeratorIDLT applies the LT predicate on the "moderator_id" field.
+func ModeratorIDLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldModeratorID, v))
+}
+
+// ModeratorIDLTE applies the LTE predicate on the "moderator_id" field.
+func ModeratorIDLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldModeratorID, v))
+}
+
+// ModeratorIDContains applies the Contains predicate on the "moderator_id" field.
+func ModeratorIDContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldModeratorID, v))
+}
+
+// ModeratorIDHasPrefix applies the HasPrefix predicate on the "moderator_id" field.
+func ModeratorIDHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldModeratorID, v))
+}
+
+// ModeratorIDHasSuffix applies the HasSuffix predicate on the "moderator_id" field.
+func ModeratorIDHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldModeratorID, v))
+}
+
+// ModeratorIDIsNil applies the IsNil predicate on the "moderator_id" field.
+func ModeratorIDIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldModeratorID))
+}
+
+// ModeratorIDNotNil applies the NotNil predicate on the "moderator_id" field.
+func ModeratorIDNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldModeratorID))
+}
+
+// ModeratorIDEqualFold applies the EqualFold predicate on the "moderator_id" field.
+func ModeratorIDEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldModeratorID, v))
+}
+
+// ModeratorIDContainsFold applies the ContainsFold predicate on the "moderator_id" field.
+func ModeratorIDContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldModeratorID, v))
+}
+
+// SenderNameEQ applies the EQ predicate on the "sender_name" field.
+func SenderNameEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldSenderName, v))
+}
+
+// SenderNameNEQ applies the NEQ predicate on the "sender_name" field.
+func SenderNameNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldSenderName, v))
+}
+
+// SenderNameIn applies the In predicate on the "sender_name" field.
+func SenderNameIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldSenderName, vs...))
+}
+
+// SenderNameNotIn applies the NotIn predicate on the "sender_name" field.
+func SenderNameNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldSenderName, vs...))
+}
+
+// SenderNameGT applies the GT predicate on the "sender_name" field.
+func SenderNameGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldSenderName, v))
+}
+
+// SenderNameGTE applies the GTE predicate on the "sender_name" field.
+func SenderNameGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldSenderName, v))
+}
+
+// SenderNameLT applies the LT predicate on the "sender_name" field.
+func SenderNameLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldSenderName, v))
+}
+
+// SenderNameLTE applies the LTE predicate on the "sender_name" field.
+func SenderNameLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldSenderName, v))
+}
+
+// SenderNameContains applies the Contains predicate on the "sender_name" field.
+func SenderNameContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldSenderName, v))
+}
+
+// SenderNameHasPrefix applies the HasPrefix predicate on the "sender_name" field.
+func SenderNameHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldSenderName, v))
+}
+
+// SenderNameHasSuffix applies the HasSuffix predicate on the "sender_name" field.
+func SenderNameHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldSenderName, v))
+}
+
+// SenderNameEqualFold applies the EqualFold predicate on the "sender_name" field.
+func SenderNameEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldSenderName, v))
+}
+
+// SenderNameContainsFold applies the ContainsFold predicate on the "sender_name" field.
+func SenderNameContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldSenderName, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldText, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasGame applies the HasEdge predicate on the "game" edge.
+func HasGame() predicate.ChatMessage {
+	return predicate.ChatMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGameWith applies the HasEdge predicate on the "game" edge with a given conditions (other predicates).
+func HasGameWith(preds ...predicate.Game) predicate.ChatMessage {
+	return predicate.ChatMessage(func(s *sql.Selector) {
+		step := newGameStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChatMessage) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChatMessage) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChatMessage) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.NotPredicates(p))
+}
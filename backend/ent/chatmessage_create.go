@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/game"
+)
+
+// ChatMessageCreate is the builder for creating a ChatMessage entity.
+type ChatMessageCreate struct {
+	config
+	mutation *ChatMessageMutation
+	hooks    []Hook
+}
+
+// SetGameID sets the "game_id" field.
+func (_c *ChatMessageCreate) SetGameID(v string) *ChatMessageCreate {
+	_c.mutation.SetGameID(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *ChatMessageCreate) SetChannel(v chatmessage.Channel) *ChatMessageCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetRound sets the "round" field.
+func (_c *ChatMessageCreate) SetRound(v int) *ChatMessageCreate {
+	_c.mutation.SetRound(v)
+	return _c
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableRound(v *int) *ChatMessageCreate {
+	if v != nil {
+		_c.SetRound(*v)
+	}
+	return _c
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_c *ChatMessageCreate) SetPlayerID(v uuid.UUID) *ChatMessageCreate {
+	_c.mutation.SetPlayerID(v)
+	return _c
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillablePlayerID(v *uuid.UUID) *ChatMessageCreate {
+	if v != nil {
+		_c.SetPlayerID(*v)
+	}
+	return _c
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_c *ChatMessageCreate) SetModeratorID(v string) *ChatMessageCreate {
+	_c.mutation.SetModeratorID(v)
+	return _c
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableModeratorID(v *string) *ChatMessageCreate {
+	if v != nil {
+		_c.SetModeratorID(*v)
+	}
+	return _c
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_c *ChatMessageCreate) SetSenderName(v string) *ChatMessageCreate {
+	_c.mutation.SetSenderName(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *ChatMessageCreate) SetText(v string) *ChatMessageCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatMessageCreate) SetCreatedAt(v time.Time) *ChatMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableCreatedAt(v *time.Time) *ChatMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatMessageCreate) SetID(v uuid.UUID) *ChatMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableID(v *uuid.UUID) *ChatMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_c *ChatMessageCreate) SetGame(v *Game) *ChatMessageCreate {
+	return _c.SetGameID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (_c *ChatMessageCreate) Mutation() *ChatMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatMessage in the database.
+func (_c *ChatMessageCreate) Save(ctx context.Context) (*ChatMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatMessageCreate) SaveX(ctx context.Context) *ChatMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatMessageCreate) defaults() {
+	if _, ok := _c.mutation.Round(); !ok {
+		v := chatmessage.DefaultRound
+		_c.mutation.SetRound(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatmessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatMessageCreate) check() error {
+	if _, ok := _c.mutation.GameID(); !ok {
+		return &ValidationError{Name: "game_id", err: errors.New(`ent: missing required field "ChatMessage.game_id"`)}
+	}
+	if v, ok := _c.mutation.GameID(); ok {
+		if err := chatmessage.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.game_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "ChatMessage.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := chatmessage.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Round(); !ok {
+		return &ValidationError{Name: "round", err: errors.New(`ent: missing required field "ChatMessage.round"`)}
+	}
+	if v, ok := _c.mutation.Round(); ok {
+		if err := chatmessage.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.round": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SenderName(); !ok {
+		return &ValidationError{Name: "sender_name", err: errors.New(`ent: missing required field "ChatMessage.sender_name"`)}
+	}
+	if v, ok := _c.mutation.SenderName(); ok {
+		if err := chatmessage.SenderNameValidator(v); err != nil {
+			return &ValidationError{Name: "sender_name", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.sender_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "ChatMessage.text"`)}
+	}
+	if v, ok := _c.mutation.Text(); ok {
+		if err := chatmessage.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.text": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatMessage.created_at"`)}
+	}
+	if len(_c.mutation.GameIDs()) == 0 {
+		return &ValidationError{Name: "game", err: errors.New(`ent: missing required edge "ChatMessage.game"`)}
+	}
+	return nil
+}
+
+func (_c *ChatMessageCreate) sqlSave(ctx context.Context) (*ChatMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatmessage.Table, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(chatmessage.FieldChannel, field.TypeEnum, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.Round(); ok {
+		_spec.SetField(chatmessage.FieldRound, field.TypeInt, value)
+		_node.Round = value
+	}
+	if value, ok := _c.mutation.PlayerID(); ok {
+		_spec.SetField(chatmessage.FieldPlayerID, field.TypeUUID, value)
+		_node.PlayerID = &value
+	}
+	if value, ok := _c.mutation.ModeratorID(); ok {
+		_spec.SetField(chatmessage.FieldModeratorID, field.TypeString, value)
+		_node.ModeratorID = value
+	}
+	if value, ok := _c.mutation.SenderName(); ok {
+		_spec.SetField(chatmessage.FieldSenderName, field.TypeString, value)
+		_node.SenderName = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(chatmessage.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.GameTable,
+			Columns: []string{chatmessage.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GameID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ChatMessageCreateBulk is the builder for creating many ChatMessage entities in bulk.
+type ChatMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ChatMessageCreate
+}
+
+// Save creates the ChatMessage entities in the database.
+func (_c *ChatMessageCreateBulk) Save(ctx context.Context) ([]*ChatMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatMessageCreateBulk) SaveX(ctx context.Context) []*ChatMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
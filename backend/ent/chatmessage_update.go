@@ -0,0 +1,617 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ChatMessageUpdate is the builder for updating ChatMessage entities.
+type ChatMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (_u *ChatMessageUpdate) Where(ps ...predicate.ChatMessage) *ChatMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *ChatMessageUpdate) SetGameID(v string) *ChatMessageUpdate {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableGameID(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ChatMessageUpdate) SetChannel(v chatmessage.Channel) *ChatMessageUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableChannel(v *chatmessage.Channel) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *ChatMessageUpdate) SetRound(v int) *ChatMessageUpdate {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableRound(v *int) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *ChatMessageUpdate) AddRound(v int) *ChatMessageUpdate {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_u *ChatMessageUpdate) SetPlayerID(v uuid.UUID) *ChatMessageUpdate {
+	_u.mutation.SetPlayerID(v)
+	return _u
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillablePlayerID(v *uuid.UUID) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetPlayerID(*v)
+	}
+	return _u
+}
+
+// ClearPlayerID clears the value of the "player_id" field.
+func (_u *ChatMessageUpdate) ClearPlayerID() *ChatMessageUpdate {
+	_u.mutation.ClearPlayerID()
+	return _u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_u *ChatMessageUpdate) SetModeratorID(v string) *ChatMessageUpdate {
+	_u.mutation.SetModeratorID(v)
+	return _u
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableModeratorID(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetModeratorID(*v)
+	}
+	return _u
+}
+
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (_u *ChatMessageUpdate) ClearModeratorID() *ChatMessageUpdate {
+	_u.mutation.ClearModeratorID()
+	return _u
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_u *ChatMessageUpdate) SetSenderName(v string) *ChatMessageUpdate {
+	_u.mutation.SetSenderName(v)
+	return _u
+}
+
+// SetNillableSenderName sets the "sender_name" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableSenderName(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetSenderName(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *ChatMessageUpdate) SetText(v string) *ChatMessageUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableText(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_u *ChatMessageUpdate) SetGame(v *Game) *ChatMessageUpdate {
+	return _u.SetGameID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (_u *ChatMessageUpdate) Mutation() *ChatMessageMutation {
+	return _u.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (_u *ChatMessageUpdate) ClearGame() *ChatMessageUpdate {
+	_u.mutation.ClearGame()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatMessageUpdate) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := chatmessage.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := chatmessage.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := chatmessage.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.round": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SenderName(); ok {
+		if err := chatmessage.SenderNameValidator(v); err != nil {
+			return &ValidationError{Name: "sender_name", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.sender_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := chatmessage.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.text": %w`, err)}
+		}
+	}
+	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatMessage.game"`)
+	}
+	return nil
+}
+
+func (_u *ChatMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(chatmessage.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(chatmessage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(chatmessage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PlayerID(); ok {
+		_spec.SetField(chatmessage.FieldPlayerID, field.TypeUUID, value)
+	}
+	if _u.mutation.PlayerIDCleared() {
+		_spec.ClearField(chatmessage.FieldPlayerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ModeratorID(); ok {
+		_spec.SetField(chatmessage.FieldModeratorID, field.TypeString, value)
+	}
+	if _u.mutation.ModeratorIDCleared() {
+		_spec.ClearField(chatmessage.FieldModeratorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SenderName(); ok {
+		_spec.SetField(chatmessage.FieldSenderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(chatmessage.FieldText, field.TypeString, value)
+	}
+	if _u.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.GameTable,
+			Columns: []string{chatmessage.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.GameTable,
+			Columns: []string{chatmessage.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatMessageUpdateOne is the builder for updating a single ChatMessage entity.
+type ChatMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *ChatMessageUpdateOne) SetGameID(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableGameID(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ChatMessageUpdateOne) SetChannel(v chatmessage.Channel) *ChatMessageUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableChannel(v *chatmessage.Channel) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *ChatMessageUpdateOne) SetRound(v int) *ChatMessageUpdateOne {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableRound(v *int) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *ChatMessageUpdateOne) AddRound(v int) *ChatMessageUpdateOne {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_u *ChatMessageUpdateOne) SetPlayerID(v uuid.UUID) *ChatMessageUpdateOne {
+	_u.mutation.SetPlayerID(v)
+	return _u
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillablePlayerID(v *uuid.UUID) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetPlayerID(*v)
+	}
+	return _u
+}
+
+// ClearPlayerID clears the value of the "player_id" field.
+func (_u *ChatMessageUpdateOne) ClearPlayerID() *ChatMessageUpdateOne {
+	_u.mutation.ClearPlayerID()
+	return _u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_u *ChatMessageUpdateOne) SetModeratorID(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetModeratorID(v)
+	return _u
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableModeratorID(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetModeratorID(*v)
+	}
+	return _u
+}
+
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (_u *ChatMessageUpdateOne) ClearModeratorID() *ChatMessageUpdateOne {
+	_u.mutation.ClearModeratorID()
+	return _u
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_u *ChatMessageUpdateOne) SetSenderName(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetSenderName(v)
+	return _u
+}
+
+// SetNillableSenderName sets the "sender_name" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableSenderName(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetSenderName(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *ChatMessageUpdateOne) SetText(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableText(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_u *ChatMessageUpdateOne) SetGame(v *Game) *ChatMessageUpdateOne {
+	return _u.SetGameID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (_u *ChatMessageUpdateOne) Mutation() *ChatMessageMutation {
+	return _u.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (_u *ChatMessageUpdateOne) ClearGame() *ChatMessageUpdateOne {
+	_u.mutation.ClearGame()
+	return _u
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (_u *ChatMessageUpdateOne) Where(ps ...predicate.ChatMessage) *ChatMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatMessageUpdateOne) Select(field string, fields ...string) *ChatMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatMessage entity.
+func (_u *ChatMessageUpdateOne) Save(ctx context.Context) (*ChatMessage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatMessageUpdateOne) SaveX(ctx context.Context) *ChatMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := chatmessage.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := chatmessage.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := chatmessage.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.round": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SenderName(); ok {
+		if err := chatmessage.SenderNameValidator(v); err != nil {
+			return &ValidationError{Name: "sender_name", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.sender_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := chatmessage.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "ChatMessage.text": %w`, err)}
+		}
+	}
+	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatMessage.game"`)
+	}
+	return nil
+}
+
+func (_u *ChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatmessage.FieldID)
+		for _, f := range fields {
+			if !chatmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(chatmessage.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(chatmessage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(chatmessage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PlayerID(); ok {
+		_spec.SetField(chatmessage.FieldPlayerID, field.TypeUUID, value)
+	}
+	if _u.mutation.PlayerIDCleared() {
+		_spec.ClearField(chatmessage.FieldPlayerID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ModeratorID(); ok {
+		_spec.SetField(chatmessage.FieldModeratorID, field.TypeString, value)
+	}
+	if _u.mutation.ModeratorIDCleared() {
+		_spec.ClearField(chatmessage.FieldModeratorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SenderName(); ok {
+		_spec.SetField(chatmessage.FieldSenderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(chatmessage.FieldText, field.TypeString, value)
+	}
+	if _u.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.GameTable,
+			Columns: []string{chatmessage.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.GameTable,
+			Columns: []string{chatmessage.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ChatMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
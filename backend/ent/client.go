@@ -17,12 +17,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mafia-night/backend/ent/admin"
+	"github.com/mafia-night/backend/ent/chatmessage"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
 	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+	"github.com/mafia-night/backend/ent/nightaction"
 	"github.com/mafia-night/backend/ent/player"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+	"github.com/mafia-night/backend/ent/roletranslation"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/ent/vote"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,18 +43,40 @@ type Client struct {
 	Schema *migrate.Schema
 	// Admin is the client for interacting with the Admin builders.
 	Admin *AdminClient
+	// ChatMessage is the client for interacting with the ChatMessage builders.
+	ChatMessage *ChatMessageClient
 	// Game is the client for interacting with the Game builders.
 	Game *GameClient
+	// GameModerator is the client for interacting with the GameModerator builders.
+	GameModerator *GameModeratorClient
 	// GameRole is the client for interacting with the GameRole builders.
 	GameRole *GameRoleClient
+	// ModeratorAction is the client for interacting with the ModeratorAction builders.
+	ModeratorAction *ModeratorActionClient
+	// NightAction is the client for interacting with the NightAction builders.
+	NightAction *NightActionClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RoleRule is the client for interacting with the RoleRule builders.
+	RoleRule *RoleRuleClient
 	// RoleTemplate is the client for interacting with the RoleTemplate builders.
 	RoleTemplate *RoleTemplateClient
+	// RoleTemplateRevision is the client for interacting with the RoleTemplateRevision builders.
+	RoleTemplateRevision *RoleTemplateRevisionClient
 	// RoleTemplateRole is the client for interacting with the RoleTemplateRole builders.
 	RoleTemplateRole *RoleTemplateRoleClient
+	// RoleTemplateSlot is the client for interacting with the RoleTemplateSlot builders.
+	RoleTemplateSlot *RoleTemplateSlotClient
+	// RoleTemplateTranslation is the client for interacting with the RoleTemplateTranslation builders.
+	RoleTemplateTranslation *RoleTemplateTranslationClient
+	// RoleTranslation is the client for interacting with the RoleTranslation builders.
+	RoleTranslation *RoleTranslationClient
+	// Spectator is the client for interacting with the Spectator builders.
+	Spectator *SpectatorClient
+	// Vote is the client for interacting with the Vote builders.
+	Vote *VoteClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -56,12 +89,23 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Admin = NewAdminClient(c.config)
+	c.ChatMessage = NewChatMessageClient(c.config)
 	c.Game = NewGameClient(c.config)
+	c.GameModerator = NewGameModeratorClient(c.config)
 	c.GameRole = NewGameRoleClient(c.config)
+	c.ModeratorAction = NewModeratorActionClient(c.config)
+	c.NightAction = NewNightActionClient(c.config)
 	c.Player = NewPlayerClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.RoleRule = NewRoleRuleClient(c.config)
 	c.RoleTemplate = NewRoleTemplateClient(c.config)
+	c.RoleTemplateRevision = NewRoleTemplateRevisionClient(c.config)
 	c.RoleTemplateRole = NewRoleTemplateRoleClient(c.config)
+	c.RoleTemplateSlot = NewRoleTemplateSlotClient(c.config)
+	c.RoleTemplateTranslation = NewRoleTemplateTranslationClient(c.config)
+	c.RoleTranslation = NewRoleTranslationClient(c.config)
+	c.Spectator = NewSpectatorClient(c.config)
+	c.Vote = NewVoteClient(c.config)
 }
 
 type (
@@ -152,15 +196,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Admin:            NewAdminClient(cfg),
-		Game:             NewGameClient(cfg),
-		GameRole:         NewGameRoleClient(cfg),
-		Player:           NewPlayerClient(cfg),
-		Role:             NewRoleClient(cfg),
-		RoleTemplate:     NewRoleTemplateClient(cfg),
-		RoleTemplateRole: NewRoleTemplateRoleClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		Admin:                   NewAdminClient(cfg),
+		ChatMessage:             NewChatMessageClient(cfg),
+		Game:                    NewGameClient(cfg),
+		GameModerator:           NewGameModeratorClient(cfg),
+		GameRole:                NewGameRoleClient(cfg),
+		ModeratorAction:         NewModeratorActionClient(cfg),
+		NightAction:             NewNightActionClient(cfg),
+		Player:                  NewPlayerClient(cfg),
+		Role:                    NewRoleClient(cfg),
+		RoleRule:                NewRoleRuleClient(cfg),
+		RoleTemplate:            NewRoleTemplateClient(cfg),
+		RoleTemplateRevision:    NewRoleTemplateRevisionClient(cfg),
+		RoleTemplateRole:        NewRoleTemplateRoleClient(cfg),
+		RoleTemplateSlot:        NewRoleTemplateSlotClient(cfg),
+		RoleTemplateTranslation: NewRoleTemplateTranslationClient(cfg),
+		RoleTranslation:         NewRoleTranslationClient(cfg),
+		Spectator:               NewSpectatorClient(cfg),
+		Vote:                    NewVoteClient(cfg),
 	}, nil
 }
 
@@ -178,15 +233,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:              ctx,
-		config:           cfg,
-		Admin:            NewAdminClient(cfg),
-		Game:             NewGameClient(cfg),
-		GameRole:         NewGameRoleClient(cfg),
-		Player:           NewPlayerClient(cfg),
-		Role:             NewRoleClient(cfg),
-		RoleTemplate:     NewRoleTemplateClient(cfg),
-		RoleTemplateRole: NewRoleTemplateRoleClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		Admin:                   NewAdminClient(cfg),
+		ChatMessage:             NewChatMessageClient(cfg),
+		Game:                    NewGameClient(cfg),
+		GameModerator:           NewGameModeratorClient(cfg),
+		GameRole:                NewGameRoleClient(cfg),
+		ModeratorAction:         NewModeratorActionClient(cfg),
+		NightAction:             NewNightActionClient(cfg),
+		Player:                  NewPlayerClient(cfg),
+		Role:                    NewRoleClient(cfg),
+		RoleRule:                NewRoleRuleClient(cfg),
+		RoleTemplate:            NewRoleTemplateClient(cfg),
+		RoleTemplateRevision:    NewRoleTemplateRevisionClient(cfg),
+		RoleTemplateRole:        NewRoleTemplateRoleClient(cfg),
+		RoleTemplateSlot:        NewRoleTemplateSlotClient(cfg),
+		RoleTemplateTranslation: NewRoleTemplateTranslationClient(cfg),
+		RoleTranslation:         NewRoleTranslationClient(cfg),
+		Spectator:               NewSpectatorClient(cfg),
+		Vote:                    NewVoteClient(cfg),
 	}, nil
 }
 
@@ -216,8 +282,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Admin, c.Game, c.GameRole, c.Player, c.Role, c.RoleTemplate,
-		c.RoleTemplateRole,
+		c.Admin, c.ChatMessage, c.Game, c.GameModerator, c.GameRole, c.ModeratorAction,
+		c.NightAction, c.Player, c.Role, c.RoleRule, c.RoleTemplate,
+		c.RoleTemplateRevision, c.RoleTemplateRole, c.RoleTemplateSlot,
+		c.RoleTemplateTranslation, c.RoleTranslation, c.Spectator, c.Vote,
 	} {
 		n.Use(hooks...)
 	}
@@ -227,8 +295,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Admin, c.Game, c.GameRole, c.Player, c.Role, c.RoleTemplate,
-		c.RoleTemplateRole,
+		c.Admin, c.ChatMessage, c.Game, c.GameModerator, c.GameRole, c.ModeratorAction,
+		c.NightAction, c.Player, c.Role, c.RoleRule, c.RoleTemplate,
+		c.RoleTemplateRevision, c.RoleTemplateRole, c.RoleTemplateSlot,
+		c.RoleTemplateTranslation, c.RoleTranslation, c.Spectator, c.Vote,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -239,18 +309,40 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AdminMutation:
 		return c.Admin.mutate(ctx, m)
+	case *ChatMessageMutation:
+		return c.ChatMessage.mutate(ctx, m)
 	case *GameMutation:
 		return c.Game.mutate(ctx, m)
+	case *GameModeratorMutation:
+		return c.GameModerator.mutate(ctx, m)
 	case *GameRoleMutation:
 		return c.GameRole.mutate(ctx, m)
+	case *ModeratorActionMutation:
+		return c.ModeratorAction.mutate(ctx, m)
+	case *NightActionMutation:
+		return c.NightAction.mutate(ctx, m)
 	case *PlayerMutation:
 		return c.Player.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *RoleRuleMutation:
+		return c.RoleRule.mutate(ctx, m)
 	case *RoleTemplateMutation:
 		return c.RoleTemplate.mutate(ctx, m)
+	case *RoleTemplateRevisionMutation:
+		return c.RoleTemplateRevision.mutate(ctx, m)
 	case *RoleTemplateRoleMutation:
 		return c.RoleTemplateRole.mutate(ctx, m)
+	case *RoleTemplateSlotMutation:
+		return c.RoleTemplateSlot.mutate(ctx, m)
+	case *RoleTemplateTranslationMutation:
+		return c.RoleTemplateTranslation.mutate(ctx, m)
+	case *RoleTranslationMutation:
+		return c.RoleTranslation.mutate(ctx, m)
+	case *SpectatorMutation:
+		return c.Spectator.mutate(ctx, m)
+	case *VoteMutation:
+		return c.Vote.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -389,6 +481,155 @@ func (c *AdminClient) mutate(ctx context.Context, m *AdminMutation) (Value, erro
 	}
 }
 
+// ChatMessageClient is a client for the ChatMessage schema.
+type ChatMessageClient struct {
+	config
+}
+
+// NewChatMessageClient returns a client for the ChatMessage from the given config.
+func NewChatMessageClient(c config) *ChatMessageClient {
+	return &ChatMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatmessage.Hooks(f(g(h())))`.
+func (c *ChatMessageClient) Use(hooks ...Hook) {
+	c.hooks.ChatMessage = append(c.hooks.ChatMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatmessage.Intercept(f(g(h())))`.
+func (c *ChatMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatMessage = append(c.inters.ChatMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ChatMessage entity.
+func (c *ChatMessageClient) Create() *ChatMessageCreate {
+	mutation := newChatMessageMutation(c.config, OpCreate)
+	return &ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatMessage entities.
+func (c *ChatMessageClient) CreateBulk(builders ...*ChatMessageCreate) *ChatMessageCreateBulk {
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatMessageClient) MapCreateBulk(slice any, setFunc func(*ChatMessageCreate, int)) *ChatMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatMessageCreateBulk{err: fmt.Errorf("calling to ChatMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatMessage.
+func (c *ChatMessageClient) Update() *ChatMessageUpdate {
+	mutation := newChatMessageMutation(c.config, OpUpdate)
+	return &ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatMessageClient) UpdateOne(_m *ChatMessage) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessage(_m))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatMessageClient) UpdateOneID(id uuid.UUID) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessageID(id))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatMessage.
+func (c *ChatMessageClient) Delete() *ChatMessageDelete {
+	mutation := newChatMessageMutation(c.config, OpDelete)
+	return &ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatMessageClient) DeleteOne(_m *ChatMessage) *ChatMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatMessageClient) DeleteOneID(id uuid.UUID) *ChatMessageDeleteOne {
+	builder := c.Delete().Where(chatmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatMessage.
+func (c *ChatMessageClient) Query() *ChatMessageQuery {
+	return &ChatMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatMessage entity by its id.
+func (c *ChatMessageClient) Get(ctx context.Context, id uuid.UUID) (*ChatMessage, error) {
+	return c.Query().Where(chatmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatMessageClient) GetX(ctx context.Context, id uuid.UUID) *ChatMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a ChatMessage.
+func (c *ChatMessageClient) QueryGame(_m *ChatMessage) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatmessage.Table, chatmessage.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatmessage.GameTable, chatmessage.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChatMessageClient) Hooks() []Hook {
+	return c.hooks.ChatMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatMessageClient) Interceptors() []Interceptor {
+	return c.inters.ChatMessage
+}
+
+func (c *ChatMessageClient) mutate(ctx context.Context, m *ChatMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatMessage mutation op: %q", m.Op())
+	}
+}
+
 // GameClient is a client for the Game schema.
 type GameClient struct {
 	config
@@ -529,6 +770,86 @@ func (c *GameClient) QueryGameRoles(_m *Game) *GameRoleQuery {
 	return query
 }
 
+// QueryModerators queries the moderators edge of a Game.
+func (c *GameClient) QueryModerators(_m *Game) *GameModeratorQuery {
+	query := (&GameModeratorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(gamemoderator.Table, gamemoderator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.ModeratorsTable, game.ModeratorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySpectators queries the spectators edge of a Game.
+func (c *GameClient) QuerySpectators(_m *Game) *SpectatorQuery {
+	query := (&SpectatorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(spectator.Table, spectator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.SpectatorsTable, game.SpectatorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVotes queries the votes edge of a Game.
+func (c *GameClient) QueryVotes(_m *Game) *VoteQuery {
+	query := (&VoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(vote.Table, vote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.VotesTable, game.VotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNightActions queries the night_actions edge of a Game.
+func (c *GameClient) QueryNightActions(_m *Game) *NightActionQuery {
+	query := (&NightActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(nightaction.Table, nightaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.NightActionsTable, game.NightActionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChatMessages queries the chat_messages edge of a Game.
+func (c *GameClient) QueryChatMessages(_m *Game) *ChatMessageQuery {
+	query := (&ChatMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(chatmessage.Table, chatmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.ChatMessagesTable, game.ChatMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *GameClient) Hooks() []Hook {
 	return c.hooks.Game
@@ -554,6 +875,155 @@ func (c *GameClient) mutate(ctx context.Context, m *GameMutation) (Value, error)
 	}
 }
 
+// GameModeratorClient is a client for the GameModerator schema.
+type GameModeratorClient struct {
+	config
+}
+
+// NewGameModeratorClient returns a client for the GameModerator from the given config.
+func NewGameModeratorClient(c config) *GameModeratorClient {
+	return &GameModeratorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gamemoderator.Hooks(f(g(h())))`.
+func (c *GameModeratorClient) Use(hooks ...Hook) {
+	c.hooks.GameModerator = append(c.hooks.GameModerator, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gamemoderator.Intercept(f(g(h())))`.
+func (c *GameModeratorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GameModerator = append(c.inters.GameModerator, interceptors...)
+}
+
+// Create returns a builder for creating a GameModerator entity.
+func (c *GameModeratorClient) Create() *GameModeratorCreate {
+	mutation := newGameModeratorMutation(c.config, OpCreate)
+	return &GameModeratorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GameModerator entities.
+func (c *GameModeratorClient) CreateBulk(builders ...*GameModeratorCreate) *GameModeratorCreateBulk {
+	return &GameModeratorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GameModeratorClient) MapCreateBulk(slice any, setFunc func(*GameModeratorCreate, int)) *GameModeratorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GameModeratorCreateBulk{err: fmt.Errorf("calling to GameModeratorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GameModeratorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GameModeratorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GameModerator.
+func (c *GameModeratorClient) Update() *GameModeratorUpdate {
+	mutation := newGameModeratorMutation(c.config, OpUpdate)
+	return &GameModeratorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GameModeratorClient) UpdateOne(_m *GameModerator) *GameModeratorUpdateOne {
+	mutation := newGameModeratorMutation(c.config, OpUpdateOne, withGameModerator(_m))
+	return &GameModeratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GameModeratorClient) UpdateOneID(id uuid.UUID) *GameModeratorUpdateOne {
+	mutation := newGameModeratorMutation(c.config, OpUpdateOne, withGameModeratorID(id))
+	return &GameModeratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GameModerator.
+func (c *GameModeratorClient) Delete() *GameModeratorDelete {
+	mutation := newGameModeratorMutation(c.config, OpDelete)
+	return &GameModeratorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GameModeratorClient) DeleteOne(_m *GameModerator) *GameModeratorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GameModeratorClient) DeleteOneID(id uuid.UUID) *GameModeratorDeleteOne {
+	builder := c.Delete().Where(gamemoderator.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GameModeratorDeleteOne{builder}
+}
+
+// Query returns a query builder for GameModerator.
+func (c *GameModeratorClient) Query() *GameModeratorQuery {
+	return &GameModeratorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGameModerator},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GameModerator entity by its id.
+func (c *GameModeratorClient) Get(ctx context.Context, id uuid.UUID) (*GameModerator, error) {
+	return c.Query().Where(gamemoderator.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GameModeratorClient) GetX(ctx context.Context, id uuid.UUID) *GameModerator {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a GameModerator.
+func (c *GameModeratorClient) QueryGame(_m *GameModerator) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(gamemoderator.Table, gamemoderator.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, gamemoderator.GameTable, gamemoderator.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GameModeratorClient) Hooks() []Hook {
+	return c.hooks.GameModerator
+}
+
+// Interceptors returns the client interceptors.
+func (c *GameModeratorClient) Interceptors() []Interceptor {
+	return c.inters.GameModerator
+}
+
+func (c *GameModeratorClient) mutate(ctx context.Context, m *GameModeratorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GameModeratorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GameModeratorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GameModeratorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GameModeratorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GameModerator mutation op: %q", m.Op())
+	}
+}
+
 // GameRoleClient is a client for the GameRole schema.
 type GameRoleClient struct {
 	config
@@ -735,62 +1205,344 @@ func (c *GameRoleClient) mutate(ctx context.Context, m *GameRoleMutation) (Value
 	}
 }
 
-// PlayerClient is a client for the Player schema.
-type PlayerClient struct {
+// ModeratorActionClient is a client for the ModeratorAction schema.
+type ModeratorActionClient struct {
 	config
 }
 
-// NewPlayerClient returns a client for the Player from the given config.
-func NewPlayerClient(c config) *PlayerClient {
-	return &PlayerClient{config: c}
+// NewModeratorActionClient returns a client for the ModeratorAction from the given config.
+func NewModeratorActionClient(c config) *ModeratorActionClient {
+	return &ModeratorActionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `player.Hooks(f(g(h())))`.
-func (c *PlayerClient) Use(hooks ...Hook) {
-	c.hooks.Player = append(c.hooks.Player, hooks...)
+// A call to `Use(f, g, h)` equals to `moderatoraction.Hooks(f(g(h())))`.
+func (c *ModeratorActionClient) Use(hooks ...Hook) {
+	c.hooks.ModeratorAction = append(c.hooks.ModeratorAction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `player.Intercept(f(g(h())))`.
-func (c *PlayerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Player = append(c.inters.Player, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `moderatoraction.Intercept(f(g(h())))`.
+func (c *ModeratorActionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModeratorAction = append(c.inters.ModeratorAction, interceptors...)
 }
 
-// Create returns a builder for creating a Player entity.
-func (c *PlayerClient) Create() *PlayerCreate {
-	mutation := newPlayerMutation(c.config, OpCreate)
-	return &PlayerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ModeratorAction entity.
+func (c *ModeratorActionClient) Create() *ModeratorActionCreate {
+	mutation := newModeratorActionMutation(c.config, OpCreate)
+	return &ModeratorActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Player entities.
-func (c *PlayerClient) CreateBulk(builders ...*PlayerCreate) *PlayerCreateBulk {
-	return &PlayerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ModeratorAction entities.
+func (c *ModeratorActionClient) CreateBulk(builders ...*ModeratorActionCreate) *ModeratorActionCreateBulk {
+	return &ModeratorActionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlayerClient) MapCreateBulk(slice any, setFunc func(*PlayerCreate, int)) *PlayerCreateBulk {
+func (c *ModeratorActionClient) MapCreateBulk(slice any, setFunc func(*ModeratorActionCreate, int)) *ModeratorActionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlayerCreateBulk{err: fmt.Errorf("calling to PlayerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ModeratorActionCreateBulk{err: fmt.Errorf("calling to ModeratorActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlayerCreate, rv.Len())
+	builders := make([]*ModeratorActionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PlayerCreateBulk{config: c.config, builders: builders}
+	return &ModeratorActionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Player.
-func (c *PlayerClient) Update() *PlayerUpdate {
-	mutation := newPlayerMutation(c.config, OpUpdate)
-	return &PlayerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ModeratorAction.
+func (c *ModeratorActionClient) Update() *ModeratorActionUpdate {
+	mutation := newModeratorActionMutation(c.config, OpUpdate)
+	return &ModeratorActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PlayerClient) UpdateOne(_m *Player) *PlayerUpdateOne {
+func (c *ModeratorActionClient) UpdateOne(_m *ModeratorAction) *ModeratorActionUpdateOne {
+	mutation := newModeratorActionMutation(c.config, OpUpdateOne, withModeratorAction(_m))
+	return &ModeratorActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModeratorActionClient) UpdateOneID(id uuid.UUID) *ModeratorActionUpdateOne {
+	mutation := newModeratorActionMutation(c.config, OpUpdateOne, withModeratorActionID(id))
+	return &ModeratorActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModeratorAction.
+func (c *ModeratorActionClient) Delete() *ModeratorActionDelete {
+	mutation := newModeratorActionMutation(c.config, OpDelete)
+	return &ModeratorActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModeratorActionClient) DeleteOne(_m *ModeratorAction) *ModeratorActionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModeratorActionClient) DeleteOneID(id uuid.UUID) *ModeratorActionDeleteOne {
+	builder := c.Delete().Where(moderatoraction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModeratorActionDeleteOne{builder}
+}
+
+// Query returns a query builder for ModeratorAction.
+func (c *ModeratorActionClient) Query() *ModeratorActionQuery {
+	return &ModeratorActionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModeratorAction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModeratorAction entity by its id.
+func (c *ModeratorActionClient) Get(ctx context.Context, id uuid.UUID) (*ModeratorAction, error) {
+	return c.Query().Where(moderatoraction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModeratorActionClient) GetX(ctx context.Context, id uuid.UUID) *ModeratorAction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModeratorActionClient) Hooks() []Hook {
+	return c.hooks.ModeratorAction
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModeratorActionClient) Interceptors() []Interceptor {
+	return c.inters.ModeratorAction
+}
+
+func (c *ModeratorActionClient) mutate(ctx context.Context, m *ModeratorActionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModeratorActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModeratorActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModeratorActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModeratorActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModeratorAction mutation op: %q", m.Op())
+	}
+}
+
+// NightActionClient is a client for the NightAction schema.
+type NightActionClient struct {
+	config
+}
+
+// NewNightActionClient returns a client for the NightAction from the given config.
+func NewNightActionClient(c config) *NightActionClient {
+	return &NightActionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nightaction.Hooks(f(g(h())))`.
+func (c *NightActionClient) Use(hooks ...Hook) {
+	c.hooks.NightAction = append(c.hooks.NightAction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nightaction.Intercept(f(g(h())))`.
+func (c *NightActionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NightAction = append(c.inters.NightAction, interceptors...)
+}
+
+// Create returns a builder for creating a NightAction entity.
+func (c *NightActionClient) Create() *NightActionCreate {
+	mutation := newNightActionMutation(c.config, OpCreate)
+	return &NightActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NightAction entities.
+func (c *NightActionClient) CreateBulk(builders ...*NightActionCreate) *NightActionCreateBulk {
+	return &NightActionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NightActionClient) MapCreateBulk(slice any, setFunc func(*NightActionCreate, int)) *NightActionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NightActionCreateBulk{err: fmt.Errorf("calling to NightActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NightActionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NightActionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NightAction.
+func (c *NightActionClient) Update() *NightActionUpdate {
+	mutation := newNightActionMutation(c.config, OpUpdate)
+	return &NightActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NightActionClient) UpdateOne(_m *NightAction) *NightActionUpdateOne {
+	mutation := newNightActionMutation(c.config, OpUpdateOne, withNightAction(_m))
+	return &NightActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NightActionClient) UpdateOneID(id uuid.UUID) *NightActionUpdateOne {
+	mutation := newNightActionMutation(c.config, OpUpdateOne, withNightActionID(id))
+	return &NightActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NightAction.
+func (c *NightActionClient) Delete() *NightActionDelete {
+	mutation := newNightActionMutation(c.config, OpDelete)
+	return &NightActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NightActionClient) DeleteOne(_m *NightAction) *NightActionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NightActionClient) DeleteOneID(id uuid.UUID) *NightActionDeleteOne {
+	builder := c.Delete().Where(nightaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NightActionDeleteOne{builder}
+}
+
+// Query returns a query builder for NightAction.
+func (c *NightActionClient) Query() *NightActionQuery {
+	return &NightActionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNightAction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NightAction entity by its id.
+func (c *NightActionClient) Get(ctx context.Context, id uuid.UUID) (*NightAction, error) {
+	return c.Query().Where(nightaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NightActionClient) GetX(ctx context.Context, id uuid.UUID) *NightAction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a NightAction.
+func (c *NightActionClient) QueryGame(_m *NightAction) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nightaction.Table, nightaction.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, nightaction.GameTable, nightaction.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NightActionClient) Hooks() []Hook {
+	return c.hooks.NightAction
+}
+
+// Interceptors returns the client interceptors.
+func (c *NightActionClient) Interceptors() []Interceptor {
+	return c.inters.NightAction
+}
+
+func (c *NightActionClient) mutate(ctx context.Context, m *NightActionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NightActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NightActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NightActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NightActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NightAction mutation op: %q", m.Op())
+	}
+}
+
+// PlayerClient is a client for the Player schema.
+type PlayerClient struct {
+	config
+}
+
+// NewPlayerClient returns a client for the Player from the given config.
+func NewPlayerClient(c config) *PlayerClient {
+	return &PlayerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `player.Hooks(f(g(h())))`.
+func (c *PlayerClient) Use(hooks ...Hook) {
+	c.hooks.Player = append(c.hooks.Player, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `player.Intercept(f(g(h())))`.
+func (c *PlayerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Player = append(c.inters.Player, interceptors...)
+}
+
+// Create returns a builder for creating a Player entity.
+func (c *PlayerClient) Create() *PlayerCreate {
+	mutation := newPlayerMutation(c.config, OpCreate)
+	return &PlayerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Player entities.
+func (c *PlayerClient) CreateBulk(builders ...*PlayerCreate) *PlayerCreateBulk {
+	return &PlayerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlayerClient) MapCreateBulk(slice any, setFunc func(*PlayerCreate, int)) *PlayerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlayerCreateBulk{err: fmt.Errorf("calling to PlayerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlayerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlayerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Player.
+func (c *PlayerClient) Update() *PlayerUpdate {
+	mutation := newPlayerMutation(c.config, OpUpdate)
+	return &PlayerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayerClient) UpdateOne(_m *Player) *PlayerUpdateOne {
 	mutation := newPlayerMutation(c.config, OpUpdateOne, withPlayer(_m))
 	return &PlayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -1040,6 +1792,70 @@ func (c *RoleClient) QueryTemplateRoles(_m *Role) *RoleTemplateRoleQuery {
 	return query
 }
 
+// QueryTemplateSlots queries the template_slots edge of a Role.
+func (c *RoleClient) QueryTemplateSlots(_m *Role) *RoleTemplateSlotQuery {
+	query := (&RoleTemplateSlotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(roletemplateslot.Table, roletemplateslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.TemplateSlotsTable, role.TemplateSlotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTranslations queries the translations edge of a Role.
+func (c *RoleClient) QueryTranslations(_m *Role) *RoleTranslationQuery {
+	query := (&RoleTranslationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(roletranslation.Table, roletranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.TranslationsTable, role.TranslationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRules queries the rules edge of a Role.
+func (c *RoleClient) QueryRules(_m *Role) *RoleRuleQuery {
+	query := (&RoleRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(rolerule.Table, rolerule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.RulesTable, role.RulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargetedByRules queries the targeted_by_rules edge of a Role.
+func (c *RoleClient) QueryTargetedByRules(_m *Role) *RoleRuleQuery {
+	query := (&RoleRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(rolerule.Table, rolerule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.TargetedByRulesTable, role.TargetedByRulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *RoleClient) Hooks() []Hook {
 	return c.hooks.Role
@@ -1065,55 +1881,220 @@ func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error)
 	}
 }
 
-// RoleTemplateClient is a client for the RoleTemplate schema.
-type RoleTemplateClient struct {
+// RoleRuleClient is a client for the RoleRule schema.
+type RoleRuleClient struct {
 	config
 }
 
-// NewRoleTemplateClient returns a client for the RoleTemplate from the given config.
-func NewRoleTemplateClient(c config) *RoleTemplateClient {
-	return &RoleTemplateClient{config: c}
+// NewRoleRuleClient returns a client for the RoleRule from the given config.
+func NewRoleRuleClient(c config) *RoleRuleClient {
+	return &RoleRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `roletemplate.Hooks(f(g(h())))`.
-func (c *RoleTemplateClient) Use(hooks ...Hook) {
-	c.hooks.RoleTemplate = append(c.hooks.RoleTemplate, hooks...)
+// A call to `Use(f, g, h)` equals to `rolerule.Hooks(f(g(h())))`.
+func (c *RoleRuleClient) Use(hooks ...Hook) {
+	c.hooks.RoleRule = append(c.hooks.RoleRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `roletemplate.Intercept(f(g(h())))`.
-func (c *RoleTemplateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RoleTemplate = append(c.inters.RoleTemplate, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `rolerule.Intercept(f(g(h())))`.
+func (c *RoleRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleRule = append(c.inters.RoleRule, interceptors...)
 }
 
-// Create returns a builder for creating a RoleTemplate entity.
-func (c *RoleTemplateClient) Create() *RoleTemplateCreate {
-	mutation := newRoleTemplateMutation(c.config, OpCreate)
-	return &RoleTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RoleRule entity.
+func (c *RoleRuleClient) Create() *RoleRuleCreate {
+	mutation := newRoleRuleMutation(c.config, OpCreate)
+	return &RoleRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RoleTemplate entities.
-func (c *RoleTemplateClient) CreateBulk(builders ...*RoleTemplateCreate) *RoleTemplateCreateBulk {
-	return &RoleTemplateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RoleRule entities.
+func (c *RoleRuleClient) CreateBulk(builders ...*RoleRuleCreate) *RoleRuleCreateBulk {
+	return &RoleRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleTemplateClient) MapCreateBulk(slice any, setFunc func(*RoleTemplateCreate, int)) *RoleTemplateCreateBulk {
+func (c *RoleRuleClient) MapCreateBulk(slice any, setFunc func(*RoleRuleCreate, int)) *RoleRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleTemplateCreateBulk{err: fmt.Errorf("calling to RoleTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RoleRuleCreateBulk{err: fmt.Errorf("calling to RoleRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleTemplateCreate, rv.Len())
+	builders := make([]*RoleRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleTemplateCreateBulk{config: c.config, builders: builders}
+	return &RoleRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RoleTemplate.
+// Update returns an update builder for RoleRule.
+func (c *RoleRuleClient) Update() *RoleRuleUpdate {
+	mutation := newRoleRuleMutation(c.config, OpUpdate)
+	return &RoleRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleRuleClient) UpdateOne(_m *RoleRule) *RoleRuleUpdateOne {
+	mutation := newRoleRuleMutation(c.config, OpUpdateOne, withRoleRule(_m))
+	return &RoleRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleRuleClient) UpdateOneID(id uuid.UUID) *RoleRuleUpdateOne {
+	mutation := newRoleRuleMutation(c.config, OpUpdateOne, withRoleRuleID(id))
+	return &RoleRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleRule.
+func (c *RoleRuleClient) Delete() *RoleRuleDelete {
+	mutation := newRoleRuleMutation(c.config, OpDelete)
+	return &RoleRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleRuleClient) DeleteOne(_m *RoleRule) *RoleRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleRuleClient) DeleteOneID(id uuid.UUID) *RoleRuleDeleteOne {
+	builder := c.Delete().Where(rolerule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleRule.
+func (c *RoleRuleClient) Query() *RoleRuleQuery {
+	return &RoleRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleRule entity by its id.
+func (c *RoleRuleClient) Get(ctx context.Context, id uuid.UUID) (*RoleRule, error) {
+	return c.Query().Where(rolerule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleRuleClient) GetX(ctx context.Context, id uuid.UUID) *RoleRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RoleRule.
+func (c *RoleRuleClient) QueryRole(_m *RoleRule) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolerule.Table, rolerule.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolerule.RoleTable, rolerule.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargetRole queries the target_role edge of a RoleRule.
+func (c *RoleRuleClient) QueryTargetRole(_m *RoleRule) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolerule.Table, rolerule.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolerule.TargetRoleTable, rolerule.TargetRoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleRuleClient) Hooks() []Hook {
+	return c.hooks.RoleRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleRuleClient) Interceptors() []Interceptor {
+	return c.inters.RoleRule
+}
+
+func (c *RoleRuleClient) mutate(ctx context.Context, m *RoleRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleRule mutation op: %q", m.Op())
+	}
+}
+
+// RoleTemplateClient is a client for the RoleTemplate schema.
+type RoleTemplateClient struct {
+	config
+}
+
+// NewRoleTemplateClient returns a client for the RoleTemplate from the given config.
+func NewRoleTemplateClient(c config) *RoleTemplateClient {
+	return &RoleTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roletemplate.Hooks(f(g(h())))`.
+func (c *RoleTemplateClient) Use(hooks ...Hook) {
+	c.hooks.RoleTemplate = append(c.hooks.RoleTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roletemplate.Intercept(f(g(h())))`.
+func (c *RoleTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleTemplate = append(c.inters.RoleTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a RoleTemplate entity.
+func (c *RoleTemplateClient) Create() *RoleTemplateCreate {
+	mutation := newRoleTemplateMutation(c.config, OpCreate)
+	return &RoleTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleTemplate entities.
+func (c *RoleTemplateClient) CreateBulk(builders ...*RoleTemplateCreate) *RoleTemplateCreateBulk {
+	return &RoleTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleTemplateClient) MapCreateBulk(slice any, setFunc func(*RoleTemplateCreate, int)) *RoleTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleTemplateCreateBulk{err: fmt.Errorf("calling to RoleTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleTemplate.
 func (c *RoleTemplateClient) Update() *RoleTemplateUpdate {
 	mutation := newRoleTemplateMutation(c.config, OpUpdate)
 	return &RoleTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
@@ -1189,6 +2170,54 @@ func (c *RoleTemplateClient) QueryTemplateRoles(_m *RoleTemplate) *RoleTemplateR
 	return query
 }
 
+// QueryOptionalSlots queries the optional_slots edge of a RoleTemplate.
+func (c *RoleTemplateClient) QueryOptionalSlots(_m *RoleTemplate) *RoleTemplateSlotQuery {
+	query := (&RoleTemplateSlotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplate.Table, roletemplate.FieldID, id),
+			sqlgraph.To(roletemplateslot.Table, roletemplateslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roletemplate.OptionalSlotsTable, roletemplate.OptionalSlotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTranslations queries the translations edge of a RoleTemplate.
+func (c *RoleTemplateClient) QueryTranslations(_m *RoleTemplate) *RoleTemplateTranslationQuery {
+	query := (&RoleTemplateTranslationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplate.Table, roletemplate.FieldID, id),
+			sqlgraph.To(roletemplatetranslation.Table, roletemplatetranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roletemplate.TranslationsTable, roletemplate.TranslationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a RoleTemplate.
+func (c *RoleTemplateClient) QueryRevisions(_m *RoleTemplate) *RoleTemplateRevisionQuery {
+	query := (&RoleTemplateRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplate.Table, roletemplate.FieldID, id),
+			sqlgraph.To(roletemplaterevision.Table, roletemplaterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roletemplate.RevisionsTable, roletemplate.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *RoleTemplateClient) Hooks() []Hook {
 	return c.hooks.RoleTemplate
@@ -1214,6 +2243,155 @@ func (c *RoleTemplateClient) mutate(ctx context.Context, m *RoleTemplateMutation
 	}
 }
 
+// RoleTemplateRevisionClient is a client for the RoleTemplateRevision schema.
+type RoleTemplateRevisionClient struct {
+	config
+}
+
+// NewRoleTemplateRevisionClient returns a client for the RoleTemplateRevision from the given config.
+func NewRoleTemplateRevisionClient(c config) *RoleTemplateRevisionClient {
+	return &RoleTemplateRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roletemplaterevision.Hooks(f(g(h())))`.
+func (c *RoleTemplateRevisionClient) Use(hooks ...Hook) {
+	c.hooks.RoleTemplateRevision = append(c.hooks.RoleTemplateRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roletemplaterevision.Intercept(f(g(h())))`.
+func (c *RoleTemplateRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleTemplateRevision = append(c.inters.RoleTemplateRevision, interceptors...)
+}
+
+// Create returns a builder for creating a RoleTemplateRevision entity.
+func (c *RoleTemplateRevisionClient) Create() *RoleTemplateRevisionCreate {
+	mutation := newRoleTemplateRevisionMutation(c.config, OpCreate)
+	return &RoleTemplateRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleTemplateRevision entities.
+func (c *RoleTemplateRevisionClient) CreateBulk(builders ...*RoleTemplateRevisionCreate) *RoleTemplateRevisionCreateBulk {
+	return &RoleTemplateRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleTemplateRevisionClient) MapCreateBulk(slice any, setFunc func(*RoleTemplateRevisionCreate, int)) *RoleTemplateRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleTemplateRevisionCreateBulk{err: fmt.Errorf("calling to RoleTemplateRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleTemplateRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleTemplateRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleTemplateRevision.
+func (c *RoleTemplateRevisionClient) Update() *RoleTemplateRevisionUpdate {
+	mutation := newRoleTemplateRevisionMutation(c.config, OpUpdate)
+	return &RoleTemplateRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleTemplateRevisionClient) UpdateOne(_m *RoleTemplateRevision) *RoleTemplateRevisionUpdateOne {
+	mutation := newRoleTemplateRevisionMutation(c.config, OpUpdateOne, withRoleTemplateRevision(_m))
+	return &RoleTemplateRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleTemplateRevisionClient) UpdateOneID(id uuid.UUID) *RoleTemplateRevisionUpdateOne {
+	mutation := newRoleTemplateRevisionMutation(c.config, OpUpdateOne, withRoleTemplateRevisionID(id))
+	return &RoleTemplateRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleTemplateRevision.
+func (c *RoleTemplateRevisionClient) Delete() *RoleTemplateRevisionDelete {
+	mutation := newRoleTemplateRevisionMutation(c.config, OpDelete)
+	return &RoleTemplateRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleTemplateRevisionClient) DeleteOne(_m *RoleTemplateRevision) *RoleTemplateRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleTemplateRevisionClient) DeleteOneID(id uuid.UUID) *RoleTemplateRevisionDeleteOne {
+	builder := c.Delete().Where(roletemplaterevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleTemplateRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleTemplateRevision.
+func (c *RoleTemplateRevisionClient) Query() *RoleTemplateRevisionQuery {
+	return &RoleTemplateRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleTemplateRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleTemplateRevision entity by its id.
+func (c *RoleTemplateRevisionClient) Get(ctx context.Context, id uuid.UUID) (*RoleTemplateRevision, error) {
+	return c.Query().Where(roletemplaterevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleTemplateRevisionClient) GetX(ctx context.Context, id uuid.UUID) *RoleTemplateRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoleTemplate queries the role_template edge of a RoleTemplateRevision.
+func (c *RoleTemplateRevisionClient) QueryRoleTemplate(_m *RoleTemplateRevision) *RoleTemplateQuery {
+	query := (&RoleTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplaterevision.Table, roletemplaterevision.FieldID, id),
+			sqlgraph.To(roletemplate.Table, roletemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roletemplaterevision.RoleTemplateTable, roletemplaterevision.RoleTemplateColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleTemplateRevisionClient) Hooks() []Hook {
+	return c.hooks.RoleTemplateRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleTemplateRevisionClient) Interceptors() []Interceptor {
+	return c.inters.RoleTemplateRevision
+}
+
+func (c *RoleTemplateRevisionClient) mutate(ctx context.Context, m *RoleTemplateRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleTemplateRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleTemplateRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleTemplateRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleTemplateRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleTemplateRevision mutation op: %q", m.Op())
+	}
+}
+
 // RoleTemplateRoleClient is a client for the RoleTemplateRole schema.
 type RoleTemplateRoleClient struct {
 	config
@@ -1379,13 +2557,779 @@ func (c *RoleTemplateRoleClient) mutate(ctx context.Context, m *RoleTemplateRole
 	}
 }
 
+// RoleTemplateSlotClient is a client for the RoleTemplateSlot schema.
+type RoleTemplateSlotClient struct {
+	config
+}
+
+// NewRoleTemplateSlotClient returns a client for the RoleTemplateSlot from the given config.
+func NewRoleTemplateSlotClient(c config) *RoleTemplateSlotClient {
+	return &RoleTemplateSlotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roletemplateslot.Hooks(f(g(h())))`.
+func (c *RoleTemplateSlotClient) Use(hooks ...Hook) {
+	c.hooks.RoleTemplateSlot = append(c.hooks.RoleTemplateSlot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roletemplateslot.Intercept(f(g(h())))`.
+func (c *RoleTemplateSlotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleTemplateSlot = append(c.inters.RoleTemplateSlot, interceptors...)
+}
+
+// Create returns a builder for creating a RoleTemplateSlot entity.
+func (c *RoleTemplateSlotClient) Create() *RoleTemplateSlotCreate {
+	mutation := newRoleTemplateSlotMutation(c.config, OpCreate)
+	return &RoleTemplateSlotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleTemplateSlot entities.
+func (c *RoleTemplateSlotClient) CreateBulk(builders ...*RoleTemplateSlotCreate) *RoleTemplateSlotCreateBulk {
+	return &RoleTemplateSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleTemplateSlotClient) MapCreateBulk(slice any, setFunc func(*RoleTemplateSlotCreate, int)) *RoleTemplateSlotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleTemplateSlotCreateBulk{err: fmt.Errorf("calling to RoleTemplateSlotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleTemplateSlotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleTemplateSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleTemplateSlot.
+func (c *RoleTemplateSlotClient) Update() *RoleTemplateSlotUpdate {
+	mutation := newRoleTemplateSlotMutation(c.config, OpUpdate)
+	return &RoleTemplateSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleTemplateSlotClient) UpdateOne(_m *RoleTemplateSlot) *RoleTemplateSlotUpdateOne {
+	mutation := newRoleTemplateSlotMutation(c.config, OpUpdateOne, withRoleTemplateSlot(_m))
+	return &RoleTemplateSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleTemplateSlotClient) UpdateOneID(id uuid.UUID) *RoleTemplateSlotUpdateOne {
+	mutation := newRoleTemplateSlotMutation(c.config, OpUpdateOne, withRoleTemplateSlotID(id))
+	return &RoleTemplateSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleTemplateSlot.
+func (c *RoleTemplateSlotClient) Delete() *RoleTemplateSlotDelete {
+	mutation := newRoleTemplateSlotMutation(c.config, OpDelete)
+	return &RoleTemplateSlotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleTemplateSlotClient) DeleteOne(_m *RoleTemplateSlot) *RoleTemplateSlotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleTemplateSlotClient) DeleteOneID(id uuid.UUID) *RoleTemplateSlotDeleteOne {
+	builder := c.Delete().Where(roletemplateslot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleTemplateSlotDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleTemplateSlot.
+func (c *RoleTemplateSlotClient) Query() *RoleTemplateSlotQuery {
+	return &RoleTemplateSlotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleTemplateSlot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleTemplateSlot entity by its id.
+func (c *RoleTemplateSlotClient) Get(ctx context.Context, id uuid.UUID) (*RoleTemplateSlot, error) {
+	return c.Query().Where(roletemplateslot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleTemplateSlotClient) GetX(ctx context.Context, id uuid.UUID) *RoleTemplateSlot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoleTemplate queries the role_template edge of a RoleTemplateSlot.
+func (c *RoleTemplateSlotClient) QueryRoleTemplate(_m *RoleTemplateSlot) *RoleTemplateQuery {
+	query := (&RoleTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplateslot.Table, roletemplateslot.FieldID, id),
+			sqlgraph.To(roletemplate.Table, roletemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roletemplateslot.RoleTemplateTable, roletemplateslot.RoleTemplateColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a RoleTemplateSlot.
+func (c *RoleTemplateSlotClient) QueryRole(_m *RoleTemplateSlot) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplateslot.Table, roletemplateslot.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roletemplateslot.RoleTable, roletemplateslot.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleTemplateSlotClient) Hooks() []Hook {
+	return c.hooks.RoleTemplateSlot
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleTemplateSlotClient) Interceptors() []Interceptor {
+	return c.inters.RoleTemplateSlot
+}
+
+func (c *RoleTemplateSlotClient) mutate(ctx context.Context, m *RoleTemplateSlotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleTemplateSlotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleTemplateSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleTemplateSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleTemplateSlotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleTemplateSlot mutation op: %q", m.Op())
+	}
+}
+
+// RoleTemplateTranslationClient is a client for the RoleTemplateTranslation schema.
+type RoleTemplateTranslationClient struct {
+	config
+}
+
+// NewRoleTemplateTranslationClient returns a client for the RoleTemplateTranslation from the given config.
+func NewRoleTemplateTranslationClient(c config) *RoleTemplateTranslationClient {
+	return &RoleTemplateTranslationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roletemplatetranslation.Hooks(f(g(h())))`.
+func (c *RoleTemplateTranslationClient) Use(hooks ...Hook) {
+	c.hooks.RoleTemplateTranslation = append(c.hooks.RoleTemplateTranslation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roletemplatetranslation.Intercept(f(g(h())))`.
+func (c *RoleTemplateTranslationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleTemplateTranslation = append(c.inters.RoleTemplateTranslation, interceptors...)
+}
+
+// Create returns a builder for creating a RoleTemplateTranslation entity.
+func (c *RoleTemplateTranslationClient) Create() *RoleTemplateTranslationCreate {
+	mutation := newRoleTemplateTranslationMutation(c.config, OpCreate)
+	return &RoleTemplateTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleTemplateTranslation entities.
+func (c *RoleTemplateTranslationClient) CreateBulk(builders ...*RoleTemplateTranslationCreate) *RoleTemplateTranslationCreateBulk {
+	return &RoleTemplateTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleTemplateTranslationClient) MapCreateBulk(slice any, setFunc func(*RoleTemplateTranslationCreate, int)) *RoleTemplateTranslationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleTemplateTranslationCreateBulk{err: fmt.Errorf("calling to RoleTemplateTranslationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleTemplateTranslationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleTemplateTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleTemplateTranslation.
+func (c *RoleTemplateTranslationClient) Update() *RoleTemplateTranslationUpdate {
+	mutation := newRoleTemplateTranslationMutation(c.config, OpUpdate)
+	return &RoleTemplateTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleTemplateTranslationClient) UpdateOne(_m *RoleTemplateTranslation) *RoleTemplateTranslationUpdateOne {
+	mutation := newRoleTemplateTranslationMutation(c.config, OpUpdateOne, withRoleTemplateTranslation(_m))
+	return &RoleTemplateTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleTemplateTranslationClient) UpdateOneID(id uuid.UUID) *RoleTemplateTranslationUpdateOne {
+	mutation := newRoleTemplateTranslationMutation(c.config, OpUpdateOne, withRoleTemplateTranslationID(id))
+	return &RoleTemplateTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleTemplateTranslation.
+func (c *RoleTemplateTranslationClient) Delete() *RoleTemplateTranslationDelete {
+	mutation := newRoleTemplateTranslationMutation(c.config, OpDelete)
+	return &RoleTemplateTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleTemplateTranslationClient) DeleteOne(_m *RoleTemplateTranslation) *RoleTemplateTranslationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleTemplateTranslationClient) DeleteOneID(id uuid.UUID) *RoleTemplateTranslationDeleteOne {
+	builder := c.Delete().Where(roletemplatetranslation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleTemplateTranslationDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleTemplateTranslation.
+func (c *RoleTemplateTranslationClient) Query() *RoleTemplateTranslationQuery {
+	return &RoleTemplateTranslationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleTemplateTranslation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleTemplateTranslation entity by its id.
+func (c *RoleTemplateTranslationClient) Get(ctx context.Context, id uuid.UUID) (*RoleTemplateTranslation, error) {
+	return c.Query().Where(roletemplatetranslation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleTemplateTranslationClient) GetX(ctx context.Context, id uuid.UUID) *RoleTemplateTranslation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoleTemplate queries the role_template edge of a RoleTemplateTranslation.
+func (c *RoleTemplateTranslationClient) QueryRoleTemplate(_m *RoleTemplateTranslation) *RoleTemplateQuery {
+	query := (&RoleTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplatetranslation.Table, roletemplatetranslation.FieldID, id),
+			sqlgraph.To(roletemplate.Table, roletemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roletemplatetranslation.RoleTemplateTable, roletemplatetranslation.RoleTemplateColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleTemplateTranslationClient) Hooks() []Hook {
+	return c.hooks.RoleTemplateTranslation
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleTemplateTranslationClient) Interceptors() []Interceptor {
+	return c.inters.RoleTemplateTranslation
+}
+
+func (c *RoleTemplateTranslationClient) mutate(ctx context.Context, m *RoleTemplateTranslationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleTemplateTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleTemplateTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleTemplateTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleTemplateTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleTemplateTranslation mutation op: %q", m.Op())
+	}
+}
+
+// RoleTranslationClient is a client for the RoleTranslation schema.
+type RoleTranslationClient struct {
+	config
+}
+
+// NewRoleTranslationClient returns a client for the RoleTranslation from the given config.
+func NewRoleTranslationClient(c config) *RoleTranslationClient {
+	return &RoleTranslationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roletranslation.Hooks(f(g(h())))`.
+func (c *RoleTranslationClient) Use(hooks ...Hook) {
+	c.hooks.RoleTranslation = append(c.hooks.RoleTranslation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roletranslation.Intercept(f(g(h())))`.
+func (c *RoleTranslationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleTranslation = append(c.inters.RoleTranslation, interceptors...)
+}
+
+// Create returns a builder for creating a RoleTranslation entity.
+func (c *RoleTranslationClient) Create() *RoleTranslationCreate {
+	mutation := newRoleTranslationMutation(c.config, OpCreate)
+	return &RoleTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleTranslation entities.
+func (c *RoleTranslationClient) CreateBulk(builders ...*RoleTranslationCreate) *RoleTranslationCreateBulk {
+	return &RoleTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleTranslationClient) MapCreateBulk(slice any, setFunc func(*RoleTranslationCreate, int)) *RoleTranslationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleTranslationCreateBulk{err: fmt.Errorf("calling to RoleTranslationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleTranslationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleTranslation.
+func (c *RoleTranslationClient) Update() *RoleTranslationUpdate {
+	mutation := newRoleTranslationMutation(c.config, OpUpdate)
+	return &RoleTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleTranslationClient) UpdateOne(_m *RoleTranslation) *RoleTranslationUpdateOne {
+	mutation := newRoleTranslationMutation(c.config, OpUpdateOne, withRoleTranslation(_m))
+	return &RoleTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleTranslationClient) UpdateOneID(id uuid.UUID) *RoleTranslationUpdateOne {
+	mutation := newRoleTranslationMutation(c.config, OpUpdateOne, withRoleTranslationID(id))
+	return &RoleTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleTranslation.
+func (c *RoleTranslationClient) Delete() *RoleTranslationDelete {
+	mutation := newRoleTranslationMutation(c.config, OpDelete)
+	return &RoleTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleTranslationClient) DeleteOne(_m *RoleTranslation) *RoleTranslationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleTranslationClient) DeleteOneID(id uuid.UUID) *RoleTranslationDeleteOne {
+	builder := c.Delete().Where(roletranslation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleTranslationDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleTranslation.
+func (c *RoleTranslationClient) Query() *RoleTranslationQuery {
+	return &RoleTranslationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleTranslation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleTranslation entity by its id.
+func (c *RoleTranslationClient) Get(ctx context.Context, id uuid.UUID) (*RoleTranslation, error) {
+	return c.Query().Where(roletranslation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleTranslationClient) GetX(ctx context.Context, id uuid.UUID) *RoleTranslation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RoleTranslation.
+func (c *RoleTranslationClient) QueryRole(_m *RoleTranslation) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletranslation.Table, roletranslation.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, roletranslation.RoleTable, roletranslation.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleTranslationClient) Hooks() []Hook {
+	return c.hooks.RoleTranslation
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleTranslationClient) Interceptors() []Interceptor {
+	return c.inters.RoleTranslation
+}
+
+func (c *RoleTranslationClient) mutate(ctx context.Context, m *RoleTranslationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleTranslation mutation op: %q", m.Op())
+	}
+}
+
+// SpectatorClient is a client for the Spectator schema.
+type SpectatorClient struct {
+	config
+}
+
+// NewSpectatorClient returns a client for the Spectator from the given config.
+func NewSpectatorClient(c config) *SpectatorClient {
+	return &SpectatorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spectator.Hooks(f(g(h())))`.
+func (c *SpectatorClient) Use(hooks ...Hook) {
+	c.hooks.Spectator = append(c.hooks.Spectator, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spectator.Intercept(f(g(h())))`.
+func (c *SpectatorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Spectator = append(c.inters.Spectator, interceptors...)
+}
+
+// Create returns a builder for creating a Spectator entity.
+func (c *SpectatorClient) Create() *SpectatorCreate {
+	mutation := newSpectatorMutation(c.config, OpCreate)
+	return &SpectatorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Spectator entities.
+func (c *SpectatorClient) CreateBulk(builders ...*SpectatorCreate) *SpectatorCreateBulk {
+	return &SpectatorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpectatorClient) MapCreateBulk(slice any, setFunc func(*SpectatorCreate, int)) *SpectatorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpectatorCreateBulk{err: fmt.Errorf("calling to SpectatorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpectatorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpectatorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Spectator.
+func (c *SpectatorClient) Update() *SpectatorUpdate {
+	mutation := newSpectatorMutation(c.config, OpUpdate)
+	return &SpectatorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpectatorClient) UpdateOne(_m *Spectator) *SpectatorUpdateOne {
+	mutation := newSpectatorMutation(c.config, OpUpdateOne, withSpectator(_m))
+	return &SpectatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpectatorClient) UpdateOneID(id uuid.UUID) *SpectatorUpdateOne {
+	mutation := newSpectatorMutation(c.config, OpUpdateOne, withSpectatorID(id))
+	return &SpectatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Spectator.
+func (c *SpectatorClient) Delete() *SpectatorDelete {
+	mutation := newSpectatorMutation(c.config, OpDelete)
+	return &SpectatorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpectatorClient) DeleteOne(_m *Spectator) *SpectatorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpectatorClient) DeleteOneID(id uuid.UUID) *SpectatorDeleteOne {
+	builder := c.Delete().Where(spectator.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpectatorDeleteOne{builder}
+}
+
+// Query returns a query builder for Spectator.
+func (c *SpectatorClient) Query() *SpectatorQuery {
+	return &SpectatorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpectator},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Spectator entity by its id.
+func (c *SpectatorClient) Get(ctx context.Context, id uuid.UUID) (*Spectator, error) {
+	return c.Query().Where(spectator.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpectatorClient) GetX(ctx context.Context, id uuid.UUID) *Spectator {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a Spectator.
+func (c *SpectatorClient) QueryGame(_m *Spectator) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(spectator.Table, spectator.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, spectator.GameTable, spectator.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SpectatorClient) Hooks() []Hook {
+	return c.hooks.Spectator
+}
+
+// Interceptors returns the client interceptors.
+func (c *SpectatorClient) Interceptors() []Interceptor {
+	return c.inters.Spectator
+}
+
+func (c *SpectatorClient) mutate(ctx context.Context, m *SpectatorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpectatorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpectatorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpectatorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpectatorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Spectator mutation op: %q", m.Op())
+	}
+}
+
+// VoteClient is a client for the Vote schema.
+type VoteClient struct {
+	config
+}
+
+// NewVoteClient returns a client for the Vote from the given config.
+func NewVoteClient(c config) *VoteClient {
+	return &VoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vote.Hooks(f(g(h())))`.
+func (c *VoteClient) Use(hooks ...Hook) {
+	c.hooks.Vote = append(c.hooks.Vote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vote.Intercept(f(g(h())))`.
+func (c *VoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Vote = append(c.inters.Vote, interceptors...)
+}
+
+// Create returns a builder for creating a Vote entity.
+func (c *VoteClient) Create() *VoteCreate {
+	mutation := newVoteMutation(c.config, OpCreate)
+	return &VoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Vote entities.
+func (c *VoteClient) CreateBulk(builders ...*VoteCreate) *VoteCreateBulk {
+	return &VoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VoteClient) MapCreateBulk(slice any, setFunc func(*VoteCreate, int)) *VoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VoteCreateBulk{err: fmt.Errorf("calling to VoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Vote.
+func (c *VoteClient) Update() *VoteUpdate {
+	mutation := newVoteMutation(c.config, OpUpdate)
+	return &VoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VoteClient) UpdateOne(_m *Vote) *VoteUpdateOne {
+	mutation := newVoteMutation(c.config, OpUpdateOne, withVote(_m))
+	return &VoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VoteClient) UpdateOneID(id uuid.UUID) *VoteUpdateOne {
+	mutation := newVoteMutation(c.config, OpUpdateOne, withVoteID(id))
+	return &VoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Vote.
+func (c *VoteClient) Delete() *VoteDelete {
+	mutation := newVoteMutation(c.config, OpDelete)
+	return &VoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VoteClient) DeleteOne(_m *Vote) *VoteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VoteClient) DeleteOneID(id uuid.UUID) *VoteDeleteOne {
+	builder := c.Delete().Where(vote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VoteDeleteOne{builder}
+}
+
+// Query returns a query builder for Vote.
+func (c *VoteClient) Query() *VoteQuery {
+	return &VoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Vote entity by its id.
+func (c *VoteClient) Get(ctx context.Context, id uuid.UUID) (*Vote, error) {
+	return c.Query().Where(vote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VoteClient) GetX(ctx context.Context, id uuid.UUID) *Vote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a Vote.
+func (c *VoteClient) QueryGame(_m *Vote) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vote.Table, vote.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vote.GameTable, vote.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VoteClient) Hooks() []Hook {
+	return c.hooks.Vote
+}
+
+// Interceptors returns the client interceptors.
+func (c *VoteClient) Interceptors() []Interceptor {
+	return c.inters.Vote
+}
+
+func (c *VoteClient) mutate(ctx context.Context, m *VoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Vote mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Admin, Game, GameRole, Player, Role, RoleTemplate, RoleTemplateRole []ent.Hook
+		Admin, ChatMessage, Game, GameModerator, GameRole, ModeratorAction, NightAction,
+		Player, Role, RoleRule, RoleTemplate, RoleTemplateRevision, RoleTemplateRole,
+		RoleTemplateSlot, RoleTemplateTranslation, RoleTranslation, Spectator,
+		Vote []ent.Hook
 	}
 	inters struct {
-		Admin, Game, GameRole, Player, Role, RoleTemplate,
-		RoleTemplateRole []ent.Interceptor
+		Admin, ChatMessage, Game, GameModerator, GameRole, ModeratorAction, NightAction,
+		Player, Role, RoleRule, RoleTemplate, RoleTemplateRevision, RoleTemplateRole,
+		RoleTemplateSlot, RoleTemplateTranslation, RoleTranslation, Spectator,
+		Vote []ent.Interceptor
 	}
 )
@@ -13,12 +13,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mafia-night/backend/ent/admin"
+	"github.com/mafia-night/backend/ent/chatmessage"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
 	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+	"github.com/mafia-night/backend/ent/nightaction"
 	"github.com/mafia-night/backend/ent/player"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+	"github.com/mafia-night/backend/ent/roletranslation"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/ent/vote"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -79,13 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			admin.Table:            admin.ValidColumn,
-			game.Table:             game.ValidColumn,
-			gamerole.Table:         gamerole.ValidColumn,
-			player.Table:           player.ValidColumn,
-			role.Table:             role.ValidColumn,
-			roletemplate.Table:     roletemplate.ValidColumn,
-			roletemplaterole.Table: roletemplaterole.ValidColumn,
+			admin.Table:                   admin.ValidColumn,
+			chatmessage.Table:             chatmessage.ValidColumn,
+			game.Table:                    game.ValidColumn,
+			gamemoderator.Table:           gamemoderator.ValidColumn,
+			gamerole.Table:                gamerole.ValidColumn,
+			moderatoraction.Table:         moderatoraction.ValidColumn,
+			nightaction.Table:             nightaction.ValidColumn,
+			player.Table:                  player.ValidColumn,
+			role.Table:                    role.ValidColumn,
+			rolerule.Table:                rolerule.ValidColumn,
+			roletemplate.Table:            roletemplate.ValidColumn,
+			roletemplaterevision.Table:    roletemplaterevision.ValidColumn,
+			roletemplaterole.Table:        roletemplaterole.ValidColumn,
+			roletemplateslot.Table:        roletemplateslot.ValidColumn,
+			roletemplatetranslation.Table: roletemplatetranslation.ValidColumn,
+			roletranslation.Table:         roletranslation.ValidColumn,
+			spectator.Table:               spectator.ValidColumn,
+			vote.Table:                    vote.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -21,6 +21,14 @@ type Game struct {
 	Status game.Status `json:"status,omitempty"`
 	// ModeratorID holds the value of the "moderator_id" field.
 	ModeratorID string `json:"moderator_id,omitempty"`
+	// Whether spectators may watch the game and if their view is delayed
+	SpectatorMode game.SpectatorMode `json:"spectator_mode,omitempty"`
+	// Delay applied to spectator updates in delayed mode
+	SpectatorDelaySeconds int `json:"spectator_delay_seconds,omitempty"`
+	// Part of the round the game is in while active
+	Phase game.Phase `json:"phase,omitempty"`
+	// One-based round number, advanced after each night
+	Round int `json:"round,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -35,9 +43,19 @@ type GameEdges struct {
 	Players []*Player `json:"players,omitempty"`
 	// GameRoles holds the value of the game_roles edge.
 	GameRoles []*GameRole `json:"game_roles,omitempty"`
+	// Moderators holds the value of the moderators edge.
+	Moderators []*GameModerator `json:"moderators,omitempty"`
+	// Spectators holds the value of the spectators edge.
+	Spectators []*Spectator `json:"spectators,omitempty"`
+	// Votes holds the value of the votes edge.
+	Votes []*Vote `json:"votes,omitempty"`
+	// NightActions holds the value of the night_actions edge.
+	NightActions []*NightAction `json:"night_actions,omitempty"`
+	// ChatMessages holds the value of the chat_messages edge.
+	ChatMessages []*ChatMessage `json:"chat_messages,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [7]bool
 }
 
 // PlayersOrErr returns the Players value or an error if the edge
@@ -58,12 +76,59 @@ func (e GameEdges) GameRolesOrErr() ([]*GameRole, error) {
 	return nil, &NotLoadedError{edge: "game_roles"}
 }
 
+// ModeratorsOrErr returns the Moderators value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) ModeratorsOrErr() ([]*GameModerator, error) {
+	if e.loadedTypes[2] {
+		return e.Moderators, nil
+	}
+	return nil, &NotLoadedError{edge: "moderators"}
+}
+
+// SpectatorsOrErr returns the Spectators value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) SpectatorsOrErr() ([]*Spectator, error) {
+	if e.loadedTypes[3] {
+		return e.Spectators, nil
+	}
+	return nil, &NotLoadedError{edge: "spectators"}
+}
+
+// VotesOrErr returns the Votes value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) VotesOrErr() ([]*Vote, error) {
+	if e.loadedTypes[4] {
+		return e.Votes, nil
+	}
+	return nil, &NotLoadedError{edge: "votes"}
+}
+
+// NightActionsOrErr returns the NightActions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) NightActionsOrErr() ([]*NightAction, error) {
+	if e.loadedTypes[5] {
+		return e.NightActions, nil
+	}
+	return nil, &NotLoadedError{edge: "night_actions"}
+}
+
+// ChatMessagesOrErr returns the ChatMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) ChatMessagesOrErr() ([]*ChatMessage, error) {
+	if e.loadedTypes[6] {
+		return e.ChatMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "chat_messages"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Game) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case game.FieldID, game.FieldStatus, game.FieldModeratorID:
+		case game.FieldSpectatorDelaySeconds, game.FieldRound:
+			values[i] = new(sql.NullInt64)
+		case game.FieldID, game.FieldStatus, game.FieldModeratorID, game.FieldSpectatorMode, game.FieldPhase:
 			values[i] = new(sql.NullString)
 		case game.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -100,6 +165,30 @@ func (_m *Game) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ModeratorID = value.String
 			}
+		case game.FieldSpectatorMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spectator_mode", values[i])
+			} else if value.Valid {
+				_m.SpectatorMode = game.SpectatorMode(value.String)
+			}
+		case game.FieldSpectatorDelaySeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field spectator_delay_seconds", values[i])
+			} else if value.Valid {
+				_m.SpectatorDelaySeconds = int(value.Int64)
+			}
+		case game.FieldPhase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phase", values[i])
+			} else if value.Valid {
+				_m.Phase = game.Phase(value.String)
+			}
+		case game.FieldRound:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field round", values[i])
+			} else if value.Valid {
+				_m.Round = int(value.Int64)
+			}
 		case game.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -129,6 +218,31 @@ func (_m *Game) QueryGameRoles() *GameRoleQuery {
 	return NewGameClient(_m.config).QueryGameRoles(_m)
 }
 
+// QueryModerators queries the "moderators" edge of the Game entity.
+func (_m *Game) QueryModerators() *GameModeratorQuery {
+	return NewGameClient(_m.config).QueryModerators(_m)
+}
+
+// QuerySpectators queries the "spectators" edge of the Game entity.
+func (_m *Game) QuerySpectators() *SpectatorQuery {
+	return NewGameClient(_m.config).QuerySpectators(_m)
+}
+
+// QueryVotes queries the "votes" edge of the Game entity.
+func (_m *Game) QueryVotes() *VoteQuery {
+	return NewGameClient(_m.config).QueryVotes(_m)
+}
+
+// QueryNightActions queries the "night_actions" edge of the Game entity.
+func (_m *Game) QueryNightActions() *NightActionQuery {
+	return NewGameClient(_m.config).QueryNightActions(_m)
+}
+
+// QueryChatMessages queries the "chat_messages" edge of the Game entity.
+func (_m *Game) QueryChatMessages() *ChatMessageQuery {
+	return NewGameClient(_m.config).QueryChatMessages(_m)
+}
+
 // Update returns a builder for updating this Game.
 // Note that you need to call Game.Unwrap() before calling this method if this Game
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -158,6 +272,18 @@ func (_m *Game) String() string {
 	builder.WriteString("moderator_id=")
 	builder.WriteString(_m.ModeratorID)
 	builder.WriteString(", ")
+	builder.WriteString("spectator_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpectatorMode))
+	builder.WriteString(", ")
+	builder.WriteString("spectator_delay_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SpectatorDelaySeconds))
+	builder.WriteString(", ")
+	builder.WriteString("phase=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Phase))
+	builder.WriteString(", ")
+	builder.WriteString("round=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Round))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -19,12 +19,30 @@ const (
 	FieldStatus = "status"
 	// FieldModeratorID holds the string denoting the moderator_id field in the database.
 	FieldModeratorID = "moderator_id"
+	// FieldSpectatorMode holds the string denoting the spectator_mode field in the database.
+	FieldSpectatorMode = "spectator_mode"
+	// FieldSpectatorDelaySeconds holds the string denoting the spectator_delay_seconds field in the database.
+	FieldSpectatorDelaySeconds = "spectator_delay_seconds"
+	// FieldPhase holds the string denoting the phase field in the database.
+	FieldPhase = "phase"
+	// FieldRound holds the string denoting the round field in the database.
+	FieldRound = "round"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgePlayers holds the string denoting the players edge name in mutations.
 	EdgePlayers = "players"
 	// EdgeGameRoles holds the string denoting the game_roles edge name in mutations.
 	EdgeGameRoles = "game_roles"
+	// EdgeModerators holds the string denoting the moderators edge name in mutations.
+	EdgeModerators = "moderators"
+	// EdgeSpectators holds the string denoting the spectators edge name in mutations.
+	EdgeSpectators = "spectators"
+	// EdgeVotes holds the string denoting the votes edge name in mutations.
+	EdgeVotes = "votes"
+	// EdgeNightActions holds the string denoting the night_actions edge name in mutations.
+	EdgeNightActions = "night_actions"
+	// EdgeChatMessages holds the string denoting the chat_messages edge name in mutations.
+	EdgeChatMessages = "chat_messages"
 	// Table holds the table name of the game in the database.
 	Table = "games"
 	// PlayersTable is the table that holds the players relation/edge.
@@ -41,6 +59,41 @@ const (
 	GameRolesInverseTable = "game_roles"
 	// GameRolesColumn is the table column denoting the game_roles relation/edge.
 	GameRolesColumn = "game_id"
+	// ModeratorsTable is the table that holds the moderators relation/edge.
+	ModeratorsTable = "game_moderators"
+	// ModeratorsInverseTable is the table name for the GameModerator entity.
+	// It exists in this package in order to avoid circular dependency with the "gamemoderator" package.
+	ModeratorsInverseTable = "game_moderators"
+	// ModeratorsColumn is the table column denoting the moderators relation/edge.
+	ModeratorsColumn = "game_id"
+	// SpectatorsTable is the table that holds the spectators relation/edge.
+	SpectatorsTable = "spectators"
+	// SpectatorsInverseTable is the table name for the Spectator entity.
+	// It exists in this package in order to avoid circular dependency with the "spectator" package.
+	SpectatorsInverseTable = "spectators"
+	// SpectatorsColumn is the table column denoting the spectators relation/edge.
+	SpectatorsColumn = "game_id"
+	// VotesTable is the table that holds the votes relation/edge.
+	VotesTable = "votes"
+	// VotesInverseTable is the table name for the Vote entity.
+	// It exists in this package in order to avoid circular dependency with the "vote" package.
+	VotesInverseTable = "votes"
+	// VotesColumn is the table column denoting the votes relation/edge.
+	VotesColumn = "game_id"
+	// NightActionsTable is the table that holds the night_actions relation/edge.
+	NightActionsTable = "night_actions"
+	// NightActionsInverseTable is the table name for the NightAction entity.
+	// It exists in this package in order to avoid circular dependency with the "nightaction" package.
+	NightActionsInverseTable = "night_actions"
+	// NightActionsColumn is the table column denoting the night_actions relation/edge.
+	NightActionsColumn = "game_id"
+	// ChatMessagesTable is the table that holds the chat_messages relation/edge.
+	ChatMessagesTable = "chat_messages"
+	// ChatMessagesInverseTable is the table name for the ChatMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "chatmessage" package.
+	ChatMessagesInverseTable = "chat_messages"
+	// ChatMessagesColumn is the table column denoting the chat_messages relation/edge.
+	ChatMessagesColumn = "game_id"
 )
 
 // Columns holds all SQL columns for game fields.
@@ -48,6 +101,10 @@ var Columns = []string{
 	FieldID,
 	FieldStatus,
 	FieldModeratorID,
+	FieldSpectatorMode,
+	FieldSpectatorDelaySeconds,
+	FieldPhase,
+	FieldRound,
 	FieldCreatedAt,
 }
 
@@ -64,6 +121,14 @@ func ValidColumn(column string) bool {
 var (
 	// ModeratorIDValidator is a validator for the "moderator_id" field. It is called by the builders before save.
 	ModeratorIDValidator func(string) error
+	// DefaultSpectatorDelaySeconds holds the default value on creation for the "spectator_delay_seconds" field.
+	DefaultSpectatorDelaySeconds int
+	// SpectatorDelaySecondsValidator is a validator for the "spectator_delay_seconds" field. It is called by the builders before save.
+	SpectatorDelaySecondsValidator func(int) error
+	// DefaultRound holds the default value on creation for the "round" field.
+	DefaultRound int
+	// RoundValidator is a validator for the "round" field. It is called by the builders before save.
+	RoundValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -97,6 +162,59 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// SpectatorMode defines the type for the "spectator_mode" enum field.
+type SpectatorMode string
+
+// SpectatorModeEnabled is the default value of the SpectatorMode enum.
+const DefaultSpectatorMode = SpectatorModeEnabled
+
+// SpectatorMode values.
+const (
+	SpectatorModeDisabled SpectatorMode = "disabled"
+	SpectatorModeEnabled  SpectatorMode = "enabled"
+	SpectatorModeDelayed  SpectatorMode = "delayed"
+)
+
+func (sm SpectatorMode) String() string {
+	return string(sm)
+}
+
+// SpectatorModeValidator is a validator for the "spectator_mode" field enum values. It is called by the builders before save.
+func SpectatorModeValidator(sm SpectatorMode) error {
+	switch sm {
+	case SpectatorModeDisabled, SpectatorModeEnabled, SpectatorModeDelayed:
+		return nil
+	default:
+		return fmt.Errorf("game: invalid enum value for spectator_mode field: %q", sm)
+	}
+}
+
+// Phase defines the type for the "phase" enum field.
+type Phase string
+
+// PhaseDay is the default value of the Phase enum.
+const DefaultPhase = PhaseDay
+
+// Phase values.
+const (
+	PhaseDay   Phase = "day"
+	PhaseNight Phase = "night"
+)
+
+func (ph Phase) String() string {
+	return string(ph)
+}
+
+// PhaseValidator is a validator for the "phase" field enum values. It is called by the builders before save.
+func PhaseValidator(ph Phase) error {
+	switch ph {
+	case PhaseDay, PhaseNight:
+		return nil
+	default:
+		return fmt.Errorf("game: invalid enum value for phase field: %q", ph)
+	}
+}
+
 // OrderOption defines the ordering options for the Game queries.
 type OrderOption func(*sql.Selector)
 
@@ -115,6 +233,26 @@ func ByModeratorID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModeratorID, opts...).ToFunc()
 }
 
+// BySpectatorMode orders the results by the spectator_mode field.
+func BySpectatorMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpectatorMode, opts...).ToFunc()
+}
+
+// BySpectatorDelaySeconds orders the results by the spectator_delay_seconds field.
+func BySpectatorDelaySeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpectatorDelaySeconds, opts...).ToFunc()
+}
+
+// ByPhase orders the results by the phase field.
+func ByPhase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhase, opts...).ToFunc()
+}
+
+// ByRound orders the results by the round field.
+func ByRound(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRound, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -147,6 +285,76 @@ func ByGameRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newGameRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByModeratorsCount orders the results by moderators count.
+func ByModeratorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModeratorsStep(), opts...)
+	}
+}
+
+// ByModerators orders the results by moderators terms.
+func ByModerators(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModeratorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySpectatorsCount orders the results by spectators count.
+func BySpectatorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSpectatorsStep(), opts...)
+	}
+}
+
+// BySpectators orders the results by spectators terms.
+func BySpectators(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSpectatorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVotesCount orders the results by votes count.
+func ByVotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVotesStep(), opts...)
+	}
+}
+
+// ByVotes orders the results by votes terms.
+func ByVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNightActionsCount orders the results by night_actions count.
+func ByNightActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNightActionsStep(), opts...)
+	}
+}
+
+// ByNightActions orders the results by night_actions terms.
+func ByNightActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNightActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChatMessagesCount orders the results by chat_messages count.
+func ByChatMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChatMessagesStep(), opts...)
+	}
+}
+
+// ByChatMessages orders the results by chat_messages terms.
+func ByChatMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChatMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPlayersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -161,3 +369,38 @@ func newGameRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, GameRolesTable, GameRolesColumn),
 	)
 }
+func newModeratorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModeratorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModeratorsTable, ModeratorsColumn),
+	)
+}
+func newSpectatorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SpectatorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SpectatorsTable, SpectatorsColumn),
+	)
+}
+func newVotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VotesTable, VotesColumn),
+	)
+}
+func newNightActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NightActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NightActionsTable, NightActionsColumn),
+	)
+}
+func newChatMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChatMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+	)
+}
@@ -70,6 +70,16 @@ func ModeratorID(v string) predicate.Game {
 	return predicate.Game(sql.FieldEQ(FieldModeratorID, v))
 }
 
+// SpectatorDelaySeconds applies equality check predicate on the "spectator_delay_seconds" field. It's identical to SpectatorDelaySecondsEQ.
+func SpectatorDelaySeconds(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldSpectatorDelaySeconds, v))
+}
+
+// Round applies equality check predicate on the "round" field. It's identical to RoundEQ.
+func Round(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldRound, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Game {
 	return predicate.Game(sql.FieldEQ(FieldCreatedAt, v))
@@ -160,6 +170,126 @@ func ModeratorIDContainsFold(v string) predicate.Game {
 	return predicate.Game(sql.FieldContainsFold(FieldModeratorID, v))
 }
 
+// SpectatorModeEQ applies the EQ predicate on the "spectator_mode" field.
+func SpectatorModeEQ(v SpectatorMode) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldSpectatorMode, v))
+}
+
+// SpectatorModeNEQ applies the NEQ predicate on the "spectator_mode" field.
+func SpectatorModeNEQ(v SpectatorMode) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldSpectatorMode, v))
+}
+
+// SpectatorModeIn applies the In predicate on the "spectator_mode" field.
+func SpectatorModeIn(vs ...SpectatorMode) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldSpectatorMode, vs...))
+}
+
+// SpectatorModeNotIn applies the NotIn predicate on the "spectator_mode" field.
+func SpectatorModeNotIn(vs ...SpectatorMode) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldSpectatorMode, vs...))
+}
+
+// SpectatorDelaySecondsEQ applies the EQ predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldSpectatorDelaySeconds, v))
+}
+
+// SpectatorDelaySecondsNEQ applies the NEQ predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldSpectatorDelaySeconds, v))
+}
+
+// SpectatorDelaySecondsIn applies the In predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldSpectatorDelaySeconds, vs...))
+}
+
+// SpectatorDelaySecondsNotIn applies the NotIn predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldSpectatorDelaySeconds, vs...))
+}
+
+// SpectatorDelaySecondsGT applies the GT predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldSpectatorDelaySeconds, v))
+}
+
+// SpectatorDelaySecondsGTE applies the GTE predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldSpectatorDelaySeconds, v))
+}
+
+// SpectatorDelaySecondsLT applies the LT predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldSpectatorDelaySeconds, v))
+}
+
+// SpectatorDelaySecondsLTE applies the LTE predicate on the "spectator_delay_seconds" field.
+func SpectatorDelaySecondsLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldSpectatorDelaySeconds, v))
+}
+
+// PhaseEQ applies the EQ predicate on the "phase" field.
+func PhaseEQ(v Phase) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldPhase, v))
+}
+
+// PhaseNEQ applies the NEQ predicate on the "phase" field.
+func PhaseNEQ(v Phase) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldPhase, v))
+}
+
+// PhaseIn applies the In predicate on the "phase" field.
+func PhaseIn(vs ...Phase) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldPhase, vs...))
+}
+
+// PhaseNotIn applies the NotIn predicate on the "phase" field.
+func PhaseNotIn(vs ...Phase) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldPhase, vs...))
+}
+
+// RoundEQ applies the EQ predicate on the "round" field.
+func RoundEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldRound, v))
+}
+
+// RoundNEQ applies the NEQ predicate on the "round" field.
+func RoundNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldRound, v))
+}
+
+// RoundIn applies the In predicate on the "round" field.
+func RoundIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldRound, vs...))
+}
+
+// RoundNotIn applies the NotIn predicate on the "round" field.
+func RoundNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldRound, vs...))
+}
+
+// RoundGT applies the GT predicate on the "round" field.
+func RoundGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldRound, v))
+}
+
+// RoundGTE applies the GTE predicate on the "round" field.
+func RoundGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldRound, v))
+}
+
+// RoundLT applies the LT predicate on the "round" field.
+func RoundLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldRound, v))
+}
+
+// RoundLTE applies the LTE predicate on the "round" field.
+func RoundLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldRound, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Game {
 	return predicate.Game(sql.FieldEQ(FieldCreatedAt, v))
@@ -246,6 +376,121 @@ func HasGameRolesWith(preds ...predicate.GameRole) predicate.Game {
 	})
 }
 
+// HasModerators applies the HasEdge predicate on the "moderators" edge.
+func HasModerators() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ModeratorsTable, ModeratorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModeratorsWith applies the HasEdge predicate on the "moderators" edge with a given conditions (other predicates).
+func HasModeratorsWith(preds ...predicate.GameModerator) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newModeratorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSpectators applies the HasEdge predicate on the "spectators" edge.
+func HasSpectators() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SpectatorsTable, SpectatorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSpectatorsWith applies the HasEdge predicate on the "spectators" edge with a given conditions (other predicates).
+func HasSpectatorsWith(preds ...predicate.Spectator) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newSpectatorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVotes applies the HasEdge predicate on the "votes" edge.
+func HasVotes() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VotesTable, VotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVotesWith applies the HasEdge predicate on the "votes" edge with a given conditions (other predicates).
+func HasVotesWith(preds ...predicate.Vote) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newVotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNightActions applies the HasEdge predicate on the "night_actions" edge.
+func HasNightActions() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NightActionsTable, NightActionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNightActionsWith applies the HasEdge predicate on the "night_actions" edge with a given conditions (other predicates).
+func HasNightActionsWith(preds ...predicate.NightAction) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newNightActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChatMessages applies the HasEdge predicate on the "chat_messages" edge.
+func HasChatMessages() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatMessagesWith applies the HasEdge predicate on the "chat_messages" edge with a given conditions (other predicates).
+func HasChatMessagesWith(preds ...predicate.ChatMessage) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newChatMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Game) predicate.Game {
 	return predicate.Game(sql.AndPredicates(predicates...))
@@ -11,9 +11,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/chatmessage"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
 	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/nightaction"
 	"github.com/mafia-night/backend/ent/player"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/ent/vote"
 )
 
 // GameCreate is the builder for creating a Game entity.
@@ -43,6 +48,62 @@ func (_c *GameCreate) SetModeratorID(v string) *GameCreate {
 	return _c
 }
 
+// SetSpectatorMode sets the "spectator_mode" field.
+func (_c *GameCreate) SetSpectatorMode(v game.SpectatorMode) *GameCreate {
+	_c.mutation.SetSpectatorMode(v)
+	return _c
+}
+
+// SetNillableSpectatorMode sets the "spectator_mode" field if the given value is not nil.
+func (_c *GameCreate) SetNillableSpectatorMode(v *game.SpectatorMode) *GameCreate {
+	if v != nil {
+		_c.SetSpectatorMode(*v)
+	}
+	return _c
+}
+
+// SetSpectatorDelaySeconds sets the "spectator_delay_seconds" field.
+func (_c *GameCreate) SetSpectatorDelaySeconds(v int) *GameCreate {
+	_c.mutation.SetSpectatorDelaySeconds(v)
+	return _c
+}
+
+// SetNillableSpectatorDelaySeconds sets the "spectator_delay_seconds" field if the given value is not nil.
+func (_c *GameCreate) SetNillableSpectatorDelaySeconds(v *int) *GameCreate {
+	if v != nil {
+		_c.SetSpectatorDelaySeconds(*v)
+	}
+	return _c
+}
+
+// SetPhase sets the "phase" field.
+func (_c *GameCreate) SetPhase(v game.Phase) *GameCreate {
+	_c.mutation.SetPhase(v)
+	return _c
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_c *GameCreate) SetNillablePhase(v *game.Phase) *GameCreate {
+	if v != nil {
+		_c.SetPhase(*v)
+	}
+	return _c
+}
+
+// SetRound sets the "round" field.
+func (_c *GameCreate) SetRound(v int) *GameCreate {
+	_c.mutation.SetRound(v)
+	return _c
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_c *GameCreate) SetNillableRound(v *int) *GameCreate {
+	if v != nil {
+		_c.SetRound(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *GameCreate) SetCreatedAt(v time.Time) *GameCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -93,6 +154,81 @@ func (_c *GameCreate) AddGameRoles(v ...*GameRole) *GameCreate {
 	return _c.AddGameRoleIDs(ids...)
 }
 
+// AddModeratorIDs adds the "moderators" edge to the GameModerator entity by IDs.
+func (_c *GameCreate) AddModeratorIDs(ids ...uuid.UUID) *GameCreate {
+	_c.mutation.AddModeratorIDs(ids...)
+	return _c
+}
+
+// AddModerators adds the "moderators" edges to the GameModerator entity.
+func (_c *GameCreate) AddModerators(v ...*GameModerator) *GameCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddModeratorIDs(ids...)
+}
+
+// AddSpectatorIDs adds the "spectators" edge to the Spectator entity by IDs.
+func (_c *GameCreate) AddSpectatorIDs(ids ...uuid.UUID) *GameCreate {
+	_c.mutation.AddSpectatorIDs(ids...)
+	return _c
+}
+
+// AddSpectators adds the "spectators" edges to the Spectator entity.
+func (_c *GameCreate) AddSpectators(v ...*Spectator) *GameCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSpectatorIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
+func (_c *GameCreate) AddVoteIDs(ids ...uuid.UUID) *GameCreate {
+	_c.mutation.AddVoteIDs(ids...)
+	return _c
+}
+
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_c *GameCreate) AddVotes(v ...*Vote) *GameCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVoteIDs(ids...)
+}
+
+// AddNightActionIDs adds the "night_actions" edge to the NightAction entity by IDs.
+func (_c *GameCreate) AddNightActionIDs(ids ...uuid.UUID) *GameCreate {
+	_c.mutation.AddNightActionIDs(ids...)
+	return _c
+}
+
+// AddNightActions adds the "night_actions" edges to the NightAction entity.
+func (_c *GameCreate) AddNightActions(v ...*NightAction) *GameCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNightActionIDs(ids...)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (_c *GameCreate) AddChatMessageIDs(ids ...uuid.UUID) *GameCreate {
+	_c.mutation.AddChatMessageIDs(ids...)
+	return _c
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (_c *GameCreate) AddChatMessages(v ...*ChatMessage) *GameCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChatMessageIDs(ids...)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (_c *GameCreate) Mutation() *GameMutation {
 	return _c.mutation
@@ -132,6 +268,22 @@ func (_c *GameCreate) defaults() {
 		v := game.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.SpectatorMode(); !ok {
+		v := game.DefaultSpectatorMode
+		_c.mutation.SetSpectatorMode(v)
+	}
+	if _, ok := _c.mutation.SpectatorDelaySeconds(); !ok {
+		v := game.DefaultSpectatorDelaySeconds
+		_c.mutation.SetSpectatorDelaySeconds(v)
+	}
+	if _, ok := _c.mutation.Phase(); !ok {
+		v := game.DefaultPhase
+		_c.mutation.SetPhase(v)
+	}
+	if _, ok := _c.mutation.Round(); !ok {
+		v := game.DefaultRound
+		_c.mutation.SetRound(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := game.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -156,6 +308,38 @@ func (_c *GameCreate) check() error {
 			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "Game.moderator_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.SpectatorMode(); !ok {
+		return &ValidationError{Name: "spectator_mode", err: errors.New(`ent: missing required field "Game.spectator_mode"`)}
+	}
+	if v, ok := _c.mutation.SpectatorMode(); ok {
+		if err := game.SpectatorModeValidator(v); err != nil {
+			return &ValidationError{Name: "spectator_mode", err: fmt.Errorf(`ent: validator failed for field "Game.spectator_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SpectatorDelaySeconds(); !ok {
+		return &ValidationError{Name: "spectator_delay_seconds", err: errors.New(`ent: missing required field "Game.spectator_delay_seconds"`)}
+	}
+	if v, ok := _c.mutation.SpectatorDelaySeconds(); ok {
+		if err := game.SpectatorDelaySecondsValidator(v); err != nil {
+			return &ValidationError{Name: "spectator_delay_seconds", err: fmt.Errorf(`ent: validator failed for field "Game.spectator_delay_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Phase(); !ok {
+		return &ValidationError{Name: "phase", err: errors.New(`ent: missing required field "Game.phase"`)}
+	}
+	if v, ok := _c.mutation.Phase(); ok {
+		if err := game.PhaseValidator(v); err != nil {
+			return &ValidationError{Name: "phase", err: fmt.Errorf(`ent: validator failed for field "Game.phase": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Round(); !ok {
+		return &ValidationError{Name: "round", err: errors.New(`ent: missing required field "Game.round"`)}
+	}
+	if v, ok := _c.mutation.Round(); ok {
+		if err := game.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "Game.round": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Game.created_at"`)}
 	}
@@ -207,6 +391,22 @@ func (_c *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		_spec.SetField(game.FieldModeratorID, field.TypeString, value)
 		_node.ModeratorID = value
 	}
+	if value, ok := _c.mutation.SpectatorMode(); ok {
+		_spec.SetField(game.FieldSpectatorMode, field.TypeEnum, value)
+		_node.SpectatorMode = value
+	}
+	if value, ok := _c.mutation.SpectatorDelaySeconds(); ok {
+		_spec.SetField(game.FieldSpectatorDelaySeconds, field.TypeInt, value)
+		_node.SpectatorDelaySeconds = value
+	}
+	if value, ok := _c.mutation.Phase(); ok {
+		_spec.SetField(game.FieldPhase, field.TypeEnum, value)
+		_node.Phase = value
+	}
+	if value, ok := _c.mutation.Round(); ok {
+		_spec.SetField(game.FieldRound, field.TypeInt, value)
+		_node.Round = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(game.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -243,6 +443,86 @@ func (_c *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ModeratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ModeratorsTable,
+			Columns: []string{game.ModeratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SpectatorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SpectatorsTable,
+			Columns: []string{game.SpectatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spectator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.VotesTable,
+			Columns: []string{game.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NightActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.NightActionsTable,
+			Columns: []string{game.NightActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ChatMessagesTable,
+			Columns: []string{game.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,21 +12,31 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mafia-night/backend/ent/chatmessage"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
 	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/nightaction"
 	"github.com/mafia-night/backend/ent/player"
 	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/ent/vote"
 )
 
 // GameQuery is the builder for querying Game entities.
 type GameQuery struct {
 	config
-	ctx           *QueryContext
-	order         []game.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Game
-	withPlayers   *PlayerQuery
-	withGameRoles *GameRoleQuery
+	ctx              *QueryContext
+	order            []game.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Game
+	withPlayers      *PlayerQuery
+	withGameRoles    *GameRoleQuery
+	withModerators   *GameModeratorQuery
+	withSpectators   *SpectatorQuery
+	withVotes        *VoteQuery
+	withNightActions *NightActionQuery
+	withChatMessages *ChatMessageQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +117,116 @@ func (_q *GameQuery) QueryGameRoles() *GameRoleQuery {
 	return query
 }
 
+// QueryModerators chains the current query on the "moderators" edge.
+func (_q *GameQuery) QueryModerators() *GameModeratorQuery {
+	query := (&GameModeratorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(gamemoderator.Table, gamemoderator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.ModeratorsTable, game.ModeratorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySpectators chains the current query on the "spectators" edge.
+func (_q *GameQuery) QuerySpectators() *SpectatorQuery {
+	query := (&SpectatorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(spectator.Table, spectator.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.SpectatorsTable, game.SpectatorsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVotes chains the current query on the "votes" edge.
+func (_q *GameQuery) QueryVotes() *VoteQuery {
+	query := (&VoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(vote.Table, vote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.VotesTable, game.VotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNightActions chains the current query on the "night_actions" edge.
+func (_q *GameQuery) QueryNightActions() *NightActionQuery {
+	query := (&NightActionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(nightaction.Table, nightaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.NightActionsTable, game.NightActionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChatMessages chains the current query on the "chat_messages" edge.
+func (_q *GameQuery) QueryChatMessages() *ChatMessageQuery {
+	query := (&ChatMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(chatmessage.Table, chatmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.ChatMessagesTable, game.ChatMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Game entity from the query.
 // Returns a *NotFoundError when no Game was found.
 func (_q *GameQuery) First(ctx context.Context) (*Game, error) {
@@ -294,13 +414,18 @@ func (_q *GameQuery) Clone() *GameQuery {
 		return nil
 	}
 	return &GameQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]game.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.Game{}, _q.predicates...),
-		withPlayers:   _q.withPlayers.Clone(),
-		withGameRoles: _q.withGameRoles.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]game.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Game{}, _q.predicates...),
+		withPlayers:      _q.withPlayers.Clone(),
+		withGameRoles:    _q.withGameRoles.Clone(),
+		withModerators:   _q.withModerators.Clone(),
+		withSpectators:   _q.withSpectators.Clone(),
+		withVotes:        _q.withVotes.Clone(),
+		withNightActions: _q.withNightActions.Clone(),
+		withChatMessages: _q.withChatMessages.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +454,61 @@ func (_q *GameQuery) WithGameRoles(opts ...func(*GameRoleQuery)) *GameQuery {
 	return _q
 }
 
+// WithModerators tells the query-builder to eager-load the nodes that are connected to
+// the "moderators" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GameQuery) WithModerators(opts ...func(*GameModeratorQuery)) *GameQuery {
+	query := (&GameModeratorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withModerators = query
+	return _q
+}
+
+// WithSpectators tells the query-builder to eager-load the nodes that are connected to
+// the "spectators" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GameQuery) WithSpectators(opts ...func(*SpectatorQuery)) *GameQuery {
+	query := (&SpectatorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSpectators = query
+	return _q
+}
+
+// WithVotes tells the query-builder to eager-load the nodes that are connected to
+// the "votes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GameQuery) WithVotes(opts ...func(*VoteQuery)) *GameQuery {
+	query := (&VoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVotes = query
+	return _q
+}
+
+// WithNightActions tells the query-builder to eager-load the nodes that are connected to
+// the "night_actions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GameQuery) WithNightActions(opts ...func(*NightActionQuery)) *GameQuery {
+	query := (&NightActionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNightActions = query
+	return _q
+}
+
+// WithChatMessages tells the query-builder to eager-load the nodes that are connected to
+// the "chat_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GameQuery) WithChatMessages(opts ...func(*ChatMessageQuery)) *GameQuery {
+	query := (&ChatMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChatMessages = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +587,14 @@ func (_q *GameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Game, e
 	var (
 		nodes       = []*Game{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [7]bool{
 			_q.withPlayers != nil,
 			_q.withGameRoles != nil,
+			_q.withModerators != nil,
+			_q.withSpectators != nil,
+			_q.withVotes != nil,
+			_q.withNightActions != nil,
+			_q.withChatMessages != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +629,41 @@ func (_q *GameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Game, e
 			return nil, err
 		}
 	}
+	if query := _q.withModerators; query != nil {
+		if err := _q.loadModerators(ctx, query, nodes,
+			func(n *Game) { n.Edges.Moderators = []*GameModerator{} },
+			func(n *Game, e *GameModerator) { n.Edges.Moderators = append(n.Edges.Moderators, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSpectators; query != nil {
+		if err := _q.loadSpectators(ctx, query, nodes,
+			func(n *Game) { n.Edges.Spectators = []*Spectator{} },
+			func(n *Game, e *Spectator) { n.Edges.Spectators = append(n.Edges.Spectators, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVotes; query != nil {
+		if err := _q.loadVotes(ctx, query, nodes,
+			func(n *Game) { n.Edges.Votes = []*Vote{} },
+			func(n *Game, e *Vote) { n.Edges.Votes = append(n.Edges.Votes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNightActions; query != nil {
+		if err := _q.loadNightActions(ctx, query, nodes,
+			func(n *Game) { n.Edges.NightActions = []*NightAction{} },
+			func(n *Game, e *NightAction) { n.Edges.NightActions = append(n.Edges.NightActions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChatMessages; query != nil {
+		if err := _q.loadChatMessages(ctx, query, nodes,
+			func(n *Game) { n.Edges.ChatMessages = []*ChatMessage{} },
+			func(n *Game, e *ChatMessage) { n.Edges.ChatMessages = append(n.Edges.ChatMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +727,156 @@ func (_q *GameQuery) loadGameRoles(ctx context.Context, query *GameRoleQuery, no
 	}
 	return nil
 }
+func (_q *GameQuery) loadModerators(ctx context.Context, query *GameModeratorQuery, nodes []*Game, init func(*Game), assign func(*Game, *GameModerator)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(gamemoderator.FieldGameID)
+	}
+	query.Where(predicate.GameModerator(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.ModeratorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GameQuery) loadSpectators(ctx context.Context, query *SpectatorQuery, nodes []*Game, init func(*Game), assign func(*Game, *Spectator)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(spectator.FieldGameID)
+	}
+	query.Where(predicate.Spectator(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.SpectatorsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GameQuery) loadVotes(ctx context.Context, query *VoteQuery, nodes []*Game, init func(*Game), assign func(*Game, *Vote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(vote.FieldGameID)
+	}
+	query.Where(predicate.Vote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.VotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GameQuery) loadNightActions(ctx context.Context, query *NightActionQuery, nodes []*Game, init func(*Game), assign func(*Game, *NightAction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(nightaction.FieldGameID)
+	}
+	query.Where(predicate.NightAction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.NightActionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GameQuery) loadChatMessages(ctx context.Context, query *ChatMessageQuery, nodes []*Game, init func(*Game), assign func(*Game, *ChatMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(chatmessage.FieldGameID)
+	}
+	query.Where(predicate.ChatMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.ChatMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *GameQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -11,10 +11,15 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/chatmessage"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
 	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/nightaction"
 	"github.com/mafia-night/backend/ent/player"
 	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/ent/vote"
 )
 
 // GameUpdate is the builder for updating Game entities.
@@ -58,6 +63,76 @@ func (_u *GameUpdate) SetNillableModeratorID(v *string) *GameUpdate {
 	return _u
 }
 
+// SetSpectatorMode sets the "spectator_mode" field.
+func (_u *GameUpdate) SetSpectatorMode(v game.SpectatorMode) *GameUpdate {
+	_u.mutation.SetSpectatorMode(v)
+	return _u
+}
+
+// SetNillableSpectatorMode sets the "spectator_mode" field if the given value is not nil.
+func (_u *GameUpdate) SetNillableSpectatorMode(v *game.SpectatorMode) *GameUpdate {
+	if v != nil {
+		_u.SetSpectatorMode(*v)
+	}
+	return _u
+}
+
+// SetSpectatorDelaySeconds sets the "spectator_delay_seconds" field.
+func (_u *GameUpdate) SetSpectatorDelaySeconds(v int) *GameUpdate {
+	_u.mutation.ResetSpectatorDelaySeconds()
+	_u.mutation.SetSpectatorDelaySeconds(v)
+	return _u
+}
+
+// SetNillableSpectatorDelaySeconds sets the "spectator_delay_seconds" field if the given value is not nil.
+func (_u *GameUpdate) SetNillableSpectatorDelaySeconds(v *int) *GameUpdate {
+	if v != nil {
+		_u.SetSpectatorDelaySeconds(*v)
+	}
+	return _u
+}
+
+// AddSpectatorDelaySeconds adds value to the "spectator_delay_seconds" field.
+func (_u *GameUpdate) AddSpectatorDelaySeconds(v int) *GameUpdate {
+	_u.mutation.AddSpectatorDelaySeconds(v)
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *GameUpdate) SetPhase(v game.Phase) *GameUpdate {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *GameUpdate) SetNillablePhase(v *game.Phase) *GameUpdate {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *GameUpdate) SetRound(v int) *GameUpdate {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *GameUpdate) SetNillableRound(v *int) *GameUpdate {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *GameUpdate) AddRound(v int) *GameUpdate {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
 // AddPlayerIDs adds the "players" edge to the Player entity by IDs.
 func (_u *GameUpdate) AddPlayerIDs(ids ...uuid.UUID) *GameUpdate {
 	_u.mutation.AddPlayerIDs(ids...)
@@ -88,6 +163,81 @@ func (_u *GameUpdate) AddGameRoles(v ...*GameRole) *GameUpdate {
 	return _u.AddGameRoleIDs(ids...)
 }
 
+// AddModeratorIDs adds the "moderators" edge to the GameModerator entity by IDs.
+func (_u *GameUpdate) AddModeratorIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.AddModeratorIDs(ids...)
+	return _u
+}
+
+// AddModerators adds the "moderators" edges to the GameModerator entity.
+func (_u *GameUpdate) AddModerators(v ...*GameModerator) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModeratorIDs(ids...)
+}
+
+// AddSpectatorIDs adds the "spectators" edge to the Spectator entity by IDs.
+func (_u *GameUpdate) AddSpectatorIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.AddSpectatorIDs(ids...)
+	return _u
+}
+
+// AddSpectators adds the "spectators" edges to the Spectator entity.
+func (_u *GameUpdate) AddSpectators(v ...*Spectator) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSpectatorIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
+func (_u *GameUpdate) AddVoteIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.AddVoteIDs(ids...)
+	return _u
+}
+
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_u *GameUpdate) AddVotes(v ...*Vote) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
+}
+
+// AddNightActionIDs adds the "night_actions" edge to the NightAction entity by IDs.
+func (_u *GameUpdate) AddNightActionIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.AddNightActionIDs(ids...)
+	return _u
+}
+
+// AddNightActions adds the "night_actions" edges to the NightAction entity.
+func (_u *GameUpdate) AddNightActions(v ...*NightAction) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNightActionIDs(ids...)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (_u *GameUpdate) AddChatMessageIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.AddChatMessageIDs(ids...)
+	return _u
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (_u *GameUpdate) AddChatMessages(v ...*ChatMessage) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatMessageIDs(ids...)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (_u *GameUpdate) Mutation() *GameMutation {
 	return _u.mutation
@@ -135,6 +285,111 @@ func (_u *GameUpdate) RemoveGameRoles(v ...*GameRole) *GameUpdate {
 	return _u.RemoveGameRoleIDs(ids...)
 }
 
+// ClearModerators clears all "moderators" edges to the GameModerator entity.
+func (_u *GameUpdate) ClearModerators() *GameUpdate {
+	_u.mutation.ClearModerators()
+	return _u
+}
+
+// RemoveModeratorIDs removes the "moderators" edge to GameModerator entities by IDs.
+func (_u *GameUpdate) RemoveModeratorIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.RemoveModeratorIDs(ids...)
+	return _u
+}
+
+// RemoveModerators removes "moderators" edges to GameModerator entities.
+func (_u *GameUpdate) RemoveModerators(v ...*GameModerator) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveModeratorIDs(ids...)
+}
+
+// ClearSpectators clears all "spectators" edges to the Spectator entity.
+func (_u *GameUpdate) ClearSpectators() *GameUpdate {
+	_u.mutation.ClearSpectators()
+	return _u
+}
+
+// RemoveSpectatorIDs removes the "spectators" edge to Spectator entities by IDs.
+func (_u *GameUpdate) RemoveSpectatorIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.RemoveSpectatorIDs(ids...)
+	return _u
+}
+
+// RemoveSpectators removes "spectators" edges to Spectator entities.
+func (_u *GameUpdate) RemoveSpectators(v ...*Spectator) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSpectatorIDs(ids...)
+}
+
+// ClearVotes clears all "votes" edges to the Vote entity.
+func (_u *GameUpdate) ClearVotes() *GameUpdate {
+	_u.mutation.ClearVotes()
+	return _u
+}
+
+// RemoveVoteIDs removes the "votes" edge to Vote entities by IDs.
+func (_u *GameUpdate) RemoveVoteIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.RemoveVoteIDs(ids...)
+	return _u
+}
+
+// RemoveVotes removes "votes" edges to Vote entities.
+func (_u *GameUpdate) RemoveVotes(v ...*Vote) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteIDs(ids...)
+}
+
+// ClearNightActions clears all "night_actions" edges to the NightAction entity.
+func (_u *GameUpdate) ClearNightActions() *GameUpdate {
+	_u.mutation.ClearNightActions()
+	return _u
+}
+
+// RemoveNightActionIDs removes the "night_actions" edge to NightAction entities by IDs.
+func (_u *GameUpdate) RemoveNightActionIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.RemoveNightActionIDs(ids...)
+	return _u
+}
+
+// RemoveNightActions removes "night_actions" edges to NightAction entities.
+func (_u *GameUpdate) RemoveNightActions(v ...*NightAction) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNightActionIDs(ids...)
+}
+
+// ClearChatMessages clears all "chat_messages" edges to the ChatMessage entity.
+func (_u *GameUpdate) ClearChatMessages() *GameUpdate {
+	_u.mutation.ClearChatMessages()
+	return _u
+}
+
+// RemoveChatMessageIDs removes the "chat_messages" edge to ChatMessage entities by IDs.
+func (_u *GameUpdate) RemoveChatMessageIDs(ids ...uuid.UUID) *GameUpdate {
+	_u.mutation.RemoveChatMessageIDs(ids...)
+	return _u
+}
+
+// RemoveChatMessages removes "chat_messages" edges to ChatMessage entities.
+func (_u *GameUpdate) RemoveChatMessages(v ...*ChatMessage) *GameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatMessageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *GameUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -174,6 +429,26 @@ func (_u *GameUpdate) check() error {
 			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "Game.moderator_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SpectatorMode(); ok {
+		if err := game.SpectatorModeValidator(v); err != nil {
+			return &ValidationError{Name: "spectator_mode", err: fmt.Errorf(`ent: validator failed for field "Game.spectator_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SpectatorDelaySeconds(); ok {
+		if err := game.SpectatorDelaySecondsValidator(v); err != nil {
+			return &ValidationError{Name: "spectator_delay_seconds", err: fmt.Errorf(`ent: validator failed for field "Game.spectator_delay_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phase(); ok {
+		if err := game.PhaseValidator(v); err != nil {
+			return &ValidationError{Name: "phase", err: fmt.Errorf(`ent: validator failed for field "Game.phase": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := game.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "Game.round": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -195,6 +470,24 @@ func (_u *GameUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ModeratorID(); ok {
 		_spec.SetField(game.FieldModeratorID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SpectatorMode(); ok {
+		_spec.SetField(game.FieldSpectatorMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SpectatorDelaySeconds(); ok {
+		_spec.SetField(game.FieldSpectatorDelaySeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSpectatorDelaySeconds(); ok {
+		_spec.AddField(game.FieldSpectatorDelaySeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(game.FieldPhase, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(game.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(game.FieldRound, field.TypeInt, value)
+	}
 	if _u.mutation.PlayersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -285,6 +578,231 @@ func (_u *GameUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ModeratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ModeratorsTable,
+			Columns: []string{game.ModeratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModeratorsIDs(); len(nodes) > 0 && !_u.mutation.ModeratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ModeratorsTable,
+			Columns: []string{game.ModeratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ModeratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ModeratorsTable,
+			Columns: []string{game.ModeratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SpectatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SpectatorsTable,
+			Columns: []string{game.SpectatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spectator.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSpectatorsIDs(); len(nodes) > 0 && !_u.mutation.SpectatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SpectatorsTable,
+			Columns: []string{game.SpectatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spectator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SpectatorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SpectatorsTable,
+			Columns: []string{game.SpectatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spectator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.VotesTable,
+			Columns: []string{game.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.VotesTable,
+			Columns: []string{game.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.VotesTable,
+			Columns: []string{game.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NightActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.NightActionsTable,
+			Columns: []string{game.NightActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNightActionsIDs(); len(nodes) > 0 && !_u.mutation.NightActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.NightActionsTable,
+			Columns: []string{game.NightActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NightActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.NightActionsTable,
+			Columns: []string{game.NightActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ChatMessagesTable,
+			Columns: []string{game.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !_u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ChatMessagesTable,
+			Columns: []string{game.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ChatMessagesTable,
+			Columns: []string{game.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{game.Label}
@@ -330,37 +848,182 @@ func (_u *GameUpdateOne) SetNillableModeratorID(v *string) *GameUpdateOne {
 	if v != nil {
 		_u.SetModeratorID(*v)
 	}
-	return _u
+	return _u
+}
+
+// SetSpectatorMode sets the "spectator_mode" field.
+func (_u *GameUpdateOne) SetSpectatorMode(v game.SpectatorMode) *GameUpdateOne {
+	_u.mutation.SetSpectatorMode(v)
+	return _u
+}
+
+// SetNillableSpectatorMode sets the "spectator_mode" field if the given value is not nil.
+func (_u *GameUpdateOne) SetNillableSpectatorMode(v *game.SpectatorMode) *GameUpdateOne {
+	if v != nil {
+		_u.SetSpectatorMode(*v)
+	}
+	return _u
+}
+
+// SetSpectatorDelaySeconds sets the "spectator_delay_seconds" field.
+func (_u *GameUpdateOne) SetSpectatorDelaySeconds(v int) *GameUpdateOne {
+	_u.mutation.ResetSpectatorDelaySeconds()
+	_u.mutation.SetSpectatorDelaySeconds(v)
+	return _u
+}
+
+// SetNillableSpectatorDelaySeconds sets the "spectator_delay_seconds" field if the given value is not nil.
+func (_u *GameUpdateOne) SetNillableSpectatorDelaySeconds(v *int) *GameUpdateOne {
+	if v != nil {
+		_u.SetSpectatorDelaySeconds(*v)
+	}
+	return _u
+}
+
+// AddSpectatorDelaySeconds adds value to the "spectator_delay_seconds" field.
+func (_u *GameUpdateOne) AddSpectatorDelaySeconds(v int) *GameUpdateOne {
+	_u.mutation.AddSpectatorDelaySeconds(v)
+	return _u
+}
+
+// SetPhase sets the "phase" field.
+func (_u *GameUpdateOne) SetPhase(v game.Phase) *GameUpdateOne {
+	_u.mutation.SetPhase(v)
+	return _u
+}
+
+// SetNillablePhase sets the "phase" field if the given value is not nil.
+func (_u *GameUpdateOne) SetNillablePhase(v *game.Phase) *GameUpdateOne {
+	if v != nil {
+		_u.SetPhase(*v)
+	}
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *GameUpdateOne) SetRound(v int) *GameUpdateOne {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *GameUpdateOne) SetNillableRound(v *int) *GameUpdateOne {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *GameUpdateOne) AddRound(v int) *GameUpdateOne {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by IDs.
+func (_u *GameUpdateOne) AddPlayerIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.AddPlayerIDs(ids...)
+	return _u
+}
+
+// AddPlayers adds the "players" edges to the Player entity.
+func (_u *GameUpdateOne) AddPlayers(v ...*Player) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPlayerIDs(ids...)
+}
+
+// AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by IDs.
+func (_u *GameUpdateOne) AddGameRoleIDs(ids ...int) *GameUpdateOne {
+	_u.mutation.AddGameRoleIDs(ids...)
+	return _u
+}
+
+// AddGameRoles adds the "game_roles" edges to the GameRole entity.
+func (_u *GameUpdateOne) AddGameRoles(v ...*GameRole) *GameUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGameRoleIDs(ids...)
+}
+
+// AddModeratorIDs adds the "moderators" edge to the GameModerator entity by IDs.
+func (_u *GameUpdateOne) AddModeratorIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.AddModeratorIDs(ids...)
+	return _u
+}
+
+// AddModerators adds the "moderators" edges to the GameModerator entity.
+func (_u *GameUpdateOne) AddModerators(v ...*GameModerator) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddModeratorIDs(ids...)
+}
+
+// AddSpectatorIDs adds the "spectators" edge to the Spectator entity by IDs.
+func (_u *GameUpdateOne) AddSpectatorIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.AddSpectatorIDs(ids...)
+	return _u
+}
+
+// AddSpectators adds the "spectators" edges to the Spectator entity.
+func (_u *GameUpdateOne) AddSpectators(v ...*Spectator) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSpectatorIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
+func (_u *GameUpdateOne) AddVoteIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.AddVoteIDs(ids...)
+	return _u
+}
+
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_u *GameUpdateOne) AddVotes(v ...*Vote) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
 }
 
-// AddPlayerIDs adds the "players" edge to the Player entity by IDs.
-func (_u *GameUpdateOne) AddPlayerIDs(ids ...uuid.UUID) *GameUpdateOne {
-	_u.mutation.AddPlayerIDs(ids...)
+// AddNightActionIDs adds the "night_actions" edge to the NightAction entity by IDs.
+func (_u *GameUpdateOne) AddNightActionIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.AddNightActionIDs(ids...)
 	return _u
 }
 
-// AddPlayers adds the "players" edges to the Player entity.
-func (_u *GameUpdateOne) AddPlayers(v ...*Player) *GameUpdateOne {
+// AddNightActions adds the "night_actions" edges to the NightAction entity.
+func (_u *GameUpdateOne) AddNightActions(v ...*NightAction) *GameUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPlayerIDs(ids...)
+	return _u.AddNightActionIDs(ids...)
 }
 
-// AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by IDs.
-func (_u *GameUpdateOne) AddGameRoleIDs(ids ...int) *GameUpdateOne {
-	_u.mutation.AddGameRoleIDs(ids...)
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (_u *GameUpdateOne) AddChatMessageIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.AddChatMessageIDs(ids...)
 	return _u
 }
 
-// AddGameRoles adds the "game_roles" edges to the GameRole entity.
-func (_u *GameUpdateOne) AddGameRoles(v ...*GameRole) *GameUpdateOne {
-	ids := make([]int, len(v))
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (_u *GameUpdateOne) AddChatMessages(v ...*ChatMessage) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddGameRoleIDs(ids...)
+	return _u.AddChatMessageIDs(ids...)
 }
 
 // Mutation returns the GameMutation object of the builder.
@@ -410,6 +1073,111 @@ func (_u *GameUpdateOne) RemoveGameRoles(v ...*GameRole) *GameUpdateOne {
 	return _u.RemoveGameRoleIDs(ids...)
 }
 
+// ClearModerators clears all "moderators" edges to the GameModerator entity.
+func (_u *GameUpdateOne) ClearModerators() *GameUpdateOne {
+	_u.mutation.ClearModerators()
+	return _u
+}
+
+// RemoveModeratorIDs removes the "moderators" edge to GameModerator entities by IDs.
+func (_u *GameUpdateOne) RemoveModeratorIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.RemoveModeratorIDs(ids...)
+	return _u
+}
+
+// RemoveModerators removes "moderators" edges to GameModerator entities.
+func (_u *GameUpdateOne) RemoveModerators(v ...*GameModerator) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveModeratorIDs(ids...)
+}
+
+// ClearSpectators clears all "spectators" edges to the Spectator entity.
+func (_u *GameUpdateOne) ClearSpectators() *GameUpdateOne {
+	_u.mutation.ClearSpectators()
+	return _u
+}
+
+// RemoveSpectatorIDs removes the "spectators" edge to Spectator entities by IDs.
+func (_u *GameUpdateOne) RemoveSpectatorIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.RemoveSpectatorIDs(ids...)
+	return _u
+}
+
+// RemoveSpectators removes "spectators" edges to Spectator entities.
+func (_u *GameUpdateOne) RemoveSpectators(v ...*Spectator) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSpectatorIDs(ids...)
+}
+
+// ClearVotes clears all "votes" edges to the Vote entity.
+func (_u *GameUpdateOne) ClearVotes() *GameUpdateOne {
+	_u.mutation.ClearVotes()
+	return _u
+}
+
+// RemoveVoteIDs removes the "votes" edge to Vote entities by IDs.
+func (_u *GameUpdateOne) RemoveVoteIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.RemoveVoteIDs(ids...)
+	return _u
+}
+
+// RemoveVotes removes "votes" edges to Vote entities.
+func (_u *GameUpdateOne) RemoveVotes(v ...*Vote) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteIDs(ids...)
+}
+
+// ClearNightActions clears all "night_actions" edges to the NightAction entity.
+func (_u *GameUpdateOne) ClearNightActions() *GameUpdateOne {
+	_u.mutation.ClearNightActions()
+	return _u
+}
+
+// RemoveNightActionIDs removes the "night_actions" edge to NightAction entities by IDs.
+func (_u *GameUpdateOne) RemoveNightActionIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.RemoveNightActionIDs(ids...)
+	return _u
+}
+
+// RemoveNightActions removes "night_actions" edges to NightAction entities.
+func (_u *GameUpdateOne) RemoveNightActions(v ...*NightAction) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNightActionIDs(ids...)
+}
+
+// ClearChatMessages clears all "chat_messages" edges to the ChatMessage entity.
+func (_u *GameUpdateOne) ClearChatMessages() *GameUpdateOne {
+	_u.mutation.ClearChatMessages()
+	return _u
+}
+
+// RemoveChatMessageIDs removes the "chat_messages" edge to ChatMessage entities by IDs.
+func (_u *GameUpdateOne) RemoveChatMessageIDs(ids ...uuid.UUID) *GameUpdateOne {
+	_u.mutation.RemoveChatMessageIDs(ids...)
+	return _u
+}
+
+// RemoveChatMessages removes "chat_messages" edges to ChatMessage entities.
+func (_u *GameUpdateOne) RemoveChatMessages(v ...*ChatMessage) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatMessageIDs(ids...)
+}
+
 // Where appends a list predicates to the GameUpdate builder.
 func (_u *GameUpdateOne) Where(ps ...predicate.Game) *GameUpdateOne {
 	_u.mutation.Where(ps...)
@@ -462,6 +1230,26 @@ func (_u *GameUpdateOne) check() error {
 			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "Game.moderator_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SpectatorMode(); ok {
+		if err := game.SpectatorModeValidator(v); err != nil {
+			return &ValidationError{Name: "spectator_mode", err: fmt.Errorf(`ent: validator failed for field "Game.spectator_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SpectatorDelaySeconds(); ok {
+		if err := game.SpectatorDelaySecondsValidator(v); err != nil {
+			return &ValidationError{Name: "spectator_delay_seconds", err: fmt.Errorf(`ent: validator failed for field "Game.spectator_delay_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phase(); ok {
+		if err := game.PhaseValidator(v); err != nil {
+			return &ValidationError{Name: "phase", err: fmt.Errorf(`ent: validator failed for field "Game.phase": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := game.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "Game.round": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -500,6 +1288,24 @@ func (_u *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error) {
 	if value, ok := _u.mutation.ModeratorID(); ok {
 		_spec.SetField(game.FieldModeratorID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SpectatorMode(); ok {
+		_spec.SetField(game.FieldSpectatorMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SpectatorDelaySeconds(); ok {
+		_spec.SetField(game.FieldSpectatorDelaySeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSpectatorDelaySeconds(); ok {
+		_spec.AddField(game.FieldSpectatorDelaySeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Phase(); ok {
+		_spec.SetField(game.FieldPhase, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(game.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(game.FieldRound, field.TypeInt, value)
+	}
 	if _u.mutation.PlayersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -590,6 +1396,231 @@ func (_u *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ModeratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ModeratorsTable,
+			Columns: []string{game.ModeratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedModeratorsIDs(); len(nodes) > 0 && !_u.mutation.ModeratorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ModeratorsTable,
+			Columns: []string{game.ModeratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ModeratorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ModeratorsTable,
+			Columns: []string{game.ModeratorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SpectatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SpectatorsTable,
+			Columns: []string{game.SpectatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spectator.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSpectatorsIDs(); len(nodes) > 0 && !_u.mutation.SpectatorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SpectatorsTable,
+			Columns: []string{game.SpectatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spectator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SpectatorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SpectatorsTable,
+			Columns: []string{game.SpectatorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spectator.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.VotesTable,
+			Columns: []string{game.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.VotesTable,
+			Columns: []string{game.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.VotesTable,
+			Columns: []string{game.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NightActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.NightActionsTable,
+			Columns: []string{game.NightActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNightActionsIDs(); len(nodes) > 0 && !_u.mutation.NightActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.NightActionsTable,
+			Columns: []string{game.NightActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NightActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.NightActionsTable,
+			Columns: []string{game.NightActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ChatMessagesTable,
+			Columns: []string{game.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !_u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ChatMessagesTable,
+			Columns: []string{game.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ChatMessagesTable,
+			Columns: []string{game.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Game{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
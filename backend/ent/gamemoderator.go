@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
+)
+
+// GameModerator is the model entity for the GameModerator schema.
+type GameModerator struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// GameID holds the value of the "game_id" field.
+	GameID string `json:"game_id,omitempty"`
+	// ModeratorID holds the value of the "moderator_id" field.
+	ModeratorID string `json:"moderator_id,omitempty"`
+	// Actions this co-moderator is allowed to perform
+	Permissions []string `json:"permissions,omitempty"`
+	// Moderator who granted access
+	AddedBy string `json:"added_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GameModeratorQuery when eager-loading is set.
+	Edges        GameModeratorEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GameModeratorEdges holds the relations/edges for other nodes in the graph.
+type GameModeratorEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GameModeratorEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GameModerator) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gamemoderator.FieldPermissions:
+			values[i] = new([]byte)
+		case gamemoderator.FieldGameID, gamemoderator.FieldModeratorID, gamemoderator.FieldAddedBy:
+			values[i] = new(sql.NullString)
+		case gamemoderator.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case gamemoderator.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GameModerator fields.
+func (_m *GameModerator) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gamemoderator.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case gamemoderator.FieldGameID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field game_id", values[i])
+			} else if value.Valid {
+				_m.GameID = value.String
+			}
+		case gamemoderator.FieldModeratorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderator_id", values[i])
+			} else if value.Valid {
+				_m.ModeratorID = value.String
+			}
+		case gamemoderator.FieldPermissions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Permissions); err != nil {
+					return fmt.Errorf("unmarshal field permissions: %w", err)
+				}
+			}
+		case gamemoderator.FieldAddedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field added_by", values[i])
+			} else if value.Valid {
+				_m.AddedBy = value.String
+			}
+		case gamemoderator.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GameModerator.
+// This includes values selected through modifiers, order, etc.
+func (_m *GameModerator) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGame queries the "game" edge of the GameModerator entity.
+func (_m *GameModerator) QueryGame() *GameQuery {
+	return NewGameModeratorClient(_m.config).QueryGame(_m)
+}
+
+// Update returns a builder for updating this GameModerator.
+// Note that you need to call GameModerator.Unwrap() before calling this method if this GameModerator
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GameModerator) Update() *GameModeratorUpdateOne {
+	return NewGameModeratorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GameModerator entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GameModerator) Unwrap() *GameModerator {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GameModerator is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GameModerator) String() string {
+	var builder strings.Builder
+	builder.WriteString("GameModerator(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("game_id=")
+	builder.WriteString(_m.GameID)
+	builder.WriteString(", ")
+	builder.WriteString("moderator_id=")
+	builder.WriteString(_m.ModeratorID)
+	builder.WriteString(", ")
+	builder.WriteString("permissions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Permissions))
+	builder.WriteString(", ")
+	builder.WriteString("added_by=")
+	builder.WriteString(_m.AddedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GameModerators is a parsable slice of GameModerator.
+type GameModerators []*GameModerator
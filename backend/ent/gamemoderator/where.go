@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gamemoderator
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLTE(FieldID, id))
+}
+
+// GameID applies equality check predicate on the "game_id" field. It's identical to GameIDEQ.
+func GameID(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldGameID, v))
+}
+
+// ModeratorID applies equality check predicate on the "moderator_id" field. It's identical to ModeratorIDEQ.
+func ModeratorID(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// AddedBy applies equality check predicate on the "added_by" field. It's identical to AddedByEQ.
+func AddedBy(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GameIDEQ applies the EQ predicate on the "game_id" field.
+func GameIDEQ(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldGameID, v))
+}
+
+// GameIDNEQ applies the NEQ predicate on the "game_id" field.
+func GameIDNEQ(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNEQ(FieldGameID, v))
+}
+
+// GameIDIn applies the In predicate on the "game_id" field.
+func GameIDIn(vs ...string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldIn(FieldGameID, vs...))
+}
+
+// GameIDNotIn applies the NotIn predicate on the "game_id" field.
+func GameIDNotIn(vs ...string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNotIn(FieldGameID, vs...))
+}
+
+// GameIDGT applies the GT predicate on the "game_id" field.
+func GameIDGT(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGT(FieldGameID, v))
+}
+
+// GameIDGTE applies the GTE predicate on the "game_id" field.
+func GameIDGTE(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGTE(FieldGameID, v))
+}
+
+// GameIDLT applies the LT predicate on the "game_id" field.
+func GameIDLT(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLT(FieldGameID, v))
+}
+
+// GameIDLTE applies the LTE predicate on the "game_id" field.
+func GameIDLTE(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLTE(FieldGameID, v))
+}
+
+// GameIDContains applies the Contains predicate on the "game_id" field.
+func GameIDContains(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldContains(FieldGameID, v))
+}
+
+// GameIDHasPrefix applies the HasPrefix predicate on the "game_id" field.
+func GameIDHasPrefix(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldHasPrefix(FieldGameID, v))
+}
+
+// GameIDHasSuffix applies the HasSuffix predicate on the "game_id" field.
+func GameIDHasSuffix(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldHasSuffix(FieldGameID, v))
+}
+
+// GameIDEqualFold applies the EqualFold predicate on the "game_id" field.
+func GameIDEqualFold(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEqualFold(FieldGameID, v))
+}
+
+// GameIDContainsFold applies the ContainsFold predicate on the "game_id" field.
+func GameIDContainsFold(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldContainsFold(FieldGameID, v))
+}
+
+// ModeratorIDEQ applies the EQ predicate on the "moderator_id" field.
+func ModeratorIDEQ(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDNEQ applies the NEQ predicate on the "moderator_id" field.
+func ModeratorIDNEQ(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDIn applies the In predicate on the "moderator_id" field.
+func ModeratorIDIn(vs ...string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDNotIn applies the NotIn predicate on the "moderator_id" field.
+func ModeratorIDNotIn(vs ...string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNotIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDGT applies the GT predicate on the "moderator_id" field.
+func ModeratorIDGT(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGT(FieldModeratorID, v))
+}
+
+// ModeratorIDGTE applies the GTE predicate on the "moderator_id" field.
+func ModeratorIDGTE(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGTE(FieldModeratorID, v))
+}
+
+// ModeratorIDLT applies the LT predicate on the "moderator_id" field.
+func ModeratorIDLT(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLT(FieldModeratorID, v))
+}
+
+// ModeratorIDLTE applies the LTE predicate on the "moderator_id" field.
+func ModeratorIDLTE(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLTE(FieldModeratorID, v))
+}
+
+// ModeratorIDContains applies the Contains predicate on the "moderator_id" field.
+func ModeratorIDContains(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldContains(FieldModeratorID, v))
+}
+
+// ModeratorIDHasPrefix applies the HasPrefix predicate on the "moderator_id" field.
+func ModeratorIDHasPrefix(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldHasPrefix(FieldModeratorID, v))
+}
+
+// ModeratorIDHasSuffix applies the HasSuffix predicate on the "moderator_id" field.
+func ModeratorIDHasSuffix(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldHasSuffix(FieldModeratorID, v))
+}
+
+// ModeratorIDEqualFold applies the EqualFold predicate on the "moderator_id" field.
+func ModeratorIDEqualFold(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEqualFold(FieldModeratorID, v))
+}
+
+// ModeratorIDContainsFold applies the ContainsFold predicate on the "moderator_id" field.
+func ModeratorIDContainsFold(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldContainsFold(FieldModeratorID, v))
+}
+
+// AddedByEQ applies the EQ predicate on the "added_by" field.
+func AddedByEQ(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// AddedByNEQ applies the NEQ predicate on the "added_by" field.
+func AddedByNEQ(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNEQ(FieldAddedBy, v))
+}
+
+// AddedByIn applies the In predicate on the "added_by" field.
+func AddedByIn(vs ...string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldIn(FieldAddedBy, vs...))
+}
+
+// AddedByNotIn applies the NotIn predicate on the "added_by" field.
+func AddedByNotIn(vs ...string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNotIn(FieldAddedBy, vs...))
+}
+
+// AddedByGT applies the GT predicate on the "added_by" field.
+func AddedByGT(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGT(FieldAddedBy, v))
+}
+
+// AddedByGTE applies the GTE predicate on the "added_by" field.
+func AddedByGTE(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGTE(FieldAddedBy, v))
+}
+
+// AddedByLT applies the LT predicate on the "added_by" field.
+func AddedByLT(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLT(FieldAddedBy, v))
+}
+
+// AddedByLTE applies the LTE predicate on the "added_by" field.
+func AddedByLTE(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLTE(FieldAddedBy, v))
+}
+
+// AddedByContains applies the Contains predicate on the "added_by" field.
+func AddedByContains(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldContains(FieldAddedBy, v))
+}
+
+// AddedByHasPrefix applies the HasPrefix predicate on the "added_by" field.
+func AddedByHasPrefix(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldHasPrefix(FieldAddedBy, v))
+}
+
+// AddedByHasSuffix applies the HasSuffix predicate on the "added_by" field.
+func AddedByHasSuffix(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldHasSuffix(FieldAddedBy, v))
+}
+
+// AddedByEqualFold applies the EqualFold predicate on the "added_by" field.
+func AddedByEqualFold(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEqualFold(FieldAddedBy, v))
+}
+
+// AddedByContainsFold applies the ContainsFold predicate on the "added_by" field.
+func AddedByContainsFold(v string) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldContainsFold(FieldAddedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GameModerator {
+	return predicate.GameModerator(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasGame applies the HasEdge predicate on the "game" edge.
+func HasGame() predicate.GameModerator {
+	return predicate.GameModerator(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGameWith applies the HasEdge predicate on the "game" edge with a given conditions (other predicates).
+func HasGameWith(preds ...predicate.Game) predicate.GameModerator {
+	return predicate.GameModerator(func(s *sql.Selector) {
+		step := newGameStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GameModerator) predicate.GameModerator {
+	return predicate.GameModerator(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GameModerator) predicate.GameModerator {
+	return predicate.GameModerator(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GameModerator) predicate.GameModerator {
+	return predicate.GameModerator(sql.NotPredicates(p))
+}
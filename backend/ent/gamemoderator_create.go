@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
+)
+
+// GameModeratorCreate is the builder for creating a GameModerator entity.
+type GameModeratorCreate struct {
+	config
+	mutation *GameModeratorMutation
+	hooks    []Hook
+}
+
+// SetGameID sets the "game_id" field.
+func (_c *GameModeratorCreate) SetGameID(v string) *GameModeratorCreate {
+	_c.mutation.SetGameID(v)
+	return _c
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_c *GameModeratorCreate) SetModeratorID(v string) *GameModeratorCreate {
+	_c.mutation.SetModeratorID(v)
+	return _c
+}
+
+// SetPermissions sets the "permissions" field.
+func (_c *GameModeratorCreate) SetPermissions(v []string) *GameModeratorCreate {
+	_c.mutation.SetPermissions(v)
+	return _c
+}
+
+// SetAddedBy sets the "added_by" field.
+func (_c *GameModeratorCreate) SetAddedBy(v string) *GameModeratorCreate {
+	_c.mutation.SetAddedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GameModeratorCreate) SetCreatedAt(v time.Time) *GameModeratorCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GameModeratorCreate) SetNillableCreatedAt(v *time.Time) *GameModeratorCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GameModeratorCreate) SetID(v uuid.UUID) *GameModeratorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GameModeratorCreate) SetNillableID(v *uuid.UUID) *GameModeratorCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_c *GameModeratorCreate) SetGame(v *Game) *GameModeratorCreate {
+	return _c.SetGameID(v.ID)
+}
+
+// Mutation returns the GameModeratorMutation object of the builder.
+func (_c *GameModeratorCreate) Mutation() *GameModeratorMutation {
+	return _c.mutation
+}
+
+// Save creates the GameModerator in the database.
+func (_c *GameModeratorCreate) Save(ctx context.Context) (*GameModerator, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GameModeratorCreate) SaveX(ctx context.Context) *GameModerator {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GameModeratorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GameModeratorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GameModeratorCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := gamemoderator.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := gamemoderator.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GameModeratorCreate) check() error {
+	if _, ok := _c.mutation.GameID(); !ok {
+		return &ValidationError{Name: "game_id", err: errors.New(`ent: missing required field "GameModerator.game_id"`)}
+	}
+	if v, ok := _c.mutation.GameID(); ok {
+		if err := gamemoderator.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "GameModerator.game_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModeratorID(); !ok {
+		return &ValidationError{Name: "moderator_id", err: errors.New(`ent: missing required field "GameModerator.moderator_id"`)}
+	}
+	if v, ok := _c.mutation.ModeratorID(); ok {
+		if err := gamemoderator.ModeratorIDValidator(v); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "GameModerator.moderator_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Permissions(); !ok {
+		return &ValidationError{Name: "permissions", err: errors.New(`ent: missing required field "GameModerator.permissions"`)}
+	}
+	if _, ok := _c.mutation.AddedBy(); !ok {
+		return &ValidationError{Name: "added_by", err: errors.New(`ent: missing required field "GameModerator.added_by"`)}
+	}
+	if v, ok := _c.mutation.AddedBy(); ok {
+		if err := gamemoderator.AddedByValidator(v); err != nil {
+			return &ValidationError{Name: "added_by", err: fmt.Errorf(`ent: validator failed for field "GameModerator.added_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GameModerator.created_at"`)}
+	}
+	if len(_c.mutation.GameIDs()) == 0 {
+		return &ValidationError{Name: "game", err: errors.New(`ent: missing required edge "GameModerator.game"`)}
+	}
+	return nil
+}
+
+func (_c *GameModeratorCreate) sqlSave(ctx context.Context) (*GameModerator, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GameModeratorCreate) createSpec() (*GameModerator, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GameModerator{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gamemoderator.Table, sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ModeratorID(); ok {
+		_spec.SetField(gamemoderator.FieldModeratorID, field.TypeString, value)
+		_node.ModeratorID = value
+	}
+	if value, ok := _c.mutation.Permissions(); ok {
+		_spec.SetField(gamemoderator.FieldPermissions, field.TypeJSON, value)
+		_node.Permissions = value
+	}
+	if value, ok := _c.mutation.AddedBy(); ok {
+		_spec.SetField(gamemoderator.FieldAddedBy, field.TypeString, value)
+		_node.AddedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(gamemoderator.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gamemoderator.GameTable,
+			Columns: []string{gamemoderator.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GameID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GameModeratorCreateBulk is the builder for creating many GameModerator entities in bulk.
+type GameModeratorCreateBulk struct {
+	config
+	err      error
+	builders []*GameModeratorCreate
+}
+
+// Save creates the GameModerator entities in the database.
+func (_c *GameModeratorCreateBulk) Save(ctx context.Context) ([]*GameModerator, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GameModerator, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GameModeratorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GameModeratorCreateBulk) SaveX(ctx context.Context) []*GameModerator {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GameModeratorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GameModeratorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
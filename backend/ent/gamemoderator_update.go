@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// GameModeratorUpdate is the builder for updating GameModerator entities.
+type GameModeratorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GameModeratorMutation
+}
+
+// Where appends a list predicates to the GameModeratorUpdate builder.
+func (_u *GameModeratorUpdate) Where(ps ...predicate.GameModerator) *GameModeratorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *GameModeratorUpdate) SetGameID(v string) *GameModeratorUpdate {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *GameModeratorUpdate) SetNillableGameID(v *string) *GameModeratorUpdate {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_u *GameModeratorUpdate) SetModeratorID(v string) *GameModeratorUpdate {
+	_u.mutation.SetModeratorID(v)
+	return _u
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (_u *GameModeratorUpdate) SetNillableModeratorID(v *string) *GameModeratorUpdate {
+	if v != nil {
+		_u.SetModeratorID(*v)
+	}
+	return _u
+}
+
+// SetPermissions sets the "permissions" field.
+func (_u *GameModeratorUpdate) SetPermissions(v []string) *GameModeratorUpdate {
+	_u.mutation.SetPermissions(v)
+	return _u
+}
+
+// AppendPermissions appends value to the "permissions" field.
+func (_u *GameModeratorUpdate) AppendPermissions(v []string) *GameModeratorUpdate {
+	_u.mutation.AppendPermissions(v)
+	return _u
+}
+
+// SetAddedBy sets the "added_by" field.
+func (_u *GameModeratorUpdate) SetAddedBy(v string) *GameModeratorUpdate {
+	_u.mutation.SetAddedBy(v)
+	return _u
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (_u *GameModeratorUpdate) SetNillableAddedBy(v *string) *GameModeratorUpdate {
+	if v != nil {
+		_u.SetAddedBy(*v)
+	}
+	return _u
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_u *GameModeratorUpdate) SetGame(v *Game) *GameModeratorUpdate {
+	return _u.SetGameID(v.ID)
+}
+
+// Mutation returns the GameModeratorMutation object of the builder.
+func (_u *GameModeratorUpdate) Mutation() *GameModeratorMutation {
+	return _u.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (_u *GameModeratorUpdate) ClearGame() *GameModeratorUpdate {
+	_u.mutation.ClearGame()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GameModeratorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GameModeratorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GameModeratorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GameModeratorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GameModeratorUpdate) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := gamemoderator.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "GameModerator.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModeratorID(); ok {
+		if err := gamemoderator.ModeratorIDValidator(v); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "GameModerator.moderator_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddedBy(); ok {
+		if err := gamemoderator.AddedByValidator(v); err != nil {
+			return &ValidationError{Name: "added_by", err: fmt.Errorf(`ent: validator failed for field "GameModerator.added_by": %w`, err)}
+		}
+	}
+	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GameModerator.game"`)
+	}
+	return nil
+}
+
+func (_u *GameModeratorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamemoderator.Table, gamemoderator.Columns, sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ModeratorID(); ok {
+		_spec.SetField(gamemoderator.FieldModeratorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Permissions(); ok {
+		_spec.SetField(gamemoderator.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gamemoderator.FieldPermissions, value)
+		})
+	}
+	if value, ok := _u.mutation.AddedBy(); ok {
+		_spec.SetField(gamemoderator.FieldAddedBy, field.TypeString, value)
+	}
+	if _u.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gamemoderator.GameTable,
+			Columns: []string{gamemoderator.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gamemoderator.GameTable,
+			Columns: []string{gamemoderator.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamemoderator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GameModeratorUpdateOne is the builder for updating a single GameModerator entity.
+type GameModeratorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GameModeratorMutation
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *GameModeratorUpdateOne) SetGameID(v string) *GameModeratorUpdateOne {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *GameModeratorUpdateOne) SetNillableGameID(v *string) *GameModeratorUpdateOne {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_u *GameModeratorUpdateOne) SetModeratorID(v string) *GameModeratorUpdateOne {
+	_u.mutation.SetModeratorID(v)
+	return _u
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (_u *GameModeratorUpdateOne) SetNillableModeratorID(v *string) *GameModeratorUpdateOne {
+	if v != nil {
+		_u.SetModeratorID(*v)
+	}
+	return _u
+}
+
+// SetPermissions sets the "permissions" field.
+func (_u *GameModeratorUpdateOne) SetPermissions(v []string) *GameModeratorUpdateOne {
+	_u.mutation.SetPermissions(v)
+	return _u
+}
+
+// AppendPermissions appends value to the "permissions" field.
+func (_u *GameModeratorUpdateOne) AppendPermissions(v []string) *GameModeratorUpdateOne {
+	_u.mutation.AppendPermissions(v)
+	return _u
+}
+
+// SetAddedBy sets the "added_by" field.
+func (_u *GameModeratorUpdateOne) SetAddedBy(v string) *GameModeratorUpdateOne {
+	_u.mutation.SetAddedBy(v)
+	return _u
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (_u *GameModeratorUpdateOne) SetNillableAddedBy(v *string) *GameModeratorUpdateOne {
+	if v != nil {
+		_u.SetAddedBy(*v)
+	}
+	return _u
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_u *GameModeratorUpdateOne) SetGame(v *Game) *GameModeratorUpdateOne {
+	return _u.SetGameID(v.ID)
+}
+
+// Mutation returns the GameModeratorMutation object of the builder.
+func (_u *GameModeratorUpdateOne) Mutation() *GameModeratorMutation {
+	return _u.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (_u *GameModeratorUpdateOne) ClearGame() *GameModeratorUpdateOne {
+	_u.mutation.ClearGame()
+	return _u
+}
+
+// Where appends a list predicates to the GameModeratorUpdate builder.
+func (_u *GameModeratorUpdateOne) Where(ps ...predicate.GameModerator) *GameModeratorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GameModeratorUpdateOne) Select(field string, fields ...string) *GameModeratorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GameModerator entity.
+func (_u *GameModeratorUpdateOne) Save(ctx context.Context) (*GameModerator, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GameModeratorUpdateOne) SaveX(ctx context.Context) *GameModerator {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GameModeratorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GameModeratorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GameModeratorUpdateOne) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := gamemoderator.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "GameModerator.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModeratorID(); ok {
+		if err := gamemoderator.ModeratorIDValidator(v); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "GameModerator.moderator_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddedBy(); ok {
+		if err := gamemoderator.AddedByValidator(v); err != nil {
+			return &ValidationError{Name: "added_by", err: fmt.Errorf(`ent: validator failed for field "GameModerator.added_by": %w`, err)}
+		}
+	}
+	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GameModerator.game"`)
+	}
+	return nil
+}
+
+func (_u *GameModeratorUpdateOne) sqlSave(ctx context.Context) (_node *GameModerator, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gamemoderator.Table, gamemoderator.Columns, sqlgraph.NewFieldSpec(gamemoderator.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GameModerator.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gamemoderator.FieldID)
+		for _, f := range fields {
+			if !gamemoderator.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gamemoderator.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ModeratorID(); ok {
+		_spec.SetField(gamemoderator.FieldModeratorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Permissions(); ok {
+		_spec.SetField(gamemoderator.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gamemoderator.FieldPermissions, value)
+		})
+	}
+	if value, ok := _u.mutation.AddedBy(); ok {
+		_spec.SetField(gamemoderator.FieldAddedBy, field.TypeString, value)
+	}
+	if _u.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gamemoderator.GameTable,
+			Columns: []string{gamemoderator.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   gamemoderator.GameTable,
+			Columns: []string{gamemoderator.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GameModerator{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gamemoderator.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,18 @@ func (f AdminFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminMutation", m)
 }
 
+// The ChatMessageFunc type is an adapter to allow the use of ordinary
+// function as ChatMessage mutator.
+type ChatMessageFunc func(context.Context, *ent.ChatMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatMessageMutation", m)
+}
+
 // The GameFunc type is an adapter to allow the use of ordinary
 // function as Game mutator.
 type GameFunc func(context.Context, *ent.GameMutation) (ent.Value, error)
@@ -33,6 +45,18 @@ func (f GameFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GameMutation", m)
 }
 
+// The GameModeratorFunc type is an adapter to allow the use of ordinary
+// function as GameModerator mutator.
+type GameModeratorFunc func(context.Context, *ent.GameModeratorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GameModeratorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GameModeratorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GameModeratorMutation", m)
+}
+
 // The GameRoleFunc type is an adapter to allow the use of ordinary
 // function as GameRole mutator.
 type GameRoleFunc func(context.Context, *ent.GameRoleMutation) (ent.Value, error)
@@ -45,6 +69,30 @@ func (f GameRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GameRoleMutation", m)
 }
 
+// The ModeratorActionFunc type is an adapter to allow the use of ordinary
+// function as ModeratorAction mutator.
+type ModeratorActionFunc func(context.Context, *ent.ModeratorActionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModeratorActionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ModeratorActionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ModeratorActionMutation", m)
+}
+
+// The NightActionFunc type is an adapter to allow the use of ordinary
+// function as NightAction mutator.
+type NightActionFunc func(context.Context, *ent.NightActionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NightActionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NightActionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NightActionMutation", m)
+}
+
 // The PlayerFunc type is an adapter to allow the use of ordinary
 // function as Player mutator.
 type PlayerFunc func(context.Context, *ent.PlayerMutation) (ent.Value, error)
@@ -69,6 +117,18 @@ func (f RoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleMutation", m)
 }
 
+// The RoleRuleFunc type is an adapter to allow the use of ordinary
+// function as RoleRule mutator.
+type RoleRuleFunc func(context.Context, *ent.RoleRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleRuleMutation", m)
+}
+
 // The RoleTemplateFunc type is an adapter to allow the use of ordinary
 // function as RoleTemplate mutator.
 type RoleTemplateFunc func(context.Context, *ent.RoleTemplateMutation) (ent.Value, error)
@@ -81,6 +141,18 @@ func (f RoleTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleTemplateMutation", m)
 }
 
+// The RoleTemplateRevisionFunc type is an adapter to allow the use of ordinary
+// function as RoleTemplateRevision mutator.
+type RoleTemplateRevisionFunc func(context.Context, *ent.RoleTemplateRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleTemplateRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleTemplateRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleTemplateRevisionMutation", m)
+}
+
 // The RoleTemplateRoleFunc type is an adapter to allow the use of ordinary
 // function as RoleTemplateRole mutator.
 type RoleTemplateRoleFunc func(context.Context, *ent.RoleTemplateRoleMutation) (ent.Value, error)
@@ -93,6 +165,66 @@ func (f RoleTemplateRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleTemplateRoleMutation", m)
 }
 
+// The RoleTemplateSlotFunc type is an adapter to allow the use of ordinary
+// function as RoleTemplateSlot mutator.
+type RoleTemplateSlotFunc func(context.Context, *ent.RoleTemplateSlotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleTemplateSlotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleTemplateSlotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleTemplateSlotMutation", m)
+}
+
+// The RoleTemplateTranslationFunc type is an adapter to allow the use of ordinary
+// function as RoleTemplateTranslation mutator.
+type RoleTemplateTranslationFunc func(context.Context, *ent.RoleTemplateTranslationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleTemplateTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleTemplateTranslationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleTemplateTranslationMutation", m)
+}
+
+// The RoleTranslationFunc type is an adapter to allow the use of ordinary
+// function as RoleTranslation mutator.
+type RoleTranslationFunc func(context.Context, *ent.RoleTranslationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleTranslationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleTranslationMutation", m)
+}
+
+// The SpectatorFunc type is an adapter to allow the use of ordinary
+// function as Spectator mutator.
+type SpectatorFunc func(context.Context, *ent.SpectatorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SpectatorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SpectatorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpectatorMutation", m)
+}
+
+// The VoteFunc type is an adapter to allow the use of ordinary
+// function as Vote mutator.
+type VoteFunc func(context.Context, *ent.VoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VoteMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
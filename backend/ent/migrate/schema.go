@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -37,11 +38,53 @@ var (
 			},
 		},
 	}
+	// ChatMessagesColumns holds the columns for the "chat_messages" table.
+	ChatMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "channel", Type: field.TypeEnum, Enums: []string{"day", "mafia", "dead", "announcements"}},
+		{Name: "round", Type: field.TypeInt, Default: 0},
+		{Name: "player_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "moderator_id", Type: field.TypeString, Nullable: true},
+		{Name: "sender_name", Type: field.TypeString},
+		{Name: "text", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "game_id", Type: field.TypeString, Size: 12},
+	}
+	// ChatMessagesTable holds the schema information for the "chat_messages" table.
+	ChatMessagesTable = &schema.Table{
+		Name:       "chat_messages",
+		Columns:    ChatMessagesColumns,
+		PrimaryKey: []*schema.Column{ChatMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chat_messages_games_chat_messages",
+				Columns:    []*schema.Column{ChatMessagesColumns[8]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatmessage_game_id_channel_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[8], ChatMessagesColumns[1], ChatMessagesColumns[7]},
+			},
+			{
+				Name:    "chatmessage_player_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[3], ChatMessagesColumns[7]},
+			},
+		},
+	}
 	// GamesColumns holds the columns for the "games" table.
 	GamesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 12},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "completed"}, Default: "pending"},
 		{Name: "moderator_id", Type: field.TypeString},
+		{Name: "spectator_mode", Type: field.TypeEnum, Enums: []string{"disabled", "enabled", "delayed"}, Default: "enabled"},
+		{Name: "spectator_delay_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "phase", Type: field.TypeEnum, Enums: []string{"day", "night"}, Default: "day"},
+		{Name: "round", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// GamesTable holds the schema information for the "games" table.
@@ -58,7 +101,37 @@ var (
 			{
 				Name:    "game_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{GamesColumns[3]},
+				Columns: []*schema.Column{GamesColumns[7]},
+			},
+		},
+	}
+	// GameModeratorsColumns holds the columns for the "game_moderators" table.
+	GameModeratorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "moderator_id", Type: field.TypeString},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "added_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "game_id", Type: field.TypeString, Size: 12},
+	}
+	// GameModeratorsTable holds the schema information for the "game_moderators" table.
+	GameModeratorsTable = &schema.Table{
+		Name:       "game_moderators",
+		Columns:    GameModeratorsColumns,
+		PrimaryKey: []*schema.Column{GameModeratorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "game_moderators_games_moderators",
+				Columns:    []*schema.Column{GameModeratorsColumns[5]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gamemoderator_game_id_moderator_id",
+				Unique:  true,
+				Columns: []*schema.Column{GameModeratorsColumns[5], GameModeratorsColumns[1]},
 			},
 		},
 	}
@@ -103,10 +176,67 @@ var (
 			},
 		},
 	}
+	// ModeratorActionsColumns holds the columns for the "moderator_actions" table.
+	ModeratorActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "game_id", Type: field.TypeString, Size: 12},
+		{Name: "moderator_id", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString, Size: 50},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ModeratorActionsTable holds the schema information for the "moderator_actions" table.
+	ModeratorActionsTable = &schema.Table{
+		Name:       "moderator_actions",
+		Columns:    ModeratorActionsColumns,
+		PrimaryKey: []*schema.Column{ModeratorActionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderatoraction_game_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModeratorActionsColumns[1], ModeratorActionsColumns[5]},
+			},
+		},
+	}
+	// NightActionsColumns holds the columns for the "night_actions" table.
+	NightActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "round", Type: field.TypeInt},
+		{Name: "player_id", Type: field.TypeUUID},
+		{Name: "target_id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "game_id", Type: field.TypeString, Size: 12},
+	}
+	// NightActionsTable holds the schema information for the "night_actions" table.
+	NightActionsTable = &schema.Table{
+		Name:       "night_actions",
+		Columns:    NightActionsColumns,
+		PrimaryKey: []*schema.Column{NightActionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "night_actions_games_night_actions",
+				Columns:    []*schema.Column{NightActionsColumns[6]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "nightaction_game_id_round_player_id",
+				Unique:  true,
+				Columns: []*schema.Column{NightActionsColumns[6], NightActionsColumns[1], NightActionsColumns[2]},
+			},
+		},
+	}
 	// PlayersColumns holds the columns for the "players" table.
 	PlayersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
+		{Name: "seat", Type: field.TypeInt, Nullable: true},
+		{Name: "alive", Type: field.TypeBool, Default: true},
+		{Name: "ready", Type: field.TypeBool, Default: false},
+		{Name: "muted", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "game_id", Type: field.TypeString, Size: 12},
 	}
@@ -118,7 +248,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "players_games_players",
-				Columns:    []*schema.Column{PlayersColumns[3]},
+				Columns:    []*schema.Column{PlayersColumns[7]},
 				RefColumns: []*schema.Column{GamesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -127,7 +257,12 @@ var (
 			{
 				Name:    "player_game_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{PlayersColumns[3], PlayersColumns[1]},
+				Columns: []*schema.Column{PlayersColumns[7], PlayersColumns[1]},
+			},
+			{
+				Name:    "player_game_id_seat",
+				Unique:  true,
+				Columns: []*schema.Column{PlayersColumns[7], PlayersColumns[2]},
 			},
 		},
 	}
@@ -137,9 +272,11 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
 		{Name: "slug", Type: field.TypeString, Unique: true, Size: 100},
 		{Name: "video", Type: field.TypeString, Size: 255},
+		{Name: "image", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "team", Type: field.TypeEnum, Enums: []string{"mafia", "village", "independent"}},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "abilities", Type: field.TypeJSON, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 	}
 	// RolesTable holds the schema information for the "roles" table.
 	RolesTable = &schema.Table{
@@ -150,21 +287,73 @@ var (
 			{
 				Name:    "role_team",
 				Unique:  false,
-				Columns: []*schema.Column{RolesColumns[4]},
+				Columns: []*schema.Column{RolesColumns[5]},
 			},
 			{
 				Name:    "role_slug",
 				Unique:  false,
 				Columns: []*schema.Column{RolesColumns[2]},
 			},
+			{
+				Name:    "role_archived_at",
+				Unique:  false,
+				Columns: []*schema.Column{RolesColumns[8]},
+			},
+		},
+	}
+	// RoleRulesColumns holds the columns for the "role_rules" table.
+	RoleRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"requires", "excludes", "max_count"}},
+		{Name: "target_team", Type: field.TypeEnum, Nullable: true, Enums: []string{"mafia", "village", "independent"}},
+		{Name: "max_count", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "role_id", Type: field.TypeUUID},
+		{Name: "target_role_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// RoleRulesTable holds the schema information for the "role_rules" table.
+	RoleRulesTable = &schema.Table{
+		Name:       "role_rules",
+		Columns:    RoleRulesColumns,
+		PrimaryKey: []*schema.Column{RoleRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_rules_roles_rules",
+				Columns:    []*schema.Column{RoleRulesColumns[5]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_rules_roles_targeted_by_rules",
+				Columns:    []*schema.Column{RoleRulesColumns[6]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolerule_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoleRulesColumns[5]},
+			},
+			{
+				Name:    "rolerule_target_role_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoleRulesColumns[6]},
+			},
 		},
 	}
 	// RoleTemplatesColumns holds the columns for the "role_templates" table.
 	RoleTemplatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "name", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "player_count", Type: field.TypeInt},
+		{Name: "max_player_count", Type: field.TypeInt, Default: 0},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"private", "pending", "published"}, Default: "published"},
+		{Name: "share_code", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "review_note", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -179,11 +368,76 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{RoleTemplatesColumns[2]},
 			},
+			{
+				Name:    "roletemplate_max_player_count",
+				Unique:  false,
+				Columns: []*schema.Column{RoleTemplatesColumns[3]},
+			},
 			{
 				Name:    "roletemplate_name",
 				Unique:  false,
 				Columns: []*schema.Column{RoleTemplatesColumns[1]},
 			},
+			{
+				Name:    "roletemplate_owner_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{RoleTemplatesColumns[5], RoleTemplatesColumns[1]},
+			},
+			{
+				Name:    "roletemplate_curated_name",
+				Unique:  true,
+				Columns: []*schema.Column{RoleTemplatesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "owner_id IS NULL",
+				},
+			},
+			{
+				Name:    "roletemplate_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoleTemplatesColumns[5]},
+			},
+			{
+				Name:    "roletemplate_status",
+				Unique:  false,
+				Columns: []*schema.Column{RoleTemplatesColumns[6]},
+			},
+		},
+	}
+	// RoleTemplateRevisionsColumns holds the columns for the "role_template_revisions" table.
+	RoleTemplateRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "player_count", Type: field.TypeInt},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "roles", Type: field.TypeJSON},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"baseline", "create", "update", "rollback", "clone", "import"}},
+		{Name: "source_number", Type: field.TypeInt, Nullable: true},
+		{Name: "author_kind", Type: field.TypeEnum, Enums: []string{"admin", "moderator", "system"}, Default: "system"},
+		{Name: "author_id", Type: field.TypeString, Nullable: true},
+		{Name: "author_name", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "role_template_id", Type: field.TypeUUID},
+	}
+	// RoleTemplateRevisionsTable holds the schema information for the "role_template_revisions" table.
+	RoleTemplateRevisionsTable = &schema.Table{
+		Name:       "role_template_revisions",
+		Columns:    RoleTemplateRevisionsColumns,
+		PrimaryKey: []*schema.Column{RoleTemplateRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_template_revisions_role_templates_revisions",
+				Columns:    []*schema.Column{RoleTemplateRevisionsColumns[12]},
+				RefColumns: []*schema.Column{RoleTemplatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roletemplaterevision_role_template_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{RoleTemplateRevisionsColumns[12], RoleTemplateRevisionsColumns[1]},
+			},
 		},
 	}
 	// RoleTemplateRolesColumns holds the columns for the "role_template_roles" table.
@@ -220,23 +474,201 @@ var (
 			},
 		},
 	}
+	// RoleTemplateSlotsColumns holds the columns for the "role_template_slots" table.
+	RoleTemplateSlotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeUUID},
+		{Name: "role_template_id", Type: field.TypeUUID},
+	}
+	// RoleTemplateSlotsTable holds the schema information for the "role_template_slots" table.
+	RoleTemplateSlotsTable = &schema.Table{
+		Name:       "role_template_slots",
+		Columns:    RoleTemplateSlotsColumns,
+		PrimaryKey: []*schema.Column{RoleTemplateSlotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_template_slots_roles_template_slots",
+				Columns:    []*schema.Column{RoleTemplateSlotsColumns[2]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "role_template_slots_role_templates_optional_slots",
+				Columns:    []*schema.Column{RoleTemplateSlotsColumns[3]},
+				RefColumns: []*schema.Column{RoleTemplatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roletemplateslot_role_template_id_position",
+				Unique:  true,
+				Columns: []*schema.Column{RoleTemplateSlotsColumns[3], RoleTemplateSlotsColumns[1]},
+			},
+		},
+	}
+	// RoleTemplateTranslationsColumns holds the columns for the "role_template_translations" table.
+	RoleTemplateTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "locale", Type: field.TypeString, Size: 35},
+		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "role_template_id", Type: field.TypeUUID},
+	}
+	// RoleTemplateTranslationsTable holds the schema information for the "role_template_translations" table.
+	RoleTemplateTranslationsTable = &schema.Table{
+		Name:       "role_template_translations",
+		Columns:    RoleTemplateTranslationsColumns,
+		PrimaryKey: []*schema.Column{RoleTemplateTranslationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_template_translations_role_templates_translations",
+				Columns:    []*schema.Column{RoleTemplateTranslationsColumns[3]},
+				RefColumns: []*schema.Column{RoleTemplatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roletemplatetranslation_role_template_id_locale",
+				Unique:  true,
+				Columns: []*schema.Column{RoleTemplateTranslationsColumns[3], RoleTemplateTranslationsColumns[1]},
+			},
+		},
+	}
+	// RoleTranslationsColumns holds the columns for the "role_translations" table.
+	RoleTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "locale", Type: field.TypeString, Size: 35},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "abilities", Type: field.TypeJSON, Nullable: true},
+		{Name: "role_id", Type: field.TypeUUID},
+	}
+	// RoleTranslationsTable holds the schema information for the "role_translations" table.
+	RoleTranslationsTable = &schema.Table{
+		Name:       "role_translations",
+		Columns:    RoleTranslationsColumns,
+		PrimaryKey: []*schema.Column{RoleTranslationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_translations_roles_translations",
+				Columns:    []*schema.Column{RoleTranslationsColumns[5]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roletranslation_role_id_locale",
+				Unique:  true,
+				Columns: []*schema.Column{RoleTranslationsColumns[5], RoleTranslationsColumns[1]},
+			},
+			{
+				Name:    "roletranslation_locale_name",
+				Unique:  true,
+				Columns: []*schema.Column{RoleTranslationsColumns[1], RoleTranslationsColumns[2]},
+			},
+		},
+	}
+	// SpectatorsColumns holds the columns for the "spectators" table.
+	SpectatorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "game_id", Type: field.TypeString, Size: 12},
+	}
+	// SpectatorsTable holds the schema information for the "spectators" table.
+	SpectatorsTable = &schema.Table{
+		Name:       "spectators",
+		Columns:    SpectatorsColumns,
+		PrimaryKey: []*schema.Column{SpectatorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "spectators_games_spectators",
+				Columns:    []*schema.Column{SpectatorsColumns[3]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "spectator_game_id",
+				Unique:  false,
+				Columns: []*schema.Column{SpectatorsColumns[3]},
+			},
+		},
+	}
+	// VotesColumns holds the columns for the "votes" table.
+	VotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "round", Type: field.TypeInt},
+		{Name: "voter_id", Type: field.TypeUUID},
+		{Name: "target_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "game_id", Type: field.TypeString, Size: 12},
+	}
+	// VotesTable holds the schema information for the "votes" table.
+	VotesTable = &schema.Table{
+		Name:       "votes",
+		Columns:    VotesColumns,
+		PrimaryKey: []*schema.Column{VotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "votes_games_votes",
+				Columns:    []*schema.Column{VotesColumns[5]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vote_game_id_round_voter_id",
+				Unique:  true,
+				Columns: []*schema.Column{VotesColumns[5], VotesColumns[1], VotesColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AdminsTable,
+		ChatMessagesTable,
 		GamesTable,
+		GameModeratorsTable,
 		GameRolesTable,
+		ModeratorActionsTable,
+		NightActionsTable,
 		PlayersTable,
 		RolesTable,
+		RoleRulesTable,
 		RoleTemplatesTable,
+		RoleTemplateRevisionsTable,
 		RoleTemplateRolesTable,
+		RoleTemplateSlotsTable,
+		RoleTemplateTranslationsTable,
+		RoleTranslationsTable,
+		SpectatorsTable,
+		VotesTable,
 	}
 )
 
 func init() {
+	ChatMessagesTable.ForeignKeys[0].RefTable = GamesTable
+	GameModeratorsTable.ForeignKeys[0].RefTable = GamesTable
 	GameRolesTable.ForeignKeys[0].RefTable = GamesTable
 	GameRolesTable.ForeignKeys[1].RefTable = PlayersTable
 	GameRolesTable.ForeignKeys[2].RefTable = RolesTable
+	NightActionsTable.ForeignKeys[0].RefTable = GamesTable
 	PlayersTable.ForeignKeys[0].RefTable = GamesTable
+	RoleRulesTable.ForeignKeys[0].RefTable = RolesTable
+	RoleRulesTable.ForeignKeys[1].RefTable = RolesTable
+	RoleTemplateRevisionsTable.ForeignKeys[0].RefTable = RoleTemplatesTable
 	RoleTemplateRolesTable.ForeignKeys[0].RefTable = RolesTable
 	RoleTemplateRolesTable.ForeignKeys[1].RefTable = RoleTemplatesTable
+	RoleTemplateSlotsTable.ForeignKeys[0].RefTable = RolesTable
+	RoleTemplateSlotsTable.ForeignKeys[1].RefTable = RoleTemplatesTable
+	RoleTemplateTranslationsTable.ForeignKeys[0].RefTable = RoleTemplatesTable
+	RoleTranslationsTable.ForeignKeys[0].RefTable = RolesTable
+	SpectatorsTable.ForeignKeys[0].RefTable = GamesTable
+	VotesTable.ForeignKeys[0].RefTable = GamesTable
 }
@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderatoraction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLTE(FieldID, id))
+}
+
+// GameID applies equality check predicate on the "game_id" field. It's identical to GameIDEQ.
+func GameID(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldGameID, v))
+}
+
+// ModeratorID applies equality check predicate on the "moderator_id" field. It's identical to ModeratorIDEQ.
+func ModeratorID(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldAction, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GameIDEQ applies the EQ predicate on the "game_id" field.
+func GameIDEQ(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldGameID, v))
+}
+
+// GameIDNEQ applies the NEQ predicate on the "game_id" field.
+func GameIDNEQ(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNEQ(FieldGameID, v))
+}
+
+// GameIDIn applies the In predicate on the "game_id" field.
+func GameIDIn(vs ...string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldIn(FieldGameID, vs...))
+}
+
+// GameIDNotIn applies the NotIn predicate on the "game_id" field.
+func GameIDNotIn(vs ...string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNotIn(FieldGameID, vs...))
+}
+
+// GameIDGT applies the GT predicate on the "game_id" field.
+func GameIDGT(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGT(FieldGameID, v))
+}
+
+// GameIDGTE applies the GTE predicate on the "game_id" field.
+func GameIDGTE(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGTE(FieldGameID, v))
+}
+
+// GameIDLT applies the LT predicate on the "game_id" field.
+func GameIDLT(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLT(FieldGameID, v))
+}
+
+// GameIDLTE applies the LTE predicate on the "game_id" field.
+func GameIDLTE(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLTE(FieldGameID, v))
+}
+
+// GameIDContains applies the Contains predicate on the "game_id" field.
+func GameIDContains(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldContains(FieldGameID, v))
+}
+
+// GameIDHasPrefix applies the HasPrefix predicate on the "game_id" field.
+func GameIDHasPrefix(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldHasPrefix(FieldGameID, v))
+}
+
+// GameIDHasSuffix applies the HasSuffix predicate on the "game_id" field.
+func GameIDHasSuffix(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldHasSuffix(FieldGameID, v))
+}
+
+// GameIDEqualFold applies the EqualFold predicate on the "game_id" field.
+func GameIDEqualFold(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEqualFold(FieldGameID, v))
+}
+
+// GameIDContainsFold applies the ContainsFold predicate on the "game_id" field.
+func GameIDContainsFold(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldContainsFold(FieldGameID, v))
+}
+
+// ModeratorIDEQ applies the EQ predicate on the "moderator_id" field.
+func ModeratorIDEQ(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDNEQ applies the NEQ predicate on the "moderator_id" field.
+func ModeratorIDNEQ(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNEQ(FieldModeratorID, v))
+}
+
+// ModeratorIDIn applies the In predicate on the "moderator_id" field.
+func ModeratorIDIn(vs ...string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDNotIn applies the NotIn predicate on the "moderator_id" field.
+func ModeratorIDNotIn(vs ...string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNotIn(FieldModeratorID, vs...))
+}
+
+// ModeratorIDGT applies the GT predicate on the "moderator_id" field.
+func ModeratorIDGT(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGT(FieldModeratorID, v))
+}
+
+// ModeratorIDGTE applies the GTE predicate on the "moderator_id" field.
+func ModeratorIDGTE(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGTE(FieldModeratorID, v))
+}
+
+// ModeratorIDLT applies the LT predicate on the "moderator_id" field.
+func ModeratorIDLT(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLT(FieldModeratorID, v))
+}
+
+// ModeratorIDLTE applies the LTE predicate on the "moderator_id" field.
+func ModeratorIDLTE(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLTE(FieldModeratorID, v))
+}
+
+// ModeratorIDContains applies the Contains predicate on the "moderator_id" field.
+func ModeratorIDContains(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldContains(FieldModeratorID, v))
+}
+
+// ModeratorIDHasPrefix applies the HasPrefix predicate on the "moderator_id" field.
+func ModeratorIDHasPrefix(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldHasPrefix(FieldModeratorID, v))
+}
+
+// ModeratorIDHasSuffix applies the HasSuffix predicate on the "moderator_id" field.
+func ModeratorIDHasSuffix(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldHasSuffix(FieldModeratorID, v))
+}
+
+// ModeratorIDEqualFold applies the EqualFold predicate on the "moderator_id" field.
+func ModeratorIDEqualFold(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEqualFold(FieldModeratorID, v))
+}
+
+// ModeratorIDContainsFold applies the ContainsFold predicate on the "moderator_id" field.
+func ModeratorIDContainsFold(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldContainsFold(FieldModeratorID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldContainsFold(FieldAction, v))
+}
+
+// DetailsIsNil applies the IsNil predicate on the "details" field.
+func DetailsIsNil() predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldIsNull(FieldDetails))
+}
+
+// DetailsNotNil applies the NotNil predicate on the "details" field.
+func DetailsNotNil() predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNotNull(FieldDetails))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModeratorAction) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModeratorAction) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModeratorAction) predicate.ModeratorAction {
+	return predicate.ModeratorAction(sql.NotPredicates(p))
+}
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+)
+
+// ModeratorActionCreate is the builder for creating a ModeratorAction entity.
+type ModeratorActionCreate struct {
+	config
+	mutation *ModeratorActionMutation
+	hooks    []Hook
+}
+
+// SetGameID sets the "game_id" field.
+func (_c *ModeratorActionCreate) SetGameID(v string) *ModeratorActionCreate {
+	_c.mutation.SetGameID(v)
+	return _c
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_c *ModeratorActionCreate) SetModeratorID(v string) *ModeratorActionCreate {
+	_c.mutation.SetModeratorID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ModeratorActionCreate) SetAction(v string) *ModeratorActionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *ModeratorActionCreate) SetDetails(v map[string]interface{}) *ModeratorActionCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModeratorActionCreate) SetCreatedAt(v time.Time) *ModeratorActionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModeratorActionCreate) SetNillableCreatedAt(v *time.Time) *ModeratorActionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ModeratorActionCreate) SetID(v uuid.UUID) *ModeratorActionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ModeratorActionCreate) SetNillableID(v *uuid.UUID) *ModeratorActionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ModeratorActionMutation object of the builder.
+func (_c *ModeratorActionCreate) Mutation() *ModeratorActionMutation {
+	return _c.mutation
+}
+
+// Save creates the ModeratorAction in the database.
+func (_c *ModeratorActionCreate) Save(ctx context.Context) (*ModeratorAction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModeratorActionCreate) SaveX(ctx context.Context) *ModeratorAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModeratorActionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModeratorActionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModeratorActionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := moderatoraction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := moderatoraction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModeratorActionCreate) check() error {
+	if _, ok := _c.mutation.GameID(); !ok {
+		return &ValidationError{Name: "game_id", err: errors.New(`ent: missing required field "ModeratorAction.game_id"`)}
+	}
+	if v, ok := _c.mutation.GameID(); ok {
+		if err := moderatoraction.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.game_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModeratorID(); !ok {
+		return &ValidationError{Name: "moderator_id", err: errors.New(`ent: missing required field "ModeratorAction.moderator_id"`)}
+	}
+	if v, ok := _c.mutation.ModeratorID(); ok {
+		if err := moderatoraction.ModeratorIDValidator(v); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.moderator_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ModeratorAction.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := moderatoraction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModeratorAction.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ModeratorActionCreate) sqlSave(ctx context.Context) (*ModeratorAction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModeratorActionCreate) createSpec() (*ModeratorAction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModeratorAction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderatoraction.Table, sqlgraph.NewFieldSpec(moderatoraction.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.GameID(); ok {
+		_spec.SetField(moderatoraction.FieldGameID, field.TypeString, value)
+		_node.GameID = value
+	}
+	if value, ok := _c.mutation.ModeratorID(); ok {
+		_spec.SetField(moderatoraction.FieldModeratorID, field.TypeString, value)
+		_node.ModeratorID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(moderatoraction.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(moderatoraction.FieldDetails, field.TypeJSON, value)
+		_node.Details = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderatoraction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ModeratorActionCreateBulk is the builder for creating many ModeratorAction entities in bulk.
+type ModeratorActionCreateBulk struct {
+	config
+	err      error
+	builders []*ModeratorActionCreate
+}
+
+// Save creates the ModeratorAction entities in the database.
+func (_c *ModeratorActionCreateBulk) Save(ctx context.Context) ([]*ModeratorAction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModeratorAction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModeratorActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModeratorActionCreateBulk) SaveX(ctx context.Context) []*ModeratorAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModeratorActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModeratorActionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
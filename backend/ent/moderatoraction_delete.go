@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ModeratorActionDelete is the builder for deleting a ModeratorAction entity.
+type ModeratorActionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModeratorActionMutation
+}
+
+// Where appends a list predicates to the ModeratorActionDelete builder.
+func (_d *ModeratorActionDelete) Where(ps ...predicate.ModeratorAction) *ModeratorActionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ModeratorActionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ModeratorActionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ModeratorActionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(moderatoraction.Table, sqlgraph.NewFieldSpec(moderatoraction.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ModeratorActionDeleteOne is the builder for deleting a single ModeratorAction entity.
+type ModeratorActionDeleteOne struct {
+	_d *ModeratorActionDelete
+}
+
+// Where appends a list predicates to the ModeratorActionDelete builder.
+func (_d *ModeratorActionDeleteOne) Where(ps ...predicate.ModeratorAction) *ModeratorActionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ModeratorActionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{moderatoraction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ModeratorActionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ModeratorActionUpdate is the builder for updating ModeratorAction entities.
+type ModeratorActionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModeratorActionMutation
+}
+
+// Where appends a list predicates to the ModeratorActionUpdate builder.
+func (_u *ModeratorActionUpdate) Where(ps ...predicate.ModeratorAction) *ModeratorActionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *ModeratorActionUpdate) SetGameID(v string) *ModeratorActionUpdate {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *ModeratorActionUpdate) SetNillableGameID(v *string) *ModeratorActionUpdate {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_u *ModeratorActionUpdate) SetModeratorID(v string) *ModeratorActionUpdate {
+	_u.mutation.SetModeratorID(v)
+	return _u
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (_u *ModeratorActionUpdate) SetNillableModeratorID(v *string) *ModeratorActionUpdate {
+	if v != nil {
+		_u.SetModeratorID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ModeratorActionUpdate) SetAction(v string) *ModeratorActionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ModeratorActionUpdate) SetNillableAction(v *string) *ModeratorActionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *ModeratorActionUpdate) SetDetails(v map[string]interface{}) *ModeratorActionUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *ModeratorActionUpdate) ClearDetails() *ModeratorActionUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the ModeratorActionMutation object of the builder.
+func (_u *ModeratorActionUpdate) Mutation() *ModeratorActionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModeratorActionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModeratorActionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModeratorActionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModeratorActionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModeratorActionUpdate) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := moderatoraction.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModeratorID(); ok {
+		if err := moderatoraction.ModeratorIDValidator(v); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.moderator_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := moderatoraction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModeratorActionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderatoraction.Table, moderatoraction.Columns, sqlgraph.NewFieldSpec(moderatoraction.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GameID(); ok {
+		_spec.SetField(moderatoraction.FieldGameID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ModeratorID(); ok {
+		_spec.SetField(moderatoraction.FieldModeratorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(moderatoraction.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(moderatoraction.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(moderatoraction.FieldDetails, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderatoraction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModeratorActionUpdateOne is the builder for updating a single ModeratorAction entity.
+type ModeratorActionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModeratorActionMutation
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *ModeratorActionUpdateOne) SetGameID(v string) *ModeratorActionUpdateOne {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *ModeratorActionUpdateOne) SetNillableGameID(v *string) *ModeratorActionUpdateOne {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (_u *ModeratorActionUpdateOne) SetModeratorID(v string) *ModeratorActionUpdateOne {
+	_u.mutation.SetModeratorID(v)
+	return _u
+}
+
+// SetNillableModeratorID sets the "moderator_id" field if the given value is not nil.
+func (_u *ModeratorActionUpdateOne) SetNillableModeratorID(v *string) *ModeratorActionUpdateOne {
+	if v != nil {
+		_u.SetModeratorID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ModeratorActionUpdateOne) SetAction(v string) *ModeratorActionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ModeratorActionUpdateOne) SetNillableAction(v *string) *ModeratorActionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *ModeratorActionUpdateOne) SetDetails(v map[string]interface{}) *ModeratorActionUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *ModeratorActionUpdateOne) ClearDetails() *ModeratorActionUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the ModeratorActionMutation object of the builder.
+func (_u *ModeratorActionUpdateOne) Mutation() *ModeratorActionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ModeratorActionUpdate builder.
+func (_u *ModeratorActionUpdateOne) Where(ps ...predicate.ModeratorAction) *ModeratorActionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModeratorActionUpdateOne) Select(field string, fields ...string) *ModeratorActionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModeratorAction entity.
+func (_u *ModeratorActionUpdateOne) Save(ctx context.Context) (*ModeratorAction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModeratorActionUpdateOne) SaveX(ctx context.Context) *ModeratorAction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModeratorActionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModeratorActionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModeratorActionUpdateOne) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := moderatoraction.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModeratorID(); ok {
+		if err := moderatoraction.ModeratorIDValidator(v); err != nil {
+			return &ValidationError{Name: "moderator_id", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.moderator_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := moderatoraction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModeratorAction.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ModeratorActionUpdateOne) sqlSave(ctx context.Context) (_node *ModeratorAction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderatoraction.Table, moderatoraction.Columns, sqlgraph.NewFieldSpec(moderatoraction.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModeratorAction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderatoraction.FieldID)
+		for _, f := range fields {
+			if !moderatoraction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderatoraction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GameID(); ok {
+		_spec.SetField(moderatoraction.FieldGameID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ModeratorID(); ok {
+		_spec.SetField(moderatoraction.FieldModeratorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(moderatoraction.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(moderatoraction.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(moderatoraction.FieldDetails, field.TypeJSON)
+	}
+	_node = &ModeratorAction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderatoraction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,13 +13,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/admin"
+	"github.com/mafia-night/backend/ent/chatmessage"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
 	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+	"github.com/mafia-night/backend/ent/nightaction"
 	"github.com/mafia-night/backend/ent/player"
 	"github.com/mafia-night/backend/ent/predicate"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+	"github.com/mafia-night/backend/ent/roletranslation"
+	"github.com/mafia-night/backend/ent/schema"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/ent/vote"
 )
 
 const (
@@ -31,13 +43,24 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAdmin            = "Admin"
-	TypeGame             = "Game"
-	TypeGameRole         = "GameRole"
-	TypePlayer           = "Player"
-	TypeRole             = "Role"
-	TypeRoleTemplate     = "RoleTemplate"
-	TypeRoleTemplateRole = "RoleTemplateRole"
+	TypeAdmin                   = "Admin"
+	TypeChatMessage             = "ChatMessage"
+	TypeGame                    = "Game"
+	TypeGameModerator           = "GameModerator"
+	TypeGameRole                = "GameRole"
+	TypeModeratorAction         = "ModeratorAction"
+	TypeNightAction             = "NightAction"
+	TypePlayer                  = "Player"
+	TypeRole                    = "Role"
+	TypeRoleRule                = "RoleRule"
+	TypeRoleTemplate            = "RoleTemplate"
+	TypeRoleTemplateRevision    = "RoleTemplateRevision"
+	TypeRoleTemplateRole        = "RoleTemplateRole"
+	TypeRoleTemplateSlot        = "RoleTemplateSlot"
+	TypeRoleTemplateTranslation = "RoleTemplateTranslation"
+	TypeRoleTranslation         = "RoleTranslation"
+	TypeSpectator               = "Spectator"
+	TypeVote                    = "Vote"
 )
 
 // AdminMutation represents an operation that mutates the Admin nodes in the graph.
@@ -718,38 +741,39 @@ func (m *AdminMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Admin edge %s", name)
 }
 
-// GameMutation represents an operation that mutates the Game nodes in the graph.
-type GameMutation struct {
+// ChatMessageMutation represents an operation that mutates the ChatMessage nodes in the graph.
+type ChatMessageMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	status            *game.Status
-	moderator_id      *string
-	created_at        *time.Time
-	clearedFields     map[string]struct{}
-	players           map[uuid.UUID]struct{}
-	removedplayers    map[uuid.UUID]struct{}
-	clearedplayers    bool
-	game_roles        map[int]struct{}
-	removedgame_roles map[int]struct{}
-	clearedgame_roles bool
-	done              bool
-	oldValue          func(context.Context) (*Game, error)
-	predicates        []predicate.Game
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	channel       *chatmessage.Channel
+	round         *int
+	addround      *int
+	player_id     *uuid.UUID
+	moderator_id  *string
+	sender_name   *string
+	text          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	game          *string
+	clearedgame   bool
+	done          bool
+	oldValue      func(context.Context) (*ChatMessage, error)
+	predicates    []predicate.ChatMessage
 }
 
-var _ ent.Mutation = (*GameMutation)(nil)
+var _ ent.Mutation = (*ChatMessageMutation)(nil)
 
-// gameOption allows management of the mutation configuration using functional options.
-type gameOption func(*GameMutation)
+// chatmessageOption allows management of the mutation configuration using functional options.
+type chatmessageOption func(*ChatMessageMutation)
 
-// newGameMutation creates new mutation for the Game entity.
-func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
-	m := &GameMutation{
+// newChatMessageMutation creates new mutation for the ChatMessage entity.
+func newChatMessageMutation(c config, op Op, opts ...chatmessageOption) *ChatMessageMutation {
+	m := &ChatMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGame,
+		typ:           TypeChatMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -758,20 +782,20 @@ func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
 	return m
 }
 
-// withGameID sets the ID field of the mutation.
-func withGameID(id string) gameOption {
-	return func(m *GameMutation) {
+// withChatMessageID sets the ID field of the mutation.
+func withChatMessageID(id uuid.UUID) chatmessageOption {
+	return func(m *ChatMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Game
+			value *ChatMessage
 		)
-		m.oldValue = func(ctx context.Context) (*Game, error) {
+		m.oldValue = func(ctx context.Context) (*ChatMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Game.Get(ctx, id)
+					value, err = m.Client().ChatMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -780,10 +804,10 @@ func withGameID(id string) gameOption {
 	}
 }
 
-// withGame sets the old Game of the mutation.
-func withGame(node *Game) gameOption {
-	return func(m *GameMutation) {
-		m.oldValue = func(context.Context) (*Game, error) {
+// withChatMessage sets the old ChatMessage of the mutation.
+func withChatMessage(node *ChatMessage) chatmessageOption {
+	return func(m *ChatMessageMutation) {
+		m.oldValue = func(context.Context) (*ChatMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -792,7 +816,7 @@ func withGame(node *Game) gameOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GameMutation) Client() *Client {
+func (m ChatMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -800,7 +824,7 @@ func (m GameMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GameMutation) Tx() (*Tx, error) {
+func (m ChatMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -810,14 +834,14 @@ func (m GameMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Game entities.
-func (m *GameMutation) SetID(id string) {
+// operation is only accepted on creation of ChatMessage entities.
+func (m *ChatMessageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GameMutation) ID() (id string, exists bool) {
+func (m *ChatMessageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -828,280 +852,440 @@ func (m *GameMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GameMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ChatMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Game.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChatMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStatus sets the "status" field.
-func (m *GameMutation) SetStatus(ga game.Status) {
-	m.status = &ga
+// SetGameID sets the "game_id" field.
+func (m *ChatMessageMutation) SetGameID(s string) {
+	m.game = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *GameMutation) Status() (r game.Status, exists bool) {
-	v := m.status
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *ChatMessageMutation) GameID() (r string, exists bool) {
+	v := m.game
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldGameID returns the old "game_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldStatus(ctx context.Context) (v game.Status, err error) {
+func (m *ChatMessageMutation) OldGameID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldGameID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.GameID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *GameMutation) ResetStatus() {
-	m.status = nil
+// ResetGameID resets all changes to the "game_id" field.
+func (m *ChatMessageMutation) ResetGameID() {
+	m.game = nil
 }
 
-// SetModeratorID sets the "moderator_id" field.
-func (m *GameMutation) SetModeratorID(s string) {
-	m.moderator_id = &s
+// SetChannel sets the "channel" field.
+func (m *ChatMessageMutation) SetChannel(c chatmessage.Channel) {
+	m.channel = &c
 }
 
-// ModeratorID returns the value of the "moderator_id" field in the mutation.
-func (m *GameMutation) ModeratorID() (r string, exists bool) {
-	v := m.moderator_id
+// Channel returns the value of the "channel" field in the mutation.
+func (m *ChatMessageMutation) Channel() (r chatmessage.Channel, exists bool) {
+	v := m.channel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModeratorID returns the old "moderator_id" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldChannel returns the old "channel" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldModeratorID(ctx context.Context) (v string, err error) {
+func (m *ChatMessageMutation) OldChannel(ctx context.Context) (v chatmessage.Channel, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModeratorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModeratorID requires an ID field in the mutation")
+		return v, errors.New("OldChannel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModeratorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
 	}
-	return oldValue.ModeratorID, nil
+	return oldValue.Channel, nil
 }
 
-// ResetModeratorID resets all changes to the "moderator_id" field.
-func (m *GameMutation) ResetModeratorID() {
-	m.moderator_id = nil
+// ResetChannel resets all changes to the "channel" field.
+func (m *ChatMessageMutation) ResetChannel() {
+	m.channel = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *GameMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRound sets the "round" field.
+func (m *ChatMessageMutation) SetRound(i int) {
+	m.round = &i
+	m.addround = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GameMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Round returns the value of the "round" field in the mutation.
+func (m *ChatMessageMutation) Round() (r int, exists bool) {
+	v := m.round
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldRound returns the old "round" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatMessageMutation) OldRound(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRound is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRound requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRound: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Round, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GameMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddRound adds i to the "round" field.
+func (m *ChatMessageMutation) AddRound(i int) {
+	if m.addround != nil {
+		*m.addround += i
+	} else {
+		m.addround = &i
+	}
 }
 
-// AddPlayerIDs adds the "players" edge to the Player entity by ids.
-func (m *GameMutation) AddPlayerIDs(ids ...uuid.UUID) {
-	if m.players == nil {
-		m.players = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.players[ids[i]] = struct{}{}
+// AddedRound returns the value that was added to the "round" field in this mutation.
+func (m *ChatMessageMutation) AddedRound() (r int, exists bool) {
+	v := m.addround
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearPlayers clears the "players" edge to the Player entity.
-func (m *GameMutation) ClearPlayers() {
-	m.clearedplayers = true
+// ResetRound resets all changes to the "round" field.
+func (m *ChatMessageMutation) ResetRound() {
+	m.round = nil
+	m.addround = nil
 }
 
-// PlayersCleared reports if the "players" edge to the Player entity was cleared.
-func (m *GameMutation) PlayersCleared() bool {
-	return m.clearedplayers
+// SetPlayerID sets the "player_id" field.
+func (m *ChatMessageMutation) SetPlayerID(u uuid.UUID) {
+	m.player_id = &u
 }
 
-// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
-func (m *GameMutation) RemovePlayerIDs(ids ...uuid.UUID) {
-	if m.removedplayers == nil {
-		m.removedplayers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.players, ids[i])
-		m.removedplayers[ids[i]] = struct{}{}
+// PlayerID returns the value of the "player_id" field in the mutation.
+func (m *ChatMessageMutation) PlayerID() (r uuid.UUID, exists bool) {
+	v := m.player_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
-func (m *GameMutation) RemovedPlayersIDs() (ids []uuid.UUID) {
-	for id := range m.removedplayers {
-		ids = append(ids, id)
+// OldPlayerID returns the old "player_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldPlayerID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
+	}
+	return oldValue.PlayerID, nil
 }
 
-// PlayersIDs returns the "players" edge IDs in the mutation.
-func (m *GameMutation) PlayersIDs() (ids []uuid.UUID) {
-	for id := range m.players {
-		ids = append(ids, id)
-	}
-	return
+// ClearPlayerID clears the value of the "player_id" field.
+func (m *ChatMessageMutation) ClearPlayerID() {
+	m.player_id = nil
+	m.clearedFields[chatmessage.FieldPlayerID] = struct{}{}
 }
 
-// ResetPlayers resets all changes to the "players" edge.
-func (m *GameMutation) ResetPlayers() {
-	m.players = nil
-	m.clearedplayers = false
-	m.removedplayers = nil
+// PlayerIDCleared returns if the "player_id" field was cleared in this mutation.
+func (m *ChatMessageMutation) PlayerIDCleared() bool {
+	_, ok := m.clearedFields[chatmessage.FieldPlayerID]
+	return ok
 }
 
-// AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by ids.
-func (m *GameMutation) AddGameRoleIDs(ids ...int) {
-	if m.game_roles == nil {
-		m.game_roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.game_roles[ids[i]] = struct{}{}
-	}
+// ResetPlayerID resets all changes to the "player_id" field.
+func (m *ChatMessageMutation) ResetPlayerID() {
+	m.player_id = nil
+	delete(m.clearedFields, chatmessage.FieldPlayerID)
 }
 
-// ClearGameRoles clears the "game_roles" edge to the GameRole entity.
-func (m *GameMutation) ClearGameRoles() {
-	m.clearedgame_roles = true
+// SetModeratorID sets the "moderator_id" field.
+func (m *ChatMessageMutation) SetModeratorID(s string) {
+	m.moderator_id = &s
 }
 
-// GameRolesCleared reports if the "game_roles" edge to the GameRole entity was cleared.
-func (m *GameMutation) GameRolesCleared() bool {
-	return m.clearedgame_roles
+// ModeratorID returns the value of the "moderator_id" field in the mutation.
+func (m *ChatMessageMutation) ModeratorID() (r string, exists bool) {
+	v := m.moderator_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveGameRoleIDs removes the "game_roles" edge to the GameRole entity by IDs.
-func (m *GameMutation) RemoveGameRoleIDs(ids ...int) {
-	if m.removedgame_roles == nil {
-		m.removedgame_roles = make(map[int]struct{})
+// OldModeratorID returns the old "moderator_id" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldModeratorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratorID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.game_roles, ids[i])
-		m.removedgame_roles[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratorID: %w", err)
 	}
+	return oldValue.ModeratorID, nil
 }
 
-// RemovedGameRoles returns the removed IDs of the "game_roles" edge to the GameRole entity.
-func (m *GameMutation) RemovedGameRolesIDs() (ids []int) {
-	for id := range m.removedgame_roles {
-		ids = append(ids, id)
-	}
-	return
+// ClearModeratorID clears the value of the "moderator_id" field.
+func (m *ChatMessageMutation) ClearModeratorID() {
+	m.moderator_id = nil
+	m.clearedFields[chatmessage.FieldModeratorID] = struct{}{}
 }
 
-// GameRolesIDs returns the "game_roles" edge IDs in the mutation.
-func (m *GameMutation) GameRolesIDs() (ids []int) {
-	for id := range m.game_roles {
-		ids = append(ids, id)
-	}
-	return
+// ModeratorIDCleared returns if the "moderator_id" field was cleared in this mutation.
+func (m *ChatMessageMutation) ModeratorIDCleared() bool {
+	_, ok := m.clearedFields[chatmessage.FieldModeratorID]
+	return ok
 }
 
-// ResetGameRoles resets all changes to the "game_roles" edge.
-func (m *GameMutation) ResetGameRoles() {
-	m.game_roles = nil
-	m.clearedgame_roles = false
-	m.removedgame_roles = nil
+// ResetModeratorID resets all changes to the "moderator_id" field.
+func (m *ChatMessageMutation) ResetModeratorID() {
+	m.moderator_id = nil
+	delete(m.clearedFields, chatmessage.FieldModeratorID)
 }
 
-// Where appends a list predicates to the GameMutation builder.
-func (m *GameMutation) Where(ps ...predicate.Game) {
-	m.predicates = append(m.predicates, ps...)
+// SetSenderName sets the "sender_name" field.
+func (m *ChatMessageMutation) SetSenderName(s string) {
+	m.sender_name = &s
 }
 
-// WhereP appends storage-level predicates to the GameMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GameMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Game, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SenderName returns the value of the "sender_name" field in the mutation.
+func (m *ChatMessageMutation) SenderName() (r string, exists bool) {
+	v := m.sender_name
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *GameMutation) Op() Op {
-	return m.op
+// OldSenderName returns the old "sender_name" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldSenderName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderName: %w", err)
+	}
+	return oldValue.SenderName, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GameMutation) SetOp(op Op) {
-	m.op = op
+// ResetSenderName resets all changes to the "sender_name" field.
+func (m *ChatMessageMutation) ResetSenderName() {
+	m.sender_name = nil
 }
 
-// Type returns the node type of this mutation (Game).
-func (m *GameMutation) Type() string {
-	return m.typ
+// SetText sets the "text" field.
+func (m *ChatMessageMutation) SetText(s string) {
+	m.text = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GameMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.status != nil {
-		fields = append(fields, game.FieldStatus)
+// Text returns the value of the "text" field in the mutation.
+func (m *ChatMessageMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *ChatMessageMutation) ResetText() {
+	m.text = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ChatMessage entity.
+// If the ChatMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *ChatMessageMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[chatmessage.FieldGameID] = struct{}{}
+}
+
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *ChatMessageMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *ChatMessageMutation) GameIDs() (ids []string) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *ChatMessageMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// Where appends a list predicates to the ChatMessageMutation builder.
+func (m *ChatMessageMutation) Where(ps ...predicate.ChatMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChatMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChatMessage).
+func (m *ChatMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChatMessageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.game != nil {
+		fields = append(fields, chatmessage.FieldGameID)
+	}
+	if m.channel != nil {
+		fields = append(fields, chatmessage.FieldChannel)
+	}
+	if m.round != nil {
+		fields = append(fields, chatmessage.FieldRound)
+	}
+	if m.player_id != nil {
+		fields = append(fields, chatmessage.FieldPlayerID)
 	}
 	if m.moderator_id != nil {
-		fields = append(fields, game.FieldModeratorID)
+		fields = append(fields, chatmessage.FieldModeratorID)
+	}
+	if m.sender_name != nil {
+		fields = append(fields, chatmessage.FieldSenderName)
+	}
+	if m.text != nil {
+		fields = append(fields, chatmessage.FieldText)
 	}
 	if m.created_at != nil {
-		fields = append(fields, game.FieldCreatedAt)
+		fields = append(fields, chatmessage.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1109,13 +1293,23 @@ func (m *GameMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GameMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case game.FieldStatus:
-		return m.Status()
-	case game.FieldModeratorID:
+	case chatmessage.FieldGameID:
+		return m.GameID()
+	case chatmessage.FieldChannel:
+		return m.Channel()
+	case chatmessage.FieldRound:
+		return m.Round()
+	case chatmessage.FieldPlayerID:
+		return m.PlayerID()
+	case chatmessage.FieldModeratorID:
 		return m.ModeratorID()
-	case game.FieldCreatedAt:
+	case chatmessage.FieldSenderName:
+		return m.SenderName()
+	case chatmessage.FieldText:
+		return m.Text()
+	case chatmessage.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1124,246 +1318,10148 @@ func (m *GameMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case game.FieldStatus:
-		return m.OldStatus(ctx)
-	case game.FieldModeratorID:
+	case chatmessage.FieldGameID:
+		return m.OldGameID(ctx)
+	case chatmessage.FieldChannel:
+		return m.OldChannel(ctx)
+	case chatmessage.FieldRound:
+		return m.OldRound(ctx)
+	case chatmessage.FieldPlayerID:
+		return m.OldPlayerID(ctx)
+	case chatmessage.FieldModeratorID:
 		return m.OldModeratorID(ctx)
-	case game.FieldCreatedAt:
+	case chatmessage.FieldSenderName:
+		return m.OldSenderName(ctx)
+	case chatmessage.FieldText:
+		return m.OldText(ctx)
+	case chatmessage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Game field %s", name)
+	return nil, fmt.Errorf("unknown ChatMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameMutation) SetField(name string, value ent.Value) error {
+func (m *ChatMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case game.FieldStatus:
-		v, ok := value.(game.Status)
+	case chatmessage.FieldGameID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetGameID(v)
 		return nil
-	case game.FieldModeratorID:
+	case chatmessage.FieldChannel:
+		v, ok := value.(chatmessage.Channel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannel(v)
+		return nil
+	case chatmessage.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRound(v)
+		return nil
+	case chatmessage.FieldPlayerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerID(v)
+		return nil
+	case chatmessage.FieldModeratorID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetModeratorID(v)
 		return nil
-	case game.FieldCreatedAt:
+	case chatmessage.FieldSenderName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderName(v)
+		return nil
+	case chatmessage.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case chatmessage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChatMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addround != nil {
+		fields = append(fields, chatmessage.FieldRound)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChatMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chatmessage.FieldRound:
+		return m.AddedRound()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case chatmessage.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRound(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChatMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(chatmessage.FieldPlayerID) {
+		fields = append(fields, chatmessage.FieldPlayerID)
+	}
+	if m.FieldCleared(chatmessage.FieldModeratorID) {
+		fields = append(fields, chatmessage.FieldModeratorID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChatMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChatMessageMutation) ClearField(name string) error {
+	switch name {
+	case chatmessage.FieldPlayerID:
+		m.ClearPlayerID()
+		return nil
+	case chatmessage.FieldModeratorID:
+		m.ClearModeratorID()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChatMessageMutation) ResetField(name string) error {
+	switch name {
+	case chatmessage.FieldGameID:
+		m.ResetGameID()
+		return nil
+	case chatmessage.FieldChannel:
+		m.ResetChannel()
+		return nil
+	case chatmessage.FieldRound:
+		m.ResetRound()
+		return nil
+	case chatmessage.FieldPlayerID:
+		m.ResetPlayerID()
+		return nil
+	case chatmessage.FieldModeratorID:
+		m.ResetModeratorID()
+		return nil
+	case chatmessage.FieldSenderName:
+		m.ResetSenderName()
+		return nil
+	case chatmessage.FieldText:
+		m.ResetText()
+		return nil
+	case chatmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChatMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.game != nil {
+		edges = append(edges, chatmessage.EdgeGame)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChatMessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case chatmessage.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChatMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChatMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgame {
+		edges = append(edges, chatmessage.EdgeGame)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChatMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case chatmessage.EdgeGame:
+		return m.clearedgame
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChatMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case chatmessage.EdgeGame:
+		m.ClearGame()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChatMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case chatmessage.EdgeGame:
+		m.ResetGame()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatMessage edge %s", name)
+}
+
+// GameMutation represents an operation that mutates the Game nodes in the graph.
+type GameMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	status                     *game.Status
+	moderator_id               *string
+	spectator_mode             *game.SpectatorMode
+	spectator_delay_seconds    *int
+	addspectator_delay_seconds *int
+	phase                      *game.Phase
+	round                      *int
+	addround                   *int
+	created_at                 *time.Time
+	clearedFields              map[string]struct{}
+	players                    map[uuid.UUID]struct{}
+	removedplayers             map[uuid.UUID]struct{}
+	clearedplayers             bool
+	game_roles                 map[int]struct{}
+	removedgame_roles          map[int]struct{}
+	clearedgame_roles          bool
+	moderators                 map[uuid.UUID]struct{}
+	removedmoderators          map[uuid.UUID]struct{}
+	clearedmoderators          bool
+	spectators                 map[uuid.UUID]struct{}
+	removedspectators          map[uuid.UUID]struct{}
+	clearedspectators          bool
+	votes                      map[uuid.UUID]struct{}
+	removedvotes               map[uuid.UUID]struct{}
+	clearedvotes               bool
+	night_actions              map[uuid.UUID]struct{}
+	removednight_actions       map[uuid.UUID]struct{}
+	clearednight_actions       bool
+	chat_messages              map[uuid.UUID]struct{}
+	removedchat_messages       map[uuid.UUID]struct{}
+	clearedchat_messages       bool
+	done                       bool
+	oldValue                   func(context.Context) (*Game, error)
+	predicates                 []predicate.Game
+}
+
+var _ ent.Mutation = (*GameMutation)(nil)
+
+// gameOption allows management of the mutation configuration using functional options.
+type gameOption func(*GameMutation)
+
+// newGameMutation creates new mutation for the Game entity.
+func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
+	m := &GameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGame,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGameID sets the ID field of the mutation.
+func withGameID(id string) gameOption {
+	return func(m *GameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Game
+		)
+		m.oldValue = func(ctx context.Context) (*Game, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Game.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGame sets the old Game of the mutation.
+func withGame(node *Game) gameOption {
+	return func(m *GameMutation) {
+		m.oldValue = func(context.Context) (*Game, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Game entities.
+func (m *GameMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GameMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GameMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Game.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *GameMutation) SetStatus(ga game.Status) {
+	m.status = &ga
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GameMutation) Status() (r game.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldStatus(ctx context.Context) (v game.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GameMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (m *GameMutation) SetModeratorID(s string) {
+	m.moderator_id = &s
+}
+
+// ModeratorID returns the value of the "moderator_id" field in the mutation.
+func (m *GameMutation) ModeratorID() (r string, exists bool) {
+	v := m.moderator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModeratorID returns the old "moderator_id" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldModeratorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratorID: %w", err)
+	}
+	return oldValue.ModeratorID, nil
+}
+
+// ResetModeratorID resets all changes to the "moderator_id" field.
+func (m *GameMutation) ResetModeratorID() {
+	m.moderator_id = nil
+}
+
+// SetSpectatorMode sets the "spectator_mode" field.
+func (m *GameMutation) SetSpectatorMode(gm game.SpectatorMode) {
+	m.spectator_mode = &gm
+}
+
+// SpectatorMode returns the value of the "spectator_mode" field in the mutation.
+func (m *GameMutation) SpectatorMode() (r game.SpectatorMode, exists bool) {
+	v := m.spectator_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpectatorMode returns the old "spectator_mode" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldSpectatorMode(ctx context.Context) (v game.SpectatorMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpectatorMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpectatorMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpectatorMode: %w", err)
+	}
+	return oldValue.SpectatorMode, nil
+}
+
+// ResetSpectatorMode resets all changes to the "spectator_mode" field.
+func (m *GameMutation) ResetSpectatorMode() {
+	m.spectator_mode = nil
+}
+
+// SetSpectatorDelaySeconds sets the "spectator_delay_seconds" field.
+func (m *GameMutation) SetSpectatorDelaySeconds(i int) {
+	m.spectator_delay_seconds = &i
+	m.addspectator_delay_seconds = nil
+}
+
+// SpectatorDelaySeconds returns the value of the "spectator_delay_seconds" field in the mutation.
+func (m *GameMutation) SpectatorDelaySeconds() (r int, exists bool) {
+	v := m.spectator_delay_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpectatorDelaySeconds returns the old "spectator_delay_seconds" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldSpectatorDelaySeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpectatorDelaySeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpectatorDelaySeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpectatorDelaySeconds: %w", err)
+	}
+	return oldValue.SpectatorDelaySeconds, nil
+}
+
+// AddSpectatorDelaySeconds adds i to the "spectator_delay_seconds" field.
+func (m *GameMutation) AddSpectatorDelaySeconds(i int) {
+	if m.addspectator_delay_seconds != nil {
+		*m.addspectator_delay_seconds += i
+	} else {
+		m.addspectator_delay_seconds = &i
+	}
+}
+
+// AddedSpectatorDelaySeconds returns the value that was added to the "spectator_delay_seconds" field in this mutation.
+func (m *GameMutation) AddedSpectatorDelaySeconds() (r int, exists bool) {
+	v := m.addspectator_delay_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpectatorDelaySeconds resets all changes to the "spectator_delay_seconds" field.
+func (m *GameMutation) ResetSpectatorDelaySeconds() {
+	m.spectator_delay_seconds = nil
+	m.addspectator_delay_seconds = nil
+}
+
+// SetPhase sets the "phase" field.
+func (m *GameMutation) SetPhase(ga game.Phase) {
+	m.phase = &ga
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *GameMutation) Phase() (r game.Phase, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldPhase(ctx context.Context) (v game.Phase, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *GameMutation) ResetPhase() {
+	m.phase = nil
+}
+
+// SetRound sets the "round" field.
+func (m *GameMutation) SetRound(i int) {
+	m.round = &i
+	m.addround = nil
+}
+
+// Round returns the value of the "round" field in the mutation.
+func (m *GameMutation) Round() (r int, exists bool) {
+	v := m.round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRound returns the old "round" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldRound(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRound: %w", err)
+	}
+	return oldValue.Round, nil
+}
+
+// AddRound adds i to the "round" field.
+func (m *GameMutation) AddRound(i int) {
+	if m.addround != nil {
+		*m.addround += i
+	} else {
+		m.addround = &i
+	}
+}
+
+// AddedRound returns the value that was added to the "round" field in this mutation.
+func (m *GameMutation) AddedRound() (r int, exists bool) {
+	v := m.addround
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRound resets all changes to the "round" field.
+func (m *GameMutation) ResetRound() {
+	m.round = nil
+	m.addround = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GameMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GameMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GameMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by ids.
+func (m *GameMutation) AddPlayerIDs(ids ...uuid.UUID) {
+	if m.players == nil {
+		m.players = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.players[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlayers clears the "players" edge to the Player entity.
+func (m *GameMutation) ClearPlayers() {
+	m.clearedplayers = true
+}
+
+// PlayersCleared reports if the "players" edge to the Player entity was cleared.
+func (m *GameMutation) PlayersCleared() bool {
+	return m.clearedplayers
+}
+
+// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
+func (m *GameMutation) RemovePlayerIDs(ids ...uuid.UUID) {
+	if m.removedplayers == nil {
+		m.removedplayers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.players, ids[i])
+		m.removedplayers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
+func (m *GameMutation) RemovedPlayersIDs() (ids []uuid.UUID) {
+	for id := range m.removedplayers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlayersIDs returns the "players" edge IDs in the mutation.
+func (m *GameMutation) PlayersIDs() (ids []uuid.UUID) {
+	for id := range m.players {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlayers resets all changes to the "players" edge.
+func (m *GameMutation) ResetPlayers() {
+	m.players = nil
+	m.clearedplayers = false
+	m.removedplayers = nil
+}
+
+// AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by ids.
+func (m *GameMutation) AddGameRoleIDs(ids ...int) {
+	if m.game_roles == nil {
+		m.game_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.game_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGameRoles clears the "game_roles" edge to the GameRole entity.
+func (m *GameMutation) ClearGameRoles() {
+	m.clearedgame_roles = true
+}
+
+// GameRolesCleared reports if the "game_roles" edge to the GameRole entity was cleared.
+func (m *GameMutation) GameRolesCleared() bool {
+	return m.clearedgame_roles
+}
+
+// RemoveGameRoleIDs removes the "game_roles" edge to the GameRole entity by IDs.
+func (m *GameMutation) RemoveGameRoleIDs(ids ...int) {
+	if m.removedgame_roles == nil {
+		m.removedgame_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.game_roles, ids[i])
+		m.removedgame_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGameRoles returns the removed IDs of the "game_roles" edge to the GameRole entity.
+func (m *GameMutation) RemovedGameRolesIDs() (ids []int) {
+	for id := range m.removedgame_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GameRolesIDs returns the "game_roles" edge IDs in the mutation.
+func (m *GameMutation) GameRolesIDs() (ids []int) {
+	for id := range m.game_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGameRoles resets all changes to the "game_roles" edge.
+func (m *GameMutation) ResetGameRoles() {
+	m.game_roles = nil
+	m.clearedgame_roles = false
+	m.removedgame_roles = nil
+}
+
+// AddModeratorIDs adds the "moderators" edge to the GameModerator entity by ids.
+func (m *GameMutation) AddModeratorIDs(ids ...uuid.UUID) {
+	if m.moderators == nil {
+		m.moderators = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.moderators[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModerators clears the "moderators" edge to the GameModerator entity.
+func (m *GameMutation) ClearModerators() {
+	m.clearedmoderators = true
+}
+
+// ModeratorsCleared reports if the "moderators" edge to the GameModerator entity was cleared.
+func (m *GameMutation) ModeratorsCleared() bool {
+	return m.clearedmoderators
+}
+
+// RemoveModeratorIDs removes the "moderators" edge to the GameModerator entity by IDs.
+func (m *GameMutation) RemoveModeratorIDs(ids ...uuid.UUID) {
+	if m.removedmoderators == nil {
+		m.removedmoderators = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.moderators, ids[i])
+		m.removedmoderators[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModerators returns the removed IDs of the "moderators" edge to the GameModerator entity.
+func (m *GameMutation) RemovedModeratorsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmoderators {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModeratorsIDs returns the "moderators" edge IDs in the mutation.
+func (m *GameMutation) ModeratorsIDs() (ids []uuid.UUID) {
+	for id := range m.moderators {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModerators resets all changes to the "moderators" edge.
+func (m *GameMutation) ResetModerators() {
+	m.moderators = nil
+	m.clearedmoderators = false
+	m.removedmoderators = nil
+}
+
+// AddSpectatorIDs adds the "spectators" edge to the Spectator entity by ids.
+func (m *GameMutation) AddSpectatorIDs(ids ...uuid.UUID) {
+	if m.spectators == nil {
+		m.spectators = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.spectators[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSpectators clears the "spectators" edge to the Spectator entity.
+func (m *GameMutation) ClearSpectators() {
+	m.clearedspectators = true
+}
+
+// SpectatorsCleared reports if the "spectators" edge to the Spectator entity was cleared.
+func (m *GameMutation) SpectatorsCleared() bool {
+	return m.clearedspectators
+}
+
+// RemoveSpectatorIDs removes the "spectators" edge to the Spectator entity by IDs.
+func (m *GameMutation) RemoveSpectatorIDs(ids ...uuid.UUID) {
+	if m.removedspectators == nil {
+		m.removedspectators = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.spectators, ids[i])
+		m.removedspectators[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSpectators returns the removed IDs of the "spectators" edge to the Spectator entity.
+func (m *GameMutation) RemovedSpectatorsIDs() (ids []uuid.UUID) {
+	for id := range m.removedspectators {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SpectatorsIDs returns the "spectators" edge IDs in the mutation.
+func (m *GameMutation) SpectatorsIDs() (ids []uuid.UUID) {
+	for id := range m.spectators {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSpectators resets all changes to the "spectators" edge.
+func (m *GameMutation) ResetSpectators() {
+	m.spectators = nil
+	m.clearedspectators = false
+	m.removedspectators = nil
+}
+
+// AddVoteIDs adds the "votes" edge to the Vote entity by ids.
+func (m *GameMutation) AddVoteIDs(ids ...uuid.UUID) {
+	if m.votes == nil {
+		m.votes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVotes clears the "votes" edge to the Vote entity.
+func (m *GameMutation) ClearVotes() {
+	m.clearedvotes = true
+}
+
+// VotesCleared reports if the "votes" edge to the Vote entity was cleared.
+func (m *GameMutation) VotesCleared() bool {
+	return m.clearedvotes
+}
+
+// RemoveVoteIDs removes the "votes" edge to the Vote entity by IDs.
+func (m *GameMutation) RemoveVoteIDs(ids ...uuid.UUID) {
+	if m.removedvotes == nil {
+		m.removedvotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.votes, ids[i])
+		m.removedvotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVotes returns the removed IDs of the "votes" edge to the Vote entity.
+func (m *GameMutation) RemovedVotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VotesIDs returns the "votes" edge IDs in the mutation.
+func (m *GameMutation) VotesIDs() (ids []uuid.UUID) {
+	for id := range m.votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVotes resets all changes to the "votes" edge.
+func (m *GameMutation) ResetVotes() {
+	m.votes = nil
+	m.clearedvotes = false
+	m.removedvotes = nil
+}
+
+// AddNightActionIDs adds the "night_actions" edge to the NightAction entity by ids.
+func (m *GameMutation) AddNightActionIDs(ids ...uuid.UUID) {
+	if m.night_actions == nil {
+		m.night_actions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.night_actions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNightActions clears the "night_actions" edge to the NightAction entity.
+func (m *GameMutation) ClearNightActions() {
+	m.clearednight_actions = true
+}
+
+// NightActionsCleared reports if the "night_actions" edge to the NightAction entity was cleared.
+func (m *GameMutation) NightActionsCleared() bool {
+	return m.clearednight_actions
+}
+
+// RemoveNightActionIDs removes the "night_actions" edge to the NightAction entity by IDs.
+func (m *GameMutation) RemoveNightActionIDs(ids ...uuid.UUID) {
+	if m.removednight_actions == nil {
+		m.removednight_actions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.night_actions, ids[i])
+		m.removednight_actions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNightActions returns the removed IDs of the "night_actions" edge to the NightAction entity.
+func (m *GameMutation) RemovedNightActionsIDs() (ids []uuid.UUID) {
+	for id := range m.removednight_actions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NightActionsIDs returns the "night_actions" edge IDs in the mutation.
+func (m *GameMutation) NightActionsIDs() (ids []uuid.UUID) {
+	for id := range m.night_actions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNightActions resets all changes to the "night_actions" edge.
+func (m *GameMutation) ResetNightActions() {
+	m.night_actions = nil
+	m.clearednight_actions = false
+	m.removednight_actions = nil
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by ids.
+func (m *GameMutation) AddChatMessageIDs(ids ...uuid.UUID) {
+	if m.chat_messages == nil {
+		m.chat_messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.chat_messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChatMessages clears the "chat_messages" edge to the ChatMessage entity.
+func (m *GameMutation) ClearChatMessages() {
+	m.clearedchat_messages = true
+}
+
+// ChatMessagesCleared reports if the "chat_messages" edge to the ChatMessage entity was cleared.
+func (m *GameMutation) ChatMessagesCleared() bool {
+	return m.clearedchat_messages
+}
+
+// RemoveChatMessageIDs removes the "chat_messages" edge to the ChatMessage entity by IDs.
+func (m *GameMutation) RemoveChatMessageIDs(ids ...uuid.UUID) {
+	if m.removedchat_messages == nil {
+		m.removedchat_messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.chat_messages, ids[i])
+		m.removedchat_messages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChatMessages returns the removed IDs of the "chat_messages" edge to the ChatMessage entity.
+func (m *GameMutation) RemovedChatMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedchat_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChatMessagesIDs returns the "chat_messages" edge IDs in the mutation.
+func (m *GameMutation) ChatMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.chat_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChatMessages resets all changes to the "chat_messages" edge.
+func (m *GameMutation) ResetChatMessages() {
+	m.chat_messages = nil
+	m.clearedchat_messages = false
+	m.removedchat_messages = nil
+}
+
+// Where appends a list predicates to the GameMutation builder.
+func (m *GameMutation) Where(ps ...predicate.Game) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GameMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GameMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Game, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GameMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GameMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Game).
+func (m *GameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.status != nil {
+		fields = append(fields, game.FieldStatus)
+	}
+	if m.moderator_id != nil {
+		fields = append(fields, game.FieldModeratorID)
+	}
+	if m.spectator_mode != nil {
+		fields = append(fields, game.FieldSpectatorMode)
+	}
+	if m.spectator_delay_seconds != nil {
+		fields = append(fields, game.FieldSpectatorDelaySeconds)
+	}
+	if m.phase != nil {
+		fields = append(fields, game.FieldPhase)
+	}
+	if m.round != nil {
+		fields = append(fields, game.FieldRound)
+	}
+	if m.created_at != nil {
+		fields = append(fields, game.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case game.FieldStatus:
+		return m.Status()
+	case game.FieldModeratorID:
+		return m.ModeratorID()
+	case game.FieldSpectatorMode:
+		return m.SpectatorMode()
+	case game.FieldSpectatorDelaySeconds:
+		return m.SpectatorDelaySeconds()
+	case game.FieldPhase:
+		return m.Phase()
+	case game.FieldRound:
+		return m.Round()
+	case game.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case game.FieldStatus:
+		return m.OldStatus(ctx)
+	case game.FieldModeratorID:
+		return m.OldModeratorID(ctx)
+	case game.FieldSpectatorMode:
+		return m.OldSpectatorMode(ctx)
+	case game.FieldSpectatorDelaySeconds:
+		return m.OldSpectatorDelaySeconds(ctx)
+	case game.FieldPhase:
+		return m.OldPhase(ctx)
+	case game.FieldRound:
+		return m.OldRound(ctx)
+	case game.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Game field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case game.FieldStatus:
+		v, ok := value.(game.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case game.FieldModeratorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModeratorID(v)
+		return nil
+	case game.FieldSpectatorMode:
+		v, ok := value.(game.SpectatorMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpectatorMode(v)
+		return nil
+	case game.FieldSpectatorDelaySeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpectatorDelaySeconds(v)
+		return nil
+	case game.FieldPhase:
+		v, ok := value.(game.Phase)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case game.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRound(v)
+		return nil
+	case game.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameMutation) AddedFields() []string {
+	var fields []string
+	if m.addspectator_delay_seconds != nil {
+		fields = append(fields, game.FieldSpectatorDelaySeconds)
+	}
+	if m.addround != nil {
+		fields = append(fields, game.FieldRound)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case game.FieldSpectatorDelaySeconds:
+		return m.AddedSpectatorDelaySeconds()
+	case game.FieldRound:
+		return m.AddedRound()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case game.FieldSpectatorDelaySeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpectatorDelaySeconds(v)
+		return nil
+	case game.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRound(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Game numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Game nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameMutation) ResetField(name string) error {
+	switch name {
+	case game.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case game.FieldModeratorID:
+		m.ResetModeratorID()
+		return nil
+	case game.FieldSpectatorMode:
+		m.ResetSpectatorMode()
+		return nil
+	case game.FieldSpectatorDelaySeconds:
+		m.ResetSpectatorDelaySeconds()
+		return nil
+	case game.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case game.FieldRound:
+		m.ResetRound()
+		return nil
+	case game.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.players != nil {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.game_roles != nil {
+		edges = append(edges, game.EdgeGameRoles)
+	}
+	if m.moderators != nil {
+		edges = append(edges, game.EdgeModerators)
+	}
+	if m.spectators != nil {
+		edges = append(edges, game.EdgeSpectators)
+	}
+	if m.votes != nil {
+		edges = append(edges, game.EdgeVotes)
+	}
+	if m.night_actions != nil {
+		edges = append(edges, game.EdgeNightActions)
+	}
+	if m.chat_messages != nil {
+		edges = append(edges, game.EdgeChatMessages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.players))
+		for id := range m.players {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeGameRoles:
+		ids := make([]ent.Value, 0, len(m.game_roles))
+		for id := range m.game_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeModerators:
+		ids := make([]ent.Value, 0, len(m.moderators))
+		for id := range m.moderators {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeSpectators:
+		ids := make([]ent.Value, 0, len(m.spectators))
+		for id := range m.spectators {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.votes))
+		for id := range m.votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeNightActions:
+		ids := make([]ent.Value, 0, len(m.night_actions))
+		for id := range m.night_actions {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeChatMessages:
+		ids := make([]ent.Value, 0, len(m.chat_messages))
+		for id := range m.chat_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedplayers != nil {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.removedgame_roles != nil {
+		edges = append(edges, game.EdgeGameRoles)
+	}
+	if m.removedmoderators != nil {
+		edges = append(edges, game.EdgeModerators)
+	}
+	if m.removedspectators != nil {
+		edges = append(edges, game.EdgeSpectators)
+	}
+	if m.removedvotes != nil {
+		edges = append(edges, game.EdgeVotes)
+	}
+	if m.removednight_actions != nil {
+		edges = append(edges, game.EdgeNightActions)
+	}
+	if m.removedchat_messages != nil {
+		edges = append(edges, game.EdgeChatMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.removedplayers))
+		for id := range m.removedplayers {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeGameRoles:
+		ids := make([]ent.Value, 0, len(m.removedgame_roles))
+		for id := range m.removedgame_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeModerators:
+		ids := make([]ent.Value, 0, len(m.removedmoderators))
+		for id := range m.removedmoderators {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeSpectators:
+		ids := make([]ent.Value, 0, len(m.removedspectators))
+		for id := range m.removedspectators {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.removedvotes))
+		for id := range m.removedvotes {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeNightActions:
+		ids := make([]ent.Value, 0, len(m.removednight_actions))
+		for id := range m.removednight_actions {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeChatMessages:
+		ids := make([]ent.Value, 0, len(m.removedchat_messages))
+		for id := range m.removedchat_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedplayers {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.clearedgame_roles {
+		edges = append(edges, game.EdgeGameRoles)
+	}
+	if m.clearedmoderators {
+		edges = append(edges, game.EdgeModerators)
+	}
+	if m.clearedspectators {
+		edges = append(edges, game.EdgeSpectators)
+	}
+	if m.clearedvotes {
+		edges = append(edges, game.EdgeVotes)
+	}
+	if m.clearednight_actions {
+		edges = append(edges, game.EdgeNightActions)
+	}
+	if m.clearedchat_messages {
+		edges = append(edges, game.EdgeChatMessages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameMutation) EdgeCleared(name string) bool {
+	switch name {
+	case game.EdgePlayers:
+		return m.clearedplayers
+	case game.EdgeGameRoles:
+		return m.clearedgame_roles
+	case game.EdgeModerators:
+		return m.clearedmoderators
+	case game.EdgeSpectators:
+		return m.clearedspectators
+	case game.EdgeVotes:
+		return m.clearedvotes
+	case game.EdgeNightActions:
+		return m.clearednight_actions
+	case game.EdgeChatMessages:
+		return m.clearedchat_messages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Game unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameMutation) ResetEdge(name string) error {
+	switch name {
+	case game.EdgePlayers:
+		m.ResetPlayers()
+		return nil
+	case game.EdgeGameRoles:
+		m.ResetGameRoles()
+		return nil
+	case game.EdgeModerators:
+		m.ResetModerators()
+		return nil
+	case game.EdgeSpectators:
+		m.ResetSpectators()
+		return nil
+	case game.EdgeVotes:
+		m.ResetVotes()
+		return nil
+	case game.EdgeNightActions:
+		m.ResetNightActions()
+		return nil
+	case game.EdgeChatMessages:
+		m.ResetChatMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown Game edge %s", name)
+}
+
+// GameModeratorMutation represents an operation that mutates the GameModerator nodes in the graph.
+type GameModeratorMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	moderator_id      *string
+	permissions       *[]string
+	appendpermissions []string
+	added_by          *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	game              *string
+	clearedgame       bool
+	done              bool
+	oldValue          func(context.Context) (*GameModerator, error)
+	predicates        []predicate.GameModerator
+}
+
+var _ ent.Mutation = (*GameModeratorMutation)(nil)
+
+// gamemoderatorOption allows management of the mutation configuration using functional options.
+type gamemoderatorOption func(*GameModeratorMutation)
+
+// newGameModeratorMutation creates new mutation for the GameModerator entity.
+func newGameModeratorMutation(c config, op Op, opts ...gamemoderatorOption) *GameModeratorMutation {
+	m := &GameModeratorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGameModerator,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGameModeratorID sets the ID field of the mutation.
+func withGameModeratorID(id uuid.UUID) gamemoderatorOption {
+	return func(m *GameModeratorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GameModerator
+		)
+		m.oldValue = func(ctx context.Context) (*GameModerator, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GameModerator.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGameModerator sets the old GameModerator of the mutation.
+func withGameModerator(node *GameModerator) gamemoderatorOption {
+	return func(m *GameModeratorMutation) {
+		m.oldValue = func(context.Context) (*GameModerator, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GameModeratorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GameModeratorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GameModerator entities.
+func (m *GameModeratorMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GameModeratorMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GameModeratorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GameModerator.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGameID sets the "game_id" field.
+func (m *GameModeratorMutation) SetGameID(s string) {
+	m.game = &s
+}
+
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *GameModeratorMutation) GameID() (r string, exists bool) {
+	v := m.game
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGameID returns the old "game_id" field's value of the GameModerator entity.
+// If the GameModerator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameModeratorMutation) OldGameID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+	}
+	return oldValue.GameID, nil
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *GameModeratorMutation) ResetGameID() {
+	m.game = nil
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (m *GameModeratorMutation) SetModeratorID(s string) {
+	m.moderator_id = &s
+}
+
+// ModeratorID returns the value of the "moderator_id" field in the mutation.
+func (m *GameModeratorMutation) ModeratorID() (r string, exists bool) {
+	v := m.moderator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModeratorID returns the old "moderator_id" field's value of the GameModerator entity.
+// If the GameModerator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameModeratorMutation) OldModeratorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratorID: %w", err)
+	}
+	return oldValue.ModeratorID, nil
+}
+
+// ResetModeratorID resets all changes to the "moderator_id" field.
+func (m *GameModeratorMutation) ResetModeratorID() {
+	m.moderator_id = nil
+}
+
+// SetPermissions sets the "permissions" field.
+func (m *GameModeratorMutation) SetPermissions(s []string) {
+	m.permissions = &s
+	m.appendpermissions = nil
+}
+
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *GameModeratorMutation) Permissions() (r []string, exists bool) {
+	v := m.permissions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissions returns the old "permissions" field's value of the GameModerator entity.
+// If the GameModerator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameModeratorMutation) OldPermissions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+	}
+	return oldValue.Permissions, nil
+}
+
+// AppendPermissions adds s to the "permissions" field.
+func (m *GameModeratorMutation) AppendPermissions(s []string) {
+	m.appendpermissions = append(m.appendpermissions, s...)
+}
+
+// AppendedPermissions returns the list of values that were appended to the "permissions" field in this mutation.
+func (m *GameModeratorMutation) AppendedPermissions() ([]string, bool) {
+	if len(m.appendpermissions) == 0 {
+		return nil, false
+	}
+	return m.appendpermissions, true
+}
+
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *GameModeratorMutation) ResetPermissions() {
+	m.permissions = nil
+	m.appendpermissions = nil
+}
+
+// SetAddedBy sets the "added_by" field.
+func (m *GameModeratorMutation) SetAddedBy(s string) {
+	m.added_by = &s
+}
+
+// AddedBy returns the value of the "added_by" field in the mutation.
+func (m *GameModeratorMutation) AddedBy() (r string, exists bool) {
+	v := m.added_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedBy returns the old "added_by" field's value of the GameModerator entity.
+// If the GameModerator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameModeratorMutation) OldAddedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedBy: %w", err)
+	}
+	return oldValue.AddedBy, nil
+}
+
+// ResetAddedBy resets all changes to the "added_by" field.
+func (m *GameModeratorMutation) ResetAddedBy() {
+	m.added_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GameModeratorMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GameModeratorMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GameModerator entity.
+// If the GameModerator object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameModeratorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GameModeratorMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *GameModeratorMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[gamemoderator.FieldGameID] = struct{}{}
+}
+
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *GameModeratorMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *GameModeratorMutation) GameIDs() (ids []string) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *GameModeratorMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// Where appends a list predicates to the GameModeratorMutation builder.
+func (m *GameModeratorMutation) Where(ps ...predicate.GameModerator) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GameModeratorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GameModeratorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GameModerator, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GameModeratorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GameModeratorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GameModerator).
+func (m *GameModeratorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameModeratorMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.game != nil {
+		fields = append(fields, gamemoderator.FieldGameID)
+	}
+	if m.moderator_id != nil {
+		fields = append(fields, gamemoderator.FieldModeratorID)
+	}
+	if m.permissions != nil {
+		fields = append(fields, gamemoderator.FieldPermissions)
+	}
+	if m.added_by != nil {
+		fields = append(fields, gamemoderator.FieldAddedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, gamemoderator.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameModeratorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gamemoderator.FieldGameID:
+		return m.GameID()
+	case gamemoderator.FieldModeratorID:
+		return m.ModeratorID()
+	case gamemoderator.FieldPermissions:
+		return m.Permissions()
+	case gamemoderator.FieldAddedBy:
+		return m.AddedBy()
+	case gamemoderator.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameModeratorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gamemoderator.FieldGameID:
+		return m.OldGameID(ctx)
+	case gamemoderator.FieldModeratorID:
+		return m.OldModeratorID(ctx)
+	case gamemoderator.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case gamemoderator.FieldAddedBy:
+		return m.OldAddedBy(ctx)
+	case gamemoderator.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GameModerator field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameModeratorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gamemoderator.FieldGameID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameID(v)
+		return nil
+	case gamemoderator.FieldModeratorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModeratorID(v)
+		return nil
+	case gamemoderator.FieldPermissions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissions(v)
+		return nil
+	case gamemoderator.FieldAddedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedBy(v)
+		return nil
+	case gamemoderator.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GameModerator field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameModeratorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameModeratorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameModeratorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GameModerator numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameModeratorMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameModeratorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameModeratorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GameModerator nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameModeratorMutation) ResetField(name string) error {
+	switch name {
+	case gamemoderator.FieldGameID:
+		m.ResetGameID()
+		return nil
+	case gamemoderator.FieldModeratorID:
+		m.ResetModeratorID()
+		return nil
+	case gamemoderator.FieldPermissions:
+		m.ResetPermissions()
+		return nil
+	case gamemoderator.FieldAddedBy:
+		m.ResetAddedBy()
+		return nil
+	case gamemoderator.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GameModerator field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameModeratorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.game != nil {
+		edges = append(edges, gamemoderator.EdgeGame)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameModeratorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gamemoderator.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameModeratorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameModeratorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameModeratorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgame {
+		edges = append(edges, gamemoderator.EdgeGame)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameModeratorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gamemoderator.EdgeGame:
+		return m.clearedgame
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameModeratorMutation) ClearEdge(name string) error {
+	switch name {
+	case gamemoderator.EdgeGame:
+		m.ClearGame()
+		return nil
+	}
+	return fmt.Errorf("unknown GameModerator unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameModeratorMutation) ResetEdge(name string) error {
+	switch name {
+	case gamemoderator.EdgeGame:
+		m.ResetGame()
+		return nil
+	}
+	return fmt.Errorf("unknown GameModerator edge %s", name)
+}
+
+// GameRoleMutation represents an operation that mutates the GameRole nodes in the graph.
+type GameRoleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	assigned_at   *time.Time
+	clearedFields map[string]struct{}
+	game          *string
+	clearedgame   bool
+	player        *uuid.UUID
+	clearedplayer bool
+	role          *uuid.UUID
+	clearedrole   bool
+	done          bool
+	oldValue      func(context.Context) (*GameRole, error)
+	predicates    []predicate.GameRole
+}
+
+var _ ent.Mutation = (*GameRoleMutation)(nil)
+
+// gameroleOption allows management of the mutation configuration using functional options.
+type gameroleOption func(*GameRoleMutation)
+
+// newGameRoleMutation creates new mutation for the GameRole entity.
+func newGameRoleMutation(c config, op Op, opts ...gameroleOption) *GameRoleMutation {
+	m := &GameRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGameRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGameRoleID sets the ID field of the mutation.
+func withGameRoleID(id int) gameroleOption {
+	return func(m *GameRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GameRole
+		)
+		m.oldValue = func(ctx context.Context) (*GameRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GameRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGameRole sets the old GameRole of the mutation.
+func withGameRole(node *GameRole) gameroleOption {
+	return func(m *GameRoleMutation) {
+		m.oldValue = func(context.Context) (*GameRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GameRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GameRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GameRoleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GameRoleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GameRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGameID sets the "game_id" field.
+func (m *GameRoleMutation) SetGameID(s string) {
+	m.game = &s
+}
+
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *GameRoleMutation) GameID() (r string, exists bool) {
+	v := m.game
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGameID returns the old "game_id" field's value of the GameRole entity.
+// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameRoleMutation) OldGameID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+	}
+	return oldValue.GameID, nil
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *GameRoleMutation) ResetGameID() {
+	m.game = nil
+}
+
+// SetPlayerID sets the "player_id" field.
+func (m *GameRoleMutation) SetPlayerID(u uuid.UUID) {
+	m.player = &u
+}
+
+// PlayerID returns the value of the "player_id" field in the mutation.
+func (m *GameRoleMutation) PlayerID() (r uuid.UUID, exists bool) {
+	v := m.player
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerID returns the old "player_id" field's value of the GameRole entity.
+// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameRoleMutation) OldPlayerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
+	}
+	return oldValue.PlayerID, nil
+}
+
+// ResetPlayerID resets all changes to the "player_id" field.
+func (m *GameRoleMutation) ResetPlayerID() {
+	m.player = nil
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *GameRoleMutation) SetRoleID(u uuid.UUID) {
+	m.role = &u
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *GameRoleMutation) RoleID() (r uuid.UUID, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the GameRole entity.
+// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameRoleMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *GameRoleMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *GameRoleMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *GameRoleMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedAt returns the old "assigned_at" field's value of the GameRole entity.
+// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameRoleMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	}
+	return oldValue.AssignedAt, nil
+}
+
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *GameRoleMutation) ResetAssignedAt() {
+	m.assigned_at = nil
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *GameRoleMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[gamerole.FieldGameID] = struct{}{}
+}
+
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *GameRoleMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *GameRoleMutation) GameIDs() (ids []string) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *GameRoleMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *GameRoleMutation) ClearPlayer() {
+	m.clearedplayer = true
+	m.clearedFields[gamerole.FieldPlayerID] = struct{}{}
+}
+
+// PlayerCleared reports if the "player" edge to the Player entity was cleared.
+func (m *GameRoleMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *GameRoleMutation) PlayerIDs() (ids []uuid.UUID) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlayer resets all changes to the "player" edge.
+func (m *GameRoleMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (m *GameRoleMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[gamerole.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Role entity was cleared.
+func (m *GameRoleMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *GameRoleMutation) RoleIDs() (ids []uuid.UUID) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *GameRoleMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// Where appends a list predicates to the GameRoleMutation builder.
+func (m *GameRoleMutation) Where(ps ...predicate.GameRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GameRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GameRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GameRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GameRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GameRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GameRole).
+func (m *GameRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameRoleMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.game != nil {
+		fields = append(fields, gamerole.FieldGameID)
+	}
+	if m.player != nil {
+		fields = append(fields, gamerole.FieldPlayerID)
+	}
+	if m.role != nil {
+		fields = append(fields, gamerole.FieldRoleID)
+	}
+	if m.assigned_at != nil {
+		fields = append(fields, gamerole.FieldAssignedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gamerole.FieldGameID:
+		return m.GameID()
+	case gamerole.FieldPlayerID:
+		return m.PlayerID()
+	case gamerole.FieldRoleID:
+		return m.RoleID()
+	case gamerole.FieldAssignedAt:
+		return m.AssignedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gamerole.FieldGameID:
+		return m.OldGameID(ctx)
+	case gamerole.FieldPlayerID:
+		return m.OldPlayerID(ctx)
+	case gamerole.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case gamerole.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GameRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gamerole.FieldGameID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameID(v)
+		return nil
+	case gamerole.FieldPlayerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerID(v)
+		return nil
+	case gamerole.FieldRoleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case gamerole.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GameRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameRoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameRoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GameRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameRoleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameRoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GameRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameRoleMutation) ResetField(name string) error {
+	switch name {
+	case gamerole.FieldGameID:
+		m.ResetGameID()
+		return nil
+	case gamerole.FieldPlayerID:
+		m.ResetPlayerID()
+		return nil
+	case gamerole.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case gamerole.FieldAssignedAt:
+		m.ResetAssignedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GameRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.game != nil {
+		edges = append(edges, gamerole.EdgeGame)
+	}
+	if m.player != nil {
+		edges = append(edges, gamerole.EdgePlayer)
+	}
+	if m.role != nil {
+		edges = append(edges, gamerole.EdgeRole)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameRoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gamerole.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	case gamerole.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	case gamerole.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameRoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedgame {
+		edges = append(edges, gamerole.EdgeGame)
+	}
+	if m.clearedplayer {
+		edges = append(edges, gamerole.EdgePlayer)
+	}
+	if m.clearedrole {
+		edges = append(edges, gamerole.EdgeRole)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameRoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gamerole.EdgeGame:
+		return m.clearedgame
+	case gamerole.EdgePlayer:
+		return m.clearedplayer
+	case gamerole.EdgeRole:
+		return m.clearedrole
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameRoleMutation) ClearEdge(name string) error {
+	switch name {
+	case gamerole.EdgeGame:
+		m.ClearGame()
+		return nil
+	case gamerole.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	case gamerole.EdgeRole:
+		m.ClearRole()
+		return nil
+	}
+	return fmt.Errorf("unknown GameRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameRoleMutation) ResetEdge(name string) error {
+	switch name {
+	case gamerole.EdgeGame:
+		m.ResetGame()
+		return nil
+	case gamerole.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	case gamerole.EdgeRole:
+		m.ResetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown GameRole edge %s", name)
+}
+
+// ModeratorActionMutation represents an operation that mutates the ModeratorAction nodes in the graph.
+type ModeratorActionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	game_id       *string
+	moderator_id  *string
+	action        *string
+	details       *map[string]interface{}
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ModeratorAction, error)
+	predicates    []predicate.ModeratorAction
+}
+
+var _ ent.Mutation = (*ModeratorActionMutation)(nil)
+
+// moderatoractionOption allows management of the mutation configuration using functional options.
+type moderatoractionOption func(*ModeratorActionMutation)
+
+// newModeratorActionMutation creates new mutation for the ModeratorAction entity.
+func newModeratorActionMutation(c config, op Op, opts ...moderatoractionOption) *ModeratorActionMutation {
+	m := &ModeratorActionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModeratorAction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModeratorActionID sets the ID field of the mutation.
+func withModeratorActionID(id uuid.UUID) moderatoractionOption {
+	return func(m *ModeratorActionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModeratorAction
+		)
+		m.oldValue = func(ctx context.Context) (*ModeratorAction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModeratorAction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModeratorAction sets the old ModeratorAction of the mutation.
+func withModeratorAction(node *ModeratorAction) moderatoractionOption {
+	return func(m *ModeratorActionMutation) {
+		m.oldValue = func(context.Context) (*ModeratorAction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModeratorActionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModeratorActionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModeratorAction entities.
+func (m *ModeratorActionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModeratorActionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModeratorActionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModeratorAction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGameID sets the "game_id" field.
+func (m *ModeratorActionMutation) SetGameID(s string) {
+	m.game_id = &s
+}
+
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *ModeratorActionMutation) GameID() (r string, exists bool) {
+	v := m.game_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGameID returns the old "game_id" field's value of the ModeratorAction entity.
+// If the ModeratorAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModeratorActionMutation) OldGameID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+	}
+	return oldValue.GameID, nil
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *ModeratorActionMutation) ResetGameID() {
+	m.game_id = nil
+}
+
+// SetModeratorID sets the "moderator_id" field.
+func (m *ModeratorActionMutation) SetModeratorID(s string) {
+	m.moderator_id = &s
+}
+
+// ModeratorID returns the value of the "moderator_id" field in the mutation.
+func (m *ModeratorActionMutation) ModeratorID() (r string, exists bool) {
+	v := m.moderator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModeratorID returns the old "moderator_id" field's value of the ModeratorAction entity.
+// If the ModeratorAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModeratorActionMutation) OldModeratorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModeratorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModeratorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModeratorID: %w", err)
+	}
+	return oldValue.ModeratorID, nil
+}
+
+// ResetModeratorID resets all changes to the "moderator_id" field.
+func (m *ModeratorActionMutation) ResetModeratorID() {
+	m.moderator_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ModeratorActionMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ModeratorActionMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ModeratorAction entity.
+// If the ModeratorAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModeratorActionMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ModeratorActionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetDetails sets the "details" field.
+func (m *ModeratorActionMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *ModeratorActionMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the ModeratorAction entity.
+// If the ModeratorAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModeratorActionMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *ModeratorActionMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[moderatoraction.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *ModeratorActionMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[moderatoraction.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *ModeratorActionMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, moderatoraction.FieldDetails)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModeratorActionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModeratorActionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModeratorAction entity.
+// If the ModeratorAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModeratorActionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModeratorActionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the ModeratorActionMutation builder.
+func (m *ModeratorActionMutation) Where(ps ...predicate.ModeratorAction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModeratorActionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModeratorActionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModeratorAction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModeratorActionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModeratorActionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModeratorAction).
+func (m *ModeratorActionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModeratorActionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.game_id != nil {
+		fields = append(fields, moderatoraction.FieldGameID)
+	}
+	if m.moderator_id != nil {
+		fields = append(fields, moderatoraction.FieldModeratorID)
+	}
+	if m.action != nil {
+		fields = append(fields, moderatoraction.FieldAction)
+	}
+	if m.details != nil {
+		fields = append(fields, moderatoraction.FieldDetails)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moderatoraction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModeratorActionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moderatoraction.FieldGameID:
+		return m.GameID()
+	case moderatoraction.FieldModeratorID:
+		return m.ModeratorID()
+	case moderatoraction.FieldAction:
+		return m.Action()
+	case moderatoraction.FieldDetails:
+		return m.Details()
+	case moderatoraction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModeratorActionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moderatoraction.FieldGameID:
+		return m.OldGameID(ctx)
+	case moderatoraction.FieldModeratorID:
+		return m.OldModeratorID(ctx)
+	case moderatoraction.FieldAction:
+		return m.OldAction(ctx)
+	case moderatoraction.FieldDetails:
+		return m.OldDetails(ctx)
+	case moderatoraction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModeratorAction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModeratorActionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moderatoraction.FieldGameID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameID(v)
+		return nil
+	case moderatoraction.FieldModeratorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModeratorID(v)
+		return nil
+	case moderatoraction.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case moderatoraction.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case moderatoraction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModeratorAction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModeratorActionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModeratorActionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModeratorActionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModeratorAction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModeratorActionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moderatoraction.FieldDetails) {
+		fields = append(fields, moderatoraction.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModeratorActionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModeratorActionMutation) ClearField(name string) error {
+	switch name {
+	case moderatoraction.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown ModeratorAction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModeratorActionMutation) ResetField(name string) error {
+	switch name {
+	case moderatoraction.FieldGameID:
+		m.ResetGameID()
+		return nil
+	case moderatoraction.FieldModeratorID:
+		m.ResetModeratorID()
+		return nil
+	case moderatoraction.FieldAction:
+		m.ResetAction()
+		return nil
+	case moderatoraction.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case moderatoraction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModeratorAction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModeratorActionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModeratorActionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModeratorActionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModeratorActionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModeratorActionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModeratorActionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModeratorActionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ModeratorAction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModeratorActionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ModeratorAction edge %s", name)
+}
+
+// NightActionMutation represents an operation that mutates the NightAction nodes in the graph.
+type NightActionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	round         *int
+	addround      *int
+	player_id     *uuid.UUID
+	target_id     *uuid.UUID
+	action        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	game          *string
+	clearedgame   bool
+	done          bool
+	oldValue      func(context.Context) (*NightAction, error)
+	predicates    []predicate.NightAction
+}
+
+var _ ent.Mutation = (*NightActionMutation)(nil)
+
+// nightactionOption allows management of the mutation configuration using functional options.
+type nightactionOption func(*NightActionMutation)
+
+// newNightActionMutation creates new mutation for the NightAction entity.
+func newNightActionMutation(c config, op Op, opts ...nightactionOption) *NightActionMutation {
+	m := &NightActionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNightAction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNightActionID sets the ID field of the mutation.
+func withNightActionID(id uuid.UUID) nightactionOption {
+	return func(m *NightActionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NightAction
+		)
+		m.oldValue = func(ctx context.Context) (*NightAction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NightAction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNightAction sets the old NightAction of the mutation.
+func withNightAction(node *NightAction) nightactionOption {
+	return func(m *NightActionMutation) {
+		m.oldValue = func(context.Context) (*NightAction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NightActionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NightActionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NightAction entities.
+func (m *NightActionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NightActionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NightActionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NightAction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGameID sets the "game_id" field.
+func (m *NightActionMutation) SetGameID(s string) {
+	m.game = &s
+}
+
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *NightActionMutation) GameID() (r string, exists bool) {
+	v := m.game
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGameID returns the old "game_id" field's value of the NightAction entity.
+// If the NightAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NightActionMutation) OldGameID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+	}
+	return oldValue.GameID, nil
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *NightActionMutation) ResetGameID() {
+	m.game = nil
+}
+
+// SetRound sets the "round" field.
+func (m *NightActionMutation) SetRound(i int) {
+	m.round = &i
+	m.addround = nil
+}
+
+// Round returns the value of the "round" field in the mutation.
+func (m *NightActionMutation) Round() (r int, exists bool) {
+	v := m.round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRound returns the old "round" field's value of the NightAction entity.
+// If the NightAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NightActionMutation) OldRound(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRound: %w", err)
+	}
+	return oldValue.Round, nil
+}
+
+// AddRound adds i to the "round" field.
+func (m *NightActionMutation) AddRound(i int) {
+	if m.addround != nil {
+		*m.addround += i
+	} else {
+		m.addround = &i
+	}
+}
+
+// AddedRound returns the value that was added to the "round" field in this mutation.
+func (m *NightActionMutation) AddedRound() (r int, exists bool) {
+	v := m.addround
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRound resets all changes to the "round" field.
+func (m *NightActionMutation) ResetRound() {
+	m.round = nil
+	m.addround = nil
+}
+
+// SetPlayerID sets the "player_id" field.
+func (m *NightActionMutation) SetPlayerID(u uuid.UUID) {
+	m.player_id = &u
+}
+
+// PlayerID returns the value of the "player_id" field in the mutation.
+func (m *NightActionMutation) PlayerID() (r uuid.UUID, exists bool) {
+	v := m.player_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerID returns the old "player_id" field's value of the NightAction entity.
+// If the NightAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NightActionMutation) OldPlayerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
+	}
+	return oldValue.PlayerID, nil
+}
+
+// ResetPlayerID resets all changes to the "player_id" field.
+func (m *NightActionMutation) ResetPlayerID() {
+	m.player_id = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *NightActionMutation) SetTargetID(u uuid.UUID) {
+	m.target_id = &u
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *NightActionMutation) TargetID() (r uuid.UUID, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the NightAction entity.
+// If the NightAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NightActionMutation) OldTargetID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *NightActionMutation) ResetTargetID() {
+	m.target_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *NightActionMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *NightActionMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the NightAction entity.
+// If the NightAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NightActionMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ClearAction clears the value of the "action" field.
+func (m *NightActionMutation) ClearAction() {
+	m.action = nil
+	m.clearedFields[nightaction.FieldAction] = struct{}{}
+}
+
+// ActionCleared returns if the "action" field was cleared in this mutation.
+func (m *NightActionMutation) ActionCleared() bool {
+	_, ok := m.clearedFields[nightaction.FieldAction]
+	return ok
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *NightActionMutation) ResetAction() {
+	m.action = nil
+	delete(m.clearedFields, nightaction.FieldAction)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NightActionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NightActionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NightAction entity.
+// If the NightAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NightActionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NightActionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *NightActionMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[nightaction.FieldGameID] = struct{}{}
+}
+
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *NightActionMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *NightActionMutation) GameIDs() (ids []string) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *NightActionMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// Where appends a list predicates to the NightActionMutation builder.
+func (m *NightActionMutation) Where(ps ...predicate.NightAction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NightActionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NightActionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NightAction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NightActionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NightActionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NightAction).
+func (m *NightActionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NightActionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.game != nil {
+		fields = append(fields, nightaction.FieldGameID)
+	}
+	if m.round != nil {
+		fields = append(fields, nightaction.FieldRound)
+	}
+	if m.player_id != nil {
+		fields = append(fields, nightaction.FieldPlayerID)
+	}
+	if m.target_id != nil {
+		fields = append(fields, nightaction.FieldTargetID)
+	}
+	if m.action != nil {
+		fields = append(fields, nightaction.FieldAction)
+	}
+	if m.created_at != nil {
+		fields = append(fields, nightaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NightActionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nightaction.FieldGameID:
+		return m.GameID()
+	case nightaction.FieldRound:
+		return m.Round()
+	case nightaction.FieldPlayerID:
+		return m.PlayerID()
+	case nightaction.FieldTargetID:
+		return m.TargetID()
+	case nightaction.FieldAction:
+		return m.Action()
+	case nightaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NightActionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nightaction.FieldGameID:
+		return m.OldGameID(ctx)
+	case nightaction.FieldRound:
+		return m.OldRound(ctx)
+	case nightaction.FieldPlayerID:
+		return m.OldPlayerID(ctx)
+	case nightaction.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case nightaction.FieldAction:
+		return m.OldAction(ctx)
+	case nightaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NightAction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NightActionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nightaction.FieldGameID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameID(v)
+		return nil
+	case nightaction.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRound(v)
+		return nil
+	case nightaction.FieldPlayerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerID(v)
+		return nil
+	case nightaction.FieldTargetID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case nightaction.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case nightaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NightAction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NightActionMutation) AddedFields() []string {
+	var fields []string
+	if m.addround != nil {
+		fields = append(fields, nightaction.FieldRound)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NightActionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nightaction.FieldRound:
+		return m.AddedRound()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NightActionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case nightaction.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRound(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NightAction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NightActionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nightaction.FieldAction) {
+		fields = append(fields, nightaction.FieldAction)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NightActionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NightActionMutation) ClearField(name string) error {
+	switch name {
+	case nightaction.FieldAction:
+		m.ClearAction()
+		return nil
+	}
+	return fmt.Errorf("unknown NightAction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NightActionMutation) ResetField(name string) error {
+	switch name {
+	case nightaction.FieldGameID:
+		m.ResetGameID()
+		return nil
+	case nightaction.FieldRound:
+		m.ResetRound()
+		return nil
+	case nightaction.FieldPlayerID:
+		m.ResetPlayerID()
+		return nil
+	case nightaction.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case nightaction.FieldAction:
+		m.ResetAction()
+		return nil
+	case nightaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NightAction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NightActionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.game != nil {
+		edges = append(edges, nightaction.EdgeGame)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NightActionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case nightaction.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NightActionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NightActionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NightActionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgame {
+		edges = append(edges, nightaction.EdgeGame)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NightActionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case nightaction.EdgeGame:
+		return m.clearedgame
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NightActionMutation) ClearEdge(name string) error {
+	switch name {
+	case nightaction.EdgeGame:
+		m.ClearGame()
+		return nil
+	}
+	return fmt.Errorf("unknown NightAction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NightActionMutation) ResetEdge(name string) error {
+	switch name {
+	case nightaction.EdgeGame:
+		m.ResetGame()
+		return nil
+	}
+	return fmt.Errorf("unknown NightAction edge %s", name)
+}
+
+// PlayerMutation represents an operation that mutates the Player nodes in the graph.
+type PlayerMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	seat             *int
+	addseat          *int
+	alive            *bool
+	ready            *bool
+	muted            *bool
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	game             *string
+	clearedgame      bool
+	game_role        *int
+	clearedgame_role bool
+	done             bool
+	oldValue         func(context.Context) (*Player, error)
+	predicates       []predicate.Player
+}
+
+var _ ent.Mutation = (*PlayerMutation)(nil)
+
+// playerOption allows management of the mutation configuration using functional options.
+type playerOption func(*PlayerMutation)
+
+// newPlayerMutation creates new mutation for the Player entity.
+func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
+	m := &PlayerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayerID sets the ID field of the mutation.
+func withPlayerID(id uuid.UUID) playerOption {
+	return func(m *PlayerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Player
+		)
+		m.oldValue = func(ctx context.Context) (*Player, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Player.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayer sets the old Player of the mutation.
+func withPlayer(node *Player) playerOption {
+	return func(m *PlayerMutation) {
+		m.oldValue = func(context.Context) (*Player, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Player entities.
+func (m *PlayerMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlayerMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlayerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PlayerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlayerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlayerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetGameID sets the "game_id" field.
+func (m *PlayerMutation) SetGameID(s string) {
+	m.game = &s
+}
+
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *PlayerMutation) GameID() (r string, exists bool) {
+	v := m.game
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGameID returns the old "game_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldGameID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+	}
+	return oldValue.GameID, nil
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *PlayerMutation) ResetGameID() {
+	m.game = nil
+}
+
+// SetSeat sets the "seat" field.
+func (m *PlayerMutation) SetSeat(i int) {
+	m.seat = &i
+	m.addseat = nil
+}
+
+// Seat returns the value of the "seat" field in the mutation.
+func (m *PlayerMutation) Seat() (r int, exists bool) {
+	v := m.seat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeat returns the old "seat" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldSeat(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeat: %w", err)
+	}
+	return oldValue.Seat, nil
+}
+
+// AddSeat adds i to the "seat" field.
+func (m *PlayerMutation) AddSeat(i int) {
+	if m.addseat != nil {
+		*m.addseat += i
+	} else {
+		m.addseat = &i
+	}
+}
+
+// AddedSeat returns the value that was added to the "seat" field in this mutation.
+func (m *PlayerMutation) AddedSeat() (r int, exists bool) {
+	v := m.addseat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeat clears the value of the "seat" field.
+func (m *PlayerMutation) ClearSeat() {
+	m.seat = nil
+	m.addseat = nil
+	m.clearedFields[player.FieldSeat] = struct{}{}
+}
+
+// SeatCleared returns if the "seat" field was cleared in this mutation.
+func (m *PlayerMutation) SeatCleared() bool {
+	_, ok := m.clearedFields[player.FieldSeat]
+	return ok
+}
+
+// ResetSeat resets all changes to the "seat" field.
+func (m *PlayerMutation) ResetSeat() {
+	m.seat = nil
+	m.addseat = nil
+	delete(m.clearedFields, player.FieldSeat)
+}
+
+// SetAlive sets the "alive" field.
+func (m *PlayerMutation) SetAlive(b bool) {
+	m.alive = &b
+}
+
+// Alive returns the value of the "alive" field in the mutation.
+func (m *PlayerMutation) Alive() (r bool, exists bool) {
+	v := m.alive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlive returns the old "alive" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldAlive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlive: %w", err)
+	}
+	return oldValue.Alive, nil
+}
+
+// ResetAlive resets all changes to the "alive" field.
+func (m *PlayerMutation) ResetAlive() {
+	m.alive = nil
+}
+
+// SetReady sets the "ready" field.
+func (m *PlayerMutation) SetReady(b bool) {
+	m.ready = &b
+}
+
+// Ready returns the value of the "ready" field in the mutation.
+func (m *PlayerMutation) Ready() (r bool, exists bool) {
+	v := m.ready
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReady returns the old "ready" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldReady(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReady is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReady requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReady: %w", err)
+	}
+	return oldValue.Ready, nil
+}
+
+// ResetReady resets all changes to the "ready" field.
+func (m *PlayerMutation) ResetReady() {
+	m.ready = nil
+}
+
+// SetMuted sets the "muted" field.
+func (m *PlayerMutation) SetMuted(b bool) {
+	m.muted = &b
+}
+
+// Muted returns the value of the "muted" field in the mutation.
+func (m *PlayerMutation) Muted() (r bool, exists bool) {
+	v := m.muted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMuted returns the old "muted" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldMuted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMuted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMuted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMuted: %w", err)
+	}
+	return oldValue.Muted, nil
+}
+
+// ResetMuted resets all changes to the "muted" field.
+func (m *PlayerMutation) ResetMuted() {
+	m.muted = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlayerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlayerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlayerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *PlayerMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[player.FieldGameID] = struct{}{}
+}
+
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *PlayerMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) GameIDs() (ids []string) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *PlayerMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// SetGameRoleID sets the "game_role" edge to the GameRole entity by id.
+func (m *PlayerMutation) SetGameRoleID(id int) {
+	m.game_role = &id
+}
+
+// ClearGameRole clears the "game_role" edge to the GameRole entity.
+func (m *PlayerMutation) ClearGameRole() {
+	m.clearedgame_role = true
+}
+
+// GameRoleCleared reports if the "game_role" edge to the GameRole entity was cleared.
+func (m *PlayerMutation) GameRoleCleared() bool {
+	return m.clearedgame_role
+}
+
+// GameRoleID returns the "game_role" edge ID in the mutation.
+func (m *PlayerMutation) GameRoleID() (id int, exists bool) {
+	if m.game_role != nil {
+		return *m.game_role, true
+	}
+	return
+}
+
+// GameRoleIDs returns the "game_role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameRoleID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) GameRoleIDs() (ids []int) {
+	if id := m.game_role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGameRole resets all changes to the "game_role" edge.
+func (m *PlayerMutation) ResetGameRole() {
+	m.game_role = nil
+	m.clearedgame_role = false
+}
+
+// Where appends a list predicates to the PlayerMutation builder.
+func (m *PlayerMutation) Where(ps ...predicate.Player) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Player, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlayerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlayerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Player).
+func (m *PlayerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, player.FieldName)
+	}
+	if m.game != nil {
+		fields = append(fields, player.FieldGameID)
+	}
+	if m.seat != nil {
+		fields = append(fields, player.FieldSeat)
+	}
+	if m.alive != nil {
+		fields = append(fields, player.FieldAlive)
+	}
+	if m.ready != nil {
+		fields = append(fields, player.FieldReady)
+	}
+	if m.muted != nil {
+		fields = append(fields, player.FieldMuted)
+	}
+	if m.created_at != nil {
+		fields = append(fields, player.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldName:
+		return m.Name()
+	case player.FieldGameID:
+		return m.GameID()
+	case player.FieldSeat:
+		return m.Seat()
+	case player.FieldAlive:
+		return m.Alive()
+	case player.FieldReady:
+		return m.Ready()
+	case player.FieldMuted:
+		return m.Muted()
+	case player.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case player.FieldName:
+		return m.OldName(ctx)
+	case player.FieldGameID:
+		return m.OldGameID(ctx)
+	case player.FieldSeat:
+		return m.OldSeat(ctx)
+	case player.FieldAlive:
+		return m.OldAlive(ctx)
+	case player.FieldReady:
+		return m.OldReady(ctx)
+	case player.FieldMuted:
+		return m.OldMuted(ctx)
+	case player.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Player field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case player.FieldGameID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameID(v)
+		return nil
+	case player.FieldSeat:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeat(v)
+		return nil
+	case player.FieldAlive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlive(v)
+		return nil
+	case player.FieldReady:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReady(v)
+		return nil
+	case player.FieldMuted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMuted(v)
+		return nil
+	case player.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerMutation) AddedFields() []string {
+	var fields []string
+	if m.addseat != nil {
+		fields = append(fields, player.FieldSeat)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldSeat:
+		return m.AddedSeat()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldSeat:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeat(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(player.FieldSeat) {
+		fields = append(fields, player.FieldSeat)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerMutation) ClearField(name string) error {
+	switch name {
+	case player.FieldSeat:
+		m.ClearSeat()
+		return nil
+	}
+	return fmt.Errorf("unknown Player nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerMutation) ResetField(name string) error {
+	switch name {
+	case player.FieldName:
+		m.ResetName()
+		return nil
+	case player.FieldGameID:
+		m.ResetGameID()
+		return nil
+	case player.FieldSeat:
+		m.ResetSeat()
+		return nil
+	case player.FieldAlive:
+		m.ResetAlive()
+		return nil
+	case player.FieldReady:
+		m.ResetReady()
+		return nil
+	case player.FieldMuted:
+		m.ResetMuted()
+		return nil
+	case player.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.game != nil {
+		edges = append(edges, player.EdgeGame)
+	}
+	if m.game_role != nil {
+		edges = append(edges, player.EdgeGameRole)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	case player.EdgeGameRole:
+		if id := m.game_role; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgame {
+		edges = append(edges, player.EdgeGame)
+	}
+	if m.clearedgame_role {
+		edges = append(edges, player.EdgeGameRole)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case player.EdgeGame:
+		return m.clearedgame
+	case player.EdgeGameRole:
+		return m.clearedgame_role
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerMutation) ClearEdge(name string) error {
+	switch name {
+	case player.EdgeGame:
+		m.ClearGame()
+		return nil
+	case player.EdgeGameRole:
+		m.ClearGameRole()
+		return nil
+	}
+	return fmt.Errorf("unknown Player unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerMutation) ResetEdge(name string) error {
+	switch name {
+	case player.EdgeGame:
+		m.ResetGame()
+		return nil
+	case player.EdgeGameRole:
+		m.ResetGameRole()
+		return nil
+	}
+	return fmt.Errorf("unknown Player edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	name                     *string
+	slug                     *string
+	video                    *string
+	image                    *string
+	team                     *role.Team
+	description              *string
+	abilities                *[]string
+	appendabilities          []string
+	archived_at              *time.Time
+	clearedFields            map[string]struct{}
+	game_roles               map[int]struct{}
+	removedgame_roles        map[int]struct{}
+	clearedgame_roles        bool
+	template_roles           map[int]struct{}
+	removedtemplate_roles    map[int]struct{}
+	clearedtemplate_roles    bool
+	template_slots           map[uuid.UUID]struct{}
+	removedtemplate_slots    map[uuid.UUID]struct{}
+	clearedtemplate_slots    bool
+	translations             map[uuid.UUID]struct{}
+	removedtranslations      map[uuid.UUID]struct{}
+	clearedtranslations      bool
+	rules                    map[uuid.UUID]struct{}
+	removedrules             map[uuid.UUID]struct{}
+	clearedrules             bool
+	targeted_by_rules        map[uuid.UUID]struct{}
+	removedtargeted_by_rules map[uuid.UUID]struct{}
+	clearedtargeted_by_rules bool
+	done                     bool
+	oldValue                 func(context.Context) (*Role, error)
+	predicates               []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id uuid.UUID) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Role entities.
+func (m *RoleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *RoleMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *RoleMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *RoleMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetVideo sets the "video" field.
+func (m *RoleMutation) SetVideo(s string) {
+	m.video = &s
+}
+
+// Video returns the value of the "video" field in the mutation.
+func (m *RoleMutation) Video() (r string, exists bool) {
+	v := m.video
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVideo returns the old "video" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldVideo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVideo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVideo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVideo: %w", err)
+	}
+	return oldValue.Video, nil
+}
+
+// ResetVideo resets all changes to the "video" field.
+func (m *RoleMutation) ResetVideo() {
+	m.video = nil
+}
+
+// SetImage sets the "image" field.
+func (m *RoleMutation) SetImage(s string) {
+	m.image = &s
+}
+
+// Image returns the value of the "image" field in the mutation.
+func (m *RoleMutation) Image() (r string, exists bool) {
+	v := m.image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImage returns the old "image" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+	}
+	return oldValue.Image, nil
+}
+
+// ClearImage clears the value of the "image" field.
+func (m *RoleMutation) ClearImage() {
+	m.image = nil
+	m.clearedFields[role.FieldImage] = struct{}{}
+}
+
+// ImageCleared returns if the "image" field was cleared in this mutation.
+func (m *RoleMutation) ImageCleared() bool {
+	_, ok := m.clearedFields[role.FieldImage]
+	return ok
+}
+
+// ResetImage resets all changes to the "image" field.
+func (m *RoleMutation) ResetImage() {
+	m.image = nil
+	delete(m.clearedFields, role.FieldImage)
+}
+
+// SetTeam sets the "team" field.
+func (m *RoleMutation) SetTeam(r role.Team) {
+	m.team = &r
+}
+
+// Team returns the value of the "team" field in the mutation.
+func (m *RoleMutation) Team() (r role.Team, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeam returns the old "team" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldTeam(ctx context.Context) (v role.Team, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
+}
+
+// ResetTeam resets all changes to the "team" field.
+func (m *RoleMutation) ResetTeam() {
+	m.team = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RoleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[role.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[role.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, role.FieldDescription)
+}
+
+// SetAbilities sets the "abilities" field.
+func (m *RoleMutation) SetAbilities(s []string) {
+	m.abilities = &s
+	m.appendabilities = nil
+}
+
+// Abilities returns the value of the "abilities" field in the mutation.
+func (m *RoleMutation) Abilities() (r []string, exists bool) {
+	v := m.abilities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbilities returns the old "abilities" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldAbilities(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbilities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbilities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbilities: %w", err)
+	}
+	return oldValue.Abilities, nil
+}
+
+// AppendAbilities adds s to the "abilities" field.
+func (m *RoleMutation) AppendAbilities(s []string) {
+	m.appendabilities = append(m.appendabilities, s...)
+}
+
+// AppendedAbilities returns the list of values that were appended to the "abilities" field in this mutation.
+func (m *RoleMutation) AppendedAbilities() ([]string, bool) {
+	if len(m.appendabilities) == 0 {
+		return nil, false
+	}
+	return m.appendabilities, true
+}
+
+// ClearAbilities clears the value of the "abilities" field.
+func (m *RoleMutation) ClearAbilities() {
+	m.abilities = nil
+	m.appendabilities = nil
+	m.clearedFields[role.FieldAbilities] = struct{}{}
+}
+
+// AbilitiesCleared returns if the "abilities" field was cleared in this mutation.
+func (m *RoleMutation) AbilitiesCleared() bool {
+	_, ok := m.clearedFields[role.FieldAbilities]
+	return ok
+}
+
+// ResetAbilities resets all changes to the "abilities" field.
+func (m *RoleMutation) ResetAbilities() {
+	m.abilities = nil
+	m.appendabilities = nil
+	delete(m.clearedFields, role.FieldAbilities)
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *RoleMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *RoleMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *RoleMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[role.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *RoleMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[role.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *RoleMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, role.FieldArchivedAt)
+}
+
+// AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by ids.
+func (m *RoleMutation) AddGameRoleIDs(ids ...int) {
+	if m.game_roles == nil {
+		m.game_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.game_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGameRoles clears the "game_roles" edge to the GameRole entity.
+func (m *RoleMutation) ClearGameRoles() {
+	m.clearedgame_roles = true
+}
+
+// GameRolesCleared reports if the "game_roles" edge to the GameRole entity was cleared.
+func (m *RoleMutation) GameRolesCleared() bool {
+	return m.clearedgame_roles
+}
+
+// RemoveGameRoleIDs removes the "game_roles" edge to the GameRole entity by IDs.
+func (m *RoleMutation) RemoveGameRoleIDs(ids ...int) {
+	if m.removedgame_roles == nil {
+		m.removedgame_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.game_roles, ids[i])
+		m.removedgame_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGameRoles returns the removed IDs of the "game_roles" edge to the GameRole entity.
+func (m *RoleMutation) RemovedGameRolesIDs() (ids []int) {
+	for id := range m.removedgame_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GameRolesIDs returns the "game_roles" edge IDs in the mutation.
+func (m *RoleMutation) GameRolesIDs() (ids []int) {
+	for id := range m.game_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGameRoles resets all changes to the "game_roles" edge.
+func (m *RoleMutation) ResetGameRoles() {
+	m.game_roles = nil
+	m.clearedgame_roles = false
+	m.removedgame_roles = nil
+}
+
+// AddTemplateRoleIDs adds the "template_roles" edge to the RoleTemplateRole entity by ids.
+func (m *RoleMutation) AddTemplateRoleIDs(ids ...int) {
+	if m.template_roles == nil {
+		m.template_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.template_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTemplateRoles clears the "template_roles" edge to the RoleTemplateRole entity.
+func (m *RoleMutation) ClearTemplateRoles() {
+	m.clearedtemplate_roles = true
+}
+
+// TemplateRolesCleared reports if the "template_roles" edge to the RoleTemplateRole entity was cleared.
+func (m *RoleMutation) TemplateRolesCleared() bool {
+	return m.clearedtemplate_roles
+}
+
+// RemoveTemplateRoleIDs removes the "template_roles" edge to the RoleTemplateRole entity by IDs.
+func (m *RoleMutation) RemoveTemplateRoleIDs(ids ...int) {
+	if m.removedtemplate_roles == nil {
+		m.removedtemplate_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.template_roles, ids[i])
+		m.removedtemplate_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTemplateRoles returns the removed IDs of the "template_roles" edge to the RoleTemplateRole entity.
+func (m *RoleMutation) RemovedTemplateRolesIDs() (ids []int) {
+	for id := range m.removedtemplate_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TemplateRolesIDs returns the "template_roles" edge IDs in the mutation.
+func (m *RoleMutation) TemplateRolesIDs() (ids []int) {
+	for id := range m.template_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTemplateRoles resets all changes to the "template_roles" edge.
+func (m *RoleMutation) ResetTemplateRoles() {
+	m.template_roles = nil
+	m.clearedtemplate_roles = false
+	m.removedtemplate_roles = nil
+}
+
+// AddTemplateSlotIDs adds the "template_slots" edge to the RoleTemplateSlot entity by ids.
+func (m *RoleMutation) AddTemplateSlotIDs(ids ...uuid.UUID) {
+	if m.template_slots == nil {
+		m.template_slots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.template_slots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTemplateSlots clears the "template_slots" edge to the RoleTemplateSlot entity.
+func (m *RoleMutation) ClearTemplateSlots() {
+	m.clearedtemplate_slots = true
+}
+
+// TemplateSlotsCleared reports if the "template_slots" edge to the RoleTemplateSlot entity was cleared.
+func (m *RoleMutation) TemplateSlotsCleared() bool {
+	return m.clearedtemplate_slots
+}
+
+// RemoveTemplateSlotIDs removes the "template_slots" edge to the RoleTemplateSlot entity by IDs.
+func (m *RoleMutation) RemoveTemplateSlotIDs(ids ...uuid.UUID) {
+	if m.removedtemplate_slots == nil {
+		m.removedtemplate_slots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.template_slots, ids[i])
+		m.removedtemplate_slots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTemplateSlots returns the removed IDs of the "template_slots" edge to the RoleTemplateSlot entity.
+func (m *RoleMutation) RemovedTemplateSlotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtemplate_slots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TemplateSlotsIDs returns the "template_slots" edge IDs in the mutation.
+func (m *RoleMutation) TemplateSlotsIDs() (ids []uuid.UUID) {
+	for id := range m.template_slots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTemplateSlots resets all changes to the "template_slots" edge.
+func (m *RoleMutation) ResetTemplateSlots() {
+	m.template_slots = nil
+	m.clearedtemplate_slots = false
+	m.removedtemplate_slots = nil
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTranslation entity by ids.
+func (m *RoleMutation) AddTranslationIDs(ids ...uuid.UUID) {
+	if m.translations == nil {
+		m.translations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.translations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTranslations clears the "translations" edge to the RoleTranslation entity.
+func (m *RoleMutation) ClearTranslations() {
+	m.clearedtranslations = true
+}
+
+// TranslationsCleared reports if the "translations" edge to the RoleTranslation entity was cleared.
+func (m *RoleMutation) TranslationsCleared() bool {
+	return m.clearedtranslations
+}
+
+// RemoveTranslationIDs removes the "translations" edge to the RoleTranslation entity by IDs.
+func (m *RoleMutation) RemoveTranslationIDs(ids ...uuid.UUID) {
+	if m.removedtranslations == nil {
+		m.removedtranslations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.translations, ids[i])
+		m.removedtranslations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTranslations returns the removed IDs of the "translations" edge to the RoleTranslation entity.
+func (m *RoleMutation) RemovedTranslationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtranslations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TranslationsIDs returns the "translations" edge IDs in the mutation.
+func (m *RoleMutation) TranslationsIDs() (ids []uuid.UUID) {
+	for id := range m.translations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTranslations resets all changes to the "translations" edge.
+func (m *RoleMutation) ResetTranslations() {
+	m.translations = nil
+	m.clearedtranslations = false
+	m.removedtranslations = nil
+}
+
+// AddRuleIDs adds the "rules" edge to the RoleRule entity by ids.
+func (m *RoleMutation) AddRuleIDs(ids ...uuid.UUID) {
+	if m.rules == nil {
+		m.rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRules clears the "rules" edge to the RoleRule entity.
+func (m *RoleMutation) ClearRules() {
+	m.clearedrules = true
+}
+
+// RulesCleared reports if the "rules" edge to the RoleRule entity was cleared.
+func (m *RoleMutation) RulesCleared() bool {
+	return m.clearedrules
+}
+
+// RemoveRuleIDs removes the "rules" edge to the RoleRule entity by IDs.
+func (m *RoleMutation) RemoveRuleIDs(ids ...uuid.UUID) {
+	if m.removedrules == nil {
+		m.removedrules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.rules, ids[i])
+		m.removedrules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRules returns the removed IDs of the "rules" edge to the RoleRule entity.
+func (m *RoleMutation) RemovedRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RulesIDs returns the "rules" edge IDs in the mutation.
+func (m *RoleMutation) RulesIDs() (ids []uuid.UUID) {
+	for id := range m.rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRules resets all changes to the "rules" edge.
+func (m *RoleMutation) ResetRules() {
+	m.rules = nil
+	m.clearedrules = false
+	m.removedrules = nil
+}
+
+// AddTargetedByRuleIDs adds the "targeted_by_rules" edge to the RoleRule entity by ids.
+func (m *RoleMutation) AddTargetedByRuleIDs(ids ...uuid.UUID) {
+	if m.targeted_by_rules == nil {
+		m.targeted_by_rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.targeted_by_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTargetedByRules clears the "targeted_by_rules" edge to the RoleRule entity.
+func (m *RoleMutation) ClearTargetedByRules() {
+	m.clearedtargeted_by_rules = true
+}
+
+// TargetedByRulesCleared reports if the "targeted_by_rules" edge to the RoleRule entity was cleared.
+func (m *RoleMutation) TargetedByRulesCleared() bool {
+	return m.clearedtargeted_by_rules
+}
+
+// RemoveTargetedByRuleIDs removes the "targeted_by_rules" edge to the RoleRule entity by IDs.
+func (m *RoleMutation) RemoveTargetedByRuleIDs(ids ...uuid.UUID) {
+	if m.removedtargeted_by_rules == nil {
+		m.removedtargeted_by_rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.targeted_by_rules, ids[i])
+		m.removedtargeted_by_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTargetedByRules returns the removed IDs of the "targeted_by_rules" edge to the RoleRule entity.
+func (m *RoleMutation) RemovedTargetedByRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtargeted_by_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TargetedByRulesIDs returns the "targeted_by_rules" edge IDs in the mutation.
+func (m *RoleMutation) TargetedByRulesIDs() (ids []uuid.UUID) {
+	for id := range m.targeted_by_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTargetedByRules resets all changes to the "targeted_by_rules" edge.
+func (m *RoleMutation) ResetTargetedByRules() {
+	m.targeted_by_rules = nil
+	m.clearedtargeted_by_rules = false
+	m.removedtargeted_by_rules = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.slug != nil {
+		fields = append(fields, role.FieldSlug)
+	}
+	if m.video != nil {
+		fields = append(fields, role.FieldVideo)
+	}
+	if m.image != nil {
+		fields = append(fields, role.FieldImage)
+	}
+	if m.team != nil {
+		fields = append(fields, role.FieldTeam)
+	}
+	if m.description != nil {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.abilities != nil {
+		fields = append(fields, role.FieldAbilities)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, role.FieldArchivedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldName:
+		return m.Name()
+	case role.FieldSlug:
+		return m.Slug()
+	case role.FieldVideo:
+		return m.Video()
+	case role.FieldImage:
+		return m.Image()
+	case role.FieldTeam:
+		return m.Team()
+	case role.FieldDescription:
+		return m.Description()
+	case role.FieldAbilities:
+		return m.Abilities()
+	case role.FieldArchivedAt:
+		return m.ArchivedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldSlug:
+		return m.OldSlug(ctx)
+	case role.FieldVideo:
+		return m.OldVideo(ctx)
+	case role.FieldImage:
+		return m.OldImage(ctx)
+	case role.FieldTeam:
+		return m.OldTeam(ctx)
+	case role.FieldDescription:
+		return m.OldDescription(ctx)
+	case role.FieldAbilities:
+		return m.OldAbilities(ctx)
+	case role.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case role.FieldVideo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVideo(v)
+		return nil
+	case role.FieldImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImage(v)
+		return nil
+	case role.FieldTeam:
+		v, ok := value.(role.Team)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeam(v)
+		return nil
+	case role.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case role.FieldAbilities:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbilities(v)
+		return nil
+	case role.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(role.FieldImage) {
+		fields = append(fields, role.FieldImage)
+	}
+	if m.FieldCleared(role.FieldDescription) {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.FieldCleared(role.FieldAbilities) {
+		fields = append(fields, role.FieldAbilities)
+	}
+	if m.FieldCleared(role.FieldArchivedAt) {
+		fields = append(fields, role.FieldArchivedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	switch name {
+	case role.FieldImage:
+		m.ClearImage()
+		return nil
+	case role.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case role.FieldAbilities:
+		m.ClearAbilities()
+		return nil
+	case role.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case role.FieldVideo:
+		m.ResetVideo()
+		return nil
+	case role.FieldImage:
+		m.ResetImage()
+		return nil
+	case role.FieldTeam:
+		m.ResetTeam()
+		return nil
+	case role.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case role.FieldAbilities:
+		m.ResetAbilities()
+		return nil
+	case role.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.game_roles != nil {
+		edges = append(edges, role.EdgeGameRoles)
+	}
+	if m.template_roles != nil {
+		edges = append(edges, role.EdgeTemplateRoles)
+	}
+	if m.template_slots != nil {
+		edges = append(edges, role.EdgeTemplateSlots)
+	}
+	if m.translations != nil {
+		edges = append(edges, role.EdgeTranslations)
+	}
+	if m.rules != nil {
+		edges = append(edges, role.EdgeRules)
+	}
+	if m.targeted_by_rules != nil {
+		edges = append(edges, role.EdgeTargetedByRules)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeGameRoles:
+		ids := make([]ent.Value, 0, len(m.game_roles))
+		for id := range m.game_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTemplateRoles:
+		ids := make([]ent.Value, 0, len(m.template_roles))
+		for id := range m.template_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTemplateSlots:
+		ids := make([]ent.Value, 0, len(m.template_slots))
+		for id := range m.template_slots {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.translations))
+		for id := range m.translations {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.rules))
+		for id := range m.rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTargetedByRules:
+		ids := make([]ent.Value, 0, len(m.targeted_by_rules))
+		for id := range m.targeted_by_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedgame_roles != nil {
+		edges = append(edges, role.EdgeGameRoles)
+	}
+	if m.removedtemplate_roles != nil {
+		edges = append(edges, role.EdgeTemplateRoles)
+	}
+	if m.removedtemplate_slots != nil {
+		edges = append(edges, role.EdgeTemplateSlots)
+	}
+	if m.removedtranslations != nil {
+		edges = append(edges, role.EdgeTranslations)
+	}
+	if m.removedrules != nil {
+		edges = append(edges, role.EdgeRules)
+	}
+	if m.removedtargeted_by_rules != nil {
+		edges = append(edges, role.EdgeTargetedByRules)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeGameRoles:
+		ids := make([]ent.Value, 0, len(m.removedgame_roles))
+		for id := range m.removedgame_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTemplateRoles:
+		ids := make([]ent.Value, 0, len(m.removedtemplate_roles))
+		for id := range m.removedtemplate_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTemplateSlots:
+		ids := make([]ent.Value, 0, len(m.removedtemplate_slots))
+		for id := range m.removedtemplate_slots {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.removedtranslations))
+		for id := range m.removedtranslations {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.removedrules))
+		for id := range m.removedrules {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeTargetedByRules:
+		ids := make([]ent.Value, 0, len(m.removedtargeted_by_rules))
+		for id := range m.removedtargeted_by_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedgame_roles {
+		edges = append(edges, role.EdgeGameRoles)
+	}
+	if m.clearedtemplate_roles {
+		edges = append(edges, role.EdgeTemplateRoles)
+	}
+	if m.clearedtemplate_slots {
+		edges = append(edges, role.EdgeTemplateSlots)
+	}
+	if m.clearedtranslations {
+		edges = append(edges, role.EdgeTranslations)
+	}
+	if m.clearedrules {
+		edges = append(edges, role.EdgeRules)
+	}
+	if m.clearedtargeted_by_rules {
+		edges = append(edges, role.EdgeTargetedByRules)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case role.EdgeGameRoles:
+		return m.clearedgame_roles
+	case role.EdgeTemplateRoles:
+		return m.clearedtemplate_roles
+	case role.EdgeTemplateSlots:
+		return m.clearedtemplate_slots
+	case role.EdgeTranslations:
+		return m.clearedtranslations
+	case role.EdgeRules:
+		return m.clearedrules
+	case role.EdgeTargetedByRules:
+		return m.clearedtargeted_by_rules
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	switch name {
+	case role.EdgeGameRoles:
+		m.ResetGameRoles()
+		return nil
+	case role.EdgeTemplateRoles:
+		m.ResetTemplateRoles()
+		return nil
+	case role.EdgeTemplateSlots:
+		m.ResetTemplateSlots()
+		return nil
+	case role.EdgeTranslations:
+		m.ResetTranslations()
+		return nil
+	case role.EdgeRules:
+		m.ResetRules()
+		return nil
+	case role.EdgeTargetedByRules:
+		m.ResetTargetedByRules()
+		return nil
+	}
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// RoleRuleMutation represents an operation that mutates the RoleRule nodes in the graph.
+type RoleRuleMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	kind               *rolerule.Kind
+	target_team        *rolerule.TargetTeam
+	max_count          *int
+	addmax_count       *int
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	role               *uuid.UUID
+	clearedrole        bool
+	target_role        *uuid.UUID
+	clearedtarget_role bool
+	done               bool
+	oldValue           func(context.Context) (*RoleRule, error)
+	predicates         []predicate.RoleRule
+}
+
+var _ ent.Mutation = (*RoleRuleMutation)(nil)
+
+// roleruleOption allows management of the mutation configuration using functional options.
+type roleruleOption func(*RoleRuleMutation)
+
+// newRoleRuleMutation creates new mutation for the RoleRule entity.
+func newRoleRuleMutation(c config, op Op, opts ...roleruleOption) *RoleRuleMutation {
+	m := &RoleRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleRuleID sets the ID field of the mutation.
+func withRoleRuleID(id uuid.UUID) roleruleOption {
+	return func(m *RoleRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleRule
+		)
+		m.oldValue = func(ctx context.Context) (*RoleRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleRule sets the old RoleRule of the mutation.
+func withRoleRule(node *RoleRule) roleruleOption {
+	return func(m *RoleRuleMutation) {
+		m.oldValue = func(context.Context) (*RoleRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleRule entities.
+func (m *RoleRuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleRuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleRuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *RoleRuleMutation) SetRoleID(u uuid.UUID) {
+	m.role = &u
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RoleRuleMutation) RoleID() (r uuid.UUID, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the RoleRule entity.
+// If the RoleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRuleMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RoleRuleMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *RoleRuleMutation) SetKind(r rolerule.Kind) {
+	m.kind = &r
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *RoleRuleMutation) Kind() (r rolerule.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the RoleRule entity.
+// If the RoleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRuleMutation) OldKind(ctx context.Context) (v rolerule.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *RoleRuleMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetTargetRoleID sets the "target_role_id" field.
+func (m *RoleRuleMutation) SetTargetRoleID(u uuid.UUID) {
+	m.target_role = &u
+}
+
+// TargetRoleID returns the value of the "target_role_id" field in the mutation.
+func (m *RoleRuleMutation) TargetRoleID() (r uuid.UUID, exists bool) {
+	v := m.target_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetRoleID returns the old "target_role_id" field's value of the RoleRule entity.
+// If the RoleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRuleMutation) OldTargetRoleID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetRoleID: %w", err)
+	}
+	return oldValue.TargetRoleID, nil
+}
+
+// ClearTargetRoleID clears the value of the "target_role_id" field.
+func (m *RoleRuleMutation) ClearTargetRoleID() {
+	m.target_role = nil
+	m.clearedFields[rolerule.FieldTargetRoleID] = struct{}{}
+}
+
+// TargetRoleIDCleared returns if the "target_role_id" field was cleared in this mutation.
+func (m *RoleRuleMutation) TargetRoleIDCleared() bool {
+	_, ok := m.clearedFields[rolerule.FieldTargetRoleID]
+	return ok
+}
+
+// ResetTargetRoleID resets all changes to the "target_role_id" field.
+func (m *RoleRuleMutation) ResetTargetRoleID() {
+	m.target_role = nil
+	delete(m.clearedFields, rolerule.FieldTargetRoleID)
+}
+
+// SetTargetTeam sets the "target_team" field.
+func (m *RoleRuleMutation) SetTargetTeam(rt rolerule.TargetTeam) {
+	m.target_team = &rt
+}
+
+// TargetTeam returns the value of the "target_team" field in the mutation.
+func (m *RoleRuleMutation) TargetTeam() (r rolerule.TargetTeam, exists bool) {
+	v := m.target_team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetTeam returns the old "target_team" field's value of the RoleRule entity.
+// If the RoleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRuleMutation) OldTargetTeam(ctx context.Context) (v *rolerule.TargetTeam, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetTeam: %w", err)
+	}
+	return oldValue.TargetTeam, nil
+}
+
+// ClearTargetTeam clears the value of the "target_team" field.
+func (m *RoleRuleMutation) ClearTargetTeam() {
+	m.target_team = nil
+	m.clearedFields[rolerule.FieldTargetTeam] = struct{}{}
+}
+
+// TargetTeamCleared returns if the "target_team" field was cleared in this mutation.
+func (m *RoleRuleMutation) TargetTeamCleared() bool {
+	_, ok := m.clearedFields[rolerule.FieldTargetTeam]
+	return ok
+}
+
+// ResetTargetTeam resets all changes to the "target_team" field.
+func (m *RoleRuleMutation) ResetTargetTeam() {
+	m.target_team = nil
+	delete(m.clearedFields, rolerule.FieldTargetTeam)
+}
+
+// SetMaxCount sets the "max_count" field.
+func (m *RoleRuleMutation) SetMaxCount(i int) {
+	m.max_count = &i
+	m.addmax_count = nil
+}
+
+// MaxCount returns the value of the "max_count" field in the mutation.
+func (m *RoleRuleMutation) MaxCount() (r int, exists bool) {
+	v := m.max_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxCount returns the old "max_count" field's value of the RoleRule entity.
+// If the RoleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRuleMutation) OldMaxCount(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxCount: %w", err)
+	}
+	return oldValue.MaxCount, nil
+}
+
+// AddMaxCount adds i to the "max_count" field.
+func (m *RoleRuleMutation) AddMaxCount(i int) {
+	if m.addmax_count != nil {
+		*m.addmax_count += i
+	} else {
+		m.addmax_count = &i
+	}
+}
+
+// AddedMaxCount returns the value that was added to the "max_count" field in this mutation.
+func (m *RoleRuleMutation) AddedMaxCount() (r int, exists bool) {
+	v := m.addmax_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (m *RoleRuleMutation) ClearMaxCount() {
+	m.max_count = nil
+	m.addmax_count = nil
+	m.clearedFields[rolerule.FieldMaxCount] = struct{}{}
+}
+
+// MaxCountCleared returns if the "max_count" field was cleared in this mutation.
+func (m *RoleRuleMutation) MaxCountCleared() bool {
+	_, ok := m.clearedFields[rolerule.FieldMaxCount]
+	return ok
+}
+
+// ResetMaxCount resets all changes to the "max_count" field.
+func (m *RoleRuleMutation) ResetMaxCount() {
+	m.max_count = nil
+	m.addmax_count = nil
+	delete(m.clearedFields, rolerule.FieldMaxCount)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleRule entity.
+// If the RoleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (m *RoleRuleMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[rolerule.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Role entity was cleared.
+func (m *RoleRuleMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *RoleRuleMutation) RoleIDs() (ids []uuid.UUID) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *RoleRuleMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// ClearTargetRole clears the "target_role" edge to the Role entity.
+func (m *RoleRuleMutation) ClearTargetRole() {
+	m.clearedtarget_role = true
+	m.clearedFields[rolerule.FieldTargetRoleID] = struct{}{}
+}
+
+// TargetRoleCleared reports if the "target_role" edge to the Role entity was cleared.
+func (m *RoleRuleMutation) TargetRoleCleared() bool {
+	return m.TargetRoleIDCleared() || m.clearedtarget_role
+}
+
+// TargetRoleIDs returns the "target_role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetRoleID instead. It exists only for internal usage by the builders.
+func (m *RoleRuleMutation) TargetRoleIDs() (ids []uuid.UUID) {
+	if id := m.target_role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTargetRole resets all changes to the "target_role" edge.
+func (m *RoleRuleMutation) ResetTargetRole() {
+	m.target_role = nil
+	m.clearedtarget_role = false
+}
+
+// Where appends a list predicates to the RoleRuleMutation builder.
+func (m *RoleRuleMutation) Where(ps ...predicate.RoleRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleRule).
+func (m *RoleRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleRuleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.role != nil {
+		fields = append(fields, rolerule.FieldRoleID)
+	}
+	if m.kind != nil {
+		fields = append(fields, rolerule.FieldKind)
+	}
+	if m.target_role != nil {
+		fields = append(fields, rolerule.FieldTargetRoleID)
+	}
+	if m.target_team != nil {
+		fields = append(fields, rolerule.FieldTargetTeam)
+	}
+	if m.max_count != nil {
+		fields = append(fields, rolerule.FieldMaxCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rolerule.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolerule.FieldRoleID:
+		return m.RoleID()
+	case rolerule.FieldKind:
+		return m.Kind()
+	case rolerule.FieldTargetRoleID:
+		return m.TargetRoleID()
+	case rolerule.FieldTargetTeam:
+		return m.TargetTeam()
+	case rolerule.FieldMaxCount:
+		return m.MaxCount()
+	case rolerule.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolerule.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case rolerule.FieldKind:
+		return m.OldKind(ctx)
+	case rolerule.FieldTargetRoleID:
+		return m.OldTargetRoleID(ctx)
+	case rolerule.FieldTargetTeam:
+		return m.OldTargetTeam(ctx)
+	case rolerule.FieldMaxCount:
+		return m.OldMaxCount(ctx)
+	case rolerule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolerule.FieldRoleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case rolerule.FieldKind:
+		v, ok := value.(rolerule.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case rolerule.FieldTargetRoleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetRoleID(v)
+		return nil
+	case rolerule.FieldTargetTeam:
+		v, ok := value.(rolerule.TargetTeam)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetTeam(v)
+		return nil
+	case rolerule.FieldMaxCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxCount(v)
+		return nil
+	case rolerule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_count != nil {
+		fields = append(fields, rolerule.FieldMaxCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rolerule.FieldMaxCount:
+		return m.AddedMaxCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rolerule.FieldMaxCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rolerule.FieldTargetRoleID) {
+		fields = append(fields, rolerule.FieldTargetRoleID)
+	}
+	if m.FieldCleared(rolerule.FieldTargetTeam) {
+		fields = append(fields, rolerule.FieldTargetTeam)
+	}
+	if m.FieldCleared(rolerule.FieldMaxCount) {
+		fields = append(fields, rolerule.FieldMaxCount)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleRuleMutation) ClearField(name string) error {
+	switch name {
+	case rolerule.FieldTargetRoleID:
+		m.ClearTargetRoleID()
+		return nil
+	case rolerule.FieldTargetTeam:
+		m.ClearTargetTeam()
+		return nil
+	case rolerule.FieldMaxCount:
+		m.ClearMaxCount()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleRuleMutation) ResetField(name string) error {
+	switch name {
+	case rolerule.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case rolerule.FieldKind:
+		m.ResetKind()
+		return nil
+	case rolerule.FieldTargetRoleID:
+		m.ResetTargetRoleID()
+		return nil
+	case rolerule.FieldTargetTeam:
+		m.ResetTargetTeam()
+		return nil
+	case rolerule.FieldMaxCount:
+		m.ResetMaxCount()
+		return nil
+	case rolerule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.role != nil {
+		edges = append(edges, rolerule.EdgeRole)
+	}
+	if m.target_role != nil {
+		edges = append(edges, rolerule.EdgeTargetRole)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rolerule.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	case rolerule.EdgeTargetRole:
+		if id := m.target_role; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrole {
+		edges = append(edges, rolerule.EdgeRole)
+	}
+	if m.clearedtarget_role {
+		edges = append(edges, rolerule.EdgeTargetRole)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rolerule.EdgeRole:
+		return m.clearedrole
+	case rolerule.EdgeTargetRole:
+		return m.clearedtarget_role
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleRuleMutation) ClearEdge(name string) error {
+	switch name {
+	case rolerule.EdgeRole:
+		m.ClearRole()
+		return nil
+	case rolerule.EdgeTargetRole:
+		m.ClearTargetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case rolerule.EdgeRole:
+		m.ResetRole()
+		return nil
+	case rolerule.EdgeTargetRole:
+		m.ResetTargetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRule edge %s", name)
+}
+
+// RoleTemplateMutation represents an operation that mutates the RoleTemplate nodes in the graph.
+type RoleTemplateMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	name                  *string
+	player_count          *int
+	addplayer_count       *int
+	max_player_count      *int
+	addmax_player_count   *int
+	description           *string
+	owner_id              *string
+	status                *roletemplate.Status
+	share_code            *string
+	review_note           *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	template_roles        map[int]struct{}
+	removedtemplate_roles map[int]struct{}
+	clearedtemplate_roles bool
+	optional_slots        map[uuid.UUID]struct{}
+	removedoptional_slots map[uuid.UUID]struct{}
+	clearedoptional_slots bool
+	translations          map[uuid.UUID]struct{}
+	removedtranslations   map[uuid.UUID]struct{}
+	clearedtranslations   bool
+	revisions             map[uuid.UUID]struct{}
+	removedrevisions      map[uuid.UUID]struct{}
+	clearedrevisions      bool
+	done                  bool
+	oldValue              func(context.Context) (*RoleTemplate, error)
+	predicates            []predicate.RoleTemplate
+}
+
+var _ ent.Mutation = (*RoleTemplateMutation)(nil)
+
+// roletemplateOption allows management of the mutation configuration using functional options.
+type roletemplateOption func(*RoleTemplateMutation)
+
+// newRoleTemplateMutation creates new mutation for the RoleTemplate entity.
+func newRoleTemplateMutation(c config, op Op, opts ...roletemplateOption) *RoleTemplateMutation {
+	m := &RoleTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleTemplateID sets the ID field of the mutation.
+func withRoleTemplateID(id uuid.UUID) roletemplateOption {
+	return func(m *RoleTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*RoleTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleTemplate sets the old RoleTemplate of the mutation.
+func withRoleTemplate(node *RoleTemplate) roletemplateOption {
+	return func(m *RoleTemplateMutation) {
+		m.oldValue = func(context.Context) (*RoleTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleTemplate entities.
+func (m *RoleTemplateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleTemplateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoleTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleTemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPlayerCount sets the "player_count" field.
+func (m *RoleTemplateMutation) SetPlayerCount(i int) {
+	m.player_count = &i
+	m.addplayer_count = nil
+}
+
+// PlayerCount returns the value of the "player_count" field in the mutation.
+func (m *RoleTemplateMutation) PlayerCount() (r int, exists bool) {
+	v := m.player_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerCount returns the old "player_count" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldPlayerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerCount: %w", err)
+	}
+	return oldValue.PlayerCount, nil
+}
+
+// AddPlayerCount adds i to the "player_count" field.
+func (m *RoleTemplateMutation) AddPlayerCount(i int) {
+	if m.addplayer_count != nil {
+		*m.addplayer_count += i
+	} else {
+		m.addplayer_count = &i
+	}
+}
+
+// AddedPlayerCount returns the value that was added to the "player_count" field in this mutation.
+func (m *RoleTemplateMutation) AddedPlayerCount() (r int, exists bool) {
+	v := m.addplayer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlayerCount resets all changes to the "player_count" field.
+func (m *RoleTemplateMutation) ResetPlayerCount() {
+	m.player_count = nil
+	m.addplayer_count = nil
+}
+
+// SetMaxPlayerCount sets the "max_player_count" field.
+func (m *RoleTemplateMutation) SetMaxPlayerCount(i int) {
+	m.max_player_count = &i
+	m.addmax_player_count = nil
+}
+
+// MaxPlayerCount returns the value of the "max_player_count" field in the mutation.
+func (m *RoleTemplateMutation) MaxPlayerCount() (r int, exists bool) {
+	v := m.max_player_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxPlayerCount returns the old "max_player_count" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldMaxPlayerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxPlayerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxPlayerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxPlayerCount: %w", err)
+	}
+	return oldValue.MaxPlayerCount, nil
+}
+
+// AddMaxPlayerCount adds i to the "max_player_count" field.
+func (m *RoleTemplateMutation) AddMaxPlayerCount(i int) {
+	if m.addmax_player_count != nil {
+		*m.addmax_player_count += i
+	} else {
+		m.addmax_player_count = &i
+	}
+}
+
+// AddedMaxPlayerCount returns the value that was added to the "max_player_count" field in this mutation.
+func (m *RoleTemplateMutation) AddedMaxPlayerCount() (r int, exists bool) {
+	v := m.addmax_player_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxPlayerCount resets all changes to the "max_player_count" field.
+func (m *RoleTemplateMutation) ResetMaxPlayerCount() {
+	m.max_player_count = nil
+	m.addmax_player_count = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoleTemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleTemplateMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RoleTemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[roletemplate.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoleTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[roletemplate.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleTemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, roletemplate.FieldDescription)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *RoleTemplateMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *RoleTemplateMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldOwnerID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (m *RoleTemplateMutation) ClearOwnerID() {
+	m.owner_id = nil
+	m.clearedFields[roletemplate.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the "owner_id" field was cleared in this mutation.
+func (m *RoleTemplateMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[roletemplate.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *RoleTemplateMutation) ResetOwnerID() {
+	m.owner_id = nil
+	delete(m.clearedFields, roletemplate.FieldOwnerID)
+}
+
+// SetStatus sets the "status" field.
+func (m *RoleTemplateMutation) SetStatus(r roletemplate.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RoleTemplateMutation) Status() (r roletemplate.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldStatus(ctx context.Context) (v roletemplate.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RoleTemplateMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetShareCode sets the "share_code" field.
+func (m *RoleTemplateMutation) SetShareCode(s string) {
+	m.share_code = &s
+}
+
+// ShareCode returns the value of the "share_code" field in the mutation.
+func (m *RoleTemplateMutation) ShareCode() (r string, exists bool) {
+	v := m.share_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareCode returns the old "share_code" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldShareCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareCode: %w", err)
+	}
+	return oldValue.ShareCode, nil
+}
+
+// ClearShareCode clears the value of the "share_code" field.
+func (m *RoleTemplateMutation) ClearShareCode() {
+	m.share_code = nil
+	m.clearedFields[roletemplate.FieldShareCode] = struct{}{}
+}
+
+// ShareCodeCleared returns if the "share_code" field was cleared in this mutation.
+func (m *RoleTemplateMutation) ShareCodeCleared() bool {
+	_, ok := m.clearedFields[roletemplate.FieldShareCode]
+	return ok
+}
+
+// ResetShareCode resets all changes to the "share_code" field.
+func (m *RoleTemplateMutation) ResetShareCode() {
+	m.share_code = nil
+	delete(m.clearedFields, roletemplate.FieldShareCode)
+}
+
+// SetReviewNote sets the "review_note" field.
+func (m *RoleTemplateMutation) SetReviewNote(s string) {
+	m.review_note = &s
+}
+
+// ReviewNote returns the value of the "review_note" field in the mutation.
+func (m *RoleTemplateMutation) ReviewNote() (r string, exists bool) {
+	v := m.review_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewNote returns the old "review_note" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldReviewNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewNote: %w", err)
+	}
+	return oldValue.ReviewNote, nil
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (m *RoleTemplateMutation) ClearReviewNote() {
+	m.review_note = nil
+	m.clearedFields[roletemplate.FieldReviewNote] = struct{}{}
+}
+
+// ReviewNoteCleared returns if the "review_note" field was cleared in this mutation.
+func (m *RoleTemplateMutation) ReviewNoteCleared() bool {
+	_, ok := m.clearedFields[roletemplate.FieldReviewNote]
+	return ok
+}
+
+// ResetReviewNote resets all changes to the "review_note" field.
+func (m *RoleTemplateMutation) ResetReviewNote() {
+	m.review_note = nil
+	delete(m.clearedFields, roletemplate.FieldReviewNote)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RoleTemplate entity.
+// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddTemplateRoleIDs adds the "template_roles" edge to the RoleTemplateRole entity by ids.
+func (m *RoleTemplateMutation) AddTemplateRoleIDs(ids ...int) {
+	if m.template_roles == nil {
+		m.template_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.template_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTemplateRoles clears the "template_roles" edge to the RoleTemplateRole entity.
+func (m *RoleTemplateMutation) ClearTemplateRoles() {
+	m.clearedtemplate_roles = true
+}
+
+// TemplateRolesCleared reports if the "template_roles" edge to the RoleTemplateRole entity was cleared.
+func (m *RoleTemplateMutation) TemplateRolesCleared() bool {
+	return m.clearedtemplate_roles
+}
+
+// RemoveTemplateRoleIDs removes the "template_roles" edge to the RoleTemplateRole entity by IDs.
+func (m *RoleTemplateMutation) RemoveTemplateRoleIDs(ids ...int) {
+	if m.removedtemplate_roles == nil {
+		m.removedtemplate_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.template_roles, ids[i])
+		m.removedtemplate_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTemplateRoles returns the removed IDs of the "template_roles" edge to the RoleTemplateRole entity.
+func (m *RoleTemplateMutation) RemovedTemplateRolesIDs() (ids []int) {
+	for id := range m.removedtemplate_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TemplateRolesIDs returns the "template_roles" edge IDs in the mutation.
+func (m *RoleTemplateMutation) TemplateRolesIDs() (ids []int) {
+	for id := range m.template_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTemplateRoles resets all changes to the "template_roles" edge.
+func (m *RoleTemplateMutation) ResetTemplateRoles() {
+	m.template_roles = nil
+	m.clearedtemplate_roles = false
+	m.removedtemplate_roles = nil
+}
+
+// AddOptionalSlotIDs adds the "optional_slots" edge to the RoleTemplateSlot entity by ids.
+func (m *RoleTemplateMutation) AddOptionalSlotIDs(ids ...uuid.UUID) {
+	if m.optional_slots == nil {
+		m.optional_slots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.optional_slots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOptionalSlots clears the "optional_slots" edge to the RoleTemplateSlot entity.
+func (m *RoleTemplateMutation) ClearOptionalSlots() {
+	m.clearedoptional_slots = true
+}
+
+// OptionalSlotsCleared reports if the "optional_slots" edge to the RoleTemplateSlot entity was cleared.
+func (m *RoleTemplateMutation) OptionalSlotsCleared() bool {
+	return m.clearedoptional_slots
+}
+
+// RemoveOptionalSlotIDs removes the "optional_slots" edge to the RoleTemplateSlot entity by IDs.
+func (m *RoleTemplateMutation) RemoveOptionalSlotIDs(ids ...uuid.UUID) {
+	if m.removedoptional_slots == nil {
+		m.removedoptional_slots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.optional_slots, ids[i])
+		m.removedoptional_slots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOptionalSlots returns the removed IDs of the "optional_slots" edge to the RoleTemplateSlot entity.
+func (m *RoleTemplateMutation) RemovedOptionalSlotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedoptional_slots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OptionalSlotsIDs returns the "optional_slots" edge IDs in the mutation.
+func (m *RoleTemplateMutation) OptionalSlotsIDs() (ids []uuid.UUID) {
+	for id := range m.optional_slots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOptionalSlots resets all changes to the "optional_slots" edge.
+func (m *RoleTemplateMutation) ResetOptionalSlots() {
+	m.optional_slots = nil
+	m.clearedoptional_slots = false
+	m.removedoptional_slots = nil
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTemplateTranslation entity by ids.
+func (m *RoleTemplateMutation) AddTranslationIDs(ids ...uuid.UUID) {
+	if m.translations == nil {
+		m.translations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.translations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTranslations clears the "translations" edge to the RoleTemplateTranslation entity.
+func (m *RoleTemplateMutation) ClearTranslations() {
+	m.clearedtranslations = true
+}
+
+// TranslationsCleared reports if the "translations" edge to the RoleTemplateTranslation entity was cleared.
+func (m *RoleTemplateMutation) TranslationsCleared() bool {
+	return m.clearedtranslations
+}
+
+// RemoveTranslationIDs removes the "translations" edge to the RoleTemplateTranslation entity by IDs.
+func (m *RoleTemplateMutation) RemoveTranslationIDs(ids ...uuid.UUID) {
+	if m.removedtranslations == nil {
+		m.removedtranslations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.translations, ids[i])
+		m.removedtranslations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTranslations returns the removed IDs of the "translations" edge to the RoleTemplateTranslation entity.
+func (m *RoleTemplateMutation) RemovedTranslationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtranslations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TranslationsIDs returns the "translations" edge IDs in the mutation.
+func (m *RoleTemplateMutation) TranslationsIDs() (ids []uuid.UUID) {
+	for id := range m.translations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTranslations resets all changes to the "translations" edge.
+func (m *RoleTemplateMutation) ResetTranslations() {
+	m.translations = nil
+	m.clearedtranslations = false
+	m.removedtranslations = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the RoleTemplateRevision entity by ids.
+func (m *RoleTemplateMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the RoleTemplateRevision entity.
+func (m *RoleTemplateMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the RoleTemplateRevision entity was cleared.
+func (m *RoleTemplateMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the RoleTemplateRevision entity by IDs.
+func (m *RoleTemplateMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the RoleTemplateRevision entity.
+func (m *RoleTemplateMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *RoleTemplateMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *RoleTemplateMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// Where appends a list predicates to the RoleTemplateMutation builder.
+func (m *RoleTemplateMutation) Where(ps ...predicate.RoleTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleTemplate).
+func (m *RoleTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, roletemplate.FieldName)
+	}
+	if m.player_count != nil {
+		fields = append(fields, roletemplate.FieldPlayerCount)
+	}
+	if m.max_player_count != nil {
+		fields = append(fields, roletemplate.FieldMaxPlayerCount)
+	}
+	if m.description != nil {
+		fields = append(fields, roletemplate.FieldDescription)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, roletemplate.FieldOwnerID)
+	}
+	if m.status != nil {
+		fields = append(fields, roletemplate.FieldStatus)
+	}
+	if m.share_code != nil {
+		fields = append(fields, roletemplate.FieldShareCode)
+	}
+	if m.review_note != nil {
+		fields = append(fields, roletemplate.FieldReviewNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, roletemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, roletemplate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roletemplate.FieldName:
+		return m.Name()
+	case roletemplate.FieldPlayerCount:
+		return m.PlayerCount()
+	case roletemplate.FieldMaxPlayerCount:
+		return m.MaxPlayerCount()
+	case roletemplate.FieldDescription:
+		return m.Description()
+	case roletemplate.FieldOwnerID:
+		return m.OwnerID()
+	case roletemplate.FieldStatus:
+		return m.Status()
+	case roletemplate.FieldShareCode:
+		return m.ShareCode()
+	case roletemplate.FieldReviewNote:
+		return m.ReviewNote()
+	case roletemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case roletemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roletemplate.FieldName:
+		return m.OldName(ctx)
+	case roletemplate.FieldPlayerCount:
+		return m.OldPlayerCount(ctx)
+	case roletemplate.FieldMaxPlayerCount:
+		return m.OldMaxPlayerCount(ctx)
+	case roletemplate.FieldDescription:
+		return m.OldDescription(ctx)
+	case roletemplate.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case roletemplate.FieldStatus:
+		return m.OldStatus(ctx)
+	case roletemplate.FieldShareCode:
+		return m.OldShareCode(ctx)
+	case roletemplate.FieldReviewNote:
+		return m.OldReviewNote(ctx)
+	case roletemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case roletemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roletemplate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case roletemplate.FieldPlayerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerCount(v)
+		return nil
+	case roletemplate.FieldMaxPlayerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxPlayerCount(v)
+		return nil
+	case roletemplate.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case roletemplate.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case roletemplate.FieldStatus:
+		v, ok := value.(roletemplate.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case roletemplate.FieldShareCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareCode(v)
+		return nil
+	case roletemplate.FieldReviewNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewNote(v)
+		return nil
+	case roletemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case roletemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleTemplateMutation) AddedFields() []string {
+	var fields []string
+	if m.addplayer_count != nil {
+		fields = append(fields, roletemplate.FieldPlayerCount)
+	}
+	if m.addmax_player_count != nil {
+		fields = append(fields, roletemplate.FieldMaxPlayerCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roletemplate.FieldPlayerCount:
+		return m.AddedPlayerCount()
+	case roletemplate.FieldMaxPlayerCount:
+		return m.AddedMaxPlayerCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case roletemplate.FieldPlayerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayerCount(v)
+		return nil
+	case roletemplate.FieldMaxPlayerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxPlayerCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(roletemplate.FieldDescription) {
+		fields = append(fields, roletemplate.FieldDescription)
+	}
+	if m.FieldCleared(roletemplate.FieldOwnerID) {
+		fields = append(fields, roletemplate.FieldOwnerID)
+	}
+	if m.FieldCleared(roletemplate.FieldShareCode) {
+		fields = append(fields, roletemplate.FieldShareCode)
+	}
+	if m.FieldCleared(roletemplate.FieldReviewNote) {
+		fields = append(fields, roletemplate.FieldReviewNote)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleTemplateMutation) ClearField(name string) error {
+	switch name {
+	case roletemplate.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case roletemplate.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	case roletemplate.FieldShareCode:
+		m.ClearShareCode()
+		return nil
+	case roletemplate.FieldReviewNote:
+		m.ClearReviewNote()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleTemplateMutation) ResetField(name string) error {
+	switch name {
+	case roletemplate.FieldName:
+		m.ResetName()
+		return nil
+	case roletemplate.FieldPlayerCount:
+		m.ResetPlayerCount()
+		return nil
+	case roletemplate.FieldMaxPlayerCount:
+		m.ResetMaxPlayerCount()
+		return nil
+	case roletemplate.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case roletemplate.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case roletemplate.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case roletemplate.FieldShareCode:
+		m.ResetShareCode()
+		return nil
+	case roletemplate.FieldReviewNote:
+		m.ResetReviewNote()
+		return nil
+	case roletemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case roletemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.template_roles != nil {
+		edges = append(edges, roletemplate.EdgeTemplateRoles)
+	}
+	if m.optional_slots != nil {
+		edges = append(edges, roletemplate.EdgeOptionalSlots)
+	}
+	if m.translations != nil {
+		edges = append(edges, roletemplate.EdgeTranslations)
+	}
+	if m.revisions != nil {
+		edges = append(edges, roletemplate.EdgeRevisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleTemplateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roletemplate.EdgeTemplateRoles:
+		ids := make([]ent.Value, 0, len(m.template_roles))
+		for id := range m.template_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roletemplate.EdgeOptionalSlots:
+		ids := make([]ent.Value, 0, len(m.optional_slots))
+		for id := range m.optional_slots {
+			ids = append(ids, id)
+		}
+		return ids
+	case roletemplate.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.translations))
+		for id := range m.translations {
+			ids = append(ids, id)
+		}
+		return ids
+	case roletemplate.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtemplate_roles != nil {
+		edges = append(edges, roletemplate.EdgeTemplateRoles)
+	}
+	if m.removedoptional_slots != nil {
+		edges = append(edges, roletemplate.EdgeOptionalSlots)
+	}
+	if m.removedtranslations != nil {
+		edges = append(edges, roletemplate.EdgeTranslations)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, roletemplate.EdgeRevisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleTemplateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case roletemplate.EdgeTemplateRoles:
+		ids := make([]ent.Value, 0, len(m.removedtemplate_roles))
+		for id := range m.removedtemplate_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roletemplate.EdgeOptionalSlots:
+		ids := make([]ent.Value, 0, len(m.removedoptional_slots))
+		for id := range m.removedoptional_slots {
+			ids = append(ids, id)
+		}
+		return ids
+	case roletemplate.EdgeTranslations:
+		ids := make([]ent.Value, 0, len(m.removedtranslations))
+		for id := range m.removedtranslations {
+			ids = append(ids, id)
+		}
+		return ids
+	case roletemplate.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtemplate_roles {
+		edges = append(edges, roletemplate.EdgeTemplateRoles)
+	}
+	if m.clearedoptional_slots {
+		edges = append(edges, roletemplate.EdgeOptionalSlots)
+	}
+	if m.clearedtranslations {
+		edges = append(edges, roletemplate.EdgeTranslations)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, roletemplate.EdgeRevisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleTemplateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roletemplate.EdgeTemplateRoles:
+		return m.clearedtemplate_roles
+	case roletemplate.EdgeOptionalSlots:
+		return m.clearedoptional_slots
+	case roletemplate.EdgeTranslations:
+		return m.clearedtranslations
+	case roletemplate.EdgeRevisions:
+		return m.clearedrevisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleTemplateMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoleTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleTemplateMutation) ResetEdge(name string) error {
+	switch name {
+	case roletemplate.EdgeTemplateRoles:
+		m.ResetTemplateRoles()
+		return nil
+	case roletemplate.EdgeOptionalSlots:
+		m.ResetOptionalSlots()
+		return nil
+	case roletemplate.EdgeTranslations:
+		m.ResetTranslations()
+		return nil
+	case roletemplate.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplate edge %s", name)
+}
+
+// RoleTemplateRevisionMutation represents an operation that mutates the RoleTemplateRevision nodes in the graph.
+type RoleTemplateRevisionMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	number               *int
+	addnumber            *int
+	name                 *string
+	player_count         *int
+	addplayer_count      *int
+	description          *string
+	roles                *[]schema.RevisionRole
+	appendroles          []schema.RevisionRole
+	action               *roletemplaterevision.Action
+	source_number        *int
+	addsource_number     *int
+	author_kind          *roletemplaterevision.AuthorKind
+	author_id            *string
+	author_name          *string
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	role_template        *uuid.UUID
+	clearedrole_template bool
+	done                 bool
+	oldValue             func(context.Context) (*RoleTemplateRevision, error)
+	predicates           []predicate.RoleTemplateRevision
+}
+
+var _ ent.Mutation = (*RoleTemplateRevisionMutation)(nil)
+
+// roletemplaterevisionOption allows management of the mutation configuration using functional options.
+type roletemplaterevisionOption func(*RoleTemplateRevisionMutation)
+
+// newRoleTemplateRevisionMutation creates new mutation for the RoleTemplateRevision entity.
+func newRoleTemplateRevisionMutation(c config, op Op, opts ...roletemplaterevisionOption) *RoleTemplateRevisionMutation {
+	m := &RoleTemplateRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleTemplateRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleTemplateRevisionID sets the ID field of the mutation.
+func withRoleTemplateRevisionID(id uuid.UUID) roletemplaterevisionOption {
+	return func(m *RoleTemplateRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleTemplateRevision
+		)
+		m.oldValue = func(ctx context.Context) (*RoleTemplateRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleTemplateRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleTemplateRevision sets the old RoleTemplateRevision of the mutation.
+func withRoleTemplateRevision(node *RoleTemplateRevision) roletemplaterevisionOption {
+	return func(m *RoleTemplateRevisionMutation) {
+		m.oldValue = func(context.Context) (*RoleTemplateRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleTemplateRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleTemplateRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleTemplateRevision entities.
+func (m *RoleTemplateRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleTemplateRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleTemplateRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleTemplateRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (m *RoleTemplateRevisionMutation) SetRoleTemplateID(u uuid.UUID) {
+	m.role_template = &u
+}
+
+// RoleTemplateID returns the value of the "role_template_id" field in the mutation.
+func (m *RoleTemplateRevisionMutation) RoleTemplateID() (r uuid.UUID, exists bool) {
+	v := m.role_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleTemplateID returns the old "role_template_id" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldRoleTemplateID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleTemplateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleTemplateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleTemplateID: %w", err)
+	}
+	return oldValue.RoleTemplateID, nil
+}
+
+// ResetRoleTemplateID resets all changes to the "role_template_id" field.
+func (m *RoleTemplateRevisionMutation) ResetRoleTemplateID() {
+	m.role_template = nil
+}
+
+// SetNumber sets the "number" field.
+func (m *RoleTemplateRevisionMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *RoleTemplateRevisionMutation) Number() (r int, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// AddNumber adds i to the "number" field.
+func (m *RoleTemplateRevisionMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
+	}
+}
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *RoleTemplateRevisionMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *RoleTemplateRevisionMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetName sets the "name" field.
+func (m *RoleTemplateRevisionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleTemplateRevisionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleTemplateRevisionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPlayerCount sets the "player_count" field.
+func (m *RoleTemplateRevisionMutation) SetPlayerCount(i int) {
+	m.player_count = &i
+	m.addplayer_count = nil
+}
+
+// PlayerCount returns the value of the "player_count" field in the mutation.
+func (m *RoleTemplateRevisionMutation) PlayerCount() (r int, exists bool) {
+	v := m.player_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerCount returns the old "player_count" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldPlayerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerCount: %w", err)
+	}
+	return oldValue.PlayerCount, nil
+}
+
+// AddPlayerCount adds i to the "player_count" field.
+func (m *RoleTemplateRevisionMutation) AddPlayerCount(i int) {
+	if m.addplayer_count != nil {
+		*m.addplayer_count += i
+	} else {
+		m.addplayer_count = &i
+	}
+}
+
+// AddedPlayerCount returns the value that was added to the "player_count" field in this mutation.
+func (m *RoleTemplateRevisionMutation) AddedPlayerCount() (r int, exists bool) {
+	v := m.addplayer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlayerCount resets all changes to the "player_count" field.
+func (m *RoleTemplateRevisionMutation) ResetPlayerCount() {
+	m.player_count = nil
+	m.addplayer_count = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoleTemplateRevisionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleTemplateRevisionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RoleTemplateRevisionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[roletemplaterevision.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoleTemplateRevisionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[roletemplaterevision.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleTemplateRevisionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, roletemplaterevision.FieldDescription)
+}
+
+// SetRoles sets the "roles" field.
+func (m *RoleTemplateRevisionMutation) SetRoles(sr []schema.RevisionRole) {
+	m.roles = &sr
+	m.appendroles = nil
+}
+
+// Roles returns the value of the "roles" field in the mutation.
+func (m *RoleTemplateRevisionMutation) Roles() (r []schema.RevisionRole, exists bool) {
+	v := m.roles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoles returns the old "roles" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldRoles(ctx context.Context) (v []schema.RevisionRole, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
+	}
+	return oldValue.Roles, nil
+}
+
+// AppendRoles adds sr to the "roles" field.
+func (m *RoleTemplateRevisionMutation) AppendRoles(sr []schema.RevisionRole) {
+	m.appendroles = append(m.appendroles, sr...)
+}
+
+// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
+func (m *RoleTemplateRevisionMutation) AppendedRoles() ([]schema.RevisionRole, bool) {
+	if len(m.appendroles) == 0 {
+		return nil, false
+	}
+	return m.appendroles, true
+}
+
+// ResetRoles resets all changes to the "roles" field.
+func (m *RoleTemplateRevisionMutation) ResetRoles() {
+	m.roles = nil
+	m.appendroles = nil
+}
+
+// SetAction sets the "action" field.
+func (m *RoleTemplateRevisionMutation) SetAction(r roletemplaterevision.Action) {
+	m.action = &r
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *RoleTemplateRevisionMutation) Action() (r roletemplaterevision.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldAction(ctx context.Context) (v roletemplaterevision.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *RoleTemplateRevisionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetSourceNumber sets the "source_number" field.
+func (m *RoleTemplateRevisionMutation) SetSourceNumber(i int) {
+	m.source_number = &i
+	m.addsource_number = nil
+}
+
+// SourceNumber returns the value of the "source_number" field in the mutation.
+func (m *RoleTemplateRevisionMutation) SourceNumber() (r int, exists bool) {
+	v := m.source_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceNumber returns the old "source_number" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldSourceNumber(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceNumber: %w", err)
+	}
+	return oldValue.SourceNumber, nil
+}
+
+// AddSourceNumber adds i to the "source_number" field.
+func (m *RoleTemplateRevisionMutation) AddSourceNumber(i int) {
+	if m.addsource_number != nil {
+		*m.addsource_number += i
+	} else {
+		m.addsource_number = &i
+	}
+}
+
+// AddedSourceNumber returns the value that was added to the "source_number" field in this mutation.
+func (m *RoleTemplateRevisionMutation) AddedSourceNumber() (r int, exists bool) {
+	v := m.addsource_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSourceNumber clears the value of the "source_number" field.
+func (m *RoleTemplateRevisionMutation) ClearSourceNumber() {
+	m.source_number = nil
+	m.addsource_number = nil
+	m.clearedFields[roletemplaterevision.FieldSourceNumber] = struct{}{}
+}
+
+// SourceNumberCleared returns if the "source_number" field was cleared in this mutation.
+func (m *RoleTemplateRevisionMutation) SourceNumberCleared() bool {
+	_, ok := m.clearedFields[roletemplaterevision.FieldSourceNumber]
+	return ok
+}
+
+// ResetSourceNumber resets all changes to the "source_number" field.
+func (m *RoleTemplateRevisionMutation) ResetSourceNumber() {
+	m.source_number = nil
+	m.addsource_number = nil
+	delete(m.clearedFields, roletemplaterevision.FieldSourceNumber)
+}
+
+// SetAuthorKind sets the "author_kind" field.
+func (m *RoleTemplateRevisionMutation) SetAuthorKind(rk roletemplaterevision.AuthorKind) {
+	m.author_kind = &rk
+}
+
+// AuthorKind returns the value of the "author_kind" field in the mutation.
+func (m *RoleTemplateRevisionMutation) AuthorKind() (r roletemplaterevision.AuthorKind, exists bool) {
+	v := m.author_kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorKind returns the old "author_kind" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldAuthorKind(ctx context.Context) (v roletemplaterevision.AuthorKind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorKind: %w", err)
+	}
+	return oldValue.AuthorKind, nil
+}
+
+// ResetAuthorKind resets all changes to the "author_kind" field.
+func (m *RoleTemplateRevisionMutation) ResetAuthorKind() {
+	m.author_kind = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *RoleTemplateRevisionMutation) SetAuthorID(s string) {
+	m.author_id = &s
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *RoleTemplateRevisionMutation) AuthorID() (r string, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldAuthorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *RoleTemplateRevisionMutation) ClearAuthorID() {
+	m.author_id = nil
+	m.clearedFields[roletemplaterevision.FieldAuthorID] = struct{}{}
+}
+
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *RoleTemplateRevisionMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[roletemplaterevision.FieldAuthorID]
+	return ok
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *RoleTemplateRevisionMutation) ResetAuthorID() {
+	m.author_id = nil
+	delete(m.clearedFields, roletemplaterevision.FieldAuthorID)
+}
+
+// SetAuthorName sets the "author_name" field.
+func (m *RoleTemplateRevisionMutation) SetAuthorName(s string) {
+	m.author_name = &s
+}
+
+// AuthorName returns the value of the "author_name" field in the mutation.
+func (m *RoleTemplateRevisionMutation) AuthorName() (r string, exists bool) {
+	v := m.author_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorName returns the old "author_name" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldAuthorName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorName: %w", err)
+	}
+	return oldValue.AuthorName, nil
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (m *RoleTemplateRevisionMutation) ClearAuthorName() {
+	m.author_name = nil
+	m.clearedFields[roletemplaterevision.FieldAuthorName] = struct{}{}
+}
+
+// AuthorNameCleared returns if the "author_name" field was cleared in this mutation.
+func (m *RoleTemplateRevisionMutation) AuthorNameCleared() bool {
+	_, ok := m.clearedFields[roletemplaterevision.FieldAuthorName]
+	return ok
+}
+
+// ResetAuthorName resets all changes to the "author_name" field.
+func (m *RoleTemplateRevisionMutation) ResetAuthorName() {
+	m.author_name = nil
+	delete(m.clearedFields, roletemplaterevision.FieldAuthorName)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleTemplateRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleTemplateRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleTemplateRevision entity.
+// If the RoleTemplateRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleTemplateRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (m *RoleTemplateRevisionMutation) ClearRoleTemplate() {
+	m.clearedrole_template = true
+	m.clearedFields[roletemplaterevision.FieldRoleTemplateID] = struct{}{}
+}
+
+// RoleTemplateCleared reports if the "role_template" edge to the RoleTemplate entity was cleared.
+func (m *RoleTemplateRevisionMutation) RoleTemplateCleared() bool {
+	return m.clearedrole_template
+}
+
+// RoleTemplateIDs returns the "role_template" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleTemplateID instead. It exists only for internal usage by the builders.
+func (m *RoleTemplateRevisionMutation) RoleTemplateIDs() (ids []uuid.UUID) {
+	if id := m.role_template; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoleTemplate resets all changes to the "role_template" edge.
+func (m *RoleTemplateRevisionMutation) ResetRoleTemplate() {
+	m.role_template = nil
+	m.clearedrole_template = false
+}
+
+// Where appends a list predicates to the RoleTemplateRevisionMutation builder.
+func (m *RoleTemplateRevisionMutation) Where(ps ...predicate.RoleTemplateRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleTemplateRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleTemplateRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleTemplateRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleTemplateRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleTemplateRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleTemplateRevision).
+func (m *RoleTemplateRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleTemplateRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.role_template != nil {
+		fields = append(fields, roletemplaterevision.FieldRoleTemplateID)
+	}
+	if m.number != nil {
+		fields = append(fields, roletemplaterevision.FieldNumber)
+	}
+	if m.name != nil {
+		fields = append(fields, roletemplaterevision.FieldName)
+	}
+	if m.player_count != nil {
+		fields = append(fields, roletemplaterevision.FieldPlayerCount)
+	}
+	if m.description != nil {
+		fields = append(fields, roletemplaterevision.FieldDescription)
+	}
+	if m.roles != nil {
+		fields = append(fields, roletemplaterevision.FieldRoles)
+	}
+	if m.action != nil {
+		fields = append(fields, roletemplaterevision.FieldAction)
+	}
+	if m.source_number != nil {
+		fields = append(fields, roletemplaterevision.FieldSourceNumber)
+	}
+	if m.author_kind != nil {
+		fields = append(fields, roletemplaterevision.FieldAuthorKind)
+	}
+	if m.author_id != nil {
+		fields = append(fields, roletemplaterevision.FieldAuthorID)
+	}
+	if m.author_name != nil {
+		fields = append(fields, roletemplaterevision.FieldAuthorName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, roletemplaterevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleTemplateRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roletemplaterevision.FieldRoleTemplateID:
+		return m.RoleTemplateID()
+	case roletemplaterevision.FieldNumber:
+		return m.Number()
+	case roletemplaterevision.FieldName:
+		return m.Name()
+	case roletemplaterevision.FieldPlayerCount:
+		return m.PlayerCount()
+	case roletemplaterevision.FieldDescription:
+		return m.Description()
+	case roletemplaterevision.FieldRoles:
+		return m.Roles()
+	case roletemplaterevision.FieldAction:
+		return m.Action()
+	case roletemplaterevision.FieldSourceNumber:
+		return m.SourceNumber()
+	case roletemplaterevision.FieldAuthorKind:
+		return m.AuthorKind()
+	case roletemplaterevision.FieldAuthorID:
+		return m.AuthorID()
+	case roletemplaterevision.FieldAuthorName:
+		return m.AuthorName()
+	case roletemplaterevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleTemplateRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roletemplaterevision.FieldRoleTemplateID:
+		return m.OldRoleTemplateID(ctx)
+	case roletemplaterevision.FieldNumber:
+		return m.OldNumber(ctx)
+	case roletemplaterevision.FieldName:
+		return m.OldName(ctx)
+	case roletemplaterevision.FieldPlayerCount:
+		return m.OldPlayerCount(ctx)
+	case roletemplaterevision.FieldDescription:
+		return m.OldDescription(ctx)
+	case roletemplaterevision.FieldRoles:
+		return m.OldRoles(ctx)
+	case roletemplaterevision.FieldAction:
+		return m.OldAction(ctx)
+	case roletemplaterevision.FieldSourceNumber:
+		return m.OldSourceNumber(ctx)
+	case roletemplaterevision.FieldAuthorKind:
+		return m.OldAuthorKind(ctx)
+	case roletemplaterevision.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case roletemplaterevision.FieldAuthorName:
+		return m.OldAuthorName(ctx)
+	case roletemplaterevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleTemplateRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleTemplateRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roletemplaterevision.FieldRoleTemplateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleTemplateID(v)
+		return nil
+	case roletemplaterevision.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case roletemplaterevision.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case roletemplaterevision.FieldPlayerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerCount(v)
+		return nil
+	case roletemplaterevision.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case roletemplaterevision.FieldRoles:
+		v, ok := value.([]schema.RevisionRole)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoles(v)
+		return nil
+	case roletemplaterevision.FieldAction:
+		v, ok := value.(roletemplaterevision.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case roletemplaterevision.FieldSourceNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceNumber(v)
+		return nil
+	case roletemplaterevision.FieldAuthorKind:
+		v, ok := value.(roletemplaterevision.AuthorKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorKind(v)
+		return nil
+	case roletemplaterevision.FieldAuthorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case roletemplaterevision.FieldAuthorName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorName(v)
+		return nil
+	case roletemplaterevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplateRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleTemplateRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber != nil {
+		fields = append(fields, roletemplaterevision.FieldNumber)
+	}
+	if m.addplayer_count != nil {
+		fields = append(fields, roletemplaterevision.FieldPlayerCount)
+	}
+	if m.addsource_number != nil {
+		fields = append(fields, roletemplaterevision.FieldSourceNumber)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleTemplateRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roletemplaterevision.FieldNumber:
+		return m.AddedNumber()
+	case roletemplaterevision.FieldPlayerCount:
+		return m.AddedPlayerCount()
+	case roletemplaterevision.FieldSourceNumber:
+		return m.AddedSourceNumber()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleTemplateRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case roletemplaterevision.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
+	case roletemplaterevision.FieldPlayerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayerCount(v)
+		return nil
+	case roletemplaterevision.FieldSourceNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSourceNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplateRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleTemplateRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(roletemplaterevision.FieldDescription) {
+		fields = append(fields, roletemplaterevision.FieldDescription)
+	}
+	if m.FieldCleared(roletemplaterevision.FieldSourceNumber) {
+		fields = append(fields, roletemplaterevision.FieldSourceNumber)
+	}
+	if m.FieldCleared(roletemplaterevision.FieldAuthorID) {
+		fields = append(fields, roletemplaterevision.FieldAuthorID)
+	}
+	if m.FieldCleared(roletemplaterevision.FieldAuthorName) {
+		fields = append(fields, roletemplaterevision.FieldAuthorName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleTemplateRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleTemplateRevisionMutation) ClearField(name string) error {
+	switch name {
+	case roletemplaterevision.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case roletemplaterevision.FieldSourceNumber:
+		m.ClearSourceNumber()
+		return nil
+	case roletemplaterevision.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case roletemplaterevision.FieldAuthorName:
+		m.ClearAuthorName()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplateRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleTemplateRevisionMutation) ResetField(name string) error {
+	switch name {
+	case roletemplaterevision.FieldRoleTemplateID:
+		m.ResetRoleTemplateID()
+		return nil
+	case roletemplaterevision.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case roletemplaterevision.FieldName:
+		m.ResetName()
+		return nil
+	case roletemplaterevision.FieldPlayerCount:
+		m.ResetPlayerCount()
+		return nil
+	case roletemplaterevision.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case roletemplaterevision.FieldRoles:
+		m.ResetRoles()
+		return nil
+	case roletemplaterevision.FieldAction:
+		m.ResetAction()
+		return nil
+	case roletemplaterevision.FieldSourceNumber:
+		m.ResetSourceNumber()
+		return nil
+	case roletemplaterevision.FieldAuthorKind:
+		m.ResetAuthorKind()
+		return nil
+	case roletemplaterevision.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case roletemplaterevision.FieldAuthorName:
+		m.ResetAuthorName()
+		return nil
+	case roletemplaterevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplateRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleTemplateRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.role_template != nil {
+		edges = append(edges, roletemplaterevision.EdgeRoleTemplate)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleTemplateRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roletemplaterevision.EdgeRoleTemplate:
+		if id := m.role_template; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleTemplateRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleTemplateRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleTemplateRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrole_template {
+		edges = append(edges, roletemplaterevision.EdgeRoleTemplate)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleTemplateRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roletemplaterevision.EdgeRoleTemplate:
+		return m.clearedrole_template
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleTemplateRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case roletemplaterevision.EdgeRoleTemplate:
+		m.ClearRoleTemplate()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplateRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleTemplateRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case roletemplaterevision.EdgeRoleTemplate:
+		m.ResetRoleTemplate()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleTemplateRevision edge %s", name)
+}
+
+// RoleTemplateRoleMutation represents an operation that mutates the RoleTemplateRole nodes in the graph.
+type RoleTemplateRoleMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	count                *int
+	addcount             *int
+	clearedFields        map[string]struct{}
+	role_template        *uuid.UUID
+	clearedrole_template bool
+	role                 *uuid.UUID
+	clearedrole          bool
+	done                 bool
+	oldValue             func(context.Context) (*RoleTemplateRole, error)
+	predicates           []predicate.RoleTemplateRole
+}
+
+var _ ent.Mutation = (*RoleTemplateRoleMutation)(nil)
+
+// roletemplateroleOption allows management of the mutation configuration using functional options.
+type roletemplateroleOption func(*RoleTemplateRoleMutation)
+
+// newRoleTemplateRoleMutation creates new mutation for the RoleTemplateRole entity.
+func newRoleTemplateRoleMutation(c config, op Op, opts ...roletemplateroleOption) *RoleTemplateRoleMutation {
+	m := &RoleTemplateRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleTemplateRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleTemplateRoleID sets the ID field of the mutation.
+func withRoleTemplateRoleID(id int) roletemplateroleOption {
+	return func(m *RoleTemplateRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleTemplateRole
+		)
+		m.oldValue = func(ctx context.Context) (*RoleTemplateRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleTemplateRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleTemplateRole sets the old RoleTemplateRole of the mutation.
+func withRoleTemplateRole(node *RoleTemplateRole) roletemplateroleOption {
+	return func(m *RoleTemplateRoleMutation) {
+		m.oldValue = func(context.Context) (*RoleTemplateRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleTemplateRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleTemplateRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleTemplateRoleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleTemplateRoleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleTemplateRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (m *RoleTemplateRoleMutation) SetRoleTemplateID(u uuid.UUID) {
+	m.role_template = &u
+}
+
+// RoleTemplateID returns the value of the "role_template_id" field in the mutation.
+func (m *RoleTemplateRoleMutation) RoleTemplateID() (r uuid.UUID, exists bool) {
+	v := m.role_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleTemplateID returns the old "role_template_id" field's value of the RoleTemplateRole entity.
+// If the RoleTemplateRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRoleMutation) OldRoleTemplateID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleTemplateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleTemplateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleTemplateID: %w", err)
+	}
+	return oldValue.RoleTemplateID, nil
+}
+
+// ResetRoleTemplateID resets all changes to the "role_template_id" field.
+func (m *RoleTemplateRoleMutation) ResetRoleTemplateID() {
+	m.role_template = nil
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *RoleTemplateRoleMutation) SetRoleID(u uuid.UUID) {
+	m.role = &u
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RoleTemplateRoleMutation) RoleID() (r uuid.UUID, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the RoleTemplateRole entity.
+// If the RoleTemplateRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRoleMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RoleTemplateRoleMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetCount sets the "count" field.
+func (m *RoleTemplateRoleMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *RoleTemplateRoleMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the RoleTemplateRole entity.
+// If the RoleTemplateRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleTemplateRoleMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *RoleTemplateRoleMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *RoleTemplateRoleMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *RoleTemplateRoleMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (m *RoleTemplateRoleMutation) ClearRoleTemplate() {
+	m.clearedrole_template = true
+	m.clearedFields[roletemplaterole.FieldRoleTemplateID] = struct{}{}
+}
+
+// RoleTemplateCleared reports if the "role_template" edge to the RoleTemplate entity was cleared.
+func (m *RoleTemplateRoleMutation) RoleTemplateCleared() bool {
+	return m.clearedrole_template
+}
+
+// RoleTemplateIDs returns the "role_template" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleTemplateID instead. It exists only for internal usage by the builders.
+func (m *RoleTemplateRoleMutation) RoleTemplateIDs() (ids []uuid.UUID) {
+	if id := m.role_template; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoleTemplate resets all changes to the "role_template" edge.
+func (m *RoleTemplateRoleMutation) ResetRoleTemplate() {
+	m.role_template = nil
+	m.clearedrole_template = false
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (m *RoleTemplateRoleMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[roletemplaterole.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Role entity was cleared.
+func (m *RoleTemplateRoleMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *RoleTemplateRoleMutation) RoleIDs() (ids []uuid.UUID) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *RoleTemplateRoleMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// Where appends a list predicates to the RoleTemplateRoleMutation builder.
+func (m *RoleTemplateRoleMutation) Where(ps ...predicate.RoleTemplateRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleTemplateRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleTemplateRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleTemplateRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleTemplateRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleTemplateRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleTemplateRole).
+func (m *RoleTemplateRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleTemplateRoleMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.role_template != nil {
+		fields = append(fields, roletemplaterole.FieldRoleTemplateID)
+	}
+	if m.role != nil {
+		fields = append(fields, roletemplaterole.FieldRoleID)
+	}
+	if m.count != nil {
+		fields = append(fields, roletemplaterole.FieldCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleTemplateRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roletemplaterole.FieldRoleTemplateID:
+		return m.RoleTemplateID()
+	case roletemplaterole.FieldRoleID:
+		return m.RoleID()
+	case roletemplaterole.FieldCount:
+		return m.Count()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleTemplateRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roletemplaterole.FieldRoleTemplateID:
+		return m.OldRoleTemplateID(ctx)
+	case roletemplaterole.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case roletemplaterole.FieldCount:
+		return m.OldCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleTemplateRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleTemplateRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roletemplaterole.FieldRoleTemplateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleTemplateID(v)
+		return nil
+	case roletemplaterole.FieldRoleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case roletemplaterole.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Game field %s", name)
+	return fmt.Errorf("unknown RoleTemplateRole field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GameMutation) AddedFields() []string {
-	return nil
+func (m *RoleTemplateRoleMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, roletemplaterole.FieldCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RoleTemplateRoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roletemplaterole.FieldCount:
+		return m.AddedCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameMutation) AddField(name string, value ent.Value) error {
+func (m *RoleTemplateRoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case roletemplaterole.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Game numeric field %s", name)
+	return fmt.Errorf("unknown RoleTemplateRole numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GameMutation) ClearedFields() []string {
+func (m *RoleTemplateRoleMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GameMutation) FieldCleared(name string) bool {
+func (m *RoleTemplateRoleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GameMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Game nullable field %s", name)
+func (m *RoleTemplateRoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoleTemplateRole nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GameMutation) ResetField(name string) error {
+func (m *RoleTemplateRoleMutation) ResetField(name string) error {
 	switch name {
-	case game.FieldStatus:
-		m.ResetStatus()
+	case roletemplaterole.FieldRoleTemplateID:
+		m.ResetRoleTemplateID()
 		return nil
-	case game.FieldModeratorID:
-		m.ResetModeratorID()
+	case roletemplaterole.FieldRoleID:
+		m.ResetRoleID()
 		return nil
-	case game.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case roletemplaterole.FieldCount:
+		m.ResetCount()
 		return nil
 	}
-	return fmt.Errorf("unknown Game field %s", name)
+	return fmt.Errorf("unknown RoleTemplateRole field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GameMutation) AddedEdges() []string {
+func (m *RoleTemplateRoleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.players != nil {
-		edges = append(edges, game.EdgePlayers)
+	if m.role_template != nil {
+		edges = append(edges, roletemplaterole.EdgeRoleTemplate)
 	}
-	if m.game_roles != nil {
-		edges = append(edges, game.EdgeGameRoles)
+	if m.role != nil {
+		edges = append(edges, roletemplaterole.EdgeRole)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GameMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleTemplateRoleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case game.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.players))
-		for id := range m.players {
-			ids = append(ids, id)
+	case roletemplaterole.EdgeRoleTemplate:
+		if id := m.role_template; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case game.EdgeGameRoles:
-		ids := make([]ent.Value, 0, len(m.game_roles))
-		for id := range m.game_roles {
-			ids = append(ids, id)
+	case roletemplaterole.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GameMutation) RemovedEdges() []string {
+func (m *RoleTemplateRoleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedplayers != nil {
-		edges = append(edges, game.EdgePlayers)
-	}
-	if m.removedgame_roles != nil {
-		edges = append(edges, game.EdgeGameRoles)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GameMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case game.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.removedplayers))
-		for id := range m.removedplayers {
-			ids = append(ids, id)
-		}
-		return ids
-	case game.EdgeGameRoles:
-		ids := make([]ent.Value, 0, len(m.removedgame_roles))
-		for id := range m.removedgame_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RoleTemplateRoleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GameMutation) ClearedEdges() []string {
+func (m *RoleTemplateRoleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedplayers {
-		edges = append(edges, game.EdgePlayers)
+	if m.clearedrole_template {
+		edges = append(edges, roletemplaterole.EdgeRoleTemplate)
 	}
-	if m.clearedgame_roles {
-		edges = append(edges, game.EdgeGameRoles)
+	if m.clearedrole {
+		edges = append(edges, roletemplaterole.EdgeRole)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GameMutation) EdgeCleared(name string) bool {
+func (m *RoleTemplateRoleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case game.EdgePlayers:
-		return m.clearedplayers
-	case game.EdgeGameRoles:
-		return m.clearedgame_roles
+	case roletemplaterole.EdgeRoleTemplate:
+		return m.clearedrole_template
+	case roletemplaterole.EdgeRole:
+		return m.clearedrole
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GameMutation) ClearEdge(name string) error {
+func (m *RoleTemplateRoleMutation) ClearEdge(name string) error {
 	switch name {
+	case roletemplaterole.EdgeRoleTemplate:
+		m.ClearRoleTemplate()
+		return nil
+	case roletemplaterole.EdgeRole:
+		m.ClearRole()
+		return nil
 	}
-	return fmt.Errorf("unknown Game unique edge %s", name)
+	return fmt.Errorf("unknown RoleTemplateRole unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GameMutation) ResetEdge(name string) error {
+func (m *RoleTemplateRoleMutation) ResetEdge(name string) error {
 	switch name {
-	case game.EdgePlayers:
-		m.ResetPlayers()
+	case roletemplaterole.EdgeRoleTemplate:
+		m.ResetRoleTemplate()
 		return nil
-	case game.EdgeGameRoles:
-		m.ResetGameRoles()
+	case roletemplaterole.EdgeRole:
+		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown Game edge %s", name)
+	return fmt.Errorf("unknown RoleTemplateRole edge %s", name)
 }
 
-// GameRoleMutation represents an operation that mutates the GameRole nodes in the graph.
-type GameRoleMutation struct {
+// RoleTemplateSlotMutation represents an operation that mutates the RoleTemplateSlot nodes in the graph.
+type RoleTemplateSlotMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	assigned_at   *time.Time
-	clearedFields map[string]struct{}
-	game          *string
-	clearedgame   bool
-	player        *uuid.UUID
-	clearedplayer bool
-	role          *uuid.UUID
-	clearedrole   bool
-	done          bool
-	oldValue      func(context.Context) (*GameRole, error)
-	predicates    []predicate.GameRole
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	position             *int
+	addposition          *int
+	clearedFields        map[string]struct{}
+	role_template        *uuid.UUID
+	clearedrole_template bool
+	role                 *uuid.UUID
+	clearedrole          bool
+	done                 bool
+	oldValue             func(context.Context) (*RoleTemplateSlot, error)
+	predicates           []predicate.RoleTemplateSlot
 }
 
-var _ ent.Mutation = (*GameRoleMutation)(nil)
+var _ ent.Mutation = (*RoleTemplateSlotMutation)(nil)
 
-// gameroleOption allows management of the mutation configuration using functional options.
-type gameroleOption func(*GameRoleMutation)
+// roletemplateslotOption allows management of the mutation configuration using functional options.
+type roletemplateslotOption func(*RoleTemplateSlotMutation)
 
-// newGameRoleMutation creates new mutation for the GameRole entity.
-func newGameRoleMutation(c config, op Op, opts ...gameroleOption) *GameRoleMutation {
-	m := &GameRoleMutation{
+// newRoleTemplateSlotMutation creates new mutation for the RoleTemplateSlot entity.
+func newRoleTemplateSlotMutation(c config, op Op, opts ...roletemplateslotOption) *RoleTemplateSlotMutation {
+	m := &RoleTemplateSlotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGameRole,
+		typ:           TypeRoleTemplateSlot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1372,20 +11468,20 @@ func newGameRoleMutation(c config, op Op, opts ...gameroleOption) *GameRoleMutat
 	return m
 }
 
-// withGameRoleID sets the ID field of the mutation.
-func withGameRoleID(id int) gameroleOption {
-	return func(m *GameRoleMutation) {
+// withRoleTemplateSlotID sets the ID field of the mutation.
+func withRoleTemplateSlotID(id uuid.UUID) roletemplateslotOption {
+	return func(m *RoleTemplateSlotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GameRole
+			value *RoleTemplateSlot
 		)
-		m.oldValue = func(ctx context.Context) (*GameRole, error) {
+		m.oldValue = func(ctx context.Context) (*RoleTemplateSlot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GameRole.Get(ctx, id)
+					value, err = m.Client().RoleTemplateSlot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1394,10 +11490,10 @@ func withGameRoleID(id int) gameroleOption {
 	}
 }
 
-// withGameRole sets the old GameRole of the mutation.
-func withGameRole(node *GameRole) gameroleOption {
-	return func(m *GameRoleMutation) {
-		m.oldValue = func(context.Context) (*GameRole, error) {
+// withRoleTemplateSlot sets the old RoleTemplateSlot of the mutation.
+func withRoleTemplateSlot(node *RoleTemplateSlot) roletemplateslotOption {
+	return func(m *RoleTemplateSlotMutation) {
+		m.oldValue = func(context.Context) (*RoleTemplateSlot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1406,7 +11502,7 @@ func withGameRole(node *GameRole) gameroleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GameRoleMutation) Client() *Client {
+func (m RoleTemplateSlotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1414,7 +11510,7 @@ func (m GameRoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GameRoleMutation) Tx() (*Tx, error) {
+func (m RoleTemplateSlotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1423,9 +11519,15 @@ func (m GameRoleMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleTemplateSlot entities.
+func (m *RoleTemplateSlotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GameRoleMutation) ID() (id int, exists bool) {
+func (m *RoleTemplateSlotMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1436,100 +11538,64 @@ func (m *GameRoleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GameRoleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RoleTemplateSlotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GameRole.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RoleTemplateSlot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetGameID sets the "game_id" field.
-func (m *GameRoleMutation) SetGameID(s string) {
-	m.game = &s
-}
-
-// GameID returns the value of the "game_id" field in the mutation.
-func (m *GameRoleMutation) GameID() (r string, exists bool) {
-	v := m.game
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldGameID returns the old "game_id" field's value of the GameRole entity.
-// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameRoleMutation) OldGameID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGameID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
-	}
-	return oldValue.GameID, nil
-}
-
-// ResetGameID resets all changes to the "game_id" field.
-func (m *GameRoleMutation) ResetGameID() {
-	m.game = nil
-}
-
-// SetPlayerID sets the "player_id" field.
-func (m *GameRoleMutation) SetPlayerID(u uuid.UUID) {
-	m.player = &u
+// SetRoleTemplateID sets the "role_template_id" field.
+func (m *RoleTemplateSlotMutation) SetRoleTemplateID(u uuid.UUID) {
+	m.role_template = &u
 }
 
-// PlayerID returns the value of the "player_id" field in the mutation.
-func (m *GameRoleMutation) PlayerID() (r uuid.UUID, exists bool) {
-	v := m.player
+// RoleTemplateID returns the value of the "role_template_id" field in the mutation.
+func (m *RoleTemplateSlotMutation) RoleTemplateID() (r uuid.UUID, exists bool) {
+	v := m.role_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlayerID returns the old "player_id" field's value of the GameRole entity.
-// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleTemplateID returns the old "role_template_id" field's value of the RoleTemplateSlot entity.
+// If the RoleTemplateSlot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameRoleMutation) OldPlayerID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *RoleTemplateSlotMutation) OldRoleTemplateID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlayerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoleTemplateID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlayerID requires an ID field in the mutation")
+		return v, errors.New("OldRoleTemplateID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlayerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoleTemplateID: %w", err)
 	}
-	return oldValue.PlayerID, nil
+	return oldValue.RoleTemplateID, nil
 }
 
-// ResetPlayerID resets all changes to the "player_id" field.
-func (m *GameRoleMutation) ResetPlayerID() {
-	m.player = nil
+// ResetRoleTemplateID resets all changes to the "role_template_id" field.
+func (m *RoleTemplateSlotMutation) ResetRoleTemplateID() {
+	m.role_template = nil
 }
 
 // SetRoleID sets the "role_id" field.
-func (m *GameRoleMutation) SetRoleID(u uuid.UUID) {
+func (m *RoleTemplateSlotMutation) SetRoleID(u uuid.UUID) {
 	m.role = &u
 }
 
 // RoleID returns the value of the "role_id" field in the mutation.
-func (m *GameRoleMutation) RoleID() (r uuid.UUID, exists bool) {
+func (m *RoleTemplateSlotMutation) RoleID() (r uuid.UUID, exists bool) {
 	v := m.role
 	if v == nil {
 		return
@@ -1537,10 +11603,10 @@ func (m *GameRoleMutation) RoleID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldRoleID returns the old "role_id" field's value of the GameRole entity.
-// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleID returns the old "role_id" field's value of the RoleTemplateSlot entity.
+// If the RoleTemplateSlot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameRoleMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *RoleTemplateSlotMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
 	}
@@ -1555,115 +11621,108 @@ func (m *GameRoleMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err erro
 }
 
 // ResetRoleID resets all changes to the "role_id" field.
-func (m *GameRoleMutation) ResetRoleID() {
+func (m *RoleTemplateSlotMutation) ResetRoleID() {
 	m.role = nil
 }
 
-// SetAssignedAt sets the "assigned_at" field.
-func (m *GameRoleMutation) SetAssignedAt(t time.Time) {
-	m.assigned_at = &t
+// SetPosition sets the "position" field.
+func (m *RoleTemplateSlotMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// AssignedAt returns the value of the "assigned_at" field in the mutation.
-func (m *GameRoleMutation) AssignedAt() (r time.Time, exists bool) {
-	v := m.assigned_at
+// Position returns the value of the "position" field in the mutation.
+func (m *RoleTemplateSlotMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignedAt returns the old "assigned_at" field's value of the GameRole entity.
-// If the GameRole object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the RoleTemplateSlot entity.
+// If the RoleTemplateSlot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameRoleMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RoleTemplateSlotMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.AssignedAt, nil
-}
-
-// ResetAssignedAt resets all changes to the "assigned_at" field.
-func (m *GameRoleMutation) ResetAssignedAt() {
-	m.assigned_at = nil
-}
-
-// ClearGame clears the "game" edge to the Game entity.
-func (m *GameRoleMutation) ClearGame() {
-	m.clearedgame = true
-	m.clearedFields[gamerole.FieldGameID] = struct{}{}
+	return oldValue.Position, nil
 }
 
-// GameCleared reports if the "game" edge to the Game entity was cleared.
-func (m *GameRoleMutation) GameCleared() bool {
-	return m.clearedgame
+// AddPosition adds i to the "position" field.
+func (m *RoleTemplateSlotMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// GameIDs returns the "game" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GameID instead. It exists only for internal usage by the builders.
-func (m *GameRoleMutation) GameIDs() (ids []string) {
-	if id := m.game; id != nil {
-		ids = append(ids, *id)
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *RoleTemplateSlotMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetGame resets all changes to the "game" edge.
-func (m *GameRoleMutation) ResetGame() {
-	m.game = nil
-	m.clearedgame = false
+// ResetPosition resets all changes to the "position" field.
+func (m *RoleTemplateSlotMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// ClearPlayer clears the "player" edge to the Player entity.
-func (m *GameRoleMutation) ClearPlayer() {
-	m.clearedplayer = true
-	m.clearedFields[gamerole.FieldPlayerID] = struct{}{}
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (m *RoleTemplateSlotMutation) ClearRoleTemplate() {
+	m.clearedrole_template = true
+	m.clearedFields[roletemplateslot.FieldRoleTemplateID] = struct{}{}
 }
 
-// PlayerCleared reports if the "player" edge to the Player entity was cleared.
-func (m *GameRoleMutation) PlayerCleared() bool {
-	return m.clearedplayer
+// RoleTemplateCleared reports if the "role_template" edge to the RoleTemplate entity was cleared.
+func (m *RoleTemplateSlotMutation) RoleTemplateCleared() bool {
+	return m.clearedrole_template
 }
 
-// PlayerIDs returns the "player" edge IDs in the mutation.
+// RoleTemplateIDs returns the "role_template" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PlayerID instead. It exists only for internal usage by the builders.
-func (m *GameRoleMutation) PlayerIDs() (ids []uuid.UUID) {
-	if id := m.player; id != nil {
+// RoleTemplateID instead. It exists only for internal usage by the builders.
+func (m *RoleTemplateSlotMutation) RoleTemplateIDs() (ids []uuid.UUID) {
+	if id := m.role_template; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPlayer resets all changes to the "player" edge.
-func (m *GameRoleMutation) ResetPlayer() {
-	m.player = nil
-	m.clearedplayer = false
+// ResetRoleTemplate resets all changes to the "role_template" edge.
+func (m *RoleTemplateSlotMutation) ResetRoleTemplate() {
+	m.role_template = nil
+	m.clearedrole_template = false
 }
 
 // ClearRole clears the "role" edge to the Role entity.
-func (m *GameRoleMutation) ClearRole() {
+func (m *RoleTemplateSlotMutation) ClearRole() {
 	m.clearedrole = true
-	m.clearedFields[gamerole.FieldRoleID] = struct{}{}
+	m.clearedFields[roletemplateslot.FieldRoleID] = struct{}{}
 }
 
 // RoleCleared reports if the "role" edge to the Role entity was cleared.
-func (m *GameRoleMutation) RoleCleared() bool {
+func (m *RoleTemplateSlotMutation) RoleCleared() bool {
 	return m.clearedrole
 }
 
 // RoleIDs returns the "role" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // RoleID instead. It exists only for internal usage by the builders.
-func (m *GameRoleMutation) RoleIDs() (ids []uuid.UUID) {
+func (m *RoleTemplateSlotMutation) RoleIDs() (ids []uuid.UUID) {
 	if id := m.role; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1671,20 +11730,20 @@ func (m *GameRoleMutation) RoleIDs() (ids []uuid.UUID) {
 }
 
 // ResetRole resets all changes to the "role" edge.
-func (m *GameRoleMutation) ResetRole() {
+func (m *RoleTemplateSlotMutation) ResetRole() {
 	m.role = nil
 	m.clearedrole = false
 }
 
-// Where appends a list predicates to the GameRoleMutation builder.
-func (m *GameRoleMutation) Where(ps ...predicate.GameRole) {
+// Where appends a list predicates to the RoleTemplateSlotMutation builder.
+func (m *RoleTemplateSlotMutation) Where(ps ...predicate.RoleTemplateSlot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GameRoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RoleTemplateSlotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GameRoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GameRole, len(ps))
+func (m *RoleTemplateSlotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleTemplateSlot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1692,36 +11751,33 @@ func (m *GameRoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GameRoleMutation) Op() Op {
+func (m *RoleTemplateSlotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GameRoleMutation) SetOp(op Op) {
+func (m *RoleTemplateSlotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GameRole).
-func (m *GameRoleMutation) Type() string {
+// Type returns the node type of this mutation (RoleTemplateSlot).
+func (m *RoleTemplateSlotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GameRoleMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.game != nil {
-		fields = append(fields, gamerole.FieldGameID)
-	}
-	if m.player != nil {
-		fields = append(fields, gamerole.FieldPlayerID)
+func (m *RoleTemplateSlotMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.role_template != nil {
+		fields = append(fields, roletemplateslot.FieldRoleTemplateID)
 	}
 	if m.role != nil {
-		fields = append(fields, gamerole.FieldRoleID)
+		fields = append(fields, roletemplateslot.FieldRoleID)
 	}
-	if m.assigned_at != nil {
-		fields = append(fields, gamerole.FieldAssignedAt)
+	if m.position != nil {
+		fields = append(fields, roletemplateslot.FieldPosition)
 	}
 	return fields
 }
@@ -1729,16 +11785,14 @@ func (m *GameRoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GameRoleMutation) Field(name string) (ent.Value, bool) {
+func (m *RoleTemplateSlotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case gamerole.FieldGameID:
-		return m.GameID()
-	case gamerole.FieldPlayerID:
-		return m.PlayerID()
-	case gamerole.FieldRoleID:
+	case roletemplateslot.FieldRoleTemplateID:
+		return m.RoleTemplateID()
+	case roletemplateslot.FieldRoleID:
 		return m.RoleID()
-	case gamerole.FieldAssignedAt:
-		return m.AssignedAt()
+	case roletemplateslot.FieldPosition:
+		return m.Position()
 	}
 	return nil, false
 }
@@ -1746,146 +11800,142 @@ func (m *GameRoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GameRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleTemplateSlotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case gamerole.FieldGameID:
-		return m.OldGameID(ctx)
-	case gamerole.FieldPlayerID:
-		return m.OldPlayerID(ctx)
-	case gamerole.FieldRoleID:
+	case roletemplateslot.FieldRoleTemplateID:
+		return m.OldRoleTemplateID(ctx)
+	case roletemplateslot.FieldRoleID:
 		return m.OldRoleID(ctx)
-	case gamerole.FieldAssignedAt:
-		return m.OldAssignedAt(ctx)
+	case roletemplateslot.FieldPosition:
+		return m.OldPosition(ctx)
 	}
-	return nil, fmt.Errorf("unknown GameRole field %s", name)
+	return nil, fmt.Errorf("unknown RoleTemplateSlot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameRoleMutation) SetField(name string, value ent.Value) error {
+func (m *RoleTemplateSlotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case gamerole.FieldGameID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGameID(v)
-		return nil
-	case gamerole.FieldPlayerID:
+	case roletemplateslot.FieldRoleTemplateID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlayerID(v)
+		m.SetRoleTemplateID(v)
 		return nil
-	case gamerole.FieldRoleID:
+	case roletemplateslot.FieldRoleID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetRoleID(v)
 		return nil
-	case gamerole.FieldAssignedAt:
-		v, ok := value.(time.Time)
+	case roletemplateslot.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssignedAt(v)
+		m.SetPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GameRole field %s", name)
+	return fmt.Errorf("unknown RoleTemplateSlot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GameRoleMutation) AddedFields() []string {
-	return nil
+func (m *RoleTemplateSlotMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, roletemplateslot.FieldPosition)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GameRoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RoleTemplateSlotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roletemplateslot.FieldPosition:
+		return m.AddedPosition()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameRoleMutation) AddField(name string, value ent.Value) error {
+func (m *RoleTemplateSlotMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case roletemplateslot.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
 	}
-	return fmt.Errorf("unknown GameRole numeric field %s", name)
+	return fmt.Errorf("unknown RoleTemplateSlot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GameRoleMutation) ClearedFields() []string {
+func (m *RoleTemplateSlotMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GameRoleMutation) FieldCleared(name string) bool {
+func (m *RoleTemplateSlotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GameRoleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown GameRole nullable field %s", name)
+func (m *RoleTemplateSlotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoleTemplateSlot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GameRoleMutation) ResetField(name string) error {
+func (m *RoleTemplateSlotMutation) ResetField(name string) error {
 	switch name {
-	case gamerole.FieldGameID:
-		m.ResetGameID()
-		return nil
-	case gamerole.FieldPlayerID:
-		m.ResetPlayerID()
+	case roletemplateslot.FieldRoleTemplateID:
+		m.ResetRoleTemplateID()
 		return nil
-	case gamerole.FieldRoleID:
+	case roletemplateslot.FieldRoleID:
 		m.ResetRoleID()
 		return nil
-	case gamerole.FieldAssignedAt:
-		m.ResetAssignedAt()
+	case roletemplateslot.FieldPosition:
+		m.ResetPosition()
 		return nil
 	}
-	return fmt.Errorf("unknown GameRole field %s", name)
+	return fmt.Errorf("unknown RoleTemplateSlot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GameRoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.game != nil {
-		edges = append(edges, gamerole.EdgeGame)
-	}
-	if m.player != nil {
-		edges = append(edges, gamerole.EdgePlayer)
+func (m *RoleTemplateSlotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.role_template != nil {
+		edges = append(edges, roletemplateslot.EdgeRoleTemplate)
 	}
 	if m.role != nil {
-		edges = append(edges, gamerole.EdgeRole)
+		edges = append(edges, roletemplateslot.EdgeRole)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GameRoleMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleTemplateSlotMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case gamerole.EdgeGame:
-		if id := m.game; id != nil {
-			return []ent.Value{*id}
-		}
-	case gamerole.EdgePlayer:
-		if id := m.player; id != nil {
+	case roletemplateslot.EdgeRoleTemplate:
+		if id := m.role_template; id != nil {
 			return []ent.Value{*id}
 		}
-	case gamerole.EdgeRole:
+	case roletemplateslot.EdgeRole:
 		if id := m.role; id != nil {
 			return []ent.Value{*id}
 		}
@@ -1894,41 +11944,36 @@ func (m *GameRoleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GameRoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *RoleTemplateSlotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GameRoleMutation) RemovedIDs(name string) []ent.Value {
+func (m *RoleTemplateSlotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GameRoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedgame {
-		edges = append(edges, gamerole.EdgeGame)
-	}
-	if m.clearedplayer {
-		edges = append(edges, gamerole.EdgePlayer)
+func (m *RoleTemplateSlotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrole_template {
+		edges = append(edges, roletemplateslot.EdgeRoleTemplate)
 	}
 	if m.clearedrole {
-		edges = append(edges, gamerole.EdgeRole)
+		edges = append(edges, roletemplateslot.EdgeRole)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GameRoleMutation) EdgeCleared(name string) bool {
+func (m *RoleTemplateSlotMutation) EdgeCleared(name string) bool {
 	switch name {
-	case gamerole.EdgeGame:
-		return m.clearedgame
-	case gamerole.EdgePlayer:
-		return m.clearedplayer
-	case gamerole.EdgeRole:
+	case roletemplateslot.EdgeRoleTemplate:
+		return m.clearedrole_template
+	case roletemplateslot.EdgeRole:
 		return m.clearedrole
 	}
 	return false
@@ -1936,67 +11981,59 @@ func (m *GameRoleMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GameRoleMutation) ClearEdge(name string) error {
+func (m *RoleTemplateSlotMutation) ClearEdge(name string) error {
 	switch name {
-	case gamerole.EdgeGame:
-		m.ClearGame()
-		return nil
-	case gamerole.EdgePlayer:
-		m.ClearPlayer()
+	case roletemplateslot.EdgeRoleTemplate:
+		m.ClearRoleTemplate()
 		return nil
-	case gamerole.EdgeRole:
+	case roletemplateslot.EdgeRole:
 		m.ClearRole()
 		return nil
 	}
-	return fmt.Errorf("unknown GameRole unique edge %s", name)
+	return fmt.Errorf("unknown RoleTemplateSlot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GameRoleMutation) ResetEdge(name string) error {
+func (m *RoleTemplateSlotMutation) ResetEdge(name string) error {
 	switch name {
-	case gamerole.EdgeGame:
-		m.ResetGame()
-		return nil
-	case gamerole.EdgePlayer:
-		m.ResetPlayer()
+	case roletemplateslot.EdgeRoleTemplate:
+		m.ResetRoleTemplate()
 		return nil
-	case gamerole.EdgeRole:
+	case roletemplateslot.EdgeRole:
 		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown GameRole edge %s", name)
+	return fmt.Errorf("unknown RoleTemplateSlot edge %s", name)
 }
 
-// PlayerMutation represents an operation that mutates the Player nodes in the graph.
-type PlayerMutation struct {
+// RoleTemplateTranslationMutation represents an operation that mutates the RoleTemplateTranslation nodes in the graph.
+type RoleTemplateTranslationMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	name             *string
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	game             *string
-	clearedgame      bool
-	game_role        *int
-	clearedgame_role bool
-	done             bool
-	oldValue         func(context.Context) (*Player, error)
-	predicates       []predicate.Player
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	locale               *string
+	description          *string
+	clearedFields        map[string]struct{}
+	role_template        *uuid.UUID
+	clearedrole_template bool
+	done                 bool
+	oldValue             func(context.Context) (*RoleTemplateTranslation, error)
+	predicates           []predicate.RoleTemplateTranslation
 }
 
-var _ ent.Mutation = (*PlayerMutation)(nil)
-
-// playerOption allows management of the mutation configuration using functional options.
-type playerOption func(*PlayerMutation)
+var _ ent.Mutation = (*RoleTemplateTranslationMutation)(nil)
 
-// newPlayerMutation creates new mutation for the Player entity.
-func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
-	m := &PlayerMutation{
+// roletemplatetranslationOption allows management of the mutation configuration using functional options.
+type roletemplatetranslationOption func(*RoleTemplateTranslationMutation)
+
+// newRoleTemplateTranslationMutation creates new mutation for the RoleTemplateTranslation entity.
+func newRoleTemplateTranslationMutation(c config, op Op, opts ...roletemplatetranslationOption) *RoleTemplateTranslationMutation {
+	m := &RoleTemplateTranslationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlayer,
+		typ:           TypeRoleTemplateTranslation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2005,20 +12042,20 @@ func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
 	return m
 }
 
-// withPlayerID sets the ID field of the mutation.
-func withPlayerID(id uuid.UUID) playerOption {
-	return func(m *PlayerMutation) {
+// withRoleTemplateTranslationID sets the ID field of the mutation.
+func withRoleTemplateTranslationID(id uuid.UUID) roletemplatetranslationOption {
+	return func(m *RoleTemplateTranslationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Player
+			value *RoleTemplateTranslation
 		)
-		m.oldValue = func(ctx context.Context) (*Player, error) {
+		m.oldValue = func(ctx context.Context) (*RoleTemplateTranslation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Player.Get(ctx, id)
+					value, err = m.Client().RoleTemplateTranslation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2027,10 +12064,10 @@ func withPlayerID(id uuid.UUID) playerOption {
 	}
 }
 
-// withPlayer sets the old Player of the mutation.
-func withPlayer(node *Player) playerOption {
-	return func(m *PlayerMutation) {
-		m.oldValue = func(context.Context) (*Player, error) {
+// withRoleTemplateTranslation sets the old RoleTemplateTranslation of the mutation.
+func withRoleTemplateTranslation(node *RoleTemplateTranslation) roletemplatetranslationOption {
+	return func(m *RoleTemplateTranslationMutation) {
+		m.oldValue = func(context.Context) (*RoleTemplateTranslation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2039,7 +12076,7 @@ func withPlayer(node *Player) playerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlayerMutation) Client() *Client {
+func (m RoleTemplateTranslationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2047,7 +12084,7 @@ func (m PlayerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PlayerMutation) Tx() (*Tx, error) {
+func (m RoleTemplateTranslationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2057,14 +12094,14 @@ func (m PlayerMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Player entities.
-func (m *PlayerMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of RoleTemplateTranslation entities.
+func (m *RoleTemplateTranslationMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PlayerMutation) ID() (id uuid.UUID, exists bool) {
+func (m *RoleTemplateTranslationMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2075,7 +12112,7 @@ func (m *PlayerMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PlayerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *RoleTemplateTranslationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2084,195 +12121,156 @@ func (m *PlayerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RoleTemplateTranslation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PlayerMutation) SetName(s string) {
-	m.name = &s
+// SetRoleTemplateID sets the "role_template_id" field.
+func (m *RoleTemplateTranslationMutation) SetRoleTemplateID(u uuid.UUID) {
+	m.role_template = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PlayerMutation) Name() (r string, exists bool) {
-	v := m.name
+// RoleTemplateID returns the value of the "role_template_id" field in the mutation.
+func (m *RoleTemplateTranslationMutation) RoleTemplateID() (r uuid.UUID, exists bool) {
+	v := m.role_template
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleTemplateID returns the old "role_template_id" field's value of the RoleTemplateTranslation entity.
+// If the RoleTemplateTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RoleTemplateTranslationMutation) OldRoleTemplateID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoleTemplateID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRoleTemplateID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoleTemplateID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.RoleTemplateID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PlayerMutation) ResetName() {
-	m.name = nil
+// ResetRoleTemplateID resets all changes to the "role_template_id" field.
+func (m *RoleTemplateTranslationMutation) ResetRoleTemplateID() {
+	m.role_template = nil
 }
 
-// SetGameID sets the "game_id" field.
-func (m *PlayerMutation) SetGameID(s string) {
-	m.game = &s
+// SetLocale sets the "locale" field.
+func (m *RoleTemplateTranslationMutation) SetLocale(s string) {
+	m.locale = &s
 }
 
-// GameID returns the value of the "game_id" field in the mutation.
-func (m *PlayerMutation) GameID() (r string, exists bool) {
-	v := m.game
+// Locale returns the value of the "locale" field in the mutation.
+func (m *RoleTemplateTranslationMutation) Locale() (r string, exists bool) {
+	v := m.locale
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGameID returns the old "game_id" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldLocale returns the old "locale" field's value of the RoleTemplateTranslation entity.
+// If the RoleTemplateTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldGameID(ctx context.Context) (v string, err error) {
+func (m *RoleTemplateTranslationMutation) OldLocale(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGameID requires an ID field in the mutation")
+		return v, errors.New("OldLocale requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
 	}
-	return oldValue.GameID, nil
+	return oldValue.Locale, nil
 }
 
-// ResetGameID resets all changes to the "game_id" field.
-func (m *PlayerMutation) ResetGameID() {
-	m.game = nil
+// ResetLocale resets all changes to the "locale" field.
+func (m *RoleTemplateTranslationMutation) ResetLocale() {
+	m.locale = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PlayerMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDescription sets the "description" field.
+func (m *RoleTemplateTranslationMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PlayerMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleTemplateTranslationMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the RoleTemplateTranslation entity.
+// If the RoleTemplateTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RoleTemplateTranslationMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PlayerMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// ClearGame clears the "game" edge to the Game entity.
-func (m *PlayerMutation) ClearGame() {
-	m.clearedgame = true
-	m.clearedFields[player.FieldGameID] = struct{}{}
-}
-
-// GameCleared reports if the "game" edge to the Game entity was cleared.
-func (m *PlayerMutation) GameCleared() bool {
-	return m.clearedgame
-}
-
-// GameIDs returns the "game" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GameID instead. It exists only for internal usage by the builders.
-func (m *PlayerMutation) GameIDs() (ids []string) {
-	if id := m.game; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return
-}
-
-// ResetGame resets all changes to the "game" edge.
-func (m *PlayerMutation) ResetGame() {
-	m.game = nil
-	m.clearedgame = false
-}
-
-// SetGameRoleID sets the "game_role" edge to the GameRole entity by id.
-func (m *PlayerMutation) SetGameRoleID(id int) {
-	m.game_role = &id
+	return oldValue.Description, nil
 }
 
-// ClearGameRole clears the "game_role" edge to the GameRole entity.
-func (m *PlayerMutation) ClearGameRole() {
-	m.clearedgame_role = true
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleTemplateTranslationMutation) ResetDescription() {
+	m.description = nil
 }
 
-// GameRoleCleared reports if the "game_role" edge to the GameRole entity was cleared.
-func (m *PlayerMutation) GameRoleCleared() bool {
-	return m.clearedgame_role
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (m *RoleTemplateTranslationMutation) ClearRoleTemplate() {
+	m.clearedrole_template = true
+	m.clearedFields[roletemplatetranslation.FieldRoleTemplateID] = struct{}{}
 }
 
-// GameRoleID returns the "game_role" edge ID in the mutation.
-func (m *PlayerMutation) GameRoleID() (id int, exists bool) {
-	if m.game_role != nil {
-		return *m.game_role, true
-	}
-	return
+// RoleTemplateCleared reports if the "role_template" edge to the RoleTemplate entity was cleared.
+func (m *RoleTemplateTranslationMutation) RoleTemplateCleared() bool {
+	return m.clearedrole_template
 }
 
-// GameRoleIDs returns the "game_role" edge IDs in the mutation.
+// RoleTemplateIDs returns the "role_template" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GameRoleID instead. It exists only for internal usage by the builders.
-func (m *PlayerMutation) GameRoleIDs() (ids []int) {
-	if id := m.game_role; id != nil {
+// RoleTemplateID instead. It exists only for internal usage by the builders.
+func (m *RoleTemplateTranslationMutation) RoleTemplateIDs() (ids []uuid.UUID) {
+	if id := m.role_template; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetGameRole resets all changes to the "game_role" edge.
-func (m *PlayerMutation) ResetGameRole() {
-	m.game_role = nil
-	m.clearedgame_role = false
+// ResetRoleTemplate resets all changes to the "role_template" edge.
+func (m *RoleTemplateTranslationMutation) ResetRoleTemplate() {
+	m.role_template = nil
+	m.clearedrole_template = false
 }
 
-// Where appends a list predicates to the PlayerMutation builder.
-func (m *PlayerMutation) Where(ps ...predicate.Player) {
+// Where appends a list predicates to the RoleTemplateTranslationMutation builder.
+func (m *RoleTemplateTranslationMutation) Where(ps ...predicate.RoleTemplateTranslation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RoleTemplateTranslationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Player, len(ps))
+func (m *RoleTemplateTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleTemplateTranslation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2280,33 +12278,33 @@ func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PlayerMutation) Op() Op {
+func (m *RoleTemplateTranslationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PlayerMutation) SetOp(op Op) {
+func (m *RoleTemplateTranslationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Player).
-func (m *PlayerMutation) Type() string {
+// Type returns the node type of this mutation (RoleTemplateTranslation).
+func (m *RoleTemplateTranslationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PlayerMutation) Fields() []string {
+func (m *RoleTemplateTranslationMutation) Fields() []string {
 	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, player.FieldName)
+	if m.role_template != nil {
+		fields = append(fields, roletemplatetranslation.FieldRoleTemplateID)
 	}
-	if m.game != nil {
-		fields = append(fields, player.FieldGameID)
+	if m.locale != nil {
+		fields = append(fields, roletemplatetranslation.FieldLocale)
 	}
-	if m.created_at != nil {
-		fields = append(fields, player.FieldCreatedAt)
+	if m.description != nil {
+		fields = append(fields, roletemplatetranslation.FieldDescription)
 	}
 	return fields
 }
@@ -2314,14 +12312,14 @@ func (m *PlayerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+func (m *RoleTemplateTranslationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case player.FieldName:
-		return m.Name()
-	case player.FieldGameID:
-		return m.GameID()
-	case player.FieldCreatedAt:
-		return m.CreatedAt()
+	case roletemplatetranslation.FieldRoleTemplateID:
+		return m.RoleTemplateID()
+	case roletemplatetranslation.FieldLocale:
+		return m.Locale()
+	case roletemplatetranslation.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -2329,128 +12327,121 @@ func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleTemplateTranslationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case player.FieldName:
-		return m.OldName(ctx)
-	case player.FieldGameID:
-		return m.OldGameID(ctx)
-	case player.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case roletemplatetranslation.FieldRoleTemplateID:
+		return m.OldRoleTemplateID(ctx)
+	case roletemplatetranslation.FieldLocale:
+		return m.OldLocale(ctx)
+	case roletemplatetranslation.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown Player field %s", name)
+	return nil, fmt.Errorf("unknown RoleTemplateTranslation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+func (m *RoleTemplateTranslationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case player.FieldName:
-		v, ok := value.(string)
+	case roletemplatetranslation.FieldRoleTemplateID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetRoleTemplateID(v)
 		return nil
-	case player.FieldGameID:
+	case roletemplatetranslation.FieldLocale:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGameID(v)
+		m.SetLocale(v)
 		return nil
-	case player.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case roletemplatetranslation.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown RoleTemplateTranslation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PlayerMutation) AddedFields() []string {
+func (m *RoleTemplateTranslationMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RoleTemplateTranslationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+func (m *RoleTemplateTranslationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Player numeric field %s", name)
+	return fmt.Errorf("unknown RoleTemplateTranslation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlayerMutation) ClearedFields() []string {
+func (m *RoleTemplateTranslationMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlayerMutation) FieldCleared(name string) bool {
+func (m *RoleTemplateTranslationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlayerMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Player nullable field %s", name)
+func (m *RoleTemplateTranslationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoleTemplateTranslation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlayerMutation) ResetField(name string) error {
+func (m *RoleTemplateTranslationMutation) ResetField(name string) error {
 	switch name {
-	case player.FieldName:
-		m.ResetName()
+	case roletemplatetranslation.FieldRoleTemplateID:
+		m.ResetRoleTemplateID()
 		return nil
-	case player.FieldGameID:
-		m.ResetGameID()
+	case roletemplatetranslation.FieldLocale:
+		m.ResetLocale()
 		return nil
-	case player.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case roletemplatetranslation.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown RoleTemplateTranslation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlayerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.game != nil {
-		edges = append(edges, player.EdgeGame)
-	}
-	if m.game_role != nil {
-		edges = append(edges, player.EdgeGameRole)
+func (m *RoleTemplateTranslationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.role_template != nil {
+		edges = append(edges, roletemplatetranslation.EdgeRoleTemplate)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleTemplateTranslationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case player.EdgeGame:
-		if id := m.game; id != nil {
-			return []ent.Value{*id}
-		}
-	case player.EdgeGameRole:
-		if id := m.game_role; id != nil {
+	case roletemplatetranslation.EdgeRoleTemplate:
+		if id := m.role_template; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2458,105 +12449,88 @@ func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlayerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *RoleTemplateTranslationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlayerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgame {
-		edges = append(edges, player.EdgeGame)
-	}
-	if m.clearedgame_role {
-		edges = append(edges, player.EdgeGameRole)
+// the given name in this mutation.
+func (m *RoleTemplateTranslationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleTemplateTranslationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrole_template {
+		edges = append(edges, roletemplatetranslation.EdgeRoleTemplate)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlayerMutation) EdgeCleared(name string) bool {
+func (m *RoleTemplateTranslationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case player.EdgeGame:
-		return m.clearedgame
-	case player.EdgeGameRole:
-		return m.clearedgame_role
+	case roletemplatetranslation.EdgeRoleTemplate:
+		return m.clearedrole_template
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlayerMutation) ClearEdge(name string) error {
+func (m *RoleTemplateTranslationMutation) ClearEdge(name string) error {
 	switch name {
-	case player.EdgeGame:
-		m.ClearGame()
-		return nil
-	case player.EdgeGameRole:
-		m.ClearGameRole()
+	case roletemplatetranslation.EdgeRoleTemplate:
+		m.ClearRoleTemplate()
 		return nil
 	}
-	return fmt.Errorf("unknown Player unique edge %s", name)
+	return fmt.Errorf("unknown RoleTemplateTranslation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlayerMutation) ResetEdge(name string) error {
+func (m *RoleTemplateTranslationMutation) ResetEdge(name string) error {
 	switch name {
-	case player.EdgeGame:
-		m.ResetGame()
-		return nil
-	case player.EdgeGameRole:
-		m.ResetGameRole()
+	case roletemplatetranslation.EdgeRoleTemplate:
+		m.ResetRoleTemplate()
 		return nil
 	}
-	return fmt.Errorf("unknown Player edge %s", name)
+	return fmt.Errorf("unknown RoleTemplateTranslation edge %s", name)
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
+// RoleTranslationMutation represents an operation that mutates the RoleTranslation nodes in the graph.
+type RoleTranslationMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	name                  *string
-	slug                  *string
-	video                 *string
-	team                  *role.Team
-	description           *string
-	abilities             *[]string
-	appendabilities       []string
-	clearedFields         map[string]struct{}
-	game_roles            map[int]struct{}
-	removedgame_roles     map[int]struct{}
-	clearedgame_roles     bool
-	template_roles        map[int]struct{}
-	removedtemplate_roles map[int]struct{}
-	clearedtemplate_roles bool
-	done                  bool
-	oldValue              func(context.Context) (*Role, error)
-	predicates            []predicate.Role
-}
-
-var _ ent.Mutation = (*RoleMutation)(nil)
-
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
-
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	locale          *string
+	name            *string
+	description     *string
+	abilities       *[]string
+	appendabilities []string
+	clearedFields   map[string]struct{}
+	role            *uuid.UUID
+	clearedrole     bool
+	done            bool
+	oldValue        func(context.Context) (*RoleTranslation, error)
+	predicates      []predicate.RoleTranslation
+}
+
+var _ ent.Mutation = (*RoleTranslationMutation)(nil)
+
+// roletranslationOption allows management of the mutation configuration using functional options.
+type roletranslationOption func(*RoleTranslationMutation)
+
+// newRoleTranslationMutation creates new mutation for the RoleTranslation entity.
+func newRoleTranslationMutation(c config, op Op, opts ...roletranslationOption) *RoleTranslationMutation {
+	m := &RoleTranslationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRole,
+		typ:           TypeRoleTranslation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2565,20 +12539,20 @@ func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
 	return m
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id uuid.UUID) roleOption {
-	return func(m *RoleMutation) {
+// withRoleTranslationID sets the ID field of the mutation.
+func withRoleTranslationID(id uuid.UUID) roletranslationOption {
+	return func(m *RoleTranslationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Role
+			value *RoleTranslation
 		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
+		m.oldValue = func(ctx context.Context) (*RoleTranslation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Role.Get(ctx, id)
+					value, err = m.Client().RoleTranslation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2587,10 +12561,10 @@ func withRoleID(id uuid.UUID) roleOption {
 	}
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
+// withRoleTranslation sets the old RoleTranslation of the mutation.
+func withRoleTranslation(node *RoleTranslation) roletranslationOption {
+	return func(m *RoleTranslationMutation) {
+		m.oldValue = func(context.Context) (*RoleTranslation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2599,7 +12573,7 @@ func withRole(node *Role) roleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
+func (m RoleTranslationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2607,7 +12581,7 @@ func (m RoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
+func (m RoleTranslationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2617,14 +12591,14 @@ func (m RoleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Role entities.
-func (m *RoleMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of RoleTranslation entities.
+func (m *RoleTranslationMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id uuid.UUID, exists bool) {
+func (m *RoleTranslationMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2635,7 +12609,7 @@ func (m *RoleMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *RoleTranslationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2644,163 +12618,127 @@ func (m *RoleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RoleTranslation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
-}
-
-// SetSlug sets the "slug" field.
-func (m *RoleMutation) SetSlug(s string) {
-	m.slug = &s
+// SetRoleID sets the "role_id" field.
+func (m *RoleTranslationMutation) SetRoleID(u uuid.UUID) {
+	m.role = &u
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *RoleMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RoleTranslationMutation) RoleID() (r uuid.UUID, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleID returns the old "role_id" field's value of the RoleTranslation entity.
+// If the RoleTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *RoleTranslationMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.RoleID, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *RoleMutation) ResetSlug() {
-	m.slug = nil
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RoleTranslationMutation) ResetRoleID() {
+	m.role = nil
 }
 
-// SetVideo sets the "video" field.
-func (m *RoleMutation) SetVideo(s string) {
-	m.video = &s
+// SetLocale sets the "locale" field.
+func (m *RoleTranslationMutation) SetLocale(s string) {
+	m.locale = &s
 }
 
-// Video returns the value of the "video" field in the mutation.
-func (m *RoleMutation) Video() (r string, exists bool) {
-	v := m.video
+// Locale returns the value of the "locale" field in the mutation.
+func (m *RoleTranslationMutation) Locale() (r string, exists bool) {
+	v := m.locale
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVideo returns the old "video" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldLocale returns the old "locale" field's value of the RoleTranslation entity.
+// If the RoleTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldVideo(ctx context.Context) (v string, err error) {
+func (m *RoleTranslationMutation) OldLocale(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVideo is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVideo requires an ID field in the mutation")
+		return v, errors.New("OldLocale requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVideo: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
 	}
-	return oldValue.Video, nil
+	return oldValue.Locale, nil
 }
 
-// ResetVideo resets all changes to the "video" field.
-func (m *RoleMutation) ResetVideo() {
-	m.video = nil
+// ResetLocale resets all changes to the "locale" field.
+func (m *RoleTranslationMutation) ResetLocale() {
+	m.locale = nil
 }
 
-// SetTeam sets the "team" field.
-func (m *RoleMutation) SetTeam(r role.Team) {
-	m.team = &r
+// SetName sets the "name" field.
+func (m *RoleTranslationMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Team returns the value of the "team" field in the mutation.
-func (m *RoleMutation) Team() (r role.Team, exists bool) {
-	v := m.team
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleTranslationMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeam returns the old "team" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the RoleTranslation entity.
+// If the RoleTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldTeam(ctx context.Context) (v role.Team, err error) {
+func (m *RoleTranslationMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeam is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeam requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Team, nil
+	return oldValue.Name, nil
 }
 
-// ResetTeam resets all changes to the "team" field.
-func (m *RoleMutation) ResetTeam() {
-	m.team = nil
+// ResetName resets all changes to the "name" field.
+func (m *RoleTranslationMutation) ResetName() {
+	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *RoleMutation) SetDescription(s string) {
+func (m *RoleTranslationMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *RoleMutation) Description() (r string, exists bool) {
+func (m *RoleTranslationMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -2808,10 +12746,10 @@ func (m *RoleMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the RoleTranslation entity.
+// If the RoleTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *RoleTranslationMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -2826,31 +12764,31 @@ func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error)
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *RoleMutation) ClearDescription() {
+func (m *RoleTranslationMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[role.FieldDescription] = struct{}{}
+	m.clearedFields[roletranslation.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RoleMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[role.FieldDescription]
+func (m *RoleTranslationMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[roletranslation.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *RoleMutation) ResetDescription() {
+func (m *RoleTranslationMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, role.FieldDescription)
+	delete(m.clearedFields, roletranslation.FieldDescription)
 }
 
 // SetAbilities sets the "abilities" field.
-func (m *RoleMutation) SetAbilities(s []string) {
+func (m *RoleTranslationMutation) SetAbilities(s []string) {
 	m.abilities = &s
 	m.appendabilities = nil
 }
 
 // Abilities returns the value of the "abilities" field in the mutation.
-func (m *RoleMutation) Abilities() (r []string, exists bool) {
+func (m *RoleTranslationMutation) Abilities() (r []string, exists bool) {
 	v := m.abilities
 	if v == nil {
 		return
@@ -2858,10 +12796,10 @@ func (m *RoleMutation) Abilities() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldAbilities returns the old "abilities" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldAbilities returns the old "abilities" field's value of the RoleTranslation entity.
+// If the RoleTranslation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldAbilities(ctx context.Context) (v []string, err error) {
+func (m *RoleTranslationMutation) OldAbilities(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAbilities is only allowed on UpdateOne operations")
 	}
@@ -2869,162 +12807,81 @@ func (m *RoleMutation) OldAbilities(ctx context.Context) (v []string, err error)
 		return v, errors.New("OldAbilities requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAbilities: %w", err)
-	}
-	return oldValue.Abilities, nil
-}
-
-// AppendAbilities adds s to the "abilities" field.
-func (m *RoleMutation) AppendAbilities(s []string) {
-	m.appendabilities = append(m.appendabilities, s...)
-}
-
-// AppendedAbilities returns the list of values that were appended to the "abilities" field in this mutation.
-func (m *RoleMutation) AppendedAbilities() ([]string, bool) {
-	if len(m.appendabilities) == 0 {
-		return nil, false
-	}
-	return m.appendabilities, true
-}
-
-// ClearAbilities clears the value of the "abilities" field.
-func (m *RoleMutation) ClearAbilities() {
-	m.abilities = nil
-	m.appendabilities = nil
-	m.clearedFields[role.FieldAbilities] = struct{}{}
-}
-
-// AbilitiesCleared returns if the "abilities" field was cleared in this mutation.
-func (m *RoleMutation) AbilitiesCleared() bool {
-	_, ok := m.clearedFields[role.FieldAbilities]
-	return ok
-}
-
-// ResetAbilities resets all changes to the "abilities" field.
-func (m *RoleMutation) ResetAbilities() {
-	m.abilities = nil
-	m.appendabilities = nil
-	delete(m.clearedFields, role.FieldAbilities)
-}
-
-// AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by ids.
-func (m *RoleMutation) AddGameRoleIDs(ids ...int) {
-	if m.game_roles == nil {
-		m.game_roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.game_roles[ids[i]] = struct{}{}
-	}
-}
-
-// ClearGameRoles clears the "game_roles" edge to the GameRole entity.
-func (m *RoleMutation) ClearGameRoles() {
-	m.clearedgame_roles = true
-}
-
-// GameRolesCleared reports if the "game_roles" edge to the GameRole entity was cleared.
-func (m *RoleMutation) GameRolesCleared() bool {
-	return m.clearedgame_roles
-}
-
-// RemoveGameRoleIDs removes the "game_roles" edge to the GameRole entity by IDs.
-func (m *RoleMutation) RemoveGameRoleIDs(ids ...int) {
-	if m.removedgame_roles == nil {
-		m.removedgame_roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.game_roles, ids[i])
-		m.removedgame_roles[ids[i]] = struct{}{}
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbilities: %w", err)
 	}
+	return oldValue.Abilities, nil
 }
 
-// RemovedGameRoles returns the removed IDs of the "game_roles" edge to the GameRole entity.
-func (m *RoleMutation) RemovedGameRolesIDs() (ids []int) {
-	for id := range m.removedgame_roles {
-		ids = append(ids, id)
-	}
-	return
+// AppendAbilities adds s to the "abilities" field.
+func (m *RoleTranslationMutation) AppendAbilities(s []string) {
+	m.appendabilities = append(m.appendabilities, s...)
 }
 
-// GameRolesIDs returns the "game_roles" edge IDs in the mutation.
-func (m *RoleMutation) GameRolesIDs() (ids []int) {
-	for id := range m.game_roles {
-		ids = append(ids, id)
+// AppendedAbilities returns the list of values that were appended to the "abilities" field in this mutation.
+func (m *RoleTranslationMutation) AppendedAbilities() ([]string, bool) {
+	if len(m.appendabilities) == 0 {
+		return nil, false
 	}
-	return
-}
-
-// ResetGameRoles resets all changes to the "game_roles" edge.
-func (m *RoleMutation) ResetGameRoles() {
-	m.game_roles = nil
-	m.clearedgame_roles = false
-	m.removedgame_roles = nil
+	return m.appendabilities, true
 }
 
-// AddTemplateRoleIDs adds the "template_roles" edge to the RoleTemplateRole entity by ids.
-func (m *RoleMutation) AddTemplateRoleIDs(ids ...int) {
-	if m.template_roles == nil {
-		m.template_roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.template_roles[ids[i]] = struct{}{}
-	}
+// ClearAbilities clears the value of the "abilities" field.
+func (m *RoleTranslationMutation) ClearAbilities() {
+	m.abilities = nil
+	m.appendabilities = nil
+	m.clearedFields[roletranslation.FieldAbilities] = struct{}{}
 }
 
-// ClearTemplateRoles clears the "template_roles" edge to the RoleTemplateRole entity.
-func (m *RoleMutation) ClearTemplateRoles() {
-	m.clearedtemplate_roles = true
+// AbilitiesCleared returns if the "abilities" field was cleared in this mutation.
+func (m *RoleTranslationMutation) AbilitiesCleared() bool {
+	_, ok := m.clearedFields[roletranslation.FieldAbilities]
+	return ok
 }
 
-// TemplateRolesCleared reports if the "template_roles" edge to the RoleTemplateRole entity was cleared.
-func (m *RoleMutation) TemplateRolesCleared() bool {
-	return m.clearedtemplate_roles
+// ResetAbilities resets all changes to the "abilities" field.
+func (m *RoleTranslationMutation) ResetAbilities() {
+	m.abilities = nil
+	m.appendabilities = nil
+	delete(m.clearedFields, roletranslation.FieldAbilities)
 }
 
-// RemoveTemplateRoleIDs removes the "template_roles" edge to the RoleTemplateRole entity by IDs.
-func (m *RoleMutation) RemoveTemplateRoleIDs(ids ...int) {
-	if m.removedtemplate_roles == nil {
-		m.removedtemplate_roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.template_roles, ids[i])
-		m.removedtemplate_roles[ids[i]] = struct{}{}
-	}
+// ClearRole clears the "role" edge to the Role entity.
+func (m *RoleTranslationMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[roletranslation.FieldRoleID] = struct{}{}
 }
 
-// RemovedTemplateRoles returns the removed IDs of the "template_roles" edge to the RoleTemplateRole entity.
-func (m *RoleMutation) RemovedTemplateRolesIDs() (ids []int) {
-	for id := range m.removedtemplate_roles {
-		ids = append(ids, id)
-	}
-	return
+// RoleCleared reports if the "role" edge to the Role entity was cleared.
+func (m *RoleTranslationMutation) RoleCleared() bool {
+	return m.clearedrole
 }
 
-// TemplateRolesIDs returns the "template_roles" edge IDs in the mutation.
-func (m *RoleMutation) TemplateRolesIDs() (ids []int) {
-	for id := range m.template_roles {
-		ids = append(ids, id)
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *RoleTranslationMutation) RoleIDs() (ids []uuid.UUID) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTemplateRoles resets all changes to the "template_roles" edge.
-func (m *RoleMutation) ResetTemplateRoles() {
-	m.template_roles = nil
-	m.clearedtemplate_roles = false
-	m.removedtemplate_roles = nil
+// ResetRole resets all changes to the "role" edge.
+func (m *RoleTranslationMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
 }
 
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
+// Where appends a list predicates to the RoleTranslationMutation builder.
+func (m *RoleTranslationMutation) Where(ps ...predicate.RoleTranslation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RoleTranslationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
+func (m *RoleTranslationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleTranslation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3032,42 +12889,39 @@ func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleMutation) Op() Op {
+func (m *RoleTranslationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
+func (m *RoleTranslationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
+// Type returns the node type of this mutation (RoleTranslation).
+func (m *RoleTranslationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
-	}
-	if m.slug != nil {
-		fields = append(fields, role.FieldSlug)
+func (m *RoleTranslationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.role != nil {
+		fields = append(fields, roletranslation.FieldRoleID)
 	}
-	if m.video != nil {
-		fields = append(fields, role.FieldVideo)
+	if m.locale != nil {
+		fields = append(fields, roletranslation.FieldLocale)
 	}
-	if m.team != nil {
-		fields = append(fields, role.FieldTeam)
+	if m.name != nil {
+		fields = append(fields, roletranslation.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, role.FieldDescription)
+		fields = append(fields, roletranslation.FieldDescription)
 	}
 	if m.abilities != nil {
-		fields = append(fields, role.FieldAbilities)
+		fields = append(fields, roletranslation.FieldAbilities)
 	}
 	return fields
 }
@@ -3075,19 +12929,17 @@ func (m *RoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+func (m *RoleTranslationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldName:
+	case roletranslation.FieldRoleID:
+		return m.RoleID()
+	case roletranslation.FieldLocale:
+		return m.Locale()
+	case roletranslation.FieldName:
 		return m.Name()
-	case role.FieldSlug:
-		return m.Slug()
-	case role.FieldVideo:
-		return m.Video()
-	case role.FieldTeam:
-		return m.Team()
-	case role.FieldDescription:
+	case roletranslation.FieldDescription:
 		return m.Description()
-	case role.FieldAbilities:
+	case roletranslation.FieldAbilities:
 		return m.Abilities()
 	}
 	return nil, false
@@ -3096,65 +12948,56 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RoleTranslationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldName:
+	case roletranslation.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case roletranslation.FieldLocale:
+		return m.OldLocale(ctx)
+	case roletranslation.FieldName:
 		return m.OldName(ctx)
-	case role.FieldSlug:
-		return m.OldSlug(ctx)
-	case role.FieldVideo:
-		return m.OldVideo(ctx)
-	case role.FieldTeam:
-		return m.OldTeam(ctx)
-	case role.FieldDescription:
+	case roletranslation.FieldDescription:
 		return m.OldDescription(ctx)
-	case role.FieldAbilities:
+	case roletranslation.FieldAbilities:
 		return m.OldAbilities(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown RoleTranslation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *RoleTranslationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldName:
-		v, ok := value.(string)
+	case roletranslation.FieldRoleID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetRoleID(v)
 		return nil
-	case role.FieldSlug:
+	case roletranslation.FieldLocale:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetLocale(v)
 		return nil
-	case role.FieldVideo:
+	case roletranslation.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVideo(v)
-		return nil
-	case role.FieldTeam:
-		v, ok := value.(role.Team)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTeam(v)
+		m.SetName(v)
 		return nil
-	case role.FieldDescription:
+	case roletranslation.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case role.FieldAbilities:
+	case roletranslation.FieldAbilities:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3162,233 +13005,189 @@ func (m *RoleMutation) SetField(name string, value ent.Value) error {
 		m.SetAbilities(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown RoleTranslation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
+func (m *RoleTranslationMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RoleTranslationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *RoleTranslationMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown RoleTranslation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
+func (m *RoleTranslationMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(role.FieldDescription) {
-		fields = append(fields, role.FieldDescription)
+	if m.FieldCleared(roletranslation.FieldDescription) {
+		fields = append(fields, roletranslation.FieldDescription)
 	}
-	if m.FieldCleared(role.FieldAbilities) {
-		fields = append(fields, role.FieldAbilities)
+	if m.FieldCleared(roletranslation.FieldAbilities) {
+		fields = append(fields, roletranslation.FieldAbilities)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *RoleTranslationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
+func (m *RoleTranslationMutation) ClearField(name string) error {
 	switch name {
-	case role.FieldDescription:
+	case roletranslation.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case role.FieldAbilities:
+	case roletranslation.FieldAbilities:
 		m.ClearAbilities()
 		return nil
 	}
-	return fmt.Errorf("unknown Role nullable field %s", name)
+	return fmt.Errorf("unknown RoleTranslation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *RoleTranslationMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldName:
-		m.ResetName()
-		return nil
-	case role.FieldSlug:
-		m.ResetSlug()
+	case roletranslation.FieldRoleID:
+		m.ResetRoleID()
 		return nil
-	case role.FieldVideo:
-		m.ResetVideo()
+	case roletranslation.FieldLocale:
+		m.ResetLocale()
 		return nil
-	case role.FieldTeam:
-		m.ResetTeam()
+	case roletranslation.FieldName:
+		m.ResetName()
 		return nil
-	case role.FieldDescription:
+	case roletranslation.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case role.FieldAbilities:
+	case roletranslation.FieldAbilities:
 		m.ResetAbilities()
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown RoleTranslation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.game_roles != nil {
-		edges = append(edges, role.EdgeGameRoles)
-	}
-	if m.template_roles != nil {
-		edges = append(edges, role.EdgeTemplateRoles)
+func (m *RoleTranslationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.role != nil {
+		edges = append(edges, roletranslation.EdgeRole)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+func (m *RoleTranslationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeGameRoles:
-		ids := make([]ent.Value, 0, len(m.game_roles))
-		for id := range m.game_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeTemplateRoles:
-		ids := make([]ent.Value, 0, len(m.template_roles))
-		for id := range m.template_roles {
-			ids = append(ids, id)
+	case roletranslation.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedgame_roles != nil {
-		edges = append(edges, role.EdgeGameRoles)
-	}
-	if m.removedtemplate_roles != nil {
-		edges = append(edges, role.EdgeTemplateRoles)
-	}
+func (m *RoleTranslationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case role.EdgeGameRoles:
-		ids := make([]ent.Value, 0, len(m.removedgame_roles))
-		for id := range m.removedgame_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeTemplateRoles:
-		ids := make([]ent.Value, 0, len(m.removedtemplate_roles))
-		for id := range m.removedtemplate_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RoleTranslationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedgame_roles {
-		edges = append(edges, role.EdgeGameRoles)
-	}
-	if m.clearedtemplate_roles {
-		edges = append(edges, role.EdgeTemplateRoles)
+func (m *RoleTranslationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrole {
+		edges = append(edges, roletranslation.EdgeRole)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
+func (m *RoleTranslationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case role.EdgeGameRoles:
-		return m.clearedgame_roles
-	case role.EdgeTemplateRoles:
-		return m.clearedtemplate_roles
+	case roletranslation.EdgeRole:
+		return m.clearedrole
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
+func (m *RoleTranslationMutation) ClearEdge(name string) error {
 	switch name {
+	case roletranslation.EdgeRole:
+		m.ClearRole()
+		return nil
 	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
+	return fmt.Errorf("unknown RoleTranslation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
+func (m *RoleTranslationMutation) ResetEdge(name string) error {
 	switch name {
-	case role.EdgeGameRoles:
-		m.ResetGameRoles()
-		return nil
-	case role.EdgeTemplateRoles:
-		m.ResetTemplateRoles()
+	case roletranslation.EdgeRole:
+		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown Role edge %s", name)
+	return fmt.Errorf("unknown RoleTranslation edge %s", name)
 }
 
-// RoleTemplateMutation represents an operation that mutates the RoleTemplate nodes in the graph.
-type RoleTemplateMutation struct {
+// SpectatorMutation represents an operation that mutates the Spectator nodes in the graph.
+type SpectatorMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	name                  *string
-	player_count          *int
-	addplayer_count       *int
-	description           *string
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	template_roles        map[int]struct{}
-	removedtemplate_roles map[int]struct{}
-	clearedtemplate_roles bool
-	done                  bool
-	oldValue              func(context.Context) (*RoleTemplate, error)
-	predicates            []predicate.RoleTemplate
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	game          *string
+	clearedgame   bool
+	done          bool
+	oldValue      func(context.Context) (*Spectator, error)
+	predicates    []predicate.Spectator
 }
 
-var _ ent.Mutation = (*RoleTemplateMutation)(nil)
+var _ ent.Mutation = (*SpectatorMutation)(nil)
 
-// roletemplateOption allows management of the mutation configuration using functional options.
-type roletemplateOption func(*RoleTemplateMutation)
+// spectatorOption allows management of the mutation configuration using functional options.
+type spectatorOption func(*SpectatorMutation)
 
-// newRoleTemplateMutation creates new mutation for the RoleTemplate entity.
-func newRoleTemplateMutation(c config, op Op, opts ...roletemplateOption) *RoleTemplateMutation {
-	m := &RoleTemplateMutation{
+// newSpectatorMutation creates new mutation for the Spectator entity.
+func newSpectatorMutation(c config, op Op, opts ...spectatorOption) *SpectatorMutation {
+	m := &SpectatorMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoleTemplate,
+		typ:           TypeSpectator,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3397,20 +13196,20 @@ func newRoleTemplateMutation(c config, op Op, opts ...roletemplateOption) *RoleT
 	return m
 }
 
-// withRoleTemplateID sets the ID field of the mutation.
-func withRoleTemplateID(id uuid.UUID) roletemplateOption {
-	return func(m *RoleTemplateMutation) {
+// withSpectatorID sets the ID field of the mutation.
+func withSpectatorID(id uuid.UUID) spectatorOption {
+	return func(m *SpectatorMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RoleTemplate
+			value *Spectator
 		)
-		m.oldValue = func(ctx context.Context) (*RoleTemplate, error) {
+		m.oldValue = func(ctx context.Context) (*Spectator, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RoleTemplate.Get(ctx, id)
+					value, err = m.Client().Spectator.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3419,10 +13218,10 @@ func withRoleTemplateID(id uuid.UUID) roletemplateOption {
 	}
 }
 
-// withRoleTemplate sets the old RoleTemplate of the mutation.
-func withRoleTemplate(node *RoleTemplate) roletemplateOption {
-	return func(m *RoleTemplateMutation) {
-		m.oldValue = func(context.Context) (*RoleTemplate, error) {
+// withSpectator sets the old Spectator of the mutation.
+func withSpectator(node *Spectator) spectatorOption {
+	return func(m *SpectatorMutation) {
+		m.oldValue = func(context.Context) (*Spectator, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3431,7 +13230,7 @@ func withRoleTemplate(node *RoleTemplate) roletemplateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleTemplateMutation) Client() *Client {
+func (m SpectatorMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3439,7 +13238,7 @@ func (m RoleTemplateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleTemplateMutation) Tx() (*Tx, error) {
+func (m SpectatorMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3449,14 +13248,14 @@ func (m RoleTemplateMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RoleTemplate entities.
-func (m *RoleTemplateMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Spectator entities.
+func (m *SpectatorMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoleTemplateMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SpectatorMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3467,7 +13266,7 @@ func (m *RoleTemplateMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoleTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SpectatorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3476,19 +13275,19 @@ func (m *RoleTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RoleTemplate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Spectator.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *RoleTemplateMutation) SetName(s string) {
+func (m *SpectatorMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *RoleTemplateMutation) Name() (r string, exists bool) {
+func (m *SpectatorMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -3496,10 +13295,10 @@ func (m *RoleTemplateMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the RoleTemplate entity.
-// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Spectator entity.
+// If the Spectator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SpectatorMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -3514,122 +13313,53 @@ func (m *RoleTemplateMutation) OldName(ctx context.Context) (v string, err error
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *RoleTemplateMutation) ResetName() {
+func (m *SpectatorMutation) ResetName() {
 	m.name = nil
 }
 
-// SetPlayerCount sets the "player_count" field.
-func (m *RoleTemplateMutation) SetPlayerCount(i int) {
-	m.player_count = &i
-	m.addplayer_count = nil
-}
-
-// PlayerCount returns the value of the "player_count" field in the mutation.
-func (m *RoleTemplateMutation) PlayerCount() (r int, exists bool) {
-	v := m.player_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPlayerCount returns the old "player_count" field's value of the RoleTemplate entity.
-// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateMutation) OldPlayerCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlayerCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlayerCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlayerCount: %w", err)
-	}
-	return oldValue.PlayerCount, nil
-}
-
-// AddPlayerCount adds i to the "player_count" field.
-func (m *RoleTemplateMutation) AddPlayerCount(i int) {
-	if m.addplayer_count != nil {
-		*m.addplayer_count += i
-	} else {
-		m.addplayer_count = &i
-	}
-}
-
-// AddedPlayerCount returns the value that was added to the "player_count" field in this mutation.
-func (m *RoleTemplateMutation) AddedPlayerCount() (r int, exists bool) {
-	v := m.addplayer_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPlayerCount resets all changes to the "player_count" field.
-func (m *RoleTemplateMutation) ResetPlayerCount() {
-	m.player_count = nil
-	m.addplayer_count = nil
-}
-
-// SetDescription sets the "description" field.
-func (m *RoleTemplateMutation) SetDescription(s string) {
-	m.description = &s
+// SetGameID sets the "game_id" field.
+func (m *SpectatorMutation) SetGameID(s string) {
+	m.game = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *RoleTemplateMutation) Description() (r string, exists bool) {
-	v := m.description
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *SpectatorMutation) GameID() (r string, exists bool) {
+	v := m.game
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the RoleTemplate entity.
-// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldGameID returns the old "game_id" field's value of the Spectator entity.
+// If the Spectator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *SpectatorMutation) OldGameID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldGameID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *RoleTemplateMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[roletemplate.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RoleTemplateMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[roletemplate.FieldDescription]
-	return ok
+	return oldValue.GameID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RoleTemplateMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, roletemplate.FieldDescription)
+// ResetGameID resets all changes to the "game_id" field.
+func (m *SpectatorMutation) ResetGameID() {
+	m.game = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RoleTemplateMutation) SetCreatedAt(t time.Time) {
+func (m *SpectatorMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoleTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SpectatorMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3637,10 +13367,10 @@ func (m *RoleTemplateMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RoleTemplate entity.
-// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Spectator entity.
+// If the Spectator object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SpectatorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3655,109 +13385,46 @@ func (m *RoleTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoleTemplateMutation) ResetCreatedAt() {
+func (m *SpectatorMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoleTemplateMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoleTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the RoleTemplate entity.
-// If the RoleTemplate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoleTemplateMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddTemplateRoleIDs adds the "template_roles" edge to the RoleTemplateRole entity by ids.
-func (m *RoleTemplateMutation) AddTemplateRoleIDs(ids ...int) {
-	if m.template_roles == nil {
-		m.template_roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.template_roles[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTemplateRoles clears the "template_roles" edge to the RoleTemplateRole entity.
-func (m *RoleTemplateMutation) ClearTemplateRoles() {
-	m.clearedtemplate_roles = true
-}
-
-// TemplateRolesCleared reports if the "template_roles" edge to the RoleTemplateRole entity was cleared.
-func (m *RoleTemplateMutation) TemplateRolesCleared() bool {
-	return m.clearedtemplate_roles
-}
-
-// RemoveTemplateRoleIDs removes the "template_roles" edge to the RoleTemplateRole entity by IDs.
-func (m *RoleTemplateMutation) RemoveTemplateRoleIDs(ids ...int) {
-	if m.removedtemplate_roles == nil {
-		m.removedtemplate_roles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.template_roles, ids[i])
-		m.removedtemplate_roles[ids[i]] = struct{}{}
-	}
+// ClearGame clears the "game" edge to the Game entity.
+func (m *SpectatorMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[spectator.FieldGameID] = struct{}{}
 }
 
-// RemovedTemplateRoles returns the removed IDs of the "template_roles" edge to the RoleTemplateRole entity.
-func (m *RoleTemplateMutation) RemovedTemplateRolesIDs() (ids []int) {
-	for id := range m.removedtemplate_roles {
-		ids = append(ids, id)
-	}
-	return
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *SpectatorMutation) GameCleared() bool {
+	return m.clearedgame
 }
 
-// TemplateRolesIDs returns the "template_roles" edge IDs in the mutation.
-func (m *RoleTemplateMutation) TemplateRolesIDs() (ids []int) {
-	for id := range m.template_roles {
-		ids = append(ids, id)
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *SpectatorMutation) GameIDs() (ids []string) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTemplateRoles resets all changes to the "template_roles" edge.
-func (m *RoleTemplateMutation) ResetTemplateRoles() {
-	m.template_roles = nil
-	m.clearedtemplate_roles = false
-	m.removedtemplate_roles = nil
+// ResetGame resets all changes to the "game" edge.
+func (m *SpectatorMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
 }
 
-// Where appends a list predicates to the RoleTemplateMutation builder.
-func (m *RoleTemplateMutation) Where(ps ...predicate.RoleTemplate) {
+// Where appends a list predicates to the SpectatorMutation builder.
+func (m *SpectatorMutation) Where(ps ...predicate.Spectator) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleTemplateMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RoleTemplate, len(ps))
+// WhereP appends storage-level predicates to the SpectatorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SpectatorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Spectator, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3765,39 +13432,33 @@ func (m *RoleTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleTemplateMutation) Op() Op {
+func (m *SpectatorMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleTemplateMutation) SetOp(op Op) {
+func (m *SpectatorMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RoleTemplate).
-func (m *RoleTemplateMutation) Type() string {
+// Type returns the node type of this mutation (Spectator).
+func (m *SpectatorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleTemplateMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *SpectatorMutation) Fields() []string {
+	fields := make([]string, 0, 3)
 	if m.name != nil {
-		fields = append(fields, roletemplate.FieldName)
-	}
-	if m.player_count != nil {
-		fields = append(fields, roletemplate.FieldPlayerCount)
+		fields = append(fields, spectator.FieldName)
 	}
-	if m.description != nil {
-		fields = append(fields, roletemplate.FieldDescription)
+	if m.game != nil {
+		fields = append(fields, spectator.FieldGameID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, roletemplate.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, roletemplate.FieldUpdatedAt)
+		fields = append(fields, spectator.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3805,18 +13466,14 @@ func (m *RoleTemplateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleTemplateMutation) Field(name string) (ent.Value, bool) {
+func (m *SpectatorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case roletemplate.FieldName:
+	case spectator.FieldName:
 		return m.Name()
-	case roletemplate.FieldPlayerCount:
-		return m.PlayerCount()
-	case roletemplate.FieldDescription:
-		return m.Description()
-	case roletemplate.FieldCreatedAt:
+	case spectator.FieldGameID:
+		return m.GameID()
+	case spectator.FieldCreatedAt:
 		return m.CreatedAt()
-	case roletemplate.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3824,267 +13481,210 @@ func (m *RoleTemplateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SpectatorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case roletemplate.FieldName:
+	case spectator.FieldName:
 		return m.OldName(ctx)
-	case roletemplate.FieldPlayerCount:
-		return m.OldPlayerCount(ctx)
-	case roletemplate.FieldDescription:
-		return m.OldDescription(ctx)
-	case roletemplate.FieldCreatedAt:
+	case spectator.FieldGameID:
+		return m.OldGameID(ctx)
+	case spectator.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case roletemplate.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RoleTemplate field %s", name)
+	return nil, fmt.Errorf("unknown Spectator field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleTemplateMutation) SetField(name string, value ent.Value) error {
+func (m *SpectatorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case roletemplate.FieldName:
+	case spectator.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case roletemplate.FieldPlayerCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlayerCount(v)
-		return nil
-	case roletemplate.FieldDescription:
+	case spectator.FieldGameID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetGameID(v)
 		return nil
-	case roletemplate.FieldCreatedAt:
+	case spectator.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case roletemplate.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplate field %s", name)
+	return fmt.Errorf("unknown Spectator field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleTemplateMutation) AddedFields() []string {
-	var fields []string
-	if m.addplayer_count != nil {
-		fields = append(fields, roletemplate.FieldPlayerCount)
-	}
-	return fields
+func (m *SpectatorMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleTemplateMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case roletemplate.FieldPlayerCount:
-		return m.AddedPlayerCount()
-	}
+func (m *SpectatorMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleTemplateMutation) AddField(name string, value ent.Value) error {
+func (m *SpectatorMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case roletemplate.FieldPlayerCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPlayerCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplate numeric field %s", name)
+	return fmt.Errorf("unknown Spectator numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleTemplateMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(roletemplate.FieldDescription) {
-		fields = append(fields, roletemplate.FieldDescription)
-	}
-	return fields
+func (m *SpectatorMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleTemplateMutation) FieldCleared(name string) bool {
+func (m *SpectatorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleTemplateMutation) ClearField(name string) error {
-	switch name {
-	case roletemplate.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown RoleTemplate nullable field %s", name)
+func (m *SpectatorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Spectator nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleTemplateMutation) ResetField(name string) error {
+func (m *SpectatorMutation) ResetField(name string) error {
 	switch name {
-	case roletemplate.FieldName:
+	case spectator.FieldName:
 		m.ResetName()
 		return nil
-	case roletemplate.FieldPlayerCount:
-		m.ResetPlayerCount()
-		return nil
-	case roletemplate.FieldDescription:
-		m.ResetDescription()
+	case spectator.FieldGameID:
+		m.ResetGameID()
 		return nil
-	case roletemplate.FieldCreatedAt:
+	case spectator.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case roletemplate.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplate field %s", name)
+	return fmt.Errorf("unknown Spectator field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleTemplateMutation) AddedEdges() []string {
+func (m *SpectatorMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.template_roles != nil {
-		edges = append(edges, roletemplate.EdgeTemplateRoles)
+	if m.game != nil {
+		edges = append(edges, spectator.EdgeGame)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleTemplateMutation) AddedIDs(name string) []ent.Value {
+func (m *SpectatorMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case roletemplate.EdgeTemplateRoles:
-		ids := make([]ent.Value, 0, len(m.template_roles))
-		for id := range m.template_roles {
-			ids = append(ids, id)
+	case spectator.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleTemplateMutation) RemovedEdges() []string {
+func (m *SpectatorMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedtemplate_roles != nil {
-		edges = append(edges, roletemplate.EdgeTemplateRoles)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleTemplateMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case roletemplate.EdgeTemplateRoles:
-		ids := make([]ent.Value, 0, len(m.removedtemplate_roles))
-		for id := range m.removedtemplate_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SpectatorMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleTemplateMutation) ClearedEdges() []string {
+func (m *SpectatorMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtemplate_roles {
-		edges = append(edges, roletemplate.EdgeTemplateRoles)
+	if m.clearedgame {
+		edges = append(edges, spectator.EdgeGame)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleTemplateMutation) EdgeCleared(name string) bool {
+func (m *SpectatorMutation) EdgeCleared(name string) bool {
 	switch name {
-	case roletemplate.EdgeTemplateRoles:
-		return m.clearedtemplate_roles
+	case spectator.EdgeGame:
+		return m.clearedgame
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleTemplateMutation) ClearEdge(name string) error {
+func (m *SpectatorMutation) ClearEdge(name string) error {
 	switch name {
+	case spectator.EdgeGame:
+		m.ClearGame()
+		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplate unique edge %s", name)
+	return fmt.Errorf("unknown Spectator unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleTemplateMutation) ResetEdge(name string) error {
+func (m *SpectatorMutation) ResetEdge(name string) error {
 	switch name {
-	case roletemplate.EdgeTemplateRoles:
-		m.ResetTemplateRoles()
+	case spectator.EdgeGame:
+		m.ResetGame()
 		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplate edge %s", name)
+	return fmt.Errorf("unknown Spectator edge %s", name)
 }
 
-// RoleTemplateRoleMutation represents an operation that mutates the RoleTemplateRole nodes in the graph.
-type RoleTemplateRoleMutation struct {
+// VoteMutation represents an operation that mutates the Vote nodes in the graph.
+type VoteMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	count                *int
-	addcount             *int
-	clearedFields        map[string]struct{}
-	role_template        *uuid.UUID
-	clearedrole_template bool
-	role                 *uuid.UUID
-	clearedrole          bool
-	done                 bool
-	oldValue             func(context.Context) (*RoleTemplateRole, error)
-	predicates           []predicate.RoleTemplateRole
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	round         *int
+	addround      *int
+	voter_id      *uuid.UUID
+	target_id     *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	game          *string
+	clearedgame   bool
+	done          bool
+	oldValue      func(context.Context) (*Vote, error)
+	predicates    []predicate.Vote
 }
 
-var _ ent.Mutation = (*RoleTemplateRoleMutation)(nil)
+var _ ent.Mutation = (*VoteMutation)(nil)
 
-// roletemplateroleOption allows management of the mutation configuration using functional options.
-type roletemplateroleOption func(*RoleTemplateRoleMutation)
+// voteOption allows management of the mutation configuration using functional options.
+type voteOption func(*VoteMutation)
 
-// newRoleTemplateRoleMutation creates new mutation for the RoleTemplateRole entity.
-func newRoleTemplateRoleMutation(c config, op Op, opts ...roletemplateroleOption) *RoleTemplateRoleMutation {
-	m := &RoleTemplateRoleMutation{
+// newVoteMutation creates new mutation for the Vote entity.
+func newVoteMutation(c config, op Op, opts ...voteOption) *VoteMutation {
+	m := &VoteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoleTemplateRole,
+		typ:           TypeVote,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4093,20 +13693,20 @@ func newRoleTemplateRoleMutation(c config, op Op, opts ...roletemplateroleOption
 	return m
 }
 
-// withRoleTemplateRoleID sets the ID field of the mutation.
-func withRoleTemplateRoleID(id int) roletemplateroleOption {
-	return func(m *RoleTemplateRoleMutation) {
+// withVoteID sets the ID field of the mutation.
+func withVoteID(id uuid.UUID) voteOption {
+	return func(m *VoteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RoleTemplateRole
+			value *Vote
 		)
-		m.oldValue = func(ctx context.Context) (*RoleTemplateRole, error) {
+		m.oldValue = func(ctx context.Context) (*Vote, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RoleTemplateRole.Get(ctx, id)
+					value, err = m.Client().Vote.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4115,10 +13715,10 @@ func withRoleTemplateRoleID(id int) roletemplateroleOption {
 	}
 }
 
-// withRoleTemplateRole sets the old RoleTemplateRole of the mutation.
-func withRoleTemplateRole(node *RoleTemplateRole) roletemplateroleOption {
-	return func(m *RoleTemplateRoleMutation) {
-		m.oldValue = func(context.Context) (*RoleTemplateRole, error) {
+// withVote sets the old Vote of the mutation.
+func withVote(node *Vote) voteOption {
+	return func(m *VoteMutation) {
+		m.oldValue = func(context.Context) (*Vote, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4127,7 +13727,7 @@ func withRoleTemplateRole(node *RoleTemplateRole) roletemplateroleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleTemplateRoleMutation) Client() *Client {
+func (m VoteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4135,7 +13735,7 @@ func (m RoleTemplateRoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleTemplateRoleMutation) Tx() (*Tx, error) {
+func (m VoteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4144,9 +13744,15 @@ func (m RoleTemplateRoleMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Vote entities.
+func (m *VoteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoleTemplateRoleMutation) ID() (id int, exists bool) {
+func (m *VoteMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4157,212 +13763,257 @@ func (m *RoleTemplateRoleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoleTemplateRoleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *VoteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RoleTemplateRole.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Vote.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRoleTemplateID sets the "role_template_id" field.
-func (m *RoleTemplateRoleMutation) SetRoleTemplateID(u uuid.UUID) {
-	m.role_template = &u
+// SetGameID sets the "game_id" field.
+func (m *VoteMutation) SetGameID(s string) {
+	m.game = &s
 }
 
-// RoleTemplateID returns the value of the "role_template_id" field in the mutation.
-func (m *RoleTemplateRoleMutation) RoleTemplateID() (r uuid.UUID, exists bool) {
-	v := m.role_template
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *VoteMutation) GameID() (r string, exists bool) {
+	v := m.game
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoleTemplateID returns the old "role_template_id" field's value of the RoleTemplateRole entity.
-// If the RoleTemplateRole object wasn't provided to the builder, the object is fetched from the database.
+// OldGameID returns the old "game_id" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateRoleMutation) OldRoleTemplateID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VoteMutation) OldGameID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoleTemplateID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoleTemplateID requires an ID field in the mutation")
+		return v, errors.New("OldGameID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoleTemplateID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
 	}
-	return oldValue.RoleTemplateID, nil
+	return oldValue.GameID, nil
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *VoteMutation) ResetGameID() {
+	m.game = nil
+}
+
+// SetRound sets the "round" field.
+func (m *VoteMutation) SetRound(i int) {
+	m.round = &i
+	m.addround = nil
+}
+
+// Round returns the value of the "round" field in the mutation.
+func (m *VoteMutation) Round() (r int, exists bool) {
+	v := m.round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRound returns the old "round" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldRound(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRound: %w", err)
+	}
+	return oldValue.Round, nil
+}
+
+// AddRound adds i to the "round" field.
+func (m *VoteMutation) AddRound(i int) {
+	if m.addround != nil {
+		*m.addround += i
+	} else {
+		m.addround = &i
+	}
+}
+
+// AddedRound returns the value that was added to the "round" field in this mutation.
+func (m *VoteMutation) AddedRound() (r int, exists bool) {
+	v := m.addround
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetRoleTemplateID resets all changes to the "role_template_id" field.
-func (m *RoleTemplateRoleMutation) ResetRoleTemplateID() {
-	m.role_template = nil
+// ResetRound resets all changes to the "round" field.
+func (m *VoteMutation) ResetRound() {
+	m.round = nil
+	m.addround = nil
 }
 
-// SetRoleID sets the "role_id" field.
-func (m *RoleTemplateRoleMutation) SetRoleID(u uuid.UUID) {
-	m.role = &u
+// SetVoterID sets the "voter_id" field.
+func (m *VoteMutation) SetVoterID(u uuid.UUID) {
+	m.voter_id = &u
 }
 
-// RoleID returns the value of the "role_id" field in the mutation.
-func (m *RoleTemplateRoleMutation) RoleID() (r uuid.UUID, exists bool) {
-	v := m.role
+// VoterID returns the value of the "voter_id" field in the mutation.
+func (m *VoteMutation) VoterID() (r uuid.UUID, exists bool) {
+	v := m.voter_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoleID returns the old "role_id" field's value of the RoleTemplateRole entity.
-// If the RoleTemplateRole object wasn't provided to the builder, the object is fetched from the database.
+// OldVoterID returns the old "voter_id" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateRoleMutation) OldRoleID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VoteMutation) OldVoterID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVoterID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoleID requires an ID field in the mutation")
+		return v, errors.New("OldVoterID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVoterID: %w", err)
 	}
-	return oldValue.RoleID, nil
+	return oldValue.VoterID, nil
 }
 
-// ResetRoleID resets all changes to the "role_id" field.
-func (m *RoleTemplateRoleMutation) ResetRoleID() {
-	m.role = nil
+// ResetVoterID resets all changes to the "voter_id" field.
+func (m *VoteMutation) ResetVoterID() {
+	m.voter_id = nil
 }
 
-// SetCount sets the "count" field.
-func (m *RoleTemplateRoleMutation) SetCount(i int) {
-	m.count = &i
-	m.addcount = nil
+// SetTargetID sets the "target_id" field.
+func (m *VoteMutation) SetTargetID(u uuid.UUID) {
+	m.target_id = &u
 }
 
-// Count returns the value of the "count" field in the mutation.
-func (m *RoleTemplateRoleMutation) Count() (r int, exists bool) {
-	v := m.count
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *VoteMutation) TargetID() (r uuid.UUID, exists bool) {
+	v := m.target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old "count" field's value of the RoleTemplateRole entity.
-// If the RoleTemplateRole object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetID returns the old "target_id" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleTemplateRoleMutation) OldCount(ctx context.Context) (v int, err error) {
+func (m *VoteMutation) OldTargetID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCount requires an ID field in the mutation")
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.TargetID, nil
 }
 
-// AddCount adds i to the "count" field.
-func (m *RoleTemplateRoleMutation) AddCount(i int) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *VoteMutation) ResetTargetID() {
+	m.target_id = nil
 }
 
-// AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *RoleTemplateRoleMutation) AddedCount() (r int, exists bool) {
-	v := m.addcount
+// SetCreatedAt sets the "created_at" field.
+func (m *VoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCount resets all changes to the "count" field.
-func (m *RoleTemplateRoleMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
-}
-
-// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
-func (m *RoleTemplateRoleMutation) ClearRoleTemplate() {
-	m.clearedrole_template = true
-	m.clearedFields[roletemplaterole.FieldRoleTemplateID] = struct{}{}
-}
-
-// RoleTemplateCleared reports if the "role_template" edge to the RoleTemplate entity was cleared.
-func (m *RoleTemplateRoleMutation) RoleTemplateCleared() bool {
-	return m.clearedrole_template
-}
-
-// RoleTemplateIDs returns the "role_template" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoleTemplateID instead. It exists only for internal usage by the builders.
-func (m *RoleTemplateRoleMutation) RoleTemplateIDs() (ids []uuid.UUID) {
-	if id := m.role_template; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the Vote entity.
+// If the Vote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetRoleTemplate resets all changes to the "role_template" edge.
-func (m *RoleTemplateRoleMutation) ResetRoleTemplate() {
-	m.role_template = nil
-	m.clearedrole_template = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VoteMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearRole clears the "role" edge to the Role entity.
-func (m *RoleTemplateRoleMutation) ClearRole() {
-	m.clearedrole = true
-	m.clearedFields[roletemplaterole.FieldRoleID] = struct{}{}
+// ClearGame clears the "game" edge to the Game entity.
+func (m *VoteMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[vote.FieldGameID] = struct{}{}
 }
 
-// RoleCleared reports if the "role" edge to the Role entity was cleared.
-func (m *RoleTemplateRoleMutation) RoleCleared() bool {
-	return m.clearedrole
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *VoteMutation) GameCleared() bool {
+	return m.clearedgame
 }
 
-// RoleIDs returns the "role" edge IDs in the mutation.
+// GameIDs returns the "game" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoleID instead. It exists only for internal usage by the builders.
-func (m *RoleTemplateRoleMutation) RoleIDs() (ids []uuid.UUID) {
-	if id := m.role; id != nil {
+// GameID instead. It exists only for internal usage by the builders.
+func (m *VoteMutation) GameIDs() (ids []string) {
+	if id := m.game; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRole resets all changes to the "role" edge.
-func (m *RoleTemplateRoleMutation) ResetRole() {
-	m.role = nil
-	m.clearedrole = false
+// ResetGame resets all changes to the "game" edge.
+func (m *VoteMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
 }
 
-// Where appends a list predicates to the RoleTemplateRoleMutation builder.
-func (m *RoleTemplateRoleMutation) Where(ps ...predicate.RoleTemplateRole) {
+// Where appends a list predicates to the VoteMutation builder.
+func (m *VoteMutation) Where(ps ...predicate.Vote) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleTemplateRoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VoteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleTemplateRoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RoleTemplateRole, len(ps))
+func (m *VoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Vote, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4370,33 +14021,39 @@ func (m *RoleTemplateRoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleTemplateRoleMutation) Op() Op {
+func (m *VoteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleTemplateRoleMutation) SetOp(op Op) {
+func (m *VoteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RoleTemplateRole).
-func (m *RoleTemplateRoleMutation) Type() string {
+// Type returns the node type of this mutation (Vote).
+func (m *VoteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleTemplateRoleMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.role_template != nil {
-		fields = append(fields, roletemplaterole.FieldRoleTemplateID)
+func (m *VoteMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.game != nil {
+		fields = append(fields, vote.FieldGameID)
 	}
-	if m.role != nil {
-		fields = append(fields, roletemplaterole.FieldRoleID)
+	if m.round != nil {
+		fields = append(fields, vote.FieldRound)
 	}
-	if m.count != nil {
-		fields = append(fields, roletemplaterole.FieldCount)
+	if m.voter_id != nil {
+		fields = append(fields, vote.FieldVoterID)
+	}
+	if m.target_id != nil {
+		fields = append(fields, vote.FieldTargetID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, vote.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4404,14 +14061,18 @@ func (m *RoleTemplateRoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleTemplateRoleMutation) Field(name string) (ent.Value, bool) {
+func (m *VoteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case roletemplaterole.FieldRoleTemplateID:
-		return m.RoleTemplateID()
-	case roletemplaterole.FieldRoleID:
-		return m.RoleID()
-	case roletemplaterole.FieldCount:
-		return m.Count()
+	case vote.FieldGameID:
+		return m.GameID()
+	case vote.FieldRound:
+		return m.Round()
+	case vote.FieldVoterID:
+		return m.VoterID()
+	case vote.FieldTargetID:
+		return m.TargetID()
+	case vote.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -4419,54 +14080,72 @@ func (m *RoleTemplateRoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleTemplateRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case roletemplaterole.FieldRoleTemplateID:
-		return m.OldRoleTemplateID(ctx)
-	case roletemplaterole.FieldRoleID:
-		return m.OldRoleID(ctx)
-	case roletemplaterole.FieldCount:
-		return m.OldCount(ctx)
+	case vote.FieldGameID:
+		return m.OldGameID(ctx)
+	case vote.FieldRound:
+		return m.OldRound(ctx)
+	case vote.FieldVoterID:
+		return m.OldVoterID(ctx)
+	case vote.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case vote.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RoleTemplateRole field %s", name)
+	return nil, fmt.Errorf("unknown Vote field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleTemplateRoleMutation) SetField(name string, value ent.Value) error {
+func (m *VoteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case roletemplaterole.FieldRoleTemplateID:
+	case vote.FieldGameID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameID(v)
+		return nil
+	case vote.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRound(v)
+		return nil
+	case vote.FieldVoterID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoleTemplateID(v)
+		m.SetVoterID(v)
 		return nil
-	case roletemplaterole.FieldRoleID:
+	case vote.FieldTargetID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoleID(v)
+		m.SetTargetID(v)
 		return nil
-	case roletemplaterole.FieldCount:
-		v, ok := value.(int)
+	case vote.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCount(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplateRole field %s", name)
+	return fmt.Errorf("unknown Vote field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleTemplateRoleMutation) AddedFields() []string {
+func (m *VoteMutation) AddedFields() []string {
 	var fields []string
-	if m.addcount != nil {
-		fields = append(fields, roletemplaterole.FieldCount)
+	if m.addround != nil {
+		fields = append(fields, vote.FieldRound)
 	}
 	return fields
 }
@@ -4474,10 +14153,10 @@ func (m *RoleTemplateRoleMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleTemplateRoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VoteMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case roletemplaterole.FieldCount:
-		return m.AddedCount()
+	case vote.FieldRound:
+		return m.AddedRound()
 	}
 	return nil, false
 }
@@ -4485,77 +14164,76 @@ func (m *RoleTemplateRoleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleTemplateRoleMutation) AddField(name string, value ent.Value) error {
+func (m *VoteMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case roletemplaterole.FieldCount:
+	case vote.FieldRound:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCount(v)
+		m.AddRound(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplateRole numeric field %s", name)
+	return fmt.Errorf("unknown Vote numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleTemplateRoleMutation) ClearedFields() []string {
+func (m *VoteMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleTemplateRoleMutation) FieldCleared(name string) bool {
+func (m *VoteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleTemplateRoleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RoleTemplateRole nullable field %s", name)
+func (m *VoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Vote nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleTemplateRoleMutation) ResetField(name string) error {
+func (m *VoteMutation) ResetField(name string) error {
 	switch name {
-	case roletemplaterole.FieldRoleTemplateID:
-		m.ResetRoleTemplateID()
+	case vote.FieldGameID:
+		m.ResetGameID()
 		return nil
-	case roletemplaterole.FieldRoleID:
-		m.ResetRoleID()
+	case vote.FieldRound:
+		m.ResetRound()
 		return nil
-	case roletemplaterole.FieldCount:
-		m.ResetCount()
+	case vote.FieldVoterID:
+		m.ResetVoterID()
+		return nil
+	case vote.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case vote.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplateRole field %s", name)
+	return fmt.Errorf("unknown Vote field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleTemplateRoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.role_template != nil {
-		edges = append(edges, roletemplaterole.EdgeRoleTemplate)
-	}
-	if m.role != nil {
-		edges = append(edges, roletemplaterole.EdgeRole)
+func (m *VoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.game != nil {
+		edges = append(edges, vote.EdgeGame)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleTemplateRoleMutation) AddedIDs(name string) []ent.Value {
+func (m *VoteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case roletemplaterole.EdgeRoleTemplate:
-		if id := m.role_template; id != nil {
-			return []ent.Value{*id}
-		}
-	case roletemplaterole.EdgeRole:
-		if id := m.role; id != nil {
+	case vote.EdgeGame:
+		if id := m.game; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4563,65 +14241,54 @@ func (m *RoleTemplateRoleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleTemplateRoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *VoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleTemplateRoleMutation) RemovedIDs(name string) []ent.Value {
+func (m *VoteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleTemplateRoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedrole_template {
-		edges = append(edges, roletemplaterole.EdgeRoleTemplate)
-	}
-	if m.clearedrole {
-		edges = append(edges, roletemplaterole.EdgeRole)
+func (m *VoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgame {
+		edges = append(edges, vote.EdgeGame)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleTemplateRoleMutation) EdgeCleared(name string) bool {
+func (m *VoteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case roletemplaterole.EdgeRoleTemplate:
-		return m.clearedrole_template
-	case roletemplaterole.EdgeRole:
-		return m.clearedrole
+	case vote.EdgeGame:
+		return m.clearedgame
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleTemplateRoleMutation) ClearEdge(name string) error {
+func (m *VoteMutation) ClearEdge(name string) error {
 	switch name {
-	case roletemplaterole.EdgeRoleTemplate:
-		m.ClearRoleTemplate()
-		return nil
-	case roletemplaterole.EdgeRole:
-		m.ClearRole()
+	case vote.EdgeGame:
+		m.ClearGame()
 		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplateRole unique edge %s", name)
+	return fmt.Errorf("unknown Vote unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleTemplateRoleMutation) ResetEdge(name string) error {
+func (m *VoteMutation) ResetEdge(name string) error {
 	switch name {
-	case roletemplaterole.EdgeRoleTemplate:
-		m.ResetRoleTemplate()
-		return nil
-	case roletemplaterole.EdgeRole:
-		m.ResetRole()
+	case vote.EdgeGame:
+		m.ResetGame()
 		return nil
 	}
-	return fmt.Errorf("unknown RoleTemplateRole edge %s", name)
+	return fmt.Errorf("unknown Vote edge %s", name)
 }
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/nightaction"
+)
+
+// NightAction is the model entity for the NightAction schema.
+type NightAction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// GameID holds the value of the "game_id" field.
+	GameID string `json:"game_id,omitempty"`
+	// Night the action was taken on
+	Round int `json:"round,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID uuid.UUID `json:"player_id,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID uuid.UUID `json:"target_id,omitempty"`
+	// Which ability was used, for roles with more than one
+	Action string `json:"action,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NightActionQuery when eager-loading is set.
+	Edges        NightActionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NightActionEdges holds the relations/edges for other nodes in the graph.
+type NightActionEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NightActionEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NightAction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nightaction.FieldRound:
+			values[i] = new(sql.NullInt64)
+		case nightaction.FieldGameID, nightaction.FieldAction:
+			values[i] = new(sql.NullString)
+		case nightaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case nightaction.FieldID, nightaction.FieldPlayerID, nightaction.FieldTargetID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NightAction fields.
+func (_m *NightAction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nightaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case nightaction.FieldGameID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field game_id", values[i])
+			} else if value.Valid {
+				_m.GameID = value.String
+			}
+		case nightaction.FieldRound:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field round", values[i])
+			} else if value.Valid {
+				_m.Round = int(value.Int64)
+			}
+		case nightaction.FieldPlayerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value != nil {
+				_m.PlayerID = *value
+			}
+		case nightaction.FieldTargetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value != nil {
+				_m.TargetID = *value
+			}
+		case nightaction.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case nightaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NightAction.
+// This includes values selected through modifiers, order, etc.
+func (_m *NightAction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGame queries the "game" edge of the NightAction entity.
+func (_m *NightAction) QueryGame() *GameQuery {
+	return NewNightActionClient(_m.config).QueryGame(_m)
+}
+
+// Update returns a builder for updating this NightAction.
+// Note that you need to call NightAction.Unwrap() before calling this method if this NightAction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NightAction) Update() *NightActionUpdateOne {
+	return NewNightActionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NightAction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NightAction) Unwrap() *NightAction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NightAction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NightAction) String() string {
+	var builder strings.Builder
+	builder.WriteString("NightAction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("game_id=")
+	builder.WriteString(_m.GameID)
+	builder.WriteString(", ")
+	builder.WriteString("round=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Round))
+	builder.WriteString(", ")
+	builder.WriteString("player_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlayerID))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NightActions is a parsable slice of NightAction.
+type NightActions []*NightAction
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/nightaction"
+)
+
+// NightActionCreate is the builder for creating a NightAction entity.
+type NightActionCreate struct {
+	config
+	mutation *NightActionMutation
+	hooks    []Hook
+}
+
+// SetGameID sets the "game_id" field.
+func (_c *NightActionCreate) SetGameID(v string) *NightActionCreate {
+	_c.mutation.SetGameID(v)
+	return _c
+}
+
+// SetRound sets the "round" field.
+func (_c *NightActionCreate) SetRound(v int) *NightActionCreate {
+	_c.mutation.SetRound(v)
+	return _c
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_c *NightActionCreate) SetPlayerID(v uuid.UUID) *NightActionCreate {
+	_c.mutation.SetPlayerID(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *NightActionCreate) SetTargetID(v uuid.UUID) *NightActionCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *NightActionCreate) SetAction(v string) *NightActionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *NightActionCreate) SetNillableAction(v *string) *NightActionCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NightActionCreate) SetCreatedAt(v time.Time) *NightActionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NightActionCreate) SetNillableCreatedAt(v *time.Time) *NightActionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NightActionCreate) SetID(v uuid.UUID) *NightActionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NightActionCreate) SetNillableID(v *uuid.UUID) *NightActionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_c *NightActionCreate) SetGame(v *Game) *NightActionCreate {
+	return _c.SetGameID(v.ID)
+}
+
+// Mutation returns the NightActionMutation object of the builder.
+func (_c *NightActionCreate) Mutation() *NightActionMutation {
+	return _c.mutation
+}
+
+// Save creates the NightAction in the database.
+func (_c *NightActionCreate) Save(ctx context.Context) (*NightAction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NightActionCreate) SaveX(ctx context.Context) *NightAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NightActionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NightActionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NightActionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := nightaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := nightaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NightActionCreate) check() error {
+	if _, ok := _c.mutation.GameID(); !ok {
+		return &ValidationError{Name: "game_id", err: errors.New(`ent: missing required field "NightAction.game_id"`)}
+	}
+	if v, ok := _c.mutation.GameID(); ok {
+		if err := nightaction.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "NightAction.game_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Round(); !ok {
+		return &ValidationError{Name: "round", err: errors.New(`ent: missing required field "NightAction.round"`)}
+	}
+	if v, ok := _c.mutation.Round(); ok {
+		if err := nightaction.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "NightAction.round": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`ent: missing required field "NightAction.player_id"`)}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "NightAction.target_id"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := nightaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "NightAction.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NightAction.created_at"`)}
+	}
+	if len(_c.mutation.GameIDs()) == 0 {
+		return &ValidationError{Name: "game", err: errors.New(`ent: missing required edge "NightAction.game"`)}
+	}
+	return nil
+}
+
+func (_c *NightActionCreate) sqlSave(ctx context.Context) (*NightAction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NightActionCreate) createSpec() (*NightAction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NightAction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(nightaction.Table, sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Round(); ok {
+		_spec.SetField(nightaction.FieldRound, field.TypeInt, value)
+		_node.Round = value
+	}
+	if value, ok := _c.mutation.PlayerID(); ok {
+		_spec.SetField(nightaction.FieldPlayerID, field.TypeUUID, value)
+		_node.PlayerID = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(nightaction.FieldTargetID, field.TypeUUID, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(nightaction.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(nightaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nightaction.GameTable,
+			Columns: []string{nightaction.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GameID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NightActionCreateBulk is the builder for creating many NightAction entities in bulk.
+type NightActionCreateBulk struct {
+	config
+	err      error
+	builders []*NightActionCreate
+}
+
+// Save creates the NightAction entities in the database.
+func (_c *NightActionCreateBulk) Save(ctx context.Context) ([]*NightAction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NightAction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NightActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NightActionCreateBulk) SaveX(ctx context.Context) []*NightAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NightActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NightActionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
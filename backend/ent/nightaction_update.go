@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/nightaction"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// NightActionUpdate is the builder for updating NightAction entities.
+type NightActionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NightActionMutation
+}
+
+// Where appends a list predicates to the NightActionUpdate builder.
+func (_u *NightActionUpdate) Where(ps ...predicate.NightAction) *NightActionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *NightActionUpdate) SetGameID(v string) *NightActionUpdate {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *NightActionUpdate) SetNillableGameID(v *string) *NightActionUpdate {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *NightActionUpdate) SetRound(v int) *NightActionUpdate {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *NightActionUpdate) SetNillableRound(v *int) *NightActionUpdate {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *NightActionUpdate) AddRound(v int) *NightActionUpdate {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_u *NightActionUpdate) SetPlayerID(v uuid.UUID) *NightActionUpdate {
+	_u.mutation.SetPlayerID(v)
+	return _u
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (_u *NightActionUpdate) SetNillablePlayerID(v *uuid.UUID) *NightActionUpdate {
+	if v != nil {
+		_u.SetPlayerID(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *NightActionUpdate) SetTargetID(v uuid.UUID) *NightActionUpdate {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *NightActionUpdate) SetNillableTargetID(v *uuid.UUID) *NightActionUpdate {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *NightActionUpdate) SetAction(v string) *NightActionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *NightActionUpdate) SetNillableAction(v *string) *NightActionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// ClearAction clears the value of the "action" field.
+func (_u *NightActionUpdate) ClearAction() *NightActionUpdate {
+	_u.mutation.ClearAction()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *NightActionUpdate) SetCreatedAt(v time.Time) *NightActionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_u *NightActionUpdate) SetGame(v *Game) *NightActionUpdate {
+	return _u.SetGameID(v.ID)
+}
+
+// Mutation returns the NightActionMutation object of the builder.
+func (_u *NightActionUpdate) Mutation() *NightActionMutation {
+	return _u.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (_u *NightActionUpdate) ClearGame() *NightActionUpdate {
+	_u.mutation.ClearGame()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NightActionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NightActionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NightActionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NightActionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NightActionUpdate) defaults() {
+	if _, ok := _u.mutation.CreatedAt(); !ok {
+		v := nightaction.UpdateDefaultCreatedAt()
+		_u.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NightActionUpdate) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := nightaction.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "NightAction.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := nightaction.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "NightAction.round": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := nightaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "NightAction.action": %w`, err)}
+		}
+	}
+	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NightAction.game"`)
+	}
+	return nil
+}
+
+func (_u *NightActionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nightaction.Table, nightaction.Columns, sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(nightaction.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(nightaction.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PlayerID(); ok {
+		_spec.SetField(nightaction.FieldPlayerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(nightaction.FieldTargetID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(nightaction.FieldAction, field.TypeString, value)
+	}
+	if _u.mutation.ActionCleared() {
+		_spec.ClearField(nightaction.FieldAction, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(nightaction.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nightaction.GameTable,
+			Columns: []string{nightaction.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nightaction.GameTable,
+			Columns: []string{nightaction.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nightaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NightActionUpdateOne is the builder for updating a single NightAction entity.
+type NightActionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NightActionMutation
+}
+
+// SetGameID sets the "game_id" field.
+func (_u *NightActionUpdateOne) SetGameID(v string) *NightActionUpdateOne {
+	_u.mutation.SetGameID(v)
+	return _u
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (_u *NightActionUpdateOne) SetNillableGameID(v *string) *NightActionUpdateOne {
+	if v != nil {
+		_u.SetGameID(*v)
+	}
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *NightActionUpdateOne) SetRound(v int) *NightActionUpdateOne {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *NightActionUpdateOne) SetNillableRound(v *int) *NightActionUpdateOne {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *NightActionUpdateOne) AddRound(v int) *NightActionUpdateOne {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (_u *NightActionUpdateOne) SetPlayerID(v uuid.UUID) *NightActionUpdateOne {
+	_u.mutation.SetPlayerID(v)
+	return _u
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (_u *NightActionUpdateOne) SetNillablePlayerID(v *uuid.UUID) *NightActionUpdateOne {
+	if v != nil {
+		_u.SetPlayerID(*v)
+	}
+	return _u
+}
+
+// SetTargetID sets the "target_id" field.
+func (_u *NightActionUpdateOne) SetTargetID(v uuid.UUID) *NightActionUpdateOne {
+	_u.mutation.SetTargetID(v)
+	return _u
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_u *NightActionUpdateOne) SetNillableTargetID(v *uuid.UUID) *NightActionUpdateOne {
+	if v != nil {
+		_u.SetTargetID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *NightActionUpdateOne) SetAction(v string) *NightActionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *NightActionUpdateOne) SetNillableAction(v *string) *NightActionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// ClearAction clears the value of the "action" field.
+func (_u *NightActionUpdateOne) ClearAction() *NightActionUpdateOne {
+	_u.mutation.ClearAction()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *NightActionUpdateOne) SetCreatedAt(v time.Time) *NightActionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (_u *NightActionUpdateOne) SetGame(v *Game) *NightActionUpdateOne {
+	return _u.SetGameID(v.ID)
+}
+
+// Mutation returns the NightActionMutation object of the builder.
+func (_u *NightActionUpdateOne) Mutation() *NightActionMutation {
+	return _u.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (_u *NightActionUpdateOne) ClearGame() *NightActionUpdateOne {
+	_u.mutation.ClearGame()
+	return _u
+}
+
+// Where appends a list predicates to the NightActionUpdate builder.
+func (_u *NightActionUpdateOne) Where(ps ...predicate.NightAction) *NightActionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NightActionUpdateOne) Select(field string, fields ...string) *NightActionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated NightAction entity.
+func (_u *NightActionUpdateOne) Save(ctx context.Context) (*NightAction, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NightActionUpdateOne) SaveX(ctx context.Context) *NightAction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NightActionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NightActionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NightActionUpdateOne) defaults() {
+	if _, ok := _u.mutation.CreatedAt(); !ok {
+		v := nightaction.UpdateDefaultCreatedAt()
+		_u.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NightActionUpdateOne) check() error {
+	if v, ok := _u.mutation.GameID(); ok {
+		if err := nightaction.GameIDValidator(v); err != nil {
+			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "NightAction.game_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := nightaction.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "NightAction.round": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := nightaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "NightAction.action": %w`, err)}
+		}
+	}
+	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NightAction.game"`)
+	}
+	return nil
+}
+
+func (_u *NightActionUpdateOne) sqlSave(ctx context.Context) (_node *NightAction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(nightaction.Table, nightaction.Columns, sqlgraph.NewFieldSpec(nightaction.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NightAction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nightaction.FieldID)
+		for _, f := range fields {
+			if !nightaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nightaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(nightaction.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(nightaction.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PlayerID(); ok {
+		_spec.SetField(nightaction.FieldPlayerID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TargetID(); ok {
+		_spec.SetField(nightaction.FieldTargetID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(nightaction.FieldAction, field.TypeString, value)
+	}
+	if _u.mutation.ActionCleared() {
+		_spec.ClearField(nightaction.FieldAction, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(nightaction.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nightaction.GameTable,
+			Columns: []string{nightaction.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   nightaction.GameTable,
+			Columns: []string{nightaction.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NightAction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nightaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
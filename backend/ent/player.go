@@ -24,6 +24,14 @@ type Player struct {
 	Name string `json:"name,omitempty"`
 	// GameID holds the value of the "game_id" field.
 	GameID string `json:"game_id,omitempty"`
+	// Zero-based seat index around the table, set when the player joins
+	Seat int `json:"seat,omitempty"`
+	// Whether the player is still in the game
+	Alive bool `json:"alive,omitempty"`
+	// Whether the player is ready for the game to start
+	Ready bool `json:"ready,omitempty"`
+	// Whether a moderator has muted the player in chat
+	Muted bool `json:"muted,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -70,6 +78,10 @@ func (*Player) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case player.FieldAlive, player.FieldReady, player.FieldMuted:
+			values[i] = new(sql.NullBool)
+		case player.FieldSeat:
+			values[i] = new(sql.NullInt64)
 		case player.FieldName, player.FieldGameID:
 			values[i] = new(sql.NullString)
 		case player.FieldCreatedAt:
@@ -109,6 +121,30 @@ func (_m *Player) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.GameID = value.String
 			}
+		case player.FieldSeat:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seat", values[i])
+			} else if value.Valid {
+				_m.Seat = int(value.Int64)
+			}
+		case player.FieldAlive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field alive", values[i])
+			} else if value.Valid {
+				_m.Alive = value.Bool
+			}
+		case player.FieldReady:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field ready", values[i])
+			} else if value.Valid {
+				_m.Ready = value.Bool
+			}
+		case player.FieldMuted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field muted", values[i])
+			} else if value.Valid {
+				_m.Muted = value.Bool
+			}
 		case player.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -167,6 +203,18 @@ func (_m *Player) String() string {
 	builder.WriteString("game_id=")
 	builder.WriteString(_m.GameID)
 	builder.WriteString(", ")
+	builder.WriteString("seat=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seat))
+	builder.WriteString(", ")
+	builder.WriteString("alive=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Alive))
+	builder.WriteString(", ")
+	builder.WriteString("ready=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ready))
+	builder.WriteString(", ")
+	builder.WriteString("muted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Muted))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -19,6 +19,14 @@ const (
 	FieldName = "name"
 	// FieldGameID holds the string denoting the game_id field in the database.
 	FieldGameID = "game_id"
+	// FieldSeat holds the string denoting the seat field in the database.
+	FieldSeat = "seat"
+	// FieldAlive holds the string denoting the alive field in the database.
+	FieldAlive = "alive"
+	// FieldReady holds the string denoting the ready field in the database.
+	FieldReady = "ready"
+	// FieldMuted holds the string denoting the muted field in the database.
+	FieldMuted = "muted"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeGame holds the string denoting the game edge name in mutations.
@@ -48,6 +56,10 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldGameID,
+	FieldSeat,
+	FieldAlive,
+	FieldReady,
+	FieldMuted,
 	FieldCreatedAt,
 }
 
@@ -66,6 +78,14 @@ var (
 	NameValidator func(string) error
 	// GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
 	GameIDValidator func(string) error
+	// SeatValidator is a validator for the "seat" field. It is called by the builders before save.
+	SeatValidator func(int) error
+	// DefaultAlive holds the default value on creation for the "alive" field.
+	DefaultAlive bool
+	// DefaultReady holds the default value on creation for the "ready" field.
+	DefaultReady bool
+	// DefaultMuted holds the default value on creation for the "muted" field.
+	DefaultMuted bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -90,6 +110,26 @@ func ByGameID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGameID, opts...).ToFunc()
 }
 
+// BySeat orders the results by the seat field.
+func BySeat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeat, opts...).ToFunc()
+}
+
+// ByAlive orders the results by the alive field.
+func ByAlive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlive, opts...).ToFunc()
+}
+
+// ByReady orders the results by the ready field.
+func ByReady(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReady, opts...).ToFunc()
+}
+
+// ByMuted orders the results by the muted field.
+func ByMuted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMuted, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -66,6 +66,26 @@ func GameID(v string) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldGameID, v))
 }
 
+// Seat applies equality check predicate on the "seat" field. It's identical to SeatEQ.
+func Seat(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSeat, v))
+}
+
+// Alive applies equality check predicate on the "alive" field. It's identical to AliveEQ.
+func Alive(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldAlive, v))
+}
+
+// Ready applies equality check predicate on the "ready" field. It's identical to ReadyEQ.
+func Ready(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldReady, v))
+}
+
+// Muted applies equality check predicate on the "muted" field. It's identical to MutedEQ.
+func Muted(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldMuted, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldCreatedAt, v))
@@ -201,6 +221,86 @@ func GameIDContainsFold(v string) predicate.Player {
 	return predicate.Player(sql.FieldContainsFold(FieldGameID, v))
 }
 
+// SeatEQ applies the EQ predicate on the "seat" field.
+func SeatEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSeat, v))
+}
+
+// SeatNEQ applies the NEQ predicate on the "seat" field.
+func SeatNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldSeat, v))
+}
+
+// SeatIn applies the In predicate on the "seat" field.
+func SeatIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldSeat, vs...))
+}
+
+// SeatNotIn applies the NotIn predicate on the "seat" field.
+func SeatNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldSeat, vs...))
+}
+
+// SeatGT applies the GT predicate on the "seat" field.
+func SeatGT(v int) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldSeat, v))
+}
+
+// SeatGTE applies the GTE predicate on the "seat" field.
+func SeatGTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldSeat, v))
+}
+
+// SeatLT applies the LT predicate on the "seat" field.
+func SeatLT(v int) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldSeat, v))
+}
+
+// SeatLTE applies the LTE predicate on the "seat" field.
+func SeatLTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldSeat, v))
+}
+
+// SeatIsNil applies the IsNil predicate on the "seat" field.
+func SeatIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldSeat))
+}
+
+// SeatNotNil applies the NotNil predicate on the "seat" field.
+func SeatNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldSeat))
+}
+
+// AliveEQ applies the EQ predicate on the "alive" field.
+func AliveEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldAlive, v))
+}
+
+// AliveNEQ applies the NEQ predicate on the "alive" field.
+func AliveNEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldAlive, v))
+}
+
+// ReadyEQ applies the EQ predicate on the "ready" field.
+func ReadyEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldReady, v))
+}
+
+// ReadyNEQ applies the NEQ predicate on the "ready" field.
+func ReadyNEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldReady, v))
+}
+
+// MutedEQ applies the EQ predicate on the "muted" field.
+func MutedEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldMuted, v))
+}
+
+// MutedNEQ applies the NEQ predicate on the "muted" field.
+func MutedNEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldMuted, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldCreatedAt, v))
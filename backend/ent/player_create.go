@@ -35,6 +35,62 @@ func (_c *PlayerCreate) SetGameID(v string) *PlayerCreate {
 	return _c
 }
 
+// SetSeat sets the "seat" field.
+func (_c *PlayerCreate) SetSeat(v int) *PlayerCreate {
+	_c.mutation.SetSeat(v)
+	return _c
+}
+
+// SetNillableSeat sets the "seat" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableSeat(v *int) *PlayerCreate {
+	if v != nil {
+		_c.SetSeat(*v)
+	}
+	return _c
+}
+
+// SetAlive sets the "alive" field.
+func (_c *PlayerCreate) SetAlive(v bool) *PlayerCreate {
+	_c.mutation.SetAlive(v)
+	return _c
+}
+
+// SetNillableAlive sets the "alive" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableAlive(v *bool) *PlayerCreate {
+	if v != nil {
+		_c.SetAlive(*v)
+	}
+	return _c
+}
+
+// SetReady sets the "ready" field.
+func (_c *PlayerCreate) SetReady(v bool) *PlayerCreate {
+	_c.mutation.SetReady(v)
+	return _c
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableReady(v *bool) *PlayerCreate {
+	if v != nil {
+		_c.SetReady(*v)
+	}
+	return _c
+}
+
+// SetMuted sets the "muted" field.
+func (_c *PlayerCreate) SetMuted(v bool) *PlayerCreate {
+	_c.mutation.SetMuted(v)
+	return _c
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_c *PlayerCreate) SetNillableMuted(v *bool) *PlayerCreate {
+	if v != nil {
+		_c.SetMuted(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PlayerCreate) SetCreatedAt(v time.Time) *PlayerCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -122,6 +178,18 @@ func (_c *PlayerCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PlayerCreate) defaults() {
+	if _, ok := _c.mutation.Alive(); !ok {
+		v := player.DefaultAlive
+		_c.mutation.SetAlive(v)
+	}
+	if _, ok := _c.mutation.Ready(); !ok {
+		v := player.DefaultReady
+		_c.mutation.SetReady(v)
+	}
+	if _, ok := _c.mutation.Muted(); !ok {
+		v := player.DefaultMuted
+		_c.mutation.SetMuted(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := player.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -150,6 +218,20 @@ func (_c *PlayerCreate) check() error {
 			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "Player.game_id": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Seat(); ok {
+		if err := player.SeatValidator(v); err != nil {
+			return &ValidationError{Name: "seat", err: fmt.Errorf(`ent: validator failed for field "Player.seat": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Alive(); !ok {
+		return &ValidationError{Name: "alive", err: errors.New(`ent: missing required field "Player.alive"`)}
+	}
+	if _, ok := _c.mutation.Ready(); !ok {
+		return &ValidationError{Name: "ready", err: errors.New(`ent: missing required field "Player.ready"`)}
+	}
+	if _, ok := _c.mutation.Muted(); !ok {
+		return &ValidationError{Name: "muted", err: errors.New(`ent: missing required field "Player.muted"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Player.created_at"`)}
 	}
@@ -195,6 +277,22 @@ func (_c *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		_spec.SetField(player.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Seat(); ok {
+		_spec.SetField(player.FieldSeat, field.TypeInt, value)
+		_node.Seat = value
+	}
+	if value, ok := _c.mutation.Alive(); ok {
+		_spec.SetField(player.FieldAlive, field.TypeBool, value)
+		_node.Alive = value
+	}
+	if value, ok := _c.mutation.Ready(); ok {
+		_spec.SetField(player.FieldReady, field.TypeBool, value)
+		_node.Ready = value
+	}
+	if value, ok := _c.mutation.Muted(); ok {
+		_spec.SetField(player.FieldMuted, field.TypeBool, value)
+		_node.Muted = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(player.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
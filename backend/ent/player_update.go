@@ -57,6 +57,75 @@ func (_u *PlayerUpdate) SetNillableGameID(v *string) *PlayerUpdate {
 	return _u
 }
 
+// SetSeat sets the "seat" field.
+func (_u *PlayerUpdate) SetSeat(v int) *PlayerUpdate {
+	_u.mutation.ResetSeat()
+	_u.mutation.SetSeat(v)
+	return _u
+}
+
+// SetNillableSeat sets the "seat" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableSeat(v *int) *PlayerUpdate {
+	if v != nil {
+		_u.SetSeat(*v)
+	}
+	return _u
+}
+
+// AddSeat adds value to the "seat" field.
+func (_u *PlayerUpdate) AddSeat(v int) *PlayerUpdate {
+	_u.mutation.AddSeat(v)
+	return _u
+}
+
+// ClearSeat clears the value of the "seat" field.
+func (_u *PlayerUpdate) ClearSeat() *PlayerUpdate {
+	_u.mutation.ClearSeat()
+	return _u
+}
+
+// SetAlive sets the "alive" field.
+func (_u *PlayerUpdate) SetAlive(v bool) *PlayerUpdate {
+	_u.mutation.SetAlive(v)
+	return _u
+}
+
+// SetNillableAlive sets the "alive" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableAlive(v *bool) *PlayerUpdate {
+	if v != nil {
+		_u.SetAlive(*v)
+	}
+	return _u
+}
+
+// SetReady sets the "ready" field.
+func (_u *PlayerUpdate) SetReady(v bool) *PlayerUpdate {
+	_u.mutation.SetReady(v)
+	return _u
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableReady(v *bool) *PlayerUpdate {
+	if v != nil {
+		_u.SetReady(*v)
+	}
+	return _u
+}
+
+// SetMuted sets the "muted" field.
+func (_u *PlayerUpdate) SetMuted(v bool) *PlayerUpdate {
+	_u.mutation.SetMuted(v)
+	return _u
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_u *PlayerUpdate) SetNillableMuted(v *bool) *PlayerUpdate {
+	if v != nil {
+		_u.SetMuted(*v)
+	}
+	return _u
+}
+
 // SetGame sets the "game" edge to the Game entity.
 func (_u *PlayerUpdate) SetGame(v *Game) *PlayerUpdate {
 	return _u.SetGameID(v.ID)
@@ -137,6 +206,11 @@ func (_u *PlayerUpdate) check() error {
 			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "Player.game_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Seat(); ok {
+		if err := player.SeatValidator(v); err != nil {
+			return &ValidationError{Name: "seat", err: fmt.Errorf(`ent: validator failed for field "Player.seat": %w`, err)}
+		}
+	}
 	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Player.game"`)
 	}
@@ -158,6 +232,24 @@ func (_u *PlayerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(player.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Seat(); ok {
+		_spec.SetField(player.FieldSeat, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeat(); ok {
+		_spec.AddField(player.FieldSeat, field.TypeInt, value)
+	}
+	if _u.mutation.SeatCleared() {
+		_spec.ClearField(player.FieldSeat, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Alive(); ok {
+		_spec.SetField(player.FieldAlive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Ready(); ok {
+		_spec.SetField(player.FieldReady, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Muted(); ok {
+		_spec.SetField(player.FieldMuted, field.TypeBool, value)
+	}
 	if _u.mutation.GameCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -264,6 +356,75 @@ func (_u *PlayerUpdateOne) SetNillableGameID(v *string) *PlayerUpdateOne {
 	return _u
 }
 
+// SetSeat sets the "seat" field.
+func (_u *PlayerUpdateOne) SetSeat(v int) *PlayerUpdateOne {
+	_u.mutation.ResetSeat()
+	_u.mutation.SetSeat(v)
+	return _u
+}
+
+// SetNillableSeat sets the "seat" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableSeat(v *int) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetSeat(*v)
+	}
+	return _u
+}
+
+// AddSeat adds value to the "seat" field.
+func (_u *PlayerUpdateOne) AddSeat(v int) *PlayerUpdateOne {
+	_u.mutation.AddSeat(v)
+	return _u
+}
+
+// ClearSeat clears the value of the "seat" field.
+func (_u *PlayerUpdateOne) ClearSeat() *PlayerUpdateOne {
+	_u.mutation.ClearSeat()
+	return _u
+}
+
+// SetAlive sets the "alive" field.
+func (_u *PlayerUpdateOne) SetAlive(v bool) *PlayerUpdateOne {
+	_u.mutation.SetAlive(v)
+	return _u
+}
+
+// SetNillableAlive sets the "alive" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableAlive(v *bool) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetAlive(*v)
+	}
+	return _u
+}
+
+// SetReady sets the "ready" field.
+func (_u *PlayerUpdateOne) SetReady(v bool) *PlayerUpdateOne {
+	_u.mutation.SetReady(v)
+	return _u
+}
+
+// SetNillableReady sets the "ready" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableReady(v *bool) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetReady(*v)
+	}
+	return _u
+}
+
+// SetMuted sets the "muted" field.
+func (_u *PlayerUpdateOne) SetMuted(v bool) *PlayerUpdateOne {
+	_u.mutation.SetMuted(v)
+	return _u
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_u *PlayerUpdateOne) SetNillableMuted(v *bool) *PlayerUpdateOne {
+	if v != nil {
+		_u.SetMuted(*v)
+	}
+	return _u
+}
+
 // SetGame sets the "game" edge to the Game entity.
 func (_u *PlayerUpdateOne) SetGame(v *Game) *PlayerUpdateOne {
 	return _u.SetGameID(v.ID)
@@ -357,6 +518,11 @@ func (_u *PlayerUpdateOne) check() error {
 			return &ValidationError{Name: "game_id", err: fmt.Errorf(`ent: validator failed for field "Player.game_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Seat(); ok {
+		if err := player.SeatValidator(v); err != nil {
+			return &ValidationError{Name: "seat", err: fmt.Errorf(`ent: validator failed for field "Player.seat": %w`, err)}
+		}
+	}
 	if _u.mutation.GameCleared() && len(_u.mutation.GameIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Player.game"`)
 	}
@@ -395,6 +561,24 @@ func (_u *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err erro
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(player.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Seat(); ok {
+		_spec.SetField(player.FieldSeat, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeat(); ok {
+		_spec.AddField(player.FieldSeat, field.TypeInt, value)
+	}
+	if _u.mutation.SeatCleared() {
+		_spec.ClearField(player.FieldSeat, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Alive(); ok {
+		_spec.SetField(player.FieldAlive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Ready(); ok {
+		_spec.SetField(player.FieldReady, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Muted(); ok {
+		_spec.SetField(player.FieldMuted, field.TypeBool, value)
+	}
 	if _u.mutation.GameCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -9,20 +9,53 @@ import (
 // Admin is the predicate function for admin builders.
 type Admin func(*sql.Selector)
 
+// ChatMessage is the predicate function for chatmessage builders.
+type ChatMessage func(*sql.Selector)
+
 // Game is the predicate function for game builders.
 type Game func(*sql.Selector)
 
+// GameModerator is the predicate function for gamemoderator builders.
+type GameModerator func(*sql.Selector)
+
 // GameRole is the predicate function for gamerole builders.
 type GameRole func(*sql.Selector)
 
+// ModeratorAction is the predicate function for moderatoraction builders.
+type ModeratorAction func(*sql.Selector)
+
+// NightAction is the predicate function for nightaction builders.
+type NightAction func(*sql.Selector)
+
 // Player is the predicate function for player builders.
 type Player func(*sql.Selector)
 
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// RoleRule is the predicate function for rolerule builders.
+type RoleRule func(*sql.Selector)
+
 // RoleTemplate is the predicate function for roletemplate builders.
 type RoleTemplate func(*sql.Selector)
 
+// RoleTemplateRevision is the predicate function for roletemplaterevision builders.
+type RoleTemplateRevision func(*sql.Selector)
+
 // RoleTemplateRole is the predicate function for roletemplaterole builders.
 type RoleTemplateRole func(*sql.Selector)
+
+// RoleTemplateSlot is the predicate function for roletemplateslot builders.
+type RoleTemplateSlot func(*sql.Selector)
+
+// RoleTemplateTranslation is the predicate function for roletemplatetranslation builders.
+type RoleTemplateTranslation func(*sql.Selector)
+
+// RoleTranslation is the predicate function for roletranslation builders.
+type RoleTranslation func(*sql.Selector)
+
+// Spectator is the predicate function for spectator builders.
+type Spectator func(*sql.Selector)
+
+// Vote is the predicate function for vote builders.
+type Vote func(*sql.Selector)
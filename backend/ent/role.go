@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -22,14 +23,18 @@ type Role struct {
 	Name string `json:"name,omitempty"`
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
-	// Video holds the value of the "video" field.
+	// URL of the role's video; empty until one is uploaded
 	Video string `json:"video,omitempty"`
+	// URL of the role's card image
+	Image string `json:"image,omitempty"`
 	// Team holds the value of the "team" field.
 	Team role.Team `json:"team,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// List of role abilities
 	Abilities []string `json:"abilities,omitempty"`
+	// When the role was archived; archived roles stay in past games but are not dealt again
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoleQuery when eager-loading is set.
 	Edges        RoleEdges `json:"edges"`
@@ -42,9 +47,17 @@ type RoleEdges struct {
 	GameRoles []*GameRole `json:"game_roles,omitempty"`
 	// TemplateRoles holds the value of the template_roles edge.
 	TemplateRoles []*RoleTemplateRole `json:"template_roles,omitempty"`
+	// TemplateSlots holds the value of the template_slots edge.
+	TemplateSlots []*RoleTemplateSlot `json:"template_slots,omitempty"`
+	// Translations holds the value of the translations edge.
+	Translations []*RoleTranslation `json:"translations,omitempty"`
+	// Rules holds the value of the rules edge.
+	Rules []*RoleRule `json:"rules,omitempty"`
+	// TargetedByRules holds the value of the targeted_by_rules edge.
+	TargetedByRules []*RoleRule `json:"targeted_by_rules,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // GameRolesOrErr returns the GameRoles value or an error if the edge
@@ -65,6 +78,42 @@ func (e RoleEdges) TemplateRolesOrErr() ([]*RoleTemplateRole, error) {
 	return nil, &NotLoadedError{edge: "template_roles"}
 }
 
+// TemplateSlotsOrErr returns the TemplateSlots value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) TemplateSlotsOrErr() ([]*RoleTemplateSlot, error) {
+	if e.loadedTypes[2] {
+		return e.TemplateSlots, nil
+	}
+	return nil, &NotLoadedError{edge: "template_slots"}
+}
+
+// TranslationsOrErr returns the Translations value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) TranslationsOrErr() ([]*RoleTranslation, error) {
+	if e.loadedTypes[3] {
+		return e.Translations, nil
+	}
+	return nil, &NotLoadedError{edge: "translations"}
+}
+
+// RulesOrErr returns the Rules value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) RulesOrErr() ([]*RoleRule, error) {
+	if e.loadedTypes[4] {
+		return e.Rules, nil
+	}
+	return nil, &NotLoadedError{edge: "rules"}
+}
+
+// TargetedByRulesOrErr returns the TargetedByRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) TargetedByRulesOrErr() ([]*RoleRule, error) {
+	if e.loadedTypes[5] {
+		return e.TargetedByRules, nil
+	}
+	return nil, &NotLoadedError{edge: "targeted_by_rules"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -72,8 +121,10 @@ func (*Role) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case role.FieldAbilities:
 			values[i] = new([]byte)
-		case role.FieldName, role.FieldSlug, role.FieldVideo, role.FieldTeam, role.FieldDescription:
+		case role.FieldName, role.FieldSlug, role.FieldVideo, role.FieldImage, role.FieldTeam, role.FieldDescription:
 			values[i] = new(sql.NullString)
+		case role.FieldArchivedAt:
+			values[i] = new(sql.NullTime)
 		case role.FieldID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -115,6 +166,12 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Video = value.String
 			}
+		case role.FieldImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image", values[i])
+			} else if value.Valid {
+				_m.Image = value.String
+			}
 		case role.FieldTeam:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field team", values[i])
@@ -135,6 +192,13 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field abilities: %w", err)
 				}
 			}
+		case role.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +222,26 @@ func (_m *Role) QueryTemplateRoles() *RoleTemplateRoleQuery {
 	return NewRoleClient(_m.config).QueryTemplateRoles(_m)
 }
 
+// QueryTemplateSlots queries the "template_slots" edge of the Role entity.
+func (_m *Role) QueryTemplateSlots() *RoleTemplateSlotQuery {
+	return NewRoleClient(_m.config).QueryTemplateSlots(_m)
+}
+
+// QueryTranslations queries the "translations" edge of the Role entity.
+func (_m *Role) QueryTranslations() *RoleTranslationQuery {
+	return NewRoleClient(_m.config).QueryTranslations(_m)
+}
+
+// QueryRules queries the "rules" edge of the Role entity.
+func (_m *Role) QueryRules() *RoleRuleQuery {
+	return NewRoleClient(_m.config).QueryRules(_m)
+}
+
+// QueryTargetedByRules queries the "targeted_by_rules" edge of the Role entity.
+func (_m *Role) QueryTargetedByRules() *RoleRuleQuery {
+	return NewRoleClient(_m.config).QueryTargetedByRules(_m)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -190,6 +274,9 @@ func (_m *Role) String() string {
 	builder.WriteString("video=")
 	builder.WriteString(_m.Video)
 	builder.WriteString(", ")
+	builder.WriteString("image=")
+	builder.WriteString(_m.Image)
+	builder.WriteString(", ")
 	builder.WriteString("team=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Team))
 	builder.WriteString(", ")
@@ -198,6 +285,11 @@ func (_m *Role) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("abilities=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Abilities))
+	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
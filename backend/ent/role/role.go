@@ -21,16 +21,28 @@ const (
 	FieldSlug = "slug"
 	// FieldVideo holds the string denoting the video field in the database.
 	FieldVideo = "video"
+	// FieldImage holds the string denoting the image field in the database.
+	FieldImage = "image"
 	// FieldTeam holds the string denoting the team field in the database.
 	FieldTeam = "team"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
 	// FieldAbilities holds the string denoting the abilities field in the database.
 	FieldAbilities = "abilities"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
 	// EdgeGameRoles holds the string denoting the game_roles edge name in mutations.
 	EdgeGameRoles = "game_roles"
 	// EdgeTemplateRoles holds the string denoting the template_roles edge name in mutations.
 	EdgeTemplateRoles = "template_roles"
+	// EdgeTemplateSlots holds the string denoting the template_slots edge name in mutations.
+	EdgeTemplateSlots = "template_slots"
+	// EdgeTranslations holds the string denoting the translations edge name in mutations.
+	EdgeTranslations = "translations"
+	// EdgeRules holds the string denoting the rules edge name in mutations.
+	EdgeRules = "rules"
+	// EdgeTargetedByRules holds the string denoting the targeted_by_rules edge name in mutations.
+	EdgeTargetedByRules = "targeted_by_rules"
 	// Table holds the table name of the role in the database.
 	Table = "roles"
 	// GameRolesTable is the table that holds the game_roles relation/edge.
@@ -47,6 +59,34 @@ const (
 	TemplateRolesInverseTable = "role_template_roles"
 	// TemplateRolesColumn is the table column denoting the template_roles relation/edge.
 	TemplateRolesColumn = "role_id"
+	// TemplateSlotsTable is the table that holds the template_slots relation/edge.
+	TemplateSlotsTable = "role_template_slots"
+	// TemplateSlotsInverseTable is the table name for the RoleTemplateSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "roletemplateslot" package.
+	TemplateSlotsInverseTable = "role_template_slots"
+	// TemplateSlotsColumn is the table column denoting the template_slots relation/edge.
+	TemplateSlotsColumn = "role_id"
+	// TranslationsTable is the table that holds the translations relation/edge.
+	TranslationsTable = "role_translations"
+	// TranslationsInverseTable is the table name for the RoleTranslation entity.
+	// It exists in this package in order to avoid circular dependency with the "roletranslation" package.
+	TranslationsInverseTable = "role_translations"
+	// TranslationsColumn is the table column denoting the translations relation/edge.
+	TranslationsColumn = "role_id"
+	// RulesTable is the table that holds the rules relation/edge.
+	RulesTable = "role_rules"
+	// RulesInverseTable is the table name for the RoleRule entity.
+	// It exists in this package in order to avoid circular dependency with the "rolerule" package.
+	RulesInverseTable = "role_rules"
+	// RulesColumn is the table column denoting the rules relation/edge.
+	RulesColumn = "role_id"
+	// TargetedByRulesTable is the table that holds the targeted_by_rules relation/edge.
+	TargetedByRulesTable = "role_rules"
+	// TargetedByRulesInverseTable is the table name for the RoleRule entity.
+	// It exists in this package in order to avoid circular dependency with the "rolerule" package.
+	TargetedByRulesInverseTable = "role_rules"
+	// TargetedByRulesColumn is the table column denoting the targeted_by_rules relation/edge.
+	TargetedByRulesColumn = "target_role_id"
 )
 
 // Columns holds all SQL columns for role fields.
@@ -55,9 +95,11 @@ var Columns = []string{
 	FieldName,
 	FieldSlug,
 	FieldVideo,
+	FieldImage,
 	FieldTeam,
 	FieldDescription,
 	FieldAbilities,
+	FieldArchivedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -77,6 +119,8 @@ var (
 	SlugValidator func(string) error
 	// VideoValidator is a validator for the "video" field. It is called by the builders before save.
 	VideoValidator func(string) error
+	// ImageValidator is a validator for the "image" field. It is called by the builders before save.
+	ImageValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -128,6 +172,11 @@ func ByVideo(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVideo, opts...).ToFunc()
 }
 
+// ByImage orders the results by the image field.
+func ByImage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImage, opts...).ToFunc()
+}
+
 // ByTeam orders the results by the team field.
 func ByTeam(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTeam, opts...).ToFunc()
@@ -138,6 +187,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
 // ByGameRolesCount orders the results by game_roles count.
 func ByGameRolesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -165,6 +219,62 @@ func ByTemplateRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTemplateRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTemplateSlotsCount orders the results by template_slots count.
+func ByTemplateSlotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTemplateSlotsStep(), opts...)
+	}
+}
+
+// ByTemplateSlots orders the results by template_slots terms.
+func ByTemplateSlots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplateSlotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTranslationsCount orders the results by translations count.
+func ByTranslationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTranslationsStep(), opts...)
+	}
+}
+
+// ByTranslations orders the results by translations terms.
+func ByTranslations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranslationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRulesCount orders the results by rules count.
+func ByRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRulesStep(), opts...)
+	}
+}
+
+// ByRules orders the results by rules terms.
+func ByRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTargetedByRulesCount orders the results by targeted_by_rules count.
+func ByTargetedByRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTargetedByRulesStep(), opts...)
+	}
+}
+
+// ByTargetedByRules orders the results by targeted_by_rules terms.
+func ByTargetedByRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTargetedByRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newGameRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -179,3 +289,31 @@ func newTemplateRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TemplateRolesTable, TemplateRolesColumn),
 	)
 }
+func newTemplateSlotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplateSlotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TemplateSlotsTable, TemplateSlotsColumn),
+	)
+}
+func newTranslationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranslationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+	)
+}
+func newRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RulesTable, RulesColumn),
+	)
+}
+func newTargetedByRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TargetedByRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TargetedByRulesTable, TargetedByRulesColumn),
+	)
+}
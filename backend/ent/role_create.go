@@ -6,13 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/gamerole"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletranslation"
 )
 
 // RoleCreate is the builder for creating a Role entity.
@@ -40,6 +44,20 @@ func (_c *RoleCreate) SetVideo(v string) *RoleCreate {
 	return _c
 }
 
+// SetImage sets the "image" field.
+func (_c *RoleCreate) SetImage(v string) *RoleCreate {
+	_c.mutation.SetImage(v)
+	return _c
+}
+
+// SetNillableImage sets the "image" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableImage(v *string) *RoleCreate {
+	if v != nil {
+		_c.SetImage(*v)
+	}
+	return _c
+}
+
 // SetTeam sets the "team" field.
 func (_c *RoleCreate) SetTeam(v role.Team) *RoleCreate {
 	_c.mutation.SetTeam(v)
@@ -66,6 +84,20 @@ func (_c *RoleCreate) SetAbilities(v []string) *RoleCreate {
 	return _c
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_c *RoleCreate) SetArchivedAt(v time.Time) *RoleCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableArchivedAt(v *time.Time) *RoleCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RoleCreate) SetID(v uuid.UUID) *RoleCreate {
 	_c.mutation.SetID(v)
@@ -110,6 +142,66 @@ func (_c *RoleCreate) AddTemplateRoles(v ...*RoleTemplateRole) *RoleCreate {
 	return _c.AddTemplateRoleIDs(ids...)
 }
 
+// AddTemplateSlotIDs adds the "template_slots" edge to the RoleTemplateSlot entity by IDs.
+func (_c *RoleCreate) AddTemplateSlotIDs(ids ...uuid.UUID) *RoleCreate {
+	_c.mutation.AddTemplateSlotIDs(ids...)
+	return _c
+}
+
+// AddTemplateSlots adds the "template_slots" edges to the RoleTemplateSlot entity.
+func (_c *RoleCreate) AddTemplateSlots(v ...*RoleTemplateSlot) *RoleCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTemplateSlotIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTranslation entity by IDs.
+func (_c *RoleCreate) AddTranslationIDs(ids ...uuid.UUID) *RoleCreate {
+	_c.mutation.AddTranslationIDs(ids...)
+	return _c
+}
+
+// AddTranslations adds the "translations" edges to the RoleTranslation entity.
+func (_c *RoleCreate) AddTranslations(v ...*RoleTranslation) *RoleCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTranslationIDs(ids...)
+}
+
+// AddRuleIDs adds the "rules" edge to the RoleRule entity by IDs.
+func (_c *RoleCreate) AddRuleIDs(ids ...uuid.UUID) *RoleCreate {
+	_c.mutation.AddRuleIDs(ids...)
+	return _c
+}
+
+// AddRules adds the "rules" edges to the RoleRule entity.
+func (_c *RoleCreate) AddRules(v ...*RoleRule) *RoleCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRuleIDs(ids...)
+}
+
+// AddTargetedByRuleIDs adds the "targeted_by_rules" edge to the RoleRule entity by IDs.
+func (_c *RoleCreate) AddTargetedByRuleIDs(ids ...uuid.UUID) *RoleCreate {
+	_c.mutation.AddTargetedByRuleIDs(ids...)
+	return _c
+}
+
+// AddTargetedByRules adds the "targeted_by_rules" edges to the RoleRule entity.
+func (_c *RoleCreate) AddTargetedByRules(v ...*RoleRule) *RoleCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTargetedByRuleIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (_c *RoleCreate) Mutation() *RoleMutation {
 	return _c.mutation
@@ -177,6 +269,11 @@ func (_c *RoleCreate) check() error {
 			return &ValidationError{Name: "video", err: fmt.Errorf(`ent: validator failed for field "Role.video": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Image(); ok {
+		if err := role.ImageValidator(v); err != nil {
+			return &ValidationError{Name: "image", err: fmt.Errorf(`ent: validator failed for field "Role.image": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Team(); !ok {
 		return &ValidationError{Name: "team", err: errors.New(`ent: missing required field "Role.team"`)}
 	}
@@ -232,6 +329,10 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldVideo, field.TypeString, value)
 		_node.Video = value
 	}
+	if value, ok := _c.mutation.Image(); ok {
+		_spec.SetField(role.FieldImage, field.TypeString, value)
+		_node.Image = value
+	}
 	if value, ok := _c.mutation.Team(); ok {
 		_spec.SetField(role.FieldTeam, field.TypeEnum, value)
 		_node.Team = value
@@ -244,6 +345,10 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldAbilities, field.TypeJSON, value)
 		_node.Abilities = value
 	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(role.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
 	if nodes := _c.mutation.GameRolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -276,6 +381,70 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TemplateSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TemplateSlotsTable,
+			Columns: []string{role.TemplateSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TranslationsTable,
+			Columns: []string{role.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RulesTable,
+			Columns: []string{role.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TargetedByRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TargetedByRulesTable,
+			Columns: []string{role.TargetedByRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
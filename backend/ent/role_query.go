@@ -16,18 +16,25 @@ import (
 	"github.com/mafia-night/backend/ent/gamerole"
 	"github.com/mafia-night/backend/ent/predicate"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletranslation"
 )
 
 // RoleQuery is the builder for querying Role entities.
 type RoleQuery struct {
 	config
-	ctx               *QueryContext
-	order             []role.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Role
-	withGameRoles     *GameRoleQuery
-	withTemplateRoles *RoleTemplateRoleQuery
+	ctx                 *QueryContext
+	order               []role.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Role
+	withGameRoles       *GameRoleQuery
+	withTemplateRoles   *RoleTemplateRoleQuery
+	withTemplateSlots   *RoleTemplateSlotQuery
+	withTranslations    *RoleTranslationQuery
+	withRules           *RoleRuleQuery
+	withTargetedByRules *RoleRuleQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +115,94 @@ func (_q *RoleQuery) QueryTemplateRoles() *RoleTemplateRoleQuery {
 	return query
 }
 
+// QueryTemplateSlots chains the current query on the "template_slots" edge.
+func (_q *RoleQuery) QueryTemplateSlots() *RoleTemplateSlotQuery {
+	query := (&RoleTemplateSlotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(roletemplateslot.Table, roletemplateslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.TemplateSlotsTable, role.TemplateSlotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTranslations chains the current query on the "translations" edge.
+func (_q *RoleQuery) QueryTranslations() *RoleTranslationQuery {
+	query := (&RoleTranslationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(roletranslation.Table, roletranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.TranslationsTable, role.TranslationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRules chains the current query on the "rules" edge.
+func (_q *RoleQuery) QueryRules() *RoleRuleQuery {
+	query := (&RoleRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(rolerule.Table, rolerule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.RulesTable, role.RulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTargetedByRules chains the current query on the "targeted_by_rules" edge.
+func (_q *RoleQuery) QueryTargetedByRules() *RoleRuleQuery {
+	query := (&RoleRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(rolerule.Table, rolerule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.TargetedByRulesTable, role.TargetedByRulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Role entity from the query.
 // Returns a *NotFoundError when no Role was found.
 func (_q *RoleQuery) First(ctx context.Context) (*Role, error) {
@@ -295,13 +390,17 @@ func (_q *RoleQuery) Clone() *RoleQuery {
 		return nil
 	}
 	return &RoleQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]role.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.Role{}, _q.predicates...),
-		withGameRoles:     _q.withGameRoles.Clone(),
-		withTemplateRoles: _q.withTemplateRoles.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]role.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Role{}, _q.predicates...),
+		withGameRoles:       _q.withGameRoles.Clone(),
+		withTemplateRoles:   _q.withTemplateRoles.Clone(),
+		withTemplateSlots:   _q.withTemplateSlots.Clone(),
+		withTranslations:    _q.withTranslations.Clone(),
+		withRules:           _q.withRules.Clone(),
+		withTargetedByRules: _q.withTargetedByRules.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +429,50 @@ func (_q *RoleQuery) WithTemplateRoles(opts ...func(*RoleTemplateRoleQuery)) *Ro
 	return _q
 }
 
+// WithTemplateSlots tells the query-builder to eager-load the nodes that are connected to
+// the "template_slots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleQuery) WithTemplateSlots(opts ...func(*RoleTemplateSlotQuery)) *RoleQuery {
+	query := (&RoleTemplateSlotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTemplateSlots = query
+	return _q
+}
+
+// WithTranslations tells the query-builder to eager-load the nodes that are connected to
+// the "translations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleQuery) WithTranslations(opts ...func(*RoleTranslationQuery)) *RoleQuery {
+	query := (&RoleTranslationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranslations = query
+	return _q
+}
+
+// WithRules tells the query-builder to eager-load the nodes that are connected to
+// the "rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleQuery) WithRules(opts ...func(*RoleRuleQuery)) *RoleQuery {
+	query := (&RoleRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRules = query
+	return _q
+}
+
+// WithTargetedByRules tells the query-builder to eager-load the nodes that are connected to
+// the "targeted_by_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleQuery) WithTargetedByRules(opts ...func(*RoleRuleQuery)) *RoleQuery {
+	query := (&RoleRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTargetedByRules = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +551,13 @@ func (_q *RoleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Role, e
 	var (
 		nodes       = []*Role{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			_q.withGameRoles != nil,
 			_q.withTemplateRoles != nil,
+			_q.withTemplateSlots != nil,
+			_q.withTranslations != nil,
+			_q.withRules != nil,
+			_q.withTargetedByRules != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -445,6 +592,34 @@ func (_q *RoleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Role, e
 			return nil, err
 		}
 	}
+	if query := _q.withTemplateSlots; query != nil {
+		if err := _q.loadTemplateSlots(ctx, query, nodes,
+			func(n *Role) { n.Edges.TemplateSlots = []*RoleTemplateSlot{} },
+			func(n *Role, e *RoleTemplateSlot) { n.Edges.TemplateSlots = append(n.Edges.TemplateSlots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTranslations; query != nil {
+		if err := _q.loadTranslations(ctx, query, nodes,
+			func(n *Role) { n.Edges.Translations = []*RoleTranslation{} },
+			func(n *Role, e *RoleTranslation) { n.Edges.Translations = append(n.Edges.Translations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRules; query != nil {
+		if err := _q.loadRules(ctx, query, nodes,
+			func(n *Role) { n.Edges.Rules = []*RoleRule{} },
+			func(n *Role, e *RoleRule) { n.Edges.Rules = append(n.Edges.Rules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTargetedByRules; query != nil {
+		if err := _q.loadTargetedByRules(ctx, query, nodes,
+			func(n *Role) { n.Edges.TargetedByRules = []*RoleRule{} },
+			func(n *Role, e *RoleRule) { n.Edges.TargetedByRules = append(n.Edges.TargetedByRules, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,6 +683,129 @@ func (_q *RoleQuery) loadTemplateRoles(ctx context.Context, query *RoleTemplateR
 	}
 	return nil
 }
+func (_q *RoleQuery) loadTemplateSlots(ctx context.Context, query *RoleTemplateSlotQuery, nodes []*Role, init func(*Role), assign func(*Role, *RoleTemplateSlot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Role)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roletemplateslot.FieldRoleID)
+	}
+	query.Where(predicate.RoleTemplateSlot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(role.TemplateSlotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RoleQuery) loadTranslations(ctx context.Context, query *RoleTranslationQuery, nodes []*Role, init func(*Role), assign func(*Role, *RoleTranslation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Role)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roletranslation.FieldRoleID)
+	}
+	query.Where(predicate.RoleTranslation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(role.TranslationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RoleQuery) loadRules(ctx context.Context, query *RoleRuleQuery, nodes []*Role, init func(*Role), assign func(*Role, *RoleRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Role)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rolerule.FieldRoleID)
+	}
+	query.Where(predicate.RoleRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(role.RulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RoleQuery) loadTargetedByRules(ctx context.Context, query *RoleRuleQuery, nodes []*Role, init func(*Role), assign func(*Role, *RoleRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Role)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rolerule.FieldTargetRoleID)
+	}
+	query.Where(predicate.RoleRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(role.TargetedByRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TargetRoleID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "target_role_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_role_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *RoleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,15 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/gamerole"
 	"github.com/mafia-night/backend/ent/predicate"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletranslation"
 )
 
 // RoleUpdate is the builder for updating Role entities.
@@ -72,6 +77,26 @@ func (_u *RoleUpdate) SetNillableVideo(v *string) *RoleUpdate {
 	return _u
 }
 
+// SetImage sets the "image" field.
+func (_u *RoleUpdate) SetImage(v string) *RoleUpdate {
+	_u.mutation.SetImage(v)
+	return _u
+}
+
+// SetNillableImage sets the "image" field if the given value is not nil.
+func (_u *RoleUpdate) SetNillableImage(v *string) *RoleUpdate {
+	if v != nil {
+		_u.SetImage(*v)
+	}
+	return _u
+}
+
+// ClearImage clears the value of the "image" field.
+func (_u *RoleUpdate) ClearImage() *RoleUpdate {
+	_u.mutation.ClearImage()
+	return _u
+}
+
 // SetTeam sets the "team" field.
 func (_u *RoleUpdate) SetTeam(v role.Team) *RoleUpdate {
 	_u.mutation.SetTeam(v)
@@ -124,6 +149,26 @@ func (_u *RoleUpdate) ClearAbilities() *RoleUpdate {
 	return _u
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_u *RoleUpdate) SetArchivedAt(v time.Time) *RoleUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *RoleUpdate) SetNillableArchivedAt(v *time.Time) *RoleUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *RoleUpdate) ClearArchivedAt() *RoleUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
 // AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by IDs.
 func (_u *RoleUpdate) AddGameRoleIDs(ids ...int) *RoleUpdate {
 	_u.mutation.AddGameRoleIDs(ids...)
@@ -154,6 +199,66 @@ func (_u *RoleUpdate) AddTemplateRoles(v ...*RoleTemplateRole) *RoleUpdate {
 	return _u.AddTemplateRoleIDs(ids...)
 }
 
+// AddTemplateSlotIDs adds the "template_slots" edge to the RoleTemplateSlot entity by IDs.
+func (_u *RoleUpdate) AddTemplateSlotIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.AddTemplateSlotIDs(ids...)
+	return _u
+}
+
+// AddTemplateSlots adds the "template_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleUpdate) AddTemplateSlots(v ...*RoleTemplateSlot) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateSlotIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTranslation entity by IDs.
+func (_u *RoleUpdate) AddTranslationIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the RoleTranslation entity.
+func (_u *RoleUpdate) AddTranslations(v ...*RoleTranslation) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
+// AddRuleIDs adds the "rules" edge to the RoleRule entity by IDs.
+func (_u *RoleUpdate) AddRuleIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.AddRuleIDs(ids...)
+	return _u
+}
+
+// AddRules adds the "rules" edges to the RoleRule entity.
+func (_u *RoleUpdate) AddRules(v ...*RoleRule) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleIDs(ids...)
+}
+
+// AddTargetedByRuleIDs adds the "targeted_by_rules" edge to the RoleRule entity by IDs.
+func (_u *RoleUpdate) AddTargetedByRuleIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.AddTargetedByRuleIDs(ids...)
+	return _u
+}
+
+// AddTargetedByRules adds the "targeted_by_rules" edges to the RoleRule entity.
+func (_u *RoleUpdate) AddTargetedByRules(v ...*RoleRule) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTargetedByRuleIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (_u *RoleUpdate) Mutation() *RoleMutation {
 	return _u.mutation
@@ -201,6 +306,90 @@ func (_u *RoleUpdate) RemoveTemplateRoles(v ...*RoleTemplateRole) *RoleUpdate {
 	return _u.RemoveTemplateRoleIDs(ids...)
 }
 
+// ClearTemplateSlots clears all "template_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleUpdate) ClearTemplateSlots() *RoleUpdate {
+	_u.mutation.ClearTemplateSlots()
+	return _u
+}
+
+// RemoveTemplateSlotIDs removes the "template_slots" edge to RoleTemplateSlot entities by IDs.
+func (_u *RoleUpdate) RemoveTemplateSlotIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.RemoveTemplateSlotIDs(ids...)
+	return _u
+}
+
+// RemoveTemplateSlots removes "template_slots" edges to RoleTemplateSlot entities.
+func (_u *RoleUpdate) RemoveTemplateSlots(v ...*RoleTemplateSlot) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateSlotIDs(ids...)
+}
+
+// ClearTranslations clears all "translations" edges to the RoleTranslation entity.
+func (_u *RoleUpdate) ClearTranslations() *RoleUpdate {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to RoleTranslation entities by IDs.
+func (_u *RoleUpdate) RemoveTranslationIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to RoleTranslation entities.
+func (_u *RoleUpdate) RemoveTranslations(v ...*RoleTranslation) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
+// ClearRules clears all "rules" edges to the RoleRule entity.
+func (_u *RoleUpdate) ClearRules() *RoleUpdate {
+	_u.mutation.ClearRules()
+	return _u
+}
+
+// RemoveRuleIDs removes the "rules" edge to RoleRule entities by IDs.
+func (_u *RoleUpdate) RemoveRuleIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.RemoveRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRules removes "rules" edges to RoleRule entities.
+func (_u *RoleUpdate) RemoveRules(v ...*RoleRule) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleIDs(ids...)
+}
+
+// ClearTargetedByRules clears all "targeted_by_rules" edges to the RoleRule entity.
+func (_u *RoleUpdate) ClearTargetedByRules() *RoleUpdate {
+	_u.mutation.ClearTargetedByRules()
+	return _u
+}
+
+// RemoveTargetedByRuleIDs removes the "targeted_by_rules" edge to RoleRule entities by IDs.
+func (_u *RoleUpdate) RemoveTargetedByRuleIDs(ids ...uuid.UUID) *RoleUpdate {
+	_u.mutation.RemoveTargetedByRuleIDs(ids...)
+	return _u
+}
+
+// RemoveTargetedByRules removes "targeted_by_rules" edges to RoleRule entities.
+func (_u *RoleUpdate) RemoveTargetedByRules(v ...*RoleRule) *RoleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTargetedByRuleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *RoleUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -245,6 +434,11 @@ func (_u *RoleUpdate) check() error {
 			return &ValidationError{Name: "video", err: fmt.Errorf(`ent: validator failed for field "Role.video": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Image(); ok {
+		if err := role.ImageValidator(v); err != nil {
+			return &ValidationError{Name: "image", err: fmt.Errorf(`ent: validator failed for field "Role.image": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Team(); ok {
 		if err := role.TeamValidator(v); err != nil {
 			return &ValidationError{Name: "team", err: fmt.Errorf(`ent: validator failed for field "Role.team": %w`, err)}
@@ -274,6 +468,12 @@ func (_u *RoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Video(); ok {
 		_spec.SetField(role.FieldVideo, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Image(); ok {
+		_spec.SetField(role.FieldImage, field.TypeString, value)
+	}
+	if _u.mutation.ImageCleared() {
+		_spec.ClearField(role.FieldImage, field.TypeString)
+	}
 	if value, ok := _u.mutation.Team(); ok {
 		_spec.SetField(role.FieldTeam, field.TypeEnum, value)
 	}
@@ -294,6 +494,12 @@ func (_u *RoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AbilitiesCleared() {
 		_spec.ClearField(role.FieldAbilities, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(role.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(role.FieldArchivedAt, field.TypeTime)
+	}
 	if _u.mutation.GameRolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -384,6 +590,186 @@ func (_u *RoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplateSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TemplateSlotsTable,
+			Columns: []string{role.TemplateSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplateSlotsIDs(); len(nodes) > 0 && !_u.mutation.TemplateSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TemplateSlotsTable,
+			Columns: []string{role.TemplateSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TemplateSlotsTable,
+			Columns: []string{role.TemplateSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TranslationsTable,
+			Columns: []string{role.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TranslationsTable,
+			Columns: []string{role.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TranslationsTable,
+			Columns: []string{role.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RulesTable,
+			Columns: []string{role.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRulesIDs(); len(nodes) > 0 && !_u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RulesTable,
+			Columns: []string{role.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RulesTable,
+			Columns: []string{role.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetedByRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TargetedByRulesTable,
+			Columns: []string{role.TargetedByRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTargetedByRulesIDs(); len(nodes) > 0 && !_u.mutation.TargetedByRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TargetedByRulesTable,
+			Columns: []string{role.TargetedByRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetedByRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TargetedByRulesTable,
+			Columns: []string{role.TargetedByRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{role.Label}
@@ -446,6 +832,26 @@ func (_u *RoleUpdateOne) SetNillableVideo(v *string) *RoleUpdateOne {
 	return _u
 }
 
+// SetImage sets the "image" field.
+func (_u *RoleUpdateOne) SetImage(v string) *RoleUpdateOne {
+	_u.mutation.SetImage(v)
+	return _u
+}
+
+// SetNillableImage sets the "image" field if the given value is not nil.
+func (_u *RoleUpdateOne) SetNillableImage(v *string) *RoleUpdateOne {
+	if v != nil {
+		_u.SetImage(*v)
+	}
+	return _u
+}
+
+// ClearImage clears the value of the "image" field.
+func (_u *RoleUpdateOne) ClearImage() *RoleUpdateOne {
+	_u.mutation.ClearImage()
+	return _u
+}
+
 // SetTeam sets the "team" field.
 func (_u *RoleUpdateOne) SetTeam(v role.Team) *RoleUpdateOne {
 	_u.mutation.SetTeam(v)
@@ -498,6 +904,26 @@ func (_u *RoleUpdateOne) ClearAbilities() *RoleUpdateOne {
 	return _u
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_u *RoleUpdateOne) SetArchivedAt(v time.Time) *RoleUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *RoleUpdateOne) SetNillableArchivedAt(v *time.Time) *RoleUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *RoleUpdateOne) ClearArchivedAt() *RoleUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
 // AddGameRoleIDs adds the "game_roles" edge to the GameRole entity by IDs.
 func (_u *RoleUpdateOne) AddGameRoleIDs(ids ...int) *RoleUpdateOne {
 	_u.mutation.AddGameRoleIDs(ids...)
@@ -528,6 +954,66 @@ func (_u *RoleUpdateOne) AddTemplateRoles(v ...*RoleTemplateRole) *RoleUpdateOne
 	return _u.AddTemplateRoleIDs(ids...)
 }
 
+// AddTemplateSlotIDs adds the "template_slots" edge to the RoleTemplateSlot entity by IDs.
+func (_u *RoleUpdateOne) AddTemplateSlotIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.AddTemplateSlotIDs(ids...)
+	return _u
+}
+
+// AddTemplateSlots adds the "template_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleUpdateOne) AddTemplateSlots(v ...*RoleTemplateSlot) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateSlotIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTranslation entity by IDs.
+func (_u *RoleUpdateOne) AddTranslationIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the RoleTranslation entity.
+func (_u *RoleUpdateOne) AddTranslations(v ...*RoleTranslation) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
+// AddRuleIDs adds the "rules" edge to the RoleRule entity by IDs.
+func (_u *RoleUpdateOne) AddRuleIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.AddRuleIDs(ids...)
+	return _u
+}
+
+// AddRules adds the "rules" edges to the RoleRule entity.
+func (_u *RoleUpdateOne) AddRules(v ...*RoleRule) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleIDs(ids...)
+}
+
+// AddTargetedByRuleIDs adds the "targeted_by_rules" edge to the RoleRule entity by IDs.
+func (_u *RoleUpdateOne) AddTargetedByRuleIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.AddTargetedByRuleIDs(ids...)
+	return _u
+}
+
+// AddTargetedByRules adds the "targeted_by_rules" edges to the RoleRule entity.
+func (_u *RoleUpdateOne) AddTargetedByRules(v ...*RoleRule) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTargetedByRuleIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (_u *RoleUpdateOne) Mutation() *RoleMutation {
 	return _u.mutation
@@ -575,6 +1061,90 @@ func (_u *RoleUpdateOne) RemoveTemplateRoles(v ...*RoleTemplateRole) *RoleUpdate
 	return _u.RemoveTemplateRoleIDs(ids...)
 }
 
+// ClearTemplateSlots clears all "template_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleUpdateOne) ClearTemplateSlots() *RoleUpdateOne {
+	_u.mutation.ClearTemplateSlots()
+	return _u
+}
+
+// RemoveTemplateSlotIDs removes the "template_slots" edge to RoleTemplateSlot entities by IDs.
+func (_u *RoleUpdateOne) RemoveTemplateSlotIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.RemoveTemplateSlotIDs(ids...)
+	return _u
+}
+
+// RemoveTemplateSlots removes "template_slots" edges to RoleTemplateSlot entities.
+func (_u *RoleUpdateOne) RemoveTemplateSlots(v ...*RoleTemplateSlot) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateSlotIDs(ids...)
+}
+
+// ClearTranslations clears all "translations" edges to the RoleTranslation entity.
+func (_u *RoleUpdateOne) ClearTranslations() *RoleUpdateOne {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to RoleTranslation entities by IDs.
+func (_u *RoleUpdateOne) RemoveTranslationIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to RoleTranslation entities.
+func (_u *RoleUpdateOne) RemoveTranslations(v ...*RoleTranslation) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
+// ClearRules clears all "rules" edges to the RoleRule entity.
+func (_u *RoleUpdateOne) ClearRules() *RoleUpdateOne {
+	_u.mutation.ClearRules()
+	return _u
+}
+
+// RemoveRuleIDs removes the "rules" edge to RoleRule entities by IDs.
+func (_u *RoleUpdateOne) RemoveRuleIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.RemoveRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRules removes "rules" edges to RoleRule entities.
+func (_u *RoleUpdateOne) RemoveRules(v ...*RoleRule) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleIDs(ids...)
+}
+
+// ClearTargetedByRules clears all "targeted_by_rules" edges to the RoleRule entity.
+func (_u *RoleUpdateOne) ClearTargetedByRules() *RoleUpdateOne {
+	_u.mutation.ClearTargetedByRules()
+	return _u
+}
+
+// RemoveTargetedByRuleIDs removes the "targeted_by_rules" edge to RoleRule entities by IDs.
+func (_u *RoleUpdateOne) RemoveTargetedByRuleIDs(ids ...uuid.UUID) *RoleUpdateOne {
+	_u.mutation.RemoveTargetedByRuleIDs(ids...)
+	return _u
+}
+
+// RemoveTargetedByRules removes "targeted_by_rules" edges to RoleRule entities.
+func (_u *RoleUpdateOne) RemoveTargetedByRules(v ...*RoleRule) *RoleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTargetedByRuleIDs(ids...)
+}
+
 // Where appends a list predicates to the RoleUpdate builder.
 func (_u *RoleUpdateOne) Where(ps ...predicate.Role) *RoleUpdateOne {
 	_u.mutation.Where(ps...)
@@ -632,6 +1202,11 @@ func (_u *RoleUpdateOne) check() error {
 			return &ValidationError{Name: "video", err: fmt.Errorf(`ent: validator failed for field "Role.video": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Image(); ok {
+		if err := role.ImageValidator(v); err != nil {
+			return &ValidationError{Name: "image", err: fmt.Errorf(`ent: validator failed for field "Role.image": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Team(); ok {
 		if err := role.TeamValidator(v); err != nil {
 			return &ValidationError{Name: "team", err: fmt.Errorf(`ent: validator failed for field "Role.team": %w`, err)}
@@ -678,6 +1253,12 @@ func (_u *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error) {
 	if value, ok := _u.mutation.Video(); ok {
 		_spec.SetField(role.FieldVideo, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Image(); ok {
+		_spec.SetField(role.FieldImage, field.TypeString, value)
+	}
+	if _u.mutation.ImageCleared() {
+		_spec.ClearField(role.FieldImage, field.TypeString)
+	}
 	if value, ok := _u.mutation.Team(); ok {
 		_spec.SetField(role.FieldTeam, field.TypeEnum, value)
 	}
@@ -698,6 +1279,12 @@ func (_u *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error) {
 	if _u.mutation.AbilitiesCleared() {
 		_spec.ClearField(role.FieldAbilities, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(role.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(role.FieldArchivedAt, field.TypeTime)
+	}
 	if _u.mutation.GameRolesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -788,6 +1375,186 @@ func (_u *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplateSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TemplateSlotsTable,
+			Columns: []string{role.TemplateSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplateSlotsIDs(); len(nodes) > 0 && !_u.mutation.TemplateSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TemplateSlotsTable,
+			Columns: []string{role.TemplateSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TemplateSlotsTable,
+			Columns: []string{role.TemplateSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TranslationsTable,
+			Columns: []string{role.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TranslationsTable,
+			Columns: []string{role.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TranslationsTable,
+			Columns: []string{role.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RulesTable,
+			Columns: []string{role.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRulesIDs(); len(nodes) > 0 && !_u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RulesTable,
+			Columns: []string{role.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.RulesTable,
+			Columns: []string{role.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetedByRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TargetedByRulesTable,
+			Columns: []string{role.TargetedByRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTargetedByRulesIDs(); len(nodes) > 0 && !_u.mutation.TargetedByRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TargetedByRulesTable,
+			Columns: []string{role.TargetedByRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetedByRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.TargetedByRulesTable,
+			Columns: []string{role.TargetedByRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Role{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+)
+
+// RoleRule is the model entity for the RoleRule schema.
+type RoleRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Role the rule applies to
+	RoleID uuid.UUID `json:"role_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind rolerule.Kind `json:"kind,omitempty"`
+	// Role that is required or excluded
+	TargetRoleID *uuid.UUID `json:"target_role_id,omitempty"`
+	// Team that is required, for requires rules without a target role
+	TargetTeam *rolerule.TargetTeam `json:"target_team,omitempty"`
+	// Most copies of the role per game, for max_count rules
+	MaxCount *int `json:"max_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleRuleQuery when eager-loading is set.
+	Edges        RoleRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleRuleEdges holds the relations/edges for other nodes in the graph.
+type RoleRuleEdges struct {
+	// Role holds the value of the role edge.
+	Role *Role `json:"role,omitempty"`
+	// TargetRole holds the value of the target_role edge.
+	TargetRole *Role `json:"target_role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleRuleEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// TargetRoleOrErr returns the TargetRole value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleRuleEdges) TargetRoleOrErr() (*Role, error) {
+	if e.TargetRole != nil {
+		return e.TargetRole, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "target_role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolerule.FieldTargetRoleID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case rolerule.FieldMaxCount:
+			values[i] = new(sql.NullInt64)
+		case rolerule.FieldKind, rolerule.FieldTargetTeam:
+			values[i] = new(sql.NullString)
+		case rolerule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case rolerule.FieldID, rolerule.FieldRoleID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleRule fields.
+func (_m *RoleRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolerule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case rolerule.FieldRoleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value != nil {
+				_m.RoleID = *value
+			}
+		case rolerule.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = rolerule.Kind(value.String)
+			}
+		case rolerule.FieldTargetRoleID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field target_role_id", values[i])
+			} else if value.Valid {
+				_m.TargetRoleID = new(uuid.UUID)
+				*_m.TargetRoleID = *value.S.(*uuid.UUID)
+			}
+		case rolerule.FieldTargetTeam:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_team", values[i])
+			} else if value.Valid {
+				_m.TargetTeam = new(rolerule.TargetTeam)
+				*_m.TargetTeam = rolerule.TargetTeam(value.String)
+			}
+		case rolerule.FieldMaxCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_count", values[i])
+			} else if value.Valid {
+				_m.MaxCount = new(int)
+				*_m.MaxCount = int(value.Int64)
+			}
+		case rolerule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoleRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRole queries the "role" edge of the RoleRule entity.
+func (_m *RoleRule) QueryRole() *RoleQuery {
+	return NewRoleRuleClient(_m.config).QueryRole(_m)
+}
+
+// QueryTargetRole queries the "target_role" edge of the RoleRule entity.
+func (_m *RoleRule) QueryTargetRole() *RoleQuery {
+	return NewRoleRuleClient(_m.config).QueryTargetRole(_m)
+}
+
+// Update returns a builder for updating this RoleRule.
+// Note that you need to call RoleRule.Unwrap() before calling this method if this RoleRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoleRule) Update() *RoleRuleUpdateOne {
+	return NewRoleRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoleRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoleRule) Unwrap() *RoleRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoleRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	if v := _m.TargetRoleID; v != nil {
+		builder.WriteString("target_role_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TargetTeam; v != nil {
+		builder.WriteString("target_team=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxCount; v != nil {
+		builder.WriteString("max_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleRules is a parsable slice of RoleRule.
+type RoleRules []*RoleRule
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolerule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the rolerule type in the database.
+	Label = "role_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoleID holds the string denoting the role_id field in the database.
+	FieldRoleID = "role_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldTargetRoleID holds the string denoting the target_role_id field in the database.
+	FieldTargetRoleID = "target_role_id"
+	// FieldTargetTeam holds the string denoting the target_team field in the database.
+	FieldTargetTeam = "target_team"
+	// FieldMaxCount holds the string denoting the max_count field in the database.
+	FieldMaxCount = "max_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRole holds the string denoting the role edge name in mutations.
+	EdgeRole = "role"
+	// EdgeTargetRole holds the string denoting the target_role edge name in mutations.
+	EdgeTargetRole = "target_role"
+	// Table holds the table name of the rolerule in the database.
+	Table = "role_rules"
+	// RoleTable is the table that holds the role relation/edge.
+	RoleTable = "role_rules"
+	// RoleInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RoleInverseTable = "roles"
+	// RoleColumn is the table column denoting the role relation/edge.
+	RoleColumn = "role_id"
+	// TargetRoleTable is the table that holds the target_role relation/edge.
+	TargetRoleTable = "role_rules"
+	// TargetRoleInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	TargetRoleInverseTable = "roles"
+	// TargetRoleColumn is the table column denoting the target_role relation/edge.
+	TargetRoleColumn = "target_role_id"
+)
+
+// Columns holds all SQL columns for rolerule fields.
+var Columns = []string{
+	FieldID,
+	FieldRoleID,
+	FieldKind,
+	FieldTargetRoleID,
+	FieldTargetTeam,
+	FieldMaxCount,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MaxCountValidator is a validator for the "max_count" field. It is called by the builders before save.
+	MaxCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindRequires Kind = "requires"
+	KindExcludes Kind = "excludes"
+	KindMaxCount Kind = "max_count"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindRequires, KindExcludes, KindMaxCount:
+		return nil
+	default:
+		return fmt.Errorf("rolerule: invalid enum value for kind field: %q", k)
+	}
+}
+
+// TargetTeam defines the type for the "target_team" enum field.
+type TargetTeam string
+
+// TargetTeam values.
+const (
+	TargetTeamMafia       TargetTeam = "mafia"
+	TargetTeamVillage     TargetTeam = "village"
+	TargetTeamIndependent TargetTeam = "independent"
+)
+
+func (tt TargetTeam) String() string {
+	return string(tt)
+}
+
+// TargetTeamValidator is a validator for the "target_team" field enum values. It is called by the builders before save.
+func TargetTeamValidator(tt TargetTeam) error {
+	switch tt {
+	case TargetTeamMafia, TargetTeamVillage, TargetTeamIndependent:
+		return nil
+	default:
+		return fmt.Errorf("rolerule: invalid enum value for target_team field: %q", tt)
+	}
+}
+
+// OrderOption defines the ordering options for the RoleRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoleID orders the results by the role_id field.
+func ByRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByTargetRoleID orders the results by the target_role_id field.
+func ByTargetRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetRoleID, opts...).ToFunc()
+}
+
+// ByTargetTeam orders the results by the target_team field.
+func ByTargetTeam(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetTeam, opts...).ToFunc()
+}
+
+// ByMaxCount orders the results by the max_count field.
+func ByMaxCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRoleField orders the results by role field.
+func ByRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTargetRoleField orders the results by target_role field.
+func ByTargetRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTargetRoleStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRoleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RoleTable, RoleColumn),
+	)
+}
+func newTargetRoleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TargetRoleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TargetRoleTable, TargetRoleColumn),
+	)
+}
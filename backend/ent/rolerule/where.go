@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolerule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldLTE(FieldID, id))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldRoleID, v))
+}
+
+// TargetRoleID applies equality check predicate on the "target_role_id" field. It's identical to TargetRoleIDEQ.
+func TargetRoleID(v uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldTargetRoleID, v))
+}
+
+// MaxCount applies equality check predicate on the "max_count" field. It's identical to MaxCountEQ.
+func MaxCount(v int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldMaxCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// TargetRoleIDEQ applies the EQ predicate on the "target_role_id" field.
+func TargetRoleIDEQ(v uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldTargetRoleID, v))
+}
+
+// TargetRoleIDNEQ applies the NEQ predicate on the "target_role_id" field.
+func TargetRoleIDNEQ(v uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNEQ(FieldTargetRoleID, v))
+}
+
+// TargetRoleIDIn applies the In predicate on the "target_role_id" field.
+func TargetRoleIDIn(vs ...uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIn(FieldTargetRoleID, vs...))
+}
+
+// TargetRoleIDNotIn applies the NotIn predicate on the "target_role_id" field.
+func TargetRoleIDNotIn(vs ...uuid.UUID) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotIn(FieldTargetRoleID, vs...))
+}
+
+// TargetRoleIDIsNil applies the IsNil predicate on the "target_role_id" field.
+func TargetRoleIDIsNil() predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIsNull(FieldTargetRoleID))
+}
+
+// TargetRoleIDNotNil applies the NotNil predicate on the "target_role_id" field.
+func TargetRoleIDNotNil() predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotNull(FieldTargetRoleID))
+}
+
+// TargetTeamEQ applies the EQ predicate on the "target_team" field.
+func TargetTeamEQ(v TargetTeam) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldTargetTeam, v))
+}
+
+// TargetTeamNEQ applies the NEQ predicate on the "target_team" field.
+func TargetTeamNEQ(v TargetTeam) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNEQ(FieldTargetTeam, v))
+}
+
+// TargetTeamIn applies the In predicate on the "target_team" field.
+func TargetTeamIn(vs ...TargetTeam) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIn(FieldTargetTeam, vs...))
+}
+
+// TargetTeamNotIn applies the NotIn predicate on the "target_team" field.
+func TargetTeamNotIn(vs ...TargetTeam) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotIn(FieldTargetTeam, vs...))
+}
+
+// TargetTeamIsNil applies the IsNil predicate on the "target_team" field.
+func TargetTeamIsNil() predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIsNull(FieldTargetTeam))
+}
+
+// TargetTeamNotNil applies the NotNil predicate on the "target_team" field.
+func TargetTeamNotNil() predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotNull(FieldTargetTeam))
+}
+
+// MaxCountEQ applies the EQ predicate on the "max_count" field.
+func MaxCountEQ(v int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldMaxCount, v))
+}
+
+// MaxCountNEQ applies the NEQ predicate on the "max_count" field.
+func MaxCountNEQ(v int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNEQ(FieldMaxCount, v))
+}
+
+// MaxCountIn applies the In predicate on the "max_count" field.
+func MaxCountIn(vs ...int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIn(FieldMaxCount, vs...))
+}
+
+// MaxCountNotIn applies the NotIn predicate on the "max_count" field.
+func MaxCountNotIn(vs ...int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotIn(FieldMaxCount, vs...))
+}
+
+// MaxCountGT applies the GT predicate on the "max_count" field.
+func MaxCountGT(v int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldGT(FieldMaxCount, v))
+}
+
+// MaxCountGTE applies the GTE predicate on the "max_count" field.
+func MaxCountGTE(v int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldGTE(FieldMaxCount, v))
+}
+
+// MaxCountLT applies the LT predicate on the "max_count" field.
+func MaxCountLT(v int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldLT(FieldMaxCount, v))
+}
+
+// MaxCountLTE applies the LTE predicate on the "max_count" field.
+func MaxCountLTE(v int) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldLTE(FieldMaxCount, v))
+}
+
+// MaxCountIsNil applies the IsNil predicate on the "max_count" field.
+func MaxCountIsNil() predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIsNull(FieldMaxCount))
+}
+
+// MaxCountNotNil applies the NotNil predicate on the "max_count" field.
+func MaxCountNotNil() predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotNull(FieldMaxCount))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoleRule {
+	return predicate.RoleRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRole applies the HasEdge predicate on the "role" edge.
+func HasRole() predicate.RoleRule {
+	return predicate.RoleRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RoleTable, RoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleWith applies the HasEdge predicate on the "role" edge with a given conditions (other predicates).
+func HasRoleWith(preds ...predicate.Role) predicate.RoleRule {
+	return predicate.RoleRule(func(s *sql.Selector) {
+		step := newRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTargetRole applies the HasEdge predicate on the "target_role" edge.
+func HasTargetRole() predicate.RoleRule {
+	return predicate.RoleRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TargetRoleTable, TargetRoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetRoleWith applies the HasEdge predicate on the "target_role" edge with a given conditions (other predicates).
+func HasTargetRoleWith(preds ...predicate.Role) predicate.RoleRule {
+	return predicate.RoleRule(func(s *sql.Selector) {
+		step := newTargetRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleRule) predicate.RoleRule {
+	return predicate.RoleRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleRule) predicate.RoleRule {
+	return predicate.RoleRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleRule) predicate.RoleRule {
+	return predicate.RoleRule(sql.NotPredicates(p))
+}
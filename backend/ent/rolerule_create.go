@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+)
+
+// RoleRuleCreate is the builder for creating a RoleRule entity.
+type RoleRuleCreate struct {
+	config
+	mutation *RoleRuleMutation
+	hooks    []Hook
+}
+
+// SetRoleID sets the "role_id" field.
+func (_c *RoleRuleCreate) SetRoleID(v uuid.UUID) *RoleRuleCreate {
+	_c.mutation.SetRoleID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *RoleRuleCreate) SetKind(v rolerule.Kind) *RoleRuleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetTargetRoleID sets the "target_role_id" field.
+func (_c *RoleRuleCreate) SetTargetRoleID(v uuid.UUID) *RoleRuleCreate {
+	_c.mutation.SetTargetRoleID(v)
+	return _c
+}
+
+// SetNillableTargetRoleID sets the "target_role_id" field if the given value is not nil.
+func (_c *RoleRuleCreate) SetNillableTargetRoleID(v *uuid.UUID) *RoleRuleCreate {
+	if v != nil {
+		_c.SetTargetRoleID(*v)
+	}
+	return _c
+}
+
+// SetTargetTeam sets the "target_team" field.
+func (_c *RoleRuleCreate) SetTargetTeam(v rolerule.TargetTeam) *RoleRuleCreate {
+	_c.mutation.SetTargetTeam(v)
+	return _c
+}
+
+// SetNillableTargetTeam sets the "target_team" field if the given value is not nil.
+func (_c *RoleRuleCreate) SetNillableTargetTeam(v *rolerule.TargetTeam) *RoleRuleCreate {
+	if v != nil {
+		_c.SetTargetTeam(*v)
+	}
+	return _c
+}
+
+// SetMaxCount sets the "max_count" field.
+func (_c *RoleRuleCreate) SetMaxCount(v int) *RoleRuleCreate {
+	_c.mutation.SetMaxCount(v)
+	return _c
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (_c *RoleRuleCreate) SetNillableMaxCount(v *int) *RoleRuleCreate {
+	if v != nil {
+		_c.SetMaxCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RoleRuleCreate) SetCreatedAt(v time.Time) *RoleRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RoleRuleCreate) SetNillableCreatedAt(v *time.Time) *RoleRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RoleRuleCreate) SetID(v uuid.UUID) *RoleRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RoleRuleCreate) SetNillableID(v *uuid.UUID) *RoleRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_c *RoleRuleCreate) SetRole(v *Role) *RoleRuleCreate {
+	return _c.SetRoleID(v.ID)
+}
+
+// SetTargetRole sets the "target_role" edge to the Role entity.
+func (_c *RoleRuleCreate) SetTargetRole(v *Role) *RoleRuleCreate {
+	return _c.SetTargetRoleID(v.ID)
+}
+
+// Mutation returns the RoleRuleMutation object of the builder.
+func (_c *RoleRuleCreate) Mutation() *RoleRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the RoleRule in the database.
+func (_c *RoleRuleCreate) Save(ctx context.Context) (*RoleRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoleRuleCreate) SaveX(ctx context.Context) *RoleRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RoleRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := rolerule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := rolerule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoleRuleCreate) check() error {
+	if _, ok := _c.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleRule.role_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "RoleRule.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := rolerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RoleRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TargetTeam(); ok {
+		if err := rolerule.TargetTeamValidator(v); err != nil {
+			return &ValidationError{Name: "target_team", err: fmt.Errorf(`ent: validator failed for field "RoleRule.target_team": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxCount(); ok {
+		if err := rolerule.MaxCountValidator(v); err != nil {
+			return &ValidationError{Name: "max_count", err: fmt.Errorf(`ent: validator failed for field "RoleRule.max_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleRule.created_at"`)}
+	}
+	if len(_c.mutation.RoleIDs()) == 0 {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleRule.role"`)}
+	}
+	return nil
+}
+
+func (_c *RoleRuleCreate) sqlSave(ctx context.Context) (*RoleRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoleRuleCreate) createSpec() (*RoleRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rolerule.Table, sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(rolerule.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.TargetTeam(); ok {
+		_spec.SetField(rolerule.FieldTargetTeam, field.TypeEnum, value)
+		_node.TargetTeam = &value
+	}
+	if value, ok := _c.mutation.MaxCount(); ok {
+		_spec.SetField(rolerule.FieldMaxCount, field.TypeInt, value)
+		_node.MaxCount = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rolerule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.RoleTable,
+			Columns: []string{rolerule.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TargetRoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.TargetRoleTable,
+			Columns: []string{rolerule.TargetRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetRoleID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleRuleCreateBulk is the builder for creating many RoleRule entities in bulk.
+type RoleRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RoleRuleCreate
+}
+
+// Save creates the RoleRule entities in the database.
+func (_c *RoleRuleCreateBulk) Save(ctx context.Context) ([]*RoleRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoleRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoleRuleCreateBulk) SaveX(ctx context.Context) []*RoleRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+)
+
+// RoleRuleUpdate is the builder for updating RoleRule entities.
+type RoleRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleRuleMutation
+}
+
+// Where appends a list predicates to the RoleRuleUpdate builder.
+func (_u *RoleRuleUpdate) Where(ps ...predicate.RoleRule) *RoleRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *RoleRuleUpdate) SetRoleID(v uuid.UUID) *RoleRuleUpdate {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *RoleRuleUpdate) SetNillableRoleID(v *uuid.UUID) *RoleRuleUpdate {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RoleRuleUpdate) SetKind(v rolerule.Kind) *RoleRuleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RoleRuleUpdate) SetNillableKind(v *rolerule.Kind) *RoleRuleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTargetRoleID sets the "target_role_id" field.
+func (_u *RoleRuleUpdate) SetTargetRoleID(v uuid.UUID) *RoleRuleUpdate {
+	_u.mutation.SetTargetRoleID(v)
+	return _u
+}
+
+// SetNillableTargetRoleID sets the "target_role_id" field if the given value is not nil.
+func (_u *RoleRuleUpdate) SetNillableTargetRoleID(v *uuid.UUID) *RoleRuleUpdate {
+	if v != nil {
+		_u.SetTargetRoleID(*v)
+	}
+	return _u
+}
+
+// ClearTargetRoleID clears the value of the "target_role_id" field.
+func (_u *RoleRuleUpdate) ClearTargetRoleID() *RoleRuleUpdate {
+	_u.mutation.ClearTargetRoleID()
+	return _u
+}
+
+// SetTargetTeam sets the "target_team" field.
+func (_u *RoleRuleUpdate) SetTargetTeam(v rolerule.TargetTeam) *RoleRuleUpdate {
+	_u.mutation.SetTargetTeam(v)
+	return _u
+}
+
+// SetNillableTargetTeam sets the "target_team" field if the given value is not nil.
+func (_u *RoleRuleUpdate) SetNillableTargetTeam(v *rolerule.TargetTeam) *RoleRuleUpdate {
+	if v != nil {
+		_u.SetTargetTeam(*v)
+	}
+	return _u
+}
+
+// ClearTargetTeam clears the value of the "target_team" field.
+func (_u *RoleRuleUpdate) ClearTargetTeam() *RoleRuleUpdate {
+	_u.mutation.ClearTargetTeam()
+	return _u
+}
+
+// SetMaxCount sets the "max_count" field.
+func (_u *RoleRuleUpdate) SetMaxCount(v int) *RoleRuleUpdate {
+	_u.mutation.ResetMaxCount()
+	_u.mutation.SetMaxCount(v)
+	return _u
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (_u *RoleRuleUpdate) SetNillableMaxCount(v *int) *RoleRuleUpdate {
+	if v != nil {
+		_u.SetMaxCount(*v)
+	}
+	return _u
+}
+
+// AddMaxCount adds value to the "max_count" field.
+func (_u *RoleRuleUpdate) AddMaxCount(v int) *RoleRuleUpdate {
+	_u.mutation.AddMaxCount(v)
+	return _u
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (_u *RoleRuleUpdate) ClearMaxCount() *RoleRuleUpdate {
+	_u.mutation.ClearMaxCount()
+	return _u
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_u *RoleRuleUpdate) SetRole(v *Role) *RoleRuleUpdate {
+	return _u.SetRoleID(v.ID)
+}
+
+// SetTargetRole sets the "target_role" edge to the Role entity.
+func (_u *RoleRuleUpdate) SetTargetRole(v *Role) *RoleRuleUpdate {
+	return _u.SetTargetRoleID(v.ID)
+}
+
+// Mutation returns the RoleRuleMutation object of the builder.
+func (_u *RoleRuleUpdate) Mutation() *RoleRuleMutation {
+	return _u.mutation
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (_u *RoleRuleUpdate) ClearRole() *RoleRuleUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// ClearTargetRole clears the "target_role" edge to the Role entity.
+func (_u *RoleRuleUpdate) ClearTargetRole() *RoleRuleUpdate {
+	_u.mutation.ClearTargetRole()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoleRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoleRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleRuleUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := rolerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RoleRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetTeam(); ok {
+		if err := rolerule.TargetTeamValidator(v); err != nil {
+			return &ValidationError{Name: "target_team", err: fmt.Errorf(`ent: validator failed for field "RoleRule.target_team": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxCount(); ok {
+		if err := rolerule.MaxCountValidator(v); err != nil {
+			return &ValidationError{Name: "max_count", err: fmt.Errorf(`ent: validator failed for field "RoleRule.max_count": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleCleared() && len(_u.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleRule.role"`)
+	}
+	return nil
+}
+
+func (_u *RoleRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolerule.Table, rolerule.Columns, sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(rolerule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetTeam(); ok {
+		_spec.SetField(rolerule.FieldTargetTeam, field.TypeEnum, value)
+	}
+	if _u.mutation.TargetTeamCleared() {
+		_spec.ClearField(rolerule.FieldTargetTeam, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MaxCount(); ok {
+		_spec.SetField(rolerule.FieldMaxCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCount(); ok {
+		_spec.AddField(rolerule.FieldMaxCount, field.TypeInt, value)
+	}
+	if _u.mutation.MaxCountCleared() {
+		_spec.ClearField(rolerule.FieldMaxCount, field.TypeInt)
+	}
+	if _u.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.RoleTable,
+			Columns: []string{rolerule.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.RoleTable,
+			Columns: []string{rolerule.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetRoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.TargetRoleTable,
+			Columns: []string{rolerule.TargetRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetRoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.TargetRoleTable,
+			Columns: []string{rolerule.TargetRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoleRuleUpdateOne is the builder for updating a single RoleRule entity.
+type RoleRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleRuleMutation
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *RoleRuleUpdateOne) SetRoleID(v uuid.UUID) *RoleRuleUpdateOne {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *RoleRuleUpdateOne) SetNillableRoleID(v *uuid.UUID) *RoleRuleUpdateOne {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RoleRuleUpdateOne) SetKind(v rolerule.Kind) *RoleRuleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RoleRuleUpdateOne) SetNillableKind(v *rolerule.Kind) *RoleRuleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTargetRoleID sets the "target_role_id" field.
+func (_u *RoleRuleUpdateOne) SetTargetRoleID(v uuid.UUID) *RoleRuleUpdateOne {
+	_u.mutation.SetTargetRoleID(v)
+	return _u
+}
+
+// SetNillableTargetRoleID sets the "target_role_id" field if the given value is not nil.
+func (_u *RoleRuleUpdateOne) SetNillableTargetRoleID(v *uuid.UUID) *RoleRuleUpdateOne {
+	if v != nil {
+		_u.SetTargetRoleID(*v)
+	}
+	return _u
+}
+
+// ClearTargetRoleID clears the value of the "target_role_id" field.
+func (_u *RoleRuleUpdateOne) ClearTargetRoleID() *RoleRuleUpdateOne {
+	_u.mutation.ClearTargetRoleID()
+	return _u
+}
+
+// SetTargetTeam sets the "target_team" field.
+func (_u *RoleRuleUpdateOne) SetTargetTeam(v rolerule.TargetTeam) *RoleRuleUpdateOne {
+	_u.mutation.SetTargetTeam(v)
+	return _u
+}
+
+// SetNillableTargetTeam sets the "target_team" field if the given value is not nil.
+func (_u *RoleRuleUpdateOne) SetNillableTargetTeam(v *rolerule.TargetTeam) *RoleRuleUpdateOne {
+	if v != nil {
+		_u.SetTargetTeam(*v)
+	}
+	return _u
+}
+
+// ClearTargetTeam clears the value of the "target_team" field.
+func (_u *RoleRuleUpdateOne) ClearTargetTeam() *RoleRuleUpdateOne {
+	_u.mutation.ClearTargetTeam()
+	return _u
+}
+
+// SetMaxCount sets the "max_count" field.
+func (_u *RoleRuleUpdateOne) SetMaxCount(v int) *RoleRuleUpdateOne {
+	_u.mutation.ResetMaxCount()
+	_u.mutation.SetMaxCount(v)
+	return _u
+}
+
+// SetNillableMaxCount sets the "max_count" field if the given value is not nil.
+func (_u *RoleRuleUpdateOne) SetNillableMaxCount(v *int) *RoleRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxCount(*v)
+	}
+	return _u
+}
+
+// AddMaxCount adds value to the "max_count" field.
+func (_u *RoleRuleUpdateOne) AddMaxCount(v int) *RoleRuleUpdateOne {
+	_u.mutation.AddMaxCount(v)
+	return _u
+}
+
+// ClearMaxCount clears the value of the "max_count" field.
+func (_u *RoleRuleUpdateOne) ClearMaxCount() *RoleRuleUpdateOne {
+	_u.mutation.ClearMaxCount()
+	return _u
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_u *RoleRuleUpdateOne) SetRole(v *Role) *RoleRuleUpdateOne {
+	return _u.SetRoleID(v.ID)
+}
+
+// SetTargetRole sets the "target_role" edge to the Role entity.
+func (_u *RoleRuleUpdateOne) SetTargetRole(v *Role) *RoleRuleUpdateOne {
+	return _u.SetTargetRoleID(v.ID)
+}
+
+// Mutation returns the RoleRuleMutation object of the builder.
+func (_u *RoleRuleUpdateOne) Mutation() *RoleRuleMutation {
+	return _u.mutation
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (_u *RoleRuleUpdateOne) ClearRole() *RoleRuleUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// ClearTargetRole clears the "target_role" edge to the Role entity.
+func (_u *RoleRuleUpdateOne) ClearTargetRole() *RoleRuleUpdateOne {
+	_u.mutation.ClearTargetRole()
+	return _u
+}
+
+// Where appends a list predicates to the RoleRuleUpdate builder.
+func (_u *RoleRuleUpdateOne) Where(ps ...predicate.RoleRule) *RoleRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoleRuleUpdateOne) Select(field string, fields ...string) *RoleRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoleRule entity.
+func (_u *RoleRuleUpdateOne) Save(ctx context.Context) (*RoleRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleRuleUpdateOne) SaveX(ctx context.Context) *RoleRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoleRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := rolerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RoleRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetTeam(); ok {
+		if err := rolerule.TargetTeamValidator(v); err != nil {
+			return &ValidationError{Name: "target_team", err: fmt.Errorf(`ent: validator failed for field "RoleRule.target_team": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxCount(); ok {
+		if err := rolerule.MaxCountValidator(v); err != nil {
+			return &ValidationError{Name: "max_count", err: fmt.Errorf(`ent: validator failed for field "RoleRule.max_count": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleCleared() && len(_u.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleRule.role"`)
+	}
+	return nil
+}
+
+func (_u *RoleRuleUpdateOne) sqlSave(ctx context.Context) (_node *RoleRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolerule.Table, rolerule.Columns, sqlgraph.NewFieldSpec(rolerule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolerule.FieldID)
+		for _, f := range fields {
+			if !rolerule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rolerule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(rolerule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetTeam(); ok {
+		_spec.SetField(rolerule.FieldTargetTeam, field.TypeEnum, value)
+	}
+	if _u.mutation.TargetTeamCleared() {
+		_spec.ClearField(rolerule.FieldTargetTeam, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MaxCount(); ok {
+		_spec.SetField(rolerule.FieldMaxCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCount(); ok {
+		_spec.AddField(rolerule.FieldMaxCount, field.TypeInt, value)
+	}
+	if _u.mutation.MaxCountCleared() {
+		_spec.ClearField(rolerule.FieldMaxCount, field.TypeInt)
+	}
+	if _u.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.RoleTable,
+			Columns: []string{rolerule.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.RoleTable,
+			Columns: []string{rolerule.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetRoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.TargetRoleTable,
+			Columns: []string{rolerule.TargetRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetRoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolerule.TargetRoleTable,
+			Columns: []string{rolerule.TargetRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
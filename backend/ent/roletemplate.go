@@ -18,12 +18,22 @@ type RoleTemplate struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// Name of the role template
+	// Name of the role template, unique among its owner's templates or among curated ones
 	Name string `json:"name,omitempty"`
-	// Total number of players this template is designed for
+	// Smallest number of players this template is designed for, filled by its core roles
 	PlayerCount int `json:"player_count,omitempty"`
+	// Largest number of players this template covers: player_count plus its optional slots
+	MaxPlayerCount int `json:"max_player_count,omitempty"`
 	// Description of the template and its gameplay style
 	Description string `json:"description,omitempty"`
+	// Moderator who owns the template; curated templates made by admins have none
+	OwnerID *string `json:"owner_id,omitempty"`
+	// Whether the template is listed publicly, awaiting admin review or only seen by its owner
+	Status roletemplate.Status `json:"status,omitempty"`
+	// Code that lets anyone holding it view the template
+	ShareCode *string `json:"share_code,omitempty"`
+	// Note left by the admin who last reviewed the template
+	ReviewNote string `json:"review_note,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -38,9 +48,15 @@ type RoleTemplate struct {
 type RoleTemplateEdges struct {
 	// TemplateRoles holds the value of the template_roles edge.
 	TemplateRoles []*RoleTemplateRole `json:"template_roles,omitempty"`
+	// OptionalSlots holds the value of the optional_slots edge.
+	OptionalSlots []*RoleTemplateSlot `json:"optional_slots,omitempty"`
+	// Translations holds the value of the translations edge.
+	Translations []*RoleTemplateTranslation `json:"translations,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*RoleTemplateRevision `json:"revisions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // TemplateRolesOrErr returns the TemplateRoles value or an error if the edge
@@ -52,14 +68,41 @@ func (e RoleTemplateEdges) TemplateRolesOrErr() ([]*RoleTemplateRole, error) {
 	return nil, &NotLoadedError{edge: "template_roles"}
 }
 
+// OptionalSlotsOrErr returns the OptionalSlots value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleTemplateEdges) OptionalSlotsOrErr() ([]*RoleTemplateSlot, error) {
+	if e.loadedTypes[1] {
+		return e.OptionalSlots, nil
+	}
+	return nil, &NotLoadedError{edge: "optional_slots"}
+}
+
+// TranslationsOrErr returns the Translations value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleTemplateEdges) TranslationsOrErr() ([]*RoleTemplateTranslation, error) {
+	if e.loadedTypes[2] {
+		return e.Translations, nil
+	}
+	return nil, &NotLoadedError{edge: "translations"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleTemplateEdges) RevisionsOrErr() ([]*RoleTemplateRevision, error) {
+	if e.loadedTypes[3] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*RoleTemplate) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case roletemplate.FieldPlayerCount:
+		case roletemplate.FieldPlayerCount, roletemplate.FieldMaxPlayerCount:
 			values[i] = new(sql.NullInt64)
-		case roletemplate.FieldName, roletemplate.FieldDescription:
+		case roletemplate.FieldName, roletemplate.FieldDescription, roletemplate.FieldOwnerID, roletemplate.FieldStatus, roletemplate.FieldShareCode, roletemplate.FieldReviewNote:
 			values[i] = new(sql.NullString)
 		case roletemplate.FieldCreatedAt, roletemplate.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -98,12 +141,44 @@ func (_m *RoleTemplate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PlayerCount = int(value.Int64)
 			}
+		case roletemplate.FieldMaxPlayerCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_player_count", values[i])
+			} else if value.Valid {
+				_m.MaxPlayerCount = int(value.Int64)
+			}
 		case roletemplate.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case roletemplate.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = new(string)
+				*_m.OwnerID = value.String
+			}
+		case roletemplate.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = roletemplate.Status(value.String)
+			}
+		case roletemplate.FieldShareCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_code", values[i])
+			} else if value.Valid {
+				_m.ShareCode = new(string)
+				*_m.ShareCode = value.String
+			}
+		case roletemplate.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				_m.ReviewNote = value.String
+			}
 		case roletemplate.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -134,6 +209,21 @@ func (_m *RoleTemplate) QueryTemplateRoles() *RoleTemplateRoleQuery {
 	return NewRoleTemplateClient(_m.config).QueryTemplateRoles(_m)
 }
 
+// QueryOptionalSlots queries the "optional_slots" edge of the RoleTemplate entity.
+func (_m *RoleTemplate) QueryOptionalSlots() *RoleTemplateSlotQuery {
+	return NewRoleTemplateClient(_m.config).QueryOptionalSlots(_m)
+}
+
+// QueryTranslations queries the "translations" edge of the RoleTemplate entity.
+func (_m *RoleTemplate) QueryTranslations() *RoleTemplateTranslationQuery {
+	return NewRoleTemplateClient(_m.config).QueryTranslations(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the RoleTemplate entity.
+func (_m *RoleTemplate) QueryRevisions() *RoleTemplateRevisionQuery {
+	return NewRoleTemplateClient(_m.config).QueryRevisions(_m)
+}
+
 // Update returns a builder for updating this RoleTemplate.
 // Note that you need to call RoleTemplate.Unwrap() before calling this method if this RoleTemplate
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -163,9 +253,28 @@ func (_m *RoleTemplate) String() string {
 	builder.WriteString("player_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PlayerCount))
 	builder.WriteString(", ")
+	builder.WriteString("max_player_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxPlayerCount))
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	if v := _m.OwnerID; v != nil {
+		builder.WriteString("owner_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ShareCode; v != nil {
+		builder.WriteString("share_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_note=")
+	builder.WriteString(_m.ReviewNote)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package roletemplate
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -19,14 +20,30 @@ const (
 	FieldName = "name"
 	// FieldPlayerCount holds the string denoting the player_count field in the database.
 	FieldPlayerCount = "player_count"
+	// FieldMaxPlayerCount holds the string denoting the max_player_count field in the database.
+	FieldMaxPlayerCount = "max_player_count"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldShareCode holds the string denoting the share_code field in the database.
+	FieldShareCode = "share_code"
+	// FieldReviewNote holds the string denoting the review_note field in the database.
+	FieldReviewNote = "review_note"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeTemplateRoles holds the string denoting the template_roles edge name in mutations.
 	EdgeTemplateRoles = "template_roles"
+	// EdgeOptionalSlots holds the string denoting the optional_slots edge name in mutations.
+	EdgeOptionalSlots = "optional_slots"
+	// EdgeTranslations holds the string denoting the translations edge name in mutations.
+	EdgeTranslations = "translations"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
 	// Table holds the table name of the roletemplate in the database.
 	Table = "role_templates"
 	// TemplateRolesTable is the table that holds the template_roles relation/edge.
@@ -36,6 +53,27 @@ const (
 	TemplateRolesInverseTable = "role_template_roles"
 	// TemplateRolesColumn is the table column denoting the template_roles relation/edge.
 	TemplateRolesColumn = "role_template_id"
+	// OptionalSlotsTable is the table that holds the optional_slots relation/edge.
+	OptionalSlotsTable = "role_template_slots"
+	// OptionalSlotsInverseTable is the table name for the RoleTemplateSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "roletemplateslot" package.
+	OptionalSlotsInverseTable = "role_template_slots"
+	// OptionalSlotsColumn is the table column denoting the optional_slots relation/edge.
+	OptionalSlotsColumn = "role_template_id"
+	// TranslationsTable is the table that holds the translations relation/edge.
+	TranslationsTable = "role_template_translations"
+	// TranslationsInverseTable is the table name for the RoleTemplateTranslation entity.
+	// It exists in this package in order to avoid circular dependency with the "roletemplatetranslation" package.
+	TranslationsInverseTable = "role_template_translations"
+	// TranslationsColumn is the table column denoting the translations relation/edge.
+	TranslationsColumn = "role_template_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "role_template_revisions"
+	// RevisionsInverseTable is the table name for the RoleTemplateRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "roletemplaterevision" package.
+	RevisionsInverseTable = "role_template_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "role_template_id"
 )
 
 // Columns holds all SQL columns for roletemplate fields.
@@ -43,7 +81,12 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldPlayerCount,
+	FieldMaxPlayerCount,
 	FieldDescription,
+	FieldOwnerID,
+	FieldStatus,
+	FieldShareCode,
+	FieldReviewNote,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -63,6 +106,8 @@ var (
 	NameValidator func(string) error
 	// PlayerCountValidator is a validator for the "player_count" field. It is called by the builders before save.
 	PlayerCountValidator func(int) error
+	// DefaultMaxPlayerCount holds the default value on creation for the "max_player_count" field.
+	DefaultMaxPlayerCount int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -73,6 +118,33 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPublished is the default value of the Status enum.
+const DefaultStatus = StatusPublished
+
+// Status values.
+const (
+	StatusPrivate   Status = "private"
+	StatusPending   Status = "pending"
+	StatusPublished Status = "published"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPrivate, StatusPending, StatusPublished:
+		return nil
+	default:
+		return fmt.Errorf("roletemplate: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the RoleTemplate queries.
 type OrderOption func(*sql.Selector)
 
@@ -91,11 +163,36 @@ func ByPlayerCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlayerCount, opts...).ToFunc()
 }
 
+// ByMaxPlayerCount orders the results by the max_player_count field.
+func ByMaxPlayerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxPlayerCount, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByShareCode orders the results by the share_code field.
+func ByShareCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareCode, opts...).ToFunc()
+}
+
+// ByReviewNote orders the results by the review_note field.
+func ByReviewNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNote, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -119,6 +216,48 @@ func ByTemplateRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTemplateRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOptionalSlotsCount orders the results by optional_slots count.
+func ByOptionalSlotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOptionalSlotsStep(), opts...)
+	}
+}
+
+// ByOptionalSlots orders the results by optional_slots terms.
+func ByOptionalSlots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOptionalSlotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTranslationsCount orders the results by translations count.
+func ByTranslationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTranslationsStep(), opts...)
+	}
+}
+
+// ByTranslations orders the results by translations terms.
+func ByTranslations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranslationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTemplateRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -126,3 +265,24 @@ func newTemplateRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TemplateRolesTable, TemplateRolesColumn),
 	)
 }
+func newOptionalSlotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OptionalSlotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OptionalSlotsTable, OptionalSlotsColumn),
+	)
+}
+func newTranslationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranslationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
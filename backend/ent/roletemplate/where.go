@@ -66,11 +66,31 @@ func PlayerCount(v int) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.FieldEQ(FieldPlayerCount, v))
 }
 
+// MaxPlayerCount applies equality check predicate on the "max_player_count" field. It's identical to MaxPlayerCountEQ.
+func MaxPlayerCount(v int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldMaxPlayerCount, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.FieldEQ(FieldDescription, v))
 }
 
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// ShareCode applies equality check predicate on the "share_code" field. It's identical to ShareCodeEQ.
+func ShareCode(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldShareCode, v))
+}
+
+// ReviewNote applies equality check predicate on the "review_note" field. It's identical to ReviewNoteEQ.
+func ReviewNote(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldReviewNote, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.FieldEQ(FieldCreatedAt, v))
@@ -186,6 +206,46 @@ func PlayerCountLTE(v int) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.FieldLTE(FieldPlayerCount, v))
 }
 
+// MaxPlayerCountEQ applies the EQ predicate on the "max_player_count" field.
+func MaxPlayerCountEQ(v int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldMaxPlayerCount, v))
+}
+
+// MaxPlayerCountNEQ applies the NEQ predicate on the "max_player_count" field.
+func MaxPlayerCountNEQ(v int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNEQ(FieldMaxPlayerCount, v))
+}
+
+// MaxPlayerCountIn applies the In predicate on the "max_player_count" field.
+func MaxPlayerCountIn(vs ...int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIn(FieldMaxPlayerCount, vs...))
+}
+
+// MaxPlayerCountNotIn applies the NotIn predicate on the "max_player_count" field.
+func MaxPlayerCountNotIn(vs ...int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotIn(FieldMaxPlayerCount, vs...))
+}
+
+// MaxPlayerCountGT applies the GT predicate on the "max_player_count" field.
+func MaxPlayerCountGT(v int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGT(FieldMaxPlayerCount, v))
+}
+
+// MaxPlayerCountGTE applies the GTE predicate on the "max_player_count" field.
+func MaxPlayerCountGTE(v int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGTE(FieldMaxPlayerCount, v))
+}
+
+// MaxPlayerCountLT applies the LT predicate on the "max_player_count" field.
+func MaxPlayerCountLT(v int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLT(FieldMaxPlayerCount, v))
+}
+
+// MaxPlayerCountLTE applies the LTE predicate on the "max_player_count" field.
+func MaxPlayerCountLTE(v int) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLTE(FieldMaxPlayerCount, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.FieldEQ(FieldDescription, v))
@@ -261,6 +321,251 @@ func DescriptionContainsFold(v string) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIsNull(FieldOwnerID))
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotNull(FieldOwnerID))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ShareCodeEQ applies the EQ predicate on the "share_code" field.
+func ShareCodeEQ(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldShareCode, v))
+}
+
+// ShareCodeNEQ applies the NEQ predicate on the "share_code" field.
+func ShareCodeNEQ(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNEQ(FieldShareCode, v))
+}
+
+// ShareCodeIn applies the In predicate on the "share_code" field.
+func ShareCodeIn(vs ...string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIn(FieldShareCode, vs...))
+}
+
+// ShareCodeNotIn applies the NotIn predicate on the "share_code" field.
+func ShareCodeNotIn(vs ...string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotIn(FieldShareCode, vs...))
+}
+
+// ShareCodeGT applies the GT predicate on the "share_code" field.
+func ShareCodeGT(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGT(FieldShareCode, v))
+}
+
+// ShareCodeGTE applies the GTE predicate on the "share_code" field.
+func ShareCodeGTE(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGTE(FieldShareCode, v))
+}
+
+// ShareCodeLT applies the LT predicate on the "share_code" field.
+func ShareCodeLT(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLT(FieldShareCode, v))
+}
+
+// ShareCodeLTE applies the LTE predicate on the "share_code" field.
+func ShareCodeLTE(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLTE(FieldShareCode, v))
+}
+
+// ShareCodeContains applies the Contains predicate on the "share_code" field.
+func ShareCodeContains(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldContains(FieldShareCode, v))
+}
+
+// ShareCodeHasPrefix applies the HasPrefix predicate on the "share_code" field.
+func ShareCodeHasPrefix(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldHasPrefix(FieldShareCode, v))
+}
+
+// ShareCodeHasSuffix applies the HasSuffix predicate on the "share_code" field.
+func ShareCodeHasSuffix(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldHasSuffix(FieldShareCode, v))
+}
+
+// ShareCodeIsNil applies the IsNil predicate on the "share_code" field.
+func ShareCodeIsNil() predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIsNull(FieldShareCode))
+}
+
+// ShareCodeNotNil applies the NotNil predicate on the "share_code" field.
+func ShareCodeNotNil() predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotNull(FieldShareCode))
+}
+
+// ShareCodeEqualFold applies the EqualFold predicate on the "share_code" field.
+func ShareCodeEqualFold(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEqualFold(FieldShareCode, v))
+}
+
+// ShareCodeContainsFold applies the ContainsFold predicate on the "share_code" field.
+func ShareCodeContainsFold(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldContainsFold(FieldShareCode, v))
+}
+
+// ReviewNoteEQ applies the EQ predicate on the "review_note" field.
+func ReviewNoteEQ(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteNEQ applies the NEQ predicate on the "review_note" field.
+func ReviewNoteNEQ(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteIn applies the In predicate on the "review_note" field.
+func ReviewNoteIn(vs ...string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteNotIn applies the NotIn predicate on the "review_note" field.
+func ReviewNoteNotIn(vs ...string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteGT applies the GT predicate on the "review_note" field.
+func ReviewNoteGT(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGT(FieldReviewNote, v))
+}
+
+// ReviewNoteGTE applies the GTE predicate on the "review_note" field.
+func ReviewNoteGTE(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldGTE(FieldReviewNote, v))
+}
+
+// ReviewNoteLT applies the LT predicate on the "review_note" field.
+func ReviewNoteLT(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLT(FieldReviewNote, v))
+}
+
+// ReviewNoteLTE applies the LTE predicate on the "review_note" field.
+func ReviewNoteLTE(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldLTE(FieldReviewNote, v))
+}
+
+// ReviewNoteContains applies the Contains predicate on the "review_note" field.
+func ReviewNoteContains(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldContains(FieldReviewNote, v))
+}
+
+// ReviewNoteHasPrefix applies the HasPrefix predicate on the "review_note" field.
+func ReviewNoteHasPrefix(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldHasPrefix(FieldReviewNote, v))
+}
+
+// ReviewNoteHasSuffix applies the HasSuffix predicate on the "review_note" field.
+func ReviewNoteHasSuffix(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldHasSuffix(FieldReviewNote, v))
+}
+
+// ReviewNoteIsNil applies the IsNil predicate on the "review_note" field.
+func ReviewNoteIsNil() predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldIsNull(FieldReviewNote))
+}
+
+// ReviewNoteNotNil applies the NotNil predicate on the "review_note" field.
+func ReviewNoteNotNil() predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldNotNull(FieldReviewNote))
+}
+
+// ReviewNoteEqualFold applies the EqualFold predicate on the "review_note" field.
+func ReviewNoteEqualFold(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldEqualFold(FieldReviewNote, v))
+}
+
+// ReviewNoteContainsFold applies the ContainsFold predicate on the "review_note" field.
+func ReviewNoteContainsFold(v string) predicate.RoleTemplate {
+	return predicate.RoleTemplate(sql.FieldContainsFold(FieldReviewNote, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.FieldEQ(FieldCreatedAt, v))
@@ -364,6 +669,75 @@ func HasTemplateRolesWith(preds ...predicate.RoleTemplateRole) predicate.RoleTem
 	})
 }
 
+// HasOptionalSlots applies the HasEdge predicate on the "optional_slots" edge.
+func HasOptionalSlots() predicate.RoleTemplate {
+	return predicate.RoleTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OptionalSlotsTable, OptionalSlotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOptionalSlotsWith applies the HasEdge predicate on the "optional_slots" edge with a given conditions (other predicates).
+func HasOptionalSlotsWith(preds ...predicate.RoleTemplateSlot) predicate.RoleTemplate {
+	return predicate.RoleTemplate(func(s *sql.Selector) {
+		step := newOptionalSlotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTranslations applies the HasEdge predicate on the "translations" edge.
+func HasTranslations() predicate.RoleTemplate {
+	return predicate.RoleTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranslationsWith applies the HasEdge predicate on the "translations" edge with a given conditions (other predicates).
+func HasTranslationsWith(preds ...predicate.RoleTemplateTranslation) predicate.RoleTemplate {
+	return predicate.RoleTemplate(func(s *sql.Selector) {
+		step := newTranslationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.RoleTemplate {
+	return predicate.RoleTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.RoleTemplateRevision) predicate.RoleTemplate {
+	return predicate.RoleTemplate(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.RoleTemplate) predicate.RoleTemplate {
 	return predicate.RoleTemplate(sql.AndPredicates(predicates...))
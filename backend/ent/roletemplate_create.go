@@ -12,7 +12,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
 )
 
 // RoleTemplateCreate is the builder for creating a RoleTemplate entity.
@@ -34,6 +37,20 @@ func (_c *RoleTemplateCreate) SetPlayerCount(v int) *RoleTemplateCreate {
 	return _c
 }
 
+// SetMaxPlayerCount sets the "max_player_count" field.
+func (_c *RoleTemplateCreate) SetMaxPlayerCount(v int) *RoleTemplateCreate {
+	_c.mutation.SetMaxPlayerCount(v)
+	return _c
+}
+
+// SetNillableMaxPlayerCount sets the "max_player_count" field if the given value is not nil.
+func (_c *RoleTemplateCreate) SetNillableMaxPlayerCount(v *int) *RoleTemplateCreate {
+	if v != nil {
+		_c.SetMaxPlayerCount(*v)
+	}
+	return _c
+}
+
 // SetDescription sets the "description" field.
 func (_c *RoleTemplateCreate) SetDescription(v string) *RoleTemplateCreate {
 	_c.mutation.SetDescription(v)
@@ -48,6 +65,62 @@ func (_c *RoleTemplateCreate) SetNillableDescription(v *string) *RoleTemplateCre
 	return _c
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (_c *RoleTemplateCreate) SetOwnerID(v string) *RoleTemplateCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *RoleTemplateCreate) SetNillableOwnerID(v *string) *RoleTemplateCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RoleTemplateCreate) SetStatus(v roletemplate.Status) *RoleTemplateCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *RoleTemplateCreate) SetNillableStatus(v *roletemplate.Status) *RoleTemplateCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetShareCode sets the "share_code" field.
+func (_c *RoleTemplateCreate) SetShareCode(v string) *RoleTemplateCreate {
+	_c.mutation.SetShareCode(v)
+	return _c
+}
+
+// SetNillableShareCode sets the "share_code" field if the given value is not nil.
+func (_c *RoleTemplateCreate) SetNillableShareCode(v *string) *RoleTemplateCreate {
+	if v != nil {
+		_c.SetShareCode(*v)
+	}
+	return _c
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_c *RoleTemplateCreate) SetReviewNote(v string) *RoleTemplateCreate {
+	_c.mutation.SetReviewNote(v)
+	return _c
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_c *RoleTemplateCreate) SetNillableReviewNote(v *string) *RoleTemplateCreate {
+	if v != nil {
+		_c.SetReviewNote(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *RoleTemplateCreate) SetCreatedAt(v time.Time) *RoleTemplateCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -105,6 +178,51 @@ func (_c *RoleTemplateCreate) AddTemplateRoles(v ...*RoleTemplateRole) *RoleTemp
 	return _c.AddTemplateRoleIDs(ids...)
 }
 
+// AddOptionalSlotIDs adds the "optional_slots" edge to the RoleTemplateSlot entity by IDs.
+func (_c *RoleTemplateCreate) AddOptionalSlotIDs(ids ...uuid.UUID) *RoleTemplateCreate {
+	_c.mutation.AddOptionalSlotIDs(ids...)
+	return _c
+}
+
+// AddOptionalSlots adds the "optional_slots" edges to the RoleTemplateSlot entity.
+func (_c *RoleTemplateCreate) AddOptionalSlots(v ...*RoleTemplateSlot) *RoleTemplateCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOptionalSlotIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTemplateTranslation entity by IDs.
+func (_c *RoleTemplateCreate) AddTranslationIDs(ids ...uuid.UUID) *RoleTemplateCreate {
+	_c.mutation.AddTranslationIDs(ids...)
+	return _c
+}
+
+// AddTranslations adds the "translations" edges to the RoleTemplateTranslation entity.
+func (_c *RoleTemplateCreate) AddTranslations(v ...*RoleTemplateTranslation) *RoleTemplateCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTranslationIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the RoleTemplateRevision entity by IDs.
+func (_c *RoleTemplateCreate) AddRevisionIDs(ids ...uuid.UUID) *RoleTemplateCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the RoleTemplateRevision entity.
+func (_c *RoleTemplateCreate) AddRevisions(v ...*RoleTemplateRevision) *RoleTemplateCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the RoleTemplateMutation object of the builder.
 func (_c *RoleTemplateCreate) Mutation() *RoleTemplateMutation {
 	return _c.mutation
@@ -140,6 +258,14 @@ func (_c *RoleTemplateCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *RoleTemplateCreate) defaults() {
+	if _, ok := _c.mutation.MaxPlayerCount(); !ok {
+		v := roletemplate.DefaultMaxPlayerCount
+		_c.mutation.SetMaxPlayerCount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := roletemplate.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := roletemplate.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -172,6 +298,17 @@ func (_c *RoleTemplateCreate) check() error {
 			return &ValidationError{Name: "player_count", err: fmt.Errorf(`ent: validator failed for field "RoleTemplate.player_count": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.MaxPlayerCount(); !ok {
+		return &ValidationError{Name: "max_player_count", err: errors.New(`ent: missing required field "RoleTemplate.max_player_count"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RoleTemplate.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := roletemplate.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoleTemplate.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleTemplate.created_at"`)}
 	}
@@ -221,10 +358,30 @@ func (_c *RoleTemplateCreate) createSpec() (*RoleTemplate, *sqlgraph.CreateSpec)
 		_spec.SetField(roletemplate.FieldPlayerCount, field.TypeInt, value)
 		_node.PlayerCount = value
 	}
+	if value, ok := _c.mutation.MaxPlayerCount(); ok {
+		_spec.SetField(roletemplate.FieldMaxPlayerCount, field.TypeInt, value)
+		_node.MaxPlayerCount = value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(roletemplate.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(roletemplate.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(roletemplate.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ShareCode(); ok {
+		_spec.SetField(roletemplate.FieldShareCode, field.TypeString, value)
+		_node.ShareCode = &value
+	}
+	if value, ok := _c.mutation.ReviewNote(); ok {
+		_spec.SetField(roletemplate.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(roletemplate.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -249,6 +406,54 @@ func (_c *RoleTemplateCreate) createSpec() (*RoleTemplate, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OptionalSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.OptionalSlotsTable,
+			Columns: []string{roletemplate.OptionalSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.TranslationsTable,
+			Columns: []string{roletemplate.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.RevisionsTable,
+			Columns: []string{roletemplate.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
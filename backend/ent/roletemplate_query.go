@@ -15,7 +15,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/predicate"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
 )
 
 // RoleTemplateQuery is the builder for querying RoleTemplate entities.
@@ -26,6 +29,9 @@ type RoleTemplateQuery struct {
 	inters            []Interceptor
 	predicates        []predicate.RoleTemplate
 	withTemplateRoles *RoleTemplateRoleQuery
+	withOptionalSlots *RoleTemplateSlotQuery
+	withTranslations  *RoleTemplateTranslationQuery
+	withRevisions     *RoleTemplateRevisionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +90,72 @@ func (_q *RoleTemplateQuery) QueryTemplateRoles() *RoleTemplateRoleQuery {
 	return query
 }
 
+// QueryOptionalSlots chains the current query on the "optional_slots" edge.
+func (_q *RoleTemplateQuery) QueryOptionalSlots() *RoleTemplateSlotQuery {
+	query := (&RoleTemplateSlotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplate.Table, roletemplate.FieldID, selector),
+			sqlgraph.To(roletemplateslot.Table, roletemplateslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roletemplate.OptionalSlotsTable, roletemplate.OptionalSlotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTranslations chains the current query on the "translations" edge.
+func (_q *RoleTemplateQuery) QueryTranslations() *RoleTemplateTranslationQuery {
+	query := (&RoleTemplateTranslationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplate.Table, roletemplate.FieldID, selector),
+			sqlgraph.To(roletemplatetranslation.Table, roletemplatetranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roletemplate.TranslationsTable, roletemplate.TranslationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *RoleTemplateQuery) QueryRevisions() *RoleTemplateRevisionQuery {
+	query := (&RoleTemplateRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roletemplate.Table, roletemplate.FieldID, selector),
+			sqlgraph.To(roletemplaterevision.Table, roletemplaterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, roletemplate.RevisionsTable, roletemplate.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first RoleTemplate entity from the query.
 // Returns a *NotFoundError when no RoleTemplate was found.
 func (_q *RoleTemplateQuery) First(ctx context.Context) (*RoleTemplate, error) {
@@ -277,6 +349,9 @@ func (_q *RoleTemplateQuery) Clone() *RoleTemplateQuery {
 		inters:            append([]Interceptor{}, _q.inters...),
 		predicates:        append([]predicate.RoleTemplate{}, _q.predicates...),
 		withTemplateRoles: _q.withTemplateRoles.Clone(),
+		withOptionalSlots: _q.withOptionalSlots.Clone(),
+		withTranslations:  _q.withTranslations.Clone(),
+		withRevisions:     _q.withRevisions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,6 +369,39 @@ func (_q *RoleTemplateQuery) WithTemplateRoles(opts ...func(*RoleTemplateRoleQue
 	return _q
 }
 
+// WithOptionalSlots tells the query-builder to eager-load the nodes that are connected to
+// the "optional_slots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleTemplateQuery) WithOptionalSlots(opts ...func(*RoleTemplateSlotQuery)) *RoleTemplateQuery {
+	query := (&RoleTemplateSlotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOptionalSlots = query
+	return _q
+}
+
+// WithTranslations tells the query-builder to eager-load the nodes that are connected to
+// the "translations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleTemplateQuery) WithTranslations(opts ...func(*RoleTemplateTranslationQuery)) *RoleTemplateQuery {
+	query := (&RoleTemplateTranslationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranslations = query
+	return _q
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RoleTemplateQuery) WithRevisions(opts ...func(*RoleTemplateRevisionQuery)) *RoleTemplateQuery {
+	query := (&RoleTemplateRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +480,11 @@ func (_q *RoleTemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*RoleTemplate{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			_q.withTemplateRoles != nil,
+			_q.withOptionalSlots != nil,
+			_q.withTranslations != nil,
+			_q.withRevisions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -401,6 +512,29 @@ func (_q *RoleTemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withOptionalSlots; query != nil {
+		if err := _q.loadOptionalSlots(ctx, query, nodes,
+			func(n *RoleTemplate) { n.Edges.OptionalSlots = []*RoleTemplateSlot{} },
+			func(n *RoleTemplate, e *RoleTemplateSlot) { n.Edges.OptionalSlots = append(n.Edges.OptionalSlots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTranslations; query != nil {
+		if err := _q.loadTranslations(ctx, query, nodes,
+			func(n *RoleTemplate) { n.Edges.Translations = []*RoleTemplateTranslation{} },
+			func(n *RoleTemplate, e *RoleTemplateTranslation) {
+				n.Edges.Translations = append(n.Edges.Translations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *RoleTemplate) { n.Edges.Revisions = []*RoleTemplateRevision{} },
+			func(n *RoleTemplate, e *RoleTemplateRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,6 +568,96 @@ func (_q *RoleTemplateQuery) loadTemplateRoles(ctx context.Context, query *RoleT
 	}
 	return nil
 }
+func (_q *RoleTemplateQuery) loadOptionalSlots(ctx context.Context, query *RoleTemplateSlotQuery, nodes []*RoleTemplate, init func(*RoleTemplate), assign func(*RoleTemplate, *RoleTemplateSlot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*RoleTemplate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roletemplateslot.FieldRoleTemplateID)
+	}
+	query.Where(predicate.RoleTemplateSlot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roletemplate.OptionalSlotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleTemplateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_template_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RoleTemplateQuery) loadTranslations(ctx context.Context, query *RoleTemplateTranslationQuery, nodes []*RoleTemplate, init func(*RoleTemplate), assign func(*RoleTemplate, *RoleTemplateTranslation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*RoleTemplate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roletemplatetranslation.FieldRoleTemplateID)
+	}
+	query.Where(predicate.RoleTemplateTranslation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roletemplate.TranslationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleTemplateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_template_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RoleTemplateQuery) loadRevisions(ctx context.Context, query *RoleTemplateRevisionQuery, nodes []*RoleTemplate, init func(*RoleTemplate), assign func(*RoleTemplate, *RoleTemplateRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*RoleTemplate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roletemplaterevision.FieldRoleTemplateID)
+	}
+	query.Where(predicate.RoleTemplateRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roletemplate.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleTemplateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_template_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *RoleTemplateQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
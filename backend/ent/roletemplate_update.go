@@ -11,9 +11,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/predicate"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
 )
 
 // RoleTemplateUpdate is the builder for updating RoleTemplate entities.
@@ -64,6 +68,27 @@ func (_u *RoleTemplateUpdate) AddPlayerCount(v int) *RoleTemplateUpdate {
 	return _u
 }
 
+// SetMaxPlayerCount sets the "max_player_count" field.
+func (_u *RoleTemplateUpdate) SetMaxPlayerCount(v int) *RoleTemplateUpdate {
+	_u.mutation.ResetMaxPlayerCount()
+	_u.mutation.SetMaxPlayerCount(v)
+	return _u
+}
+
+// SetNillableMaxPlayerCount sets the "max_player_count" field if the given value is not nil.
+func (_u *RoleTemplateUpdate) SetNillableMaxPlayerCount(v *int) *RoleTemplateUpdate {
+	if v != nil {
+		_u.SetMaxPlayerCount(*v)
+	}
+	return _u
+}
+
+// AddMaxPlayerCount adds value to the "max_player_count" field.
+func (_u *RoleTemplateUpdate) AddMaxPlayerCount(v int) *RoleTemplateUpdate {
+	_u.mutation.AddMaxPlayerCount(v)
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *RoleTemplateUpdate) SetDescription(v string) *RoleTemplateUpdate {
 	_u.mutation.SetDescription(v)
@@ -84,6 +109,80 @@ func (_u *RoleTemplateUpdate) ClearDescription() *RoleTemplateUpdate {
 	return _u
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (_u *RoleTemplateUpdate) SetOwnerID(v string) *RoleTemplateUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *RoleTemplateUpdate) SetNillableOwnerID(v *string) *RoleTemplateUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *RoleTemplateUpdate) ClearOwnerID() *RoleTemplateUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RoleTemplateUpdate) SetStatus(v roletemplate.Status) *RoleTemplateUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RoleTemplateUpdate) SetNillableStatus(v *roletemplate.Status) *RoleTemplateUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetShareCode sets the "share_code" field.
+func (_u *RoleTemplateUpdate) SetShareCode(v string) *RoleTemplateUpdate {
+	_u.mutation.SetShareCode(v)
+	return _u
+}
+
+// SetNillableShareCode sets the "share_code" field if the given value is not nil.
+func (_u *RoleTemplateUpdate) SetNillableShareCode(v *string) *RoleTemplateUpdate {
+	if v != nil {
+		_u.SetShareCode(*v)
+	}
+	return _u
+}
+
+// ClearShareCode clears the value of the "share_code" field.
+func (_u *RoleTemplateUpdate) ClearShareCode() *RoleTemplateUpdate {
+	_u.mutation.ClearShareCode()
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *RoleTemplateUpdate) SetReviewNote(v string) *RoleTemplateUpdate {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *RoleTemplateUpdate) SetNillableReviewNote(v *string) *RoleTemplateUpdate {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (_u *RoleTemplateUpdate) ClearReviewNote() *RoleTemplateUpdate {
+	_u.mutation.ClearReviewNote()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *RoleTemplateUpdate) SetUpdatedAt(v time.Time) *RoleTemplateUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -105,6 +204,51 @@ func (_u *RoleTemplateUpdate) AddTemplateRoles(v ...*RoleTemplateRole) *RoleTemp
 	return _u.AddTemplateRoleIDs(ids...)
 }
 
+// AddOptionalSlotIDs adds the "optional_slots" edge to the RoleTemplateSlot entity by IDs.
+func (_u *RoleTemplateUpdate) AddOptionalSlotIDs(ids ...uuid.UUID) *RoleTemplateUpdate {
+	_u.mutation.AddOptionalSlotIDs(ids...)
+	return _u
+}
+
+// AddOptionalSlots adds the "optional_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleTemplateUpdate) AddOptionalSlots(v ...*RoleTemplateSlot) *RoleTemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOptionalSlotIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTemplateTranslation entity by IDs.
+func (_u *RoleTemplateUpdate) AddTranslationIDs(ids ...uuid.UUID) *RoleTemplateUpdate {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the RoleTemplateTranslation entity.
+func (_u *RoleTemplateUpdate) AddTranslations(v ...*RoleTemplateTranslation) *RoleTemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the RoleTemplateRevision entity by IDs.
+func (_u *RoleTemplateUpdate) AddRevisionIDs(ids ...uuid.UUID) *RoleTemplateUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the RoleTemplateRevision entity.
+func (_u *RoleTemplateUpdate) AddRevisions(v ...*RoleTemplateRevision) *RoleTemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the RoleTemplateMutation object of the builder.
 func (_u *RoleTemplateUpdate) Mutation() *RoleTemplateMutation {
 	return _u.mutation
@@ -131,6 +275,69 @@ func (_u *RoleTemplateUpdate) RemoveTemplateRoles(v ...*RoleTemplateRole) *RoleT
 	return _u.RemoveTemplateRoleIDs(ids...)
 }
 
+// ClearOptionalSlots clears all "optional_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleTemplateUpdate) ClearOptionalSlots() *RoleTemplateUpdate {
+	_u.mutation.ClearOptionalSlots()
+	return _u
+}
+
+// RemoveOptionalSlotIDs removes the "optional_slots" edge to RoleTemplateSlot entities by IDs.
+func (_u *RoleTemplateUpdate) RemoveOptionalSlotIDs(ids ...uuid.UUID) *RoleTemplateUpdate {
+	_u.mutation.RemoveOptionalSlotIDs(ids...)
+	return _u
+}
+
+// RemoveOptionalSlots removes "optional_slots" edges to RoleTemplateSlot entities.
+func (_u *RoleTemplateUpdate) RemoveOptionalSlots(v ...*RoleTemplateSlot) *RoleTemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOptionalSlotIDs(ids...)
+}
+
+// ClearTranslations clears all "translations" edges to the RoleTemplateTranslation entity.
+func (_u *RoleTemplateUpdate) ClearTranslations() *RoleTemplateUpdate {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to RoleTemplateTranslation entities by IDs.
+func (_u *RoleTemplateUpdate) RemoveTranslationIDs(ids ...uuid.UUID) *RoleTemplateUpdate {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to RoleTemplateTranslation entities.
+func (_u *RoleTemplateUpdate) RemoveTranslations(v ...*RoleTemplateTranslation) *RoleTemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the RoleTemplateRevision entity.
+func (_u *RoleTemplateUpdate) ClearRevisions() *RoleTemplateUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to RoleTemplateRevision entities by IDs.
+func (_u *RoleTemplateUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *RoleTemplateUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to RoleTemplateRevision entities.
+func (_u *RoleTemplateUpdate) RemoveRevisions(v ...*RoleTemplateRevision) *RoleTemplateUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *RoleTemplateUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -179,6 +386,11 @@ func (_u *RoleTemplateUpdate) check() error {
 			return &ValidationError{Name: "player_count", err: fmt.Errorf(`ent: validator failed for field "RoleTemplate.player_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := roletemplate.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoleTemplate.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -203,12 +415,39 @@ func (_u *RoleTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.AddedPlayerCount(); ok {
 		_spec.AddField(roletemplate.FieldPlayerCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.MaxPlayerCount(); ok {
+		_spec.SetField(roletemplate.FieldMaxPlayerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxPlayerCount(); ok {
+		_spec.AddField(roletemplate.FieldMaxPlayerCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(roletemplate.FieldDescription, field.TypeString, value)
 	}
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(roletemplate.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(roletemplate.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(roletemplate.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(roletemplate.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ShareCode(); ok {
+		_spec.SetField(roletemplate.FieldShareCode, field.TypeString, value)
+	}
+	if _u.mutation.ShareCodeCleared() {
+		_spec.ClearField(roletemplate.FieldShareCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(roletemplate.FieldReviewNote, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNoteCleared() {
+		_spec.ClearField(roletemplate.FieldReviewNote, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(roletemplate.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -257,6 +496,141 @@ func (_u *RoleTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OptionalSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.OptionalSlotsTable,
+			Columns: []string{roletemplate.OptionalSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOptionalSlotsIDs(); len(nodes) > 0 && !_u.mutation.OptionalSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.OptionalSlotsTable,
+			Columns: []string{roletemplate.OptionalSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OptionalSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.OptionalSlotsTable,
+			Columns: []string{roletemplate.OptionalSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.TranslationsTable,
+			Columns: []string{roletemplate.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.TranslationsTable,
+			Columns: []string{roletemplate.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.TranslationsTable,
+			Columns: []string{roletemplate.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.RevisionsTable,
+			Columns: []string{roletemplate.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.RevisionsTable,
+			Columns: []string{roletemplate.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.RevisionsTable,
+			Columns: []string{roletemplate.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{roletemplate.Label}
@@ -312,6 +686,27 @@ func (_u *RoleTemplateUpdateOne) AddPlayerCount(v int) *RoleTemplateUpdateOne {
 	return _u
 }
 
+// SetMaxPlayerCount sets the "max_player_count" field.
+func (_u *RoleTemplateUpdateOne) SetMaxPlayerCount(v int) *RoleTemplateUpdateOne {
+	_u.mutation.ResetMaxPlayerCount()
+	_u.mutation.SetMaxPlayerCount(v)
+	return _u
+}
+
+// SetNillableMaxPlayerCount sets the "max_player_count" field if the given value is not nil.
+func (_u *RoleTemplateUpdateOne) SetNillableMaxPlayerCount(v *int) *RoleTemplateUpdateOne {
+	if v != nil {
+		_u.SetMaxPlayerCount(*v)
+	}
+	return _u
+}
+
+// AddMaxPlayerCount adds value to the "max_player_count" field.
+func (_u *RoleTemplateUpdateOne) AddMaxPlayerCount(v int) *RoleTemplateUpdateOne {
+	_u.mutation.AddMaxPlayerCount(v)
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *RoleTemplateUpdateOne) SetDescription(v string) *RoleTemplateUpdateOne {
 	_u.mutation.SetDescription(v)
@@ -332,6 +727,80 @@ func (_u *RoleTemplateUpdateOne) ClearDescription() *RoleTemplateUpdateOne {
 	return _u
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (_u *RoleTemplateUpdateOne) SetOwnerID(v string) *RoleTemplateUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *RoleTemplateUpdateOne) SetNillableOwnerID(v *string) *RoleTemplateUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *RoleTemplateUpdateOne) ClearOwnerID() *RoleTemplateUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RoleTemplateUpdateOne) SetStatus(v roletemplate.Status) *RoleTemplateUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RoleTemplateUpdateOne) SetNillableStatus(v *roletemplate.Status) *RoleTemplateUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetShareCode sets the "share_code" field.
+func (_u *RoleTemplateUpdateOne) SetShareCode(v string) *RoleTemplateUpdateOne {
+	_u.mutation.SetShareCode(v)
+	return _u
+}
+
+// SetNillableShareCode sets the "share_code" field if the given value is not nil.
+func (_u *RoleTemplateUpdateOne) SetNillableShareCode(v *string) *RoleTemplateUpdateOne {
+	if v != nil {
+		_u.SetShareCode(*v)
+	}
+	return _u
+}
+
+// ClearShareCode clears the value of the "share_code" field.
+func (_u *RoleTemplateUpdateOne) ClearShareCode() *RoleTemplateUpdateOne {
+	_u.mutation.ClearShareCode()
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *RoleTemplateUpdateOne) SetReviewNote(v string) *RoleTemplateUpdateOne {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *RoleTemplateUpdateOne) SetNillableReviewNote(v *string) *RoleTemplateUpdateOne {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (_u *RoleTemplateUpdateOne) ClearReviewNote() *RoleTemplateUpdateOne {
+	_u.mutation.ClearReviewNote()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *RoleTemplateUpdateOne) SetUpdatedAt(v time.Time) *RoleTemplateUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -353,6 +822,51 @@ func (_u *RoleTemplateUpdateOne) AddTemplateRoles(v ...*RoleTemplateRole) *RoleT
 	return _u.AddTemplateRoleIDs(ids...)
 }
 
+// AddOptionalSlotIDs adds the "optional_slots" edge to the RoleTemplateSlot entity by IDs.
+func (_u *RoleTemplateUpdateOne) AddOptionalSlotIDs(ids ...uuid.UUID) *RoleTemplateUpdateOne {
+	_u.mutation.AddOptionalSlotIDs(ids...)
+	return _u
+}
+
+// AddOptionalSlots adds the "optional_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleTemplateUpdateOne) AddOptionalSlots(v ...*RoleTemplateSlot) *RoleTemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOptionalSlotIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the RoleTemplateTranslation entity by IDs.
+func (_u *RoleTemplateUpdateOne) AddTranslationIDs(ids ...uuid.UUID) *RoleTemplateUpdateOne {
+	_u.mutation.AddTranslationIDs(ids...)
+	return _u
+}
+
+// AddTranslations adds the "translations" edges to the RoleTemplateTranslation entity.
+func (_u *RoleTemplateUpdateOne) AddTranslations(v ...*RoleTemplateTranslation) *RoleTemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTranslationIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the RoleTemplateRevision entity by IDs.
+func (_u *RoleTemplateUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *RoleTemplateUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the RoleTemplateRevision entity.
+func (_u *RoleTemplateUpdateOne) AddRevisions(v ...*RoleTemplateRevision) *RoleTemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the RoleTemplateMutation object of the builder.
 func (_u *RoleTemplateUpdateOne) Mutation() *RoleTemplateMutation {
 	return _u.mutation
@@ -379,6 +893,69 @@ func (_u *RoleTemplateUpdateOne) RemoveTemplateRoles(v ...*RoleTemplateRole) *Ro
 	return _u.RemoveTemplateRoleIDs(ids...)
 }
 
+// ClearOptionalSlots clears all "optional_slots" edges to the RoleTemplateSlot entity.
+func (_u *RoleTemplateUpdateOne) ClearOptionalSlots() *RoleTemplateUpdateOne {
+	_u.mutation.ClearOptionalSlots()
+	return _u
+}
+
+// RemoveOptionalSlotIDs removes the "optional_slots" edge to RoleTemplateSlot entities by IDs.
+func (_u *RoleTemplateUpdateOne) RemoveOptionalSlotIDs(ids ...uuid.UUID) *RoleTemplateUpdateOne {
+	_u.mutation.RemoveOptionalSlotIDs(ids...)
+	return _u
+}
+
+// RemoveOptionalSlots removes "optional_slots" edges to RoleTemplateSlot entities.
+func (_u *RoleTemplateUpdateOne) RemoveOptionalSlots(v ...*RoleTemplateSlot) *RoleTemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOptionalSlotIDs(ids...)
+}
+
+// ClearTranslations clears all "translations" edges to the RoleTemplateTranslation entity.
+func (_u *RoleTemplateUpdateOne) ClearTranslations() *RoleTemplateUpdateOne {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// RemoveTranslationIDs removes the "translations" edge to RoleTemplateTranslation entities by IDs.
+func (_u *RoleTemplateUpdateOne) RemoveTranslationIDs(ids ...uuid.UUID) *RoleTemplateUpdateOne {
+	_u.mutation.RemoveTranslationIDs(ids...)
+	return _u
+}
+
+// RemoveTranslations removes "translations" edges to RoleTemplateTranslation entities.
+func (_u *RoleTemplateUpdateOne) RemoveTranslations(v ...*RoleTemplateTranslation) *RoleTemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTranslationIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the RoleTemplateRevision entity.
+func (_u *RoleTemplateUpdateOne) ClearRevisions() *RoleTemplateUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to RoleTemplateRevision entities by IDs.
+func (_u *RoleTemplateUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *RoleTemplateUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to RoleTemplateRevision entities.
+func (_u *RoleTemplateUpdateOne) RemoveRevisions(v ...*RoleTemplateRevision) *RoleTemplateUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Where appends a list predicates to the RoleTemplateUpdate builder.
 func (_u *RoleTemplateUpdateOne) Where(ps ...predicate.RoleTemplate) *RoleTemplateUpdateOne {
 	_u.mutation.Where(ps...)
@@ -440,6 +1017,11 @@ func (_u *RoleTemplateUpdateOne) check() error {
 			return &ValidationError{Name: "player_count", err: fmt.Errorf(`ent: validator failed for field "RoleTemplate.player_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := roletemplate.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoleTemplate.status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -481,12 +1063,39 @@ func (_u *RoleTemplateUpdateOne) sqlSave(ctx context.Context) (_node *RoleTempla
 	if value, ok := _u.mutation.AddedPlayerCount(); ok {
 		_spec.AddField(roletemplate.FieldPlayerCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.MaxPlayerCount(); ok {
+		_spec.SetField(roletemplate.FieldMaxPlayerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxPlayerCount(); ok {
+		_spec.AddField(roletemplate.FieldMaxPlayerCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(roletemplate.FieldDescription, field.TypeString, value)
 	}
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(roletemplate.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(roletemplate.FieldOwnerID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(roletemplate.FieldOwnerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(roletemplate.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ShareCode(); ok {
+		_spec.SetField(roletemplate.FieldShareCode, field.TypeString, value)
+	}
+	if _u.mutation.ShareCodeCleared() {
+		_spec.ClearField(roletemplate.FieldShareCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(roletemplate.FieldReviewNote, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNoteCleared() {
+		_spec.ClearField(roletemplate.FieldReviewNote, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(roletemplate.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -535,6 +1144,141 @@ func (_u *RoleTemplateUpdateOne) sqlSave(ctx context.Context) (_node *RoleTempla
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OptionalSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.OptionalSlotsTable,
+			Columns: []string{roletemplate.OptionalSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOptionalSlotsIDs(); len(nodes) > 0 && !_u.mutation.OptionalSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.OptionalSlotsTable,
+			Columns: []string{roletemplate.OptionalSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OptionalSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.OptionalSlotsTable,
+			Columns: []string{roletemplate.OptionalSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.TranslationsTable,
+			Columns: []string{roletemplate.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !_u.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.TranslationsTable,
+			Columns: []string{roletemplate.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.TranslationsTable,
+			Columns: []string{roletemplate.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.RevisionsTable,
+			Columns: []string{roletemplate.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.RevisionsTable,
+			Columns: []string{roletemplate.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   roletemplate.RevisionsTable,
+			Columns: []string{roletemplate.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &RoleTemplate{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
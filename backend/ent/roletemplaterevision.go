@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+	"github.com/mafia-night/backend/ent/schema"
+)
+
+// RoleTemplateRevision is the model entity for the RoleTemplateRevision schema.
+type RoleTemplateRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Reference to the revised role template
+	RoleTemplateID uuid.UUID `json:"role_template_id,omitempty"`
+	// Revision number, counting from 1 for each template
+	Number int `json:"number,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PlayerCount holds the value of the "player_count" field.
+	PlayerCount int `json:"player_count,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Core roles ordered by slug, then optional roles in the order they switch on
+	Roles []schema.RevisionRole `json:"roles,omitempty"`
+	// What made the revision; baseline records a template as it was before its first tracked change
+	Action roletemplaterevision.Action `json:"action,omitempty"`
+	// Revision restored by a rollback
+	SourceNumber *int `json:"source_number,omitempty"`
+	// AuthorKind holds the value of the "author_kind" field.
+	AuthorKind roletemplaterevision.AuthorKind `json:"author_kind,omitempty"`
+	// Admin or moderator who made the change
+	AuthorID string `json:"author_id,omitempty"`
+	// Username of the admin who made the change
+	AuthorName string `json:"author_name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleTemplateRevisionQuery when eager-loading is set.
+	Edges        RoleTemplateRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleTemplateRevisionEdges holds the relations/edges for other nodes in the graph.
+type RoleTemplateRevisionEdges struct {
+	// RoleTemplate holds the value of the role_template edge.
+	RoleTemplate *RoleTemplate `json:"role_template,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RoleTemplateOrErr returns the RoleTemplate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleTemplateRevisionEdges) RoleTemplateOrErr() (*RoleTemplate, error) {
+	if e.RoleTemplate != nil {
+		return e.RoleTemplate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: roletemplate.Label}
+	}
+	return nil, &NotLoadedError{edge: "role_template"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleTemplateRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roletemplaterevision.FieldRoles:
+			values[i] = new([]byte)
+		case roletemplaterevision.FieldNumber, roletemplaterevision.FieldPlayerCount, roletemplaterevision.FieldSourceNumber:
+			values[i] = new(sql.NullInt64)
+		case roletemplaterevision.FieldName, roletemplaterevision.FieldDescription, roletemplaterevision.FieldAction, roletemplaterevision.FieldAuthorKind, roletemplaterevision.FieldAuthorID, roletemplaterevision.FieldAuthorName:
+			values[i] = new(sql.NullString)
+		case roletemplaterevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case roletemplaterevision.FieldID, roletemplaterevision.FieldRoleTemplateID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleTemplateRevision fields.
+func (_m *RoleTemplateRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roletemplaterevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case roletemplaterevision.FieldRoleTemplateID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field role_template_id", values[i])
+			} else if value != nil {
+				_m.RoleTemplateID = *value
+			}
+		case roletemplaterevision.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = int(value.Int64)
+			}
+		case roletemplaterevision.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case roletemplaterevision.FieldPlayerCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field player_count", values[i])
+			} else if value.Valid {
+				_m.PlayerCount = int(value.Int64)
+			}
+		case roletemplaterevision.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case roletemplaterevision.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
+		case roletemplaterevision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = roletemplaterevision.Action(value.String)
+			}
+		case roletemplaterevision.FieldSourceNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_number", values[i])
+			} else if value.Valid {
+				_m.SourceNumber = new(int)
+				*_m.SourceNumber = int(value.Int64)
+			}
+		case roletemplaterevision.FieldAuthorKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_kind", values[i])
+			} else if value.Valid {
+				_m.AuthorKind = roletemplaterevision.AuthorKind(value.String)
+			}
+		case roletemplaterevision.FieldAuthorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value.Valid {
+				_m.AuthorID = value.String
+			}
+		case roletemplaterevision.FieldAuthorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_name", values[i])
+			} else if value.Valid {
+				_m.AuthorName = value.String
+			}
+		case roletemplaterevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleTemplateRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoleTemplateRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRoleTemplate queries the "role_template" edge of the RoleTemplateRevision entity.
+func (_m *RoleTemplateRevision) QueryRoleTemplate() *RoleTemplateQuery {
+	return NewRoleTemplateRevisionClient(_m.config).QueryRoleTemplate(_m)
+}
+
+// Update returns a builder for updating this RoleTemplateRevision.
+// Note that you need to call RoleTemplateRevision.Unwrap() before calling this method if this RoleTemplateRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoleTemplateRevision) Update() *RoleTemplateRevisionUpdateOne {
+	return NewRoleTemplateRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoleTemplateRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoleTemplateRevision) Unwrap() *RoleTemplateRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleTemplateRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoleTemplateRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleTemplateRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role_template_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleTemplateID))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Number))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("player_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlayerCount))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Roles))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	if v := _m.SourceNumber; v != nil {
+		builder.WriteString("source_number=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("author_kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AuthorKind))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(_m.AuthorID)
+	builder.WriteString(", ")
+	builder.WriteString("author_name=")
+	builder.WriteString(_m.AuthorName)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleTemplateRevisions is a parsable slice of RoleTemplateRevision.
+type RoleTemplateRevisions []*RoleTemplateRevision
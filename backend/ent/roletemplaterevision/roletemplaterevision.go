@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roletemplaterevision
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the roletemplaterevision type in the database.
+	Label = "role_template_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoleTemplateID holds the string denoting the role_template_id field in the database.
+	FieldRoleTemplateID = "role_template_id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPlayerCount holds the string denoting the player_count field in the database.
+	FieldPlayerCount = "player_count"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldRoles holds the string denoting the roles field in the database.
+	FieldRoles = "roles"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldSourceNumber holds the string denoting the source_number field in the database.
+	FieldSourceNumber = "source_number"
+	// FieldAuthorKind holds the string denoting the author_kind field in the database.
+	FieldAuthorKind = "author_kind"
+	// FieldAuthorID holds the string denoting the author_id field in the database.
+	FieldAuthorID = "author_id"
+	// FieldAuthorName holds the string denoting the author_name field in the database.
+	FieldAuthorName = "author_name"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRoleTemplate holds the string denoting the role_template edge name in mutations.
+	EdgeRoleTemplate = "role_template"
+	// Table holds the table name of the roletemplaterevision in the database.
+	Table = "role_template_revisions"
+	// RoleTemplateTable is the table that holds the role_template relation/edge.
+	RoleTemplateTable = "role_template_revisions"
+	// RoleTemplateInverseTable is the table name for the RoleTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "roletemplate" package.
+	RoleTemplateInverseTable = "role_templates"
+	// RoleTemplateColumn is the table column denoting the role_template relation/edge.
+	RoleTemplateColumn = "role_template_id"
+)
+
+// Columns holds all SQL columns for roletemplaterevision fields.
+var Columns = []string{
+	FieldID,
+	FieldRoleTemplateID,
+	FieldNumber,
+	FieldName,
+	FieldPlayerCount,
+	FieldDescription,
+	FieldRoles,
+	FieldAction,
+	FieldSourceNumber,
+	FieldAuthorKind,
+	FieldAuthorID,
+	FieldAuthorName,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	NumberValidator func(int) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// PlayerCountValidator is a validator for the "player_count" field. It is called by the builders before save.
+	PlayerCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionBaseline Action = "baseline"
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionRollback Action = "rollback"
+	ActionClone    Action = "clone"
+	ActionImport   Action = "import"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionBaseline, ActionCreate, ActionUpdate, ActionRollback, ActionClone, ActionImport:
+		return nil
+	default:
+		return fmt.Errorf("roletemplaterevision: invalid enum value for action field: %q", a)
+	}
+}
+
+// AuthorKind defines the type for the "author_kind" enum field.
+type AuthorKind string
+
+// AuthorKindSystem is the default value of the AuthorKind enum.
+const DefaultAuthorKind = AuthorKindSystem
+
+// AuthorKind values.
+const (
+	AuthorKindAdmin     AuthorKind = "admin"
+	AuthorKindModerator AuthorKind = "moderator"
+	AuthorKindSystem    AuthorKind = "system"
+)
+
+func (ak AuthorKind) String() string {
+	return string(ak)
+}
+
+// AuthorKindValidator is a validator for the "author_kind" field enum values. It is called by the builders before save.
+func AuthorKindValidator(ak AuthorKind) error {
+	switch ak {
+	case AuthorKindAdmin, AuthorKindModerator, AuthorKindSystem:
+		return nil
+	default:
+		return fmt.Errorf("roletemplaterevision: invalid enum value for author_kind field: %q", ak)
+	}
+}
+
+// OrderOption defines the ordering options for the RoleTemplateRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoleTemplateID orders the results by the role_template_id field.
+func ByRoleTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleTemplateID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPlayerCount orders the results by the player_count field.
+func ByPlayerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayerCount, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// BySourceNumber orders the results by the source_number field.
+func BySourceNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceNumber, opts...).ToFunc()
+}
+
+// ByAuthorKind orders the results by the author_kind field.
+func ByAuthorKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorKind, opts...).ToFunc()
+}
+
+// ByAuthorID orders the results by the author_id field.
+func ByAuthorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorID, opts...).ToFunc()
+}
+
+// ByAuthorName orders the results by the author_name field.
+func ByAuthorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRoleTemplateField orders the results by role_template field.
+func ByRoleTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleTemplateStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRoleTemplateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleTemplateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RoleTemplateTable, RoleTemplateColumn),
+	)
+}
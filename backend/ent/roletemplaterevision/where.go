@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roletemplaterevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldID, id))
+}
+
+// RoleTemplateID applies equality check predicate on the "role_template_id" field. It's identical to RoleTemplateIDEQ.
+func RoleTemplateID(v uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldRoleTemplateID, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldName, v))
+}
+
+// PlayerCount applies equality check predicate on the "player_count" field. It's identical to PlayerCountEQ.
+func PlayerCount(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldPlayerCount, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldDescription, v))
+}
+
+// SourceNumber applies equality check predicate on the "source_number" field. It's identical to SourceNumberEQ.
+func SourceNumber(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldSourceNumber, v))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorName applies equality check predicate on the "author_name" field. It's identical to AuthorNameEQ.
+func AuthorName(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RoleTemplateIDEQ applies the EQ predicate on the "role_template_id" field.
+func RoleTemplateIDEQ(v uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldRoleTemplateID, v))
+}
+
+// RoleTemplateIDNEQ applies the NEQ predicate on the "role_template_id" field.
+func RoleTemplateIDNEQ(v uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldRoleTemplateID, v))
+}
+
+// RoleTemplateIDIn applies the In predicate on the "role_template_id" field.
+func RoleTemplateIDIn(vs ...uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldRoleTemplateID, vs...))
+}
+
+// RoleTemplateIDNotIn applies the NotIn predicate on the "role_template_id" field.
+func RoleTemplateIDNotIn(vs ...uuid.UUID) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldRoleTemplateID, vs...))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldNumber, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContainsFold(FieldName, v))
+}
+
+// PlayerCountEQ applies the EQ predicate on the "player_count" field.
+func PlayerCountEQ(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldPlayerCount, v))
+}
+
+// PlayerCountNEQ applies the NEQ predicate on the "player_count" field.
+func PlayerCountNEQ(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldPlayerCount, v))
+}
+
+// PlayerCountIn applies the In predicate on the "player_count" field.
+func PlayerCountIn(vs ...int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldPlayerCount, vs...))
+}
+
+// PlayerCountNotIn applies the NotIn predicate on the "player_count" field.
+func PlayerCountNotIn(vs ...int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldPlayerCount, vs...))
+}
+
+// PlayerCountGT applies the GT predicate on the "player_count" field.
+func PlayerCountGT(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldPlayerCount, v))
+}
+
+// PlayerCountGTE applies the GTE predicate on the "player_count" field.
+func PlayerCountGTE(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldPlayerCount, v))
+}
+
+// PlayerCountLT applies the LT predicate on the "player_count" field.
+func PlayerCountLT(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldPlayerCount, v))
+}
+
+// PlayerCountLTE applies the LTE predicate on the "player_count" field.
+func PlayerCountLTE(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldPlayerCount, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// SourceNumberEQ applies the EQ predicate on the "source_number" field.
+func SourceNumberEQ(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldSourceNumber, v))
+}
+
+// SourceNumberNEQ applies the NEQ predicate on the "source_number" field.
+func SourceNumberNEQ(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldSourceNumber, v))
+}
+
+// SourceNumberIn applies the In predicate on the "source_number" field.
+func SourceNumberIn(vs ...int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldSourceNumber, vs...))
+}
+
+// SourceNumberNotIn applies the NotIn predicate on the "source_number" field.
+func SourceNumberNotIn(vs ...int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldSourceNumber, vs...))
+}
+
+// SourceNumberGT applies the GT predicate on the "source_number" field.
+func SourceNumberGT(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldSourceNumber, v))
+}
+
+// SourceNumberGTE applies the GTE predicate on the "source_number" field.
+func SourceNumberGTE(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldSourceNumber, v))
+}
+
+// SourceNumberLT applies the LT predicate on the "source_number" field.
+func SourceNumberLT(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldSourceNumber, v))
+}
+
+// SourceNumberLTE applies the LTE predicate on the "source_number" field.
+func SourceNumberLTE(v int) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldSourceNumber, v))
+}
+
+// SourceNumberIsNil applies the IsNil predicate on the "source_number" field.
+func SourceNumberIsNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIsNull(FieldSourceNumber))
+}
+
+// SourceNumberNotNil applies the NotNil predicate on the "source_number" field.
+func SourceNumberNotNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotNull(FieldSourceNumber))
+}
+
+// AuthorKindEQ applies the EQ predicate on the "author_kind" field.
+func AuthorKindEQ(v AuthorKind) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldAuthorKind, v))
+}
+
+// AuthorKindNEQ applies the NEQ predicate on the "author_kind" field.
+func AuthorKindNEQ(v AuthorKind) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldAuthorKind, v))
+}
+
+// AuthorKindIn applies the In predicate on the "author_kind" field.
+func AuthorKindIn(vs ...AuthorKind) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldAuthorKind, vs...))
+}
+
+// AuthorKindNotIn applies the NotIn predicate on the "author_kind" field.
+func AuthorKindNotIn(vs ...AuthorKind) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldAuthorKind, vs...))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDGT applies the GT predicate on the "author_id" field.
+func AuthorIDGT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldAuthorID, v))
+}
+
+// AuthorIDGTE applies the GTE predicate on the "author_id" field.
+func AuthorIDGTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldAuthorID, v))
+}
+
+// AuthorIDLT applies the LT predicate on the "author_id" field.
+func AuthorIDLT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldAuthorID, v))
+}
+
+// AuthorIDLTE applies the LTE predicate on the "author_id" field.
+func AuthorIDLTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldAuthorID, v))
+}
+
+// AuthorIDContains applies the Contains predicate on the "author_id" field.
+func AuthorIDContains(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContains(FieldAuthorID, v))
+}
+
+// AuthorIDHasPrefix applies the HasPrefix predicate on the "author_id" field.
+func AuthorIDHasPrefix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasPrefix(FieldAuthorID, v))
+}
+
+// AuthorIDHasSuffix applies the HasSuffix predicate on the "author_id" field.
+func AuthorIDHasSuffix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasSuffix(FieldAuthorID, v))
+}
+
+// AuthorIDIsNil applies the IsNil predicate on the "author_id" field.
+func AuthorIDIsNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIsNull(FieldAuthorID))
+}
+
+// AuthorIDNotNil applies the NotNil predicate on the "author_id" field.
+func AuthorIDNotNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotNull(FieldAuthorID))
+}
+
+// AuthorIDEqualFold applies the EqualFold predicate on the "author_id" field.
+func AuthorIDEqualFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEqualFold(FieldAuthorID, v))
+}
+
+// AuthorIDContainsFold applies the ContainsFold predicate on the "author_id" field.
+func AuthorIDContainsFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContainsFold(FieldAuthorID, v))
+}
+
+// AuthorNameEQ applies the EQ predicate on the "author_name" field.
+func AuthorNameEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// AuthorNameNEQ applies the NEQ predicate on the "author_name" field.
+func AuthorNameNEQ(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldAuthorName, v))
+}
+
+// AuthorNameIn applies the In predicate on the "author_name" field.
+func AuthorNameIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameNotIn applies the NotIn predicate on the "author_name" field.
+func AuthorNameNotIn(vs ...string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameGT applies the GT predicate on the "author_name" field.
+func AuthorNameGT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldAuthorName, v))
+}
+
+// AuthorNameGTE applies the GTE predicate on the "author_name" field.
+func AuthorNameGTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldAuthorName, v))
+}
+
+// AuthorNameLT applies the LT predicate on the "author_name" field.
+func AuthorNameLT(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldAuthorName, v))
+}
+
+// AuthorNameLTE applies the LTE predicate on the "author_name" field.
+func AuthorNameLTE(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldAuthorName, v))
+}
+
+// AuthorNameContains applies the Contains predicate on the "author_name" field.
+func AuthorNameContains(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContains(FieldAuthorName, v))
+}
+
+// AuthorNameHasPrefix applies the HasPrefix predicate on the "author_name" field.
+func AuthorNameHasPrefix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasPrefix(FieldAuthorName, v))
+}
+
+// AuthorNameHasSuffix applies the HasSuffix predicate on the "author_name" field.
+func AuthorNameHasSuffix(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldHasSuffix(FieldAuthorName, v))
+}
+
+// AuthorNameIsNil applies the IsNil predicate on the "author_name" field.
+func AuthorNameIsNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIsNull(FieldAuthorName))
+}
+
+// AuthorNameNotNil applies the NotNil predicate on the "author_name" field.
+func AuthorNameNotNil() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotNull(FieldAuthorName))
+}
+
+// AuthorNameEqualFold applies the EqualFold predicate on the "author_name" field.
+func AuthorNameEqualFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEqualFold(FieldAuthorName, v))
+}
+
+// AuthorNameContainsFold applies the ContainsFold predicate on the "author_name" field.
+func AuthorNameContainsFold(v string) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldContainsFold(FieldAuthorName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRoleTemplate applies the HasEdge predicate on the "role_template" edge.
+func HasRoleTemplate() predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RoleTemplateTable, RoleTemplateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleTemplateWith applies the HasEdge predicate on the "role_template" edge with a given conditions (other predicates).
+func HasRoleTemplateWith(preds ...predicate.RoleTemplate) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(func(s *sql.Selector) {
+		step := newRoleTemplateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleTemplateRevision) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleTemplateRevision) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleTemplateRevision) predicate.RoleTemplateRevision {
+	return predicate.RoleTemplateRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,449 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+	"github.com/mafia-night/backend/ent/schema"
+)
+
+// RoleTemplateRevisionCreate is the builder for creating a RoleTemplateRevision entity.
+type RoleTemplateRevisionCreate struct {
+	config
+	mutation *RoleTemplateRevisionMutation
+	hooks    []Hook
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_c *RoleTemplateRevisionCreate) SetRoleTemplateID(v uuid.UUID) *RoleTemplateRevisionCreate {
+	_c.mutation.SetRoleTemplateID(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *RoleTemplateRevisionCreate) SetNumber(v int) *RoleTemplateRevisionCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *RoleTemplateRevisionCreate) SetName(v string) *RoleTemplateRevisionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPlayerCount sets the "player_count" field.
+func (_c *RoleTemplateRevisionCreate) SetPlayerCount(v int) *RoleTemplateRevisionCreate {
+	_c.mutation.SetPlayerCount(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *RoleTemplateRevisionCreate) SetDescription(v string) *RoleTemplateRevisionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *RoleTemplateRevisionCreate) SetNillableDescription(v *string) *RoleTemplateRevisionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetRoles sets the "roles" field.
+func (_c *RoleTemplateRevisionCreate) SetRoles(v []schema.RevisionRole) *RoleTemplateRevisionCreate {
+	_c.mutation.SetRoles(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *RoleTemplateRevisionCreate) SetAction(v roletemplaterevision.Action) *RoleTemplateRevisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetSourceNumber sets the "source_number" field.
+func (_c *RoleTemplateRevisionCreate) SetSourceNumber(v int) *RoleTemplateRevisionCreate {
+	_c.mutation.SetSourceNumber(v)
+	return _c
+}
+
+// SetNillableSourceNumber sets the "source_number" field if the given value is not nil.
+func (_c *RoleTemplateRevisionCreate) SetNillableSourceNumber(v *int) *RoleTemplateRevisionCreate {
+	if v != nil {
+		_c.SetSourceNumber(*v)
+	}
+	return _c
+}
+
+// SetAuthorKind sets the "author_kind" field.
+func (_c *RoleTemplateRevisionCreate) SetAuthorKind(v roletemplaterevision.AuthorKind) *RoleTemplateRevisionCreate {
+	_c.mutation.SetAuthorKind(v)
+	return _c
+}
+
+// SetNillableAuthorKind sets the "author_kind" field if the given value is not nil.
+func (_c *RoleTemplateRevisionCreate) SetNillableAuthorKind(v *roletemplaterevision.AuthorKind) *RoleTemplateRevisionCreate {
+	if v != nil {
+		_c.SetAuthorKind(*v)
+	}
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *RoleTemplateRevisionCreate) SetAuthorID(v string) *RoleTemplateRevisionCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_c *RoleTemplateRevisionCreate) SetNillableAuthorID(v *string) *RoleTemplateRevisionCreate {
+	if v != nil {
+		_c.SetAuthorID(*v)
+	}
+	return _c
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_c *RoleTemplateRevisionCreate) SetAuthorName(v string) *RoleTemplateRevisionCreate {
+	_c.mutation.SetAuthorName(v)
+	return _c
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_c *RoleTemplateRevisionCreate) SetNillableAuthorName(v *string) *RoleTemplateRevisionCreate {
+	if v != nil {
+		_c.SetAuthorName(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RoleTemplateRevisionCreate) SetCreatedAt(v time.Time) *RoleTemplateRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RoleTemplateRevisionCreate) SetNillableCreatedAt(v *time.Time) *RoleTemplateRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RoleTemplateRevisionCreate) SetID(v uuid.UUID) *RoleTemplateRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RoleTemplateRevisionCreate) SetNillableID(v *uuid.UUID) *RoleTemplateRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_c *RoleTemplateRevisionCreate) SetRoleTemplate(v *RoleTemplate) *RoleTemplateRevisionCreate {
+	return _c.SetRoleTemplateID(v.ID)
+}
+
+// Mutation returns the RoleTemplateRevisionMutation object of the builder.
+func (_c *RoleTemplateRevisionCreate) Mutation() *RoleTemplateRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the RoleTemplateRevision in the database.
+func (_c *RoleTemplateRevisionCreate) Save(ctx context.Context) (*RoleTemplateRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoleTemplateRevisionCreate) SaveX(ctx context.Context) *RoleTemplateRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTemplateRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTemplateRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RoleTemplateRevisionCreate) defaults() {
+	if _, ok := _c.mutation.AuthorKind(); !ok {
+		v := roletemplaterevision.DefaultAuthorKind
+		_c.mutation.SetAuthorKind(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := roletemplaterevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := roletemplaterevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoleTemplateRevisionCreate) check() error {
+	if _, ok := _c.mutation.RoleTemplateID(); !ok {
+		return &ValidationError{Name: "role_template_id", err: errors.New(`ent: missing required field "RoleTemplateRevision.role_template_id"`)}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "RoleTemplateRevision.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := roletemplaterevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RoleTemplateRevision.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := roletemplaterevision.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PlayerCount(); !ok {
+		return &ValidationError{Name: "player_count", err: errors.New(`ent: missing required field "RoleTemplateRevision.player_count"`)}
+	}
+	if v, ok := _c.mutation.PlayerCount(); ok {
+		if err := roletemplaterevision.PlayerCountValidator(v); err != nil {
+			return &ValidationError{Name: "player_count", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.player_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Roles(); !ok {
+		return &ValidationError{Name: "roles", err: errors.New(`ent: missing required field "RoleTemplateRevision.roles"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "RoleTemplateRevision.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := roletemplaterevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AuthorKind(); !ok {
+		return &ValidationError{Name: "author_kind", err: errors.New(`ent: missing required field "RoleTemplateRevision.author_kind"`)}
+	}
+	if v, ok := _c.mutation.AuthorKind(); ok {
+		if err := roletemplaterevision.AuthorKindValidator(v); err != nil {
+			return &ValidationError{Name: "author_kind", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.author_kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleTemplateRevision.created_at"`)}
+	}
+	if len(_c.mutation.RoleTemplateIDs()) == 0 {
+		return &ValidationError{Name: "role_template", err: errors.New(`ent: missing required edge "RoleTemplateRevision.role_template"`)}
+	}
+	return nil
+}
+
+func (_c *RoleTemplateRevisionCreate) sqlSave(ctx context.Context) (*RoleTemplateRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoleTemplateRevisionCreate) createSpec() (*RoleTemplateRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleTemplateRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(roletemplaterevision.Table, sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(roletemplaterevision.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(roletemplaterevision.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.PlayerCount(); ok {
+		_spec.SetField(roletemplaterevision.FieldPlayerCount, field.TypeInt, value)
+		_node.PlayerCount = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(roletemplaterevision.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Roles(); ok {
+		_spec.SetField(roletemplaterevision.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(roletemplaterevision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.SourceNumber(); ok {
+		_spec.SetField(roletemplaterevision.FieldSourceNumber, field.TypeInt, value)
+		_node.SourceNumber = &value
+	}
+	if value, ok := _c.mutation.AuthorKind(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorKind, field.TypeEnum, value)
+		_node.AuthorKind = value
+	}
+	if value, ok := _c.mutation.AuthorID(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorID, field.TypeString, value)
+		_node.AuthorID = value
+	}
+	if value, ok := _c.mutation.AuthorName(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorName, field.TypeString, value)
+		_node.AuthorName = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(roletemplaterevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplaterevision.RoleTemplateTable,
+			Columns: []string{roletemplaterevision.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleTemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleTemplateRevisionCreateBulk is the builder for creating many RoleTemplateRevision entities in bulk.
+type RoleTemplateRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*RoleTemplateRevisionCreate
+}
+
+// Save creates the RoleTemplateRevision entities in the database.
+func (_c *RoleTemplateRevisionCreateBulk) Save(ctx context.Context) ([]*RoleTemplateRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoleTemplateRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleTemplateRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoleTemplateRevisionCreateBulk) SaveX(ctx context.Context) []*RoleTemplateRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTemplateRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTemplateRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
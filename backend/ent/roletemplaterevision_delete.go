@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+)
+
+// RoleTemplateRevisionDelete is the builder for deleting a RoleTemplateRevision entity.
+type RoleTemplateRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoleTemplateRevisionMutation
+}
+
+// Where appends a list predicates to the RoleTemplateRevisionDelete builder.
+func (_d *RoleTemplateRevisionDelete) Where(ps ...predicate.RoleTemplateRevision) *RoleTemplateRevisionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RoleTemplateRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RoleTemplateRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RoleTemplateRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roletemplaterevision.Table, sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RoleTemplateRevisionDeleteOne is the builder for deleting a single RoleTemplateRevision entity.
+type RoleTemplateRevisionDeleteOne struct {
+	_d *RoleTemplateRevisionDelete
+}
+
+// Where appends a list predicates to the RoleTemplateRevisionDelete builder.
+func (_d *RoleTemplateRevisionDeleteOne) Where(ps ...predicate.RoleTemplateRevision) *RoleTemplateRevisionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RoleTemplateRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roletemplaterevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RoleTemplateRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
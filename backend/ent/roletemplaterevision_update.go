@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+	"github.com/mafia-night/backend/ent/schema"
+)
+
+// RoleTemplateRevisionUpdate is the builder for updating RoleTemplateRevision entities.
+type RoleTemplateRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleTemplateRevisionMutation
+}
+
+// Where appends a list predicates to the RoleTemplateRevisionUpdate builder.
+func (_u *RoleTemplateRevisionUpdate) Where(ps ...predicate.RoleTemplateRevision) *RoleTemplateRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_u *RoleTemplateRevisionUpdate) SetRoleTemplateID(v uuid.UUID) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetRoleTemplateID(v)
+	return _u
+}
+
+// SetNillableRoleTemplateID sets the "role_template_id" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableRoleTemplateID(v *uuid.UUID) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetRoleTemplateID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *RoleTemplateRevisionUpdate) SetNumber(v int) *RoleTemplateRevisionUpdate {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableNumber(v *int) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *RoleTemplateRevisionUpdate) AddNumber(v int) *RoleTemplateRevisionUpdate {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoleTemplateRevisionUpdate) SetName(v string) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableName(v *string) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPlayerCount sets the "player_count" field.
+func (_u *RoleTemplateRevisionUpdate) SetPlayerCount(v int) *RoleTemplateRevisionUpdate {
+	_u.mutation.ResetPlayerCount()
+	_u.mutation.SetPlayerCount(v)
+	return _u
+}
+
+// SetNillablePlayerCount sets the "player_count" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillablePlayerCount(v *int) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetPlayerCount(*v)
+	}
+	return _u
+}
+
+// AddPlayerCount adds value to the "player_count" field.
+func (_u *RoleTemplateRevisionUpdate) AddPlayerCount(v int) *RoleTemplateRevisionUpdate {
+	_u.mutation.AddPlayerCount(v)
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoleTemplateRevisionUpdate) SetDescription(v string) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableDescription(v *string) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RoleTemplateRevisionUpdate) ClearDescription() *RoleTemplateRevisionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *RoleTemplateRevisionUpdate) SetRoles(v []schema.RevisionRole) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *RoleTemplateRevisionUpdate) AppendRoles(v []schema.RevisionRole) *RoleTemplateRevisionUpdate {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *RoleTemplateRevisionUpdate) SetAction(v roletemplaterevision.Action) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableAction(v *roletemplaterevision.Action) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetSourceNumber sets the "source_number" field.
+func (_u *RoleTemplateRevisionUpdate) SetSourceNumber(v int) *RoleTemplateRevisionUpdate {
+	_u.mutation.ResetSourceNumber()
+	_u.mutation.SetSourceNumber(v)
+	return _u
+}
+
+// SetNillableSourceNumber sets the "source_number" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableSourceNumber(v *int) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetSourceNumber(*v)
+	}
+	return _u
+}
+
+// AddSourceNumber adds value to the "source_number" field.
+func (_u *RoleTemplateRevisionUpdate) AddSourceNumber(v int) *RoleTemplateRevisionUpdate {
+	_u.mutation.AddSourceNumber(v)
+	return _u
+}
+
+// ClearSourceNumber clears the value of the "source_number" field.
+func (_u *RoleTemplateRevisionUpdate) ClearSourceNumber() *RoleTemplateRevisionUpdate {
+	_u.mutation.ClearSourceNumber()
+	return _u
+}
+
+// SetAuthorKind sets the "author_kind" field.
+func (_u *RoleTemplateRevisionUpdate) SetAuthorKind(v roletemplaterevision.AuthorKind) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetAuthorKind(v)
+	return _u
+}
+
+// SetNillableAuthorKind sets the "author_kind" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableAuthorKind(v *roletemplaterevision.AuthorKind) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetAuthorKind(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *RoleTemplateRevisionUpdate) SetAuthorID(v string) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableAuthorID(v *string) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (_u *RoleTemplateRevisionUpdate) ClearAuthorID() *RoleTemplateRevisionUpdate {
+	_u.mutation.ClearAuthorID()
+	return _u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_u *RoleTemplateRevisionUpdate) SetAuthorName(v string) *RoleTemplateRevisionUpdate {
+	_u.mutation.SetAuthorName(v)
+	return _u
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdate) SetNillableAuthorName(v *string) *RoleTemplateRevisionUpdate {
+	if v != nil {
+		_u.SetAuthorName(*v)
+	}
+	return _u
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (_u *RoleTemplateRevisionUpdate) ClearAuthorName() *RoleTemplateRevisionUpdate {
+	_u.mutation.ClearAuthorName()
+	return _u
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateRevisionUpdate) SetRoleTemplate(v *RoleTemplate) *RoleTemplateRevisionUpdate {
+	return _u.SetRoleTemplateID(v.ID)
+}
+
+// Mutation returns the RoleTemplateRevisionMutation object of the builder.
+func (_u *RoleTemplateRevisionUpdate) Mutation() *RoleTemplateRevisionMutation {
+	return _u.mutation
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateRevisionUpdate) ClearRoleTemplate() *RoleTemplateRevisionUpdate {
+	_u.mutation.ClearRoleTemplate()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoleTemplateRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTemplateRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoleTemplateRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTemplateRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTemplateRevisionUpdate) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := roletemplaterevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := roletemplaterevision.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlayerCount(); ok {
+		if err := roletemplaterevision.PlayerCountValidator(v); err != nil {
+			return &ValidationError{Name: "player_count", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.player_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := roletemplaterevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorKind(); ok {
+		if err := roletemplaterevision.AuthorKindValidator(v); err != nil {
+			return &ValidationError{Name: "author_kind", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.author_kind": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleTemplateCleared() && len(_u.mutation.RoleTemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateRevision.role_template"`)
+	}
+	return nil
+}
+
+func (_u *RoleTemplateRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletemplaterevision.Table, roletemplaterevision.Columns, sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(roletemplaterevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(roletemplaterevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(roletemplaterevision.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlayerCount(); ok {
+		_spec.SetField(roletemplaterevision.FieldPlayerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPlayerCount(); ok {
+		_spec.AddField(roletemplaterevision.FieldPlayerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(roletemplaterevision.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(roletemplaterevision.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(roletemplaterevision.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, roletemplaterevision.FieldRoles, value)
+		})
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(roletemplaterevision.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SourceNumber(); ok {
+		_spec.SetField(roletemplaterevision.FieldSourceNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSourceNumber(); ok {
+		_spec.AddField(roletemplaterevision.FieldSourceNumber, field.TypeInt, value)
+	}
+	if _u.mutation.SourceNumberCleared() {
+		_spec.ClearField(roletemplaterevision.FieldSourceNumber, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AuthorKind(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorID, field.TypeString, value)
+	}
+	if _u.mutation.AuthorIDCleared() {
+		_spec.ClearField(roletemplaterevision.FieldAuthorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorName(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorName, field.TypeString, value)
+	}
+	if _u.mutation.AuthorNameCleared() {
+		_spec.ClearField(roletemplaterevision.FieldAuthorName, field.TypeString)
+	}
+	if _u.mutation.RoleTemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplaterevision.RoleTemplateTable,
+			Columns: []string{roletemplaterevision.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplaterevision.RoleTemplateTable,
+			Columns: []string{roletemplaterevision.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletemplaterevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoleTemplateRevisionUpdateOne is the builder for updating a single RoleTemplateRevision entity.
+type RoleTemplateRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleTemplateRevisionMutation
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetRoleTemplateID(v uuid.UUID) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetRoleTemplateID(v)
+	return _u
+}
+
+// SetNillableRoleTemplateID sets the "role_template_id" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableRoleTemplateID(v *uuid.UUID) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetRoleTemplateID(*v)
+	}
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetNumber(v int) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ResetNumber()
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableNumber(v *int) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// AddNumber adds value to the "number" field.
+func (_u *RoleTemplateRevisionUpdateOne) AddNumber(v int) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.AddNumber(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetName(v string) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableName(v *string) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPlayerCount sets the "player_count" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetPlayerCount(v int) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ResetPlayerCount()
+	_u.mutation.SetPlayerCount(v)
+	return _u
+}
+
+// SetNillablePlayerCount sets the "player_count" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillablePlayerCount(v *int) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetPlayerCount(*v)
+	}
+	return _u
+}
+
+// AddPlayerCount adds value to the "player_count" field.
+func (_u *RoleTemplateRevisionUpdateOne) AddPlayerCount(v int) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.AddPlayerCount(v)
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetDescription(v string) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableDescription(v *string) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RoleTemplateRevisionUpdateOne) ClearDescription() *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetRoles(v []schema.RevisionRole) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *RoleTemplateRevisionUpdateOne) AppendRoles(v []schema.RevisionRole) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetAction(v roletemplaterevision.Action) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableAction(v *roletemplaterevision.Action) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetSourceNumber sets the "source_number" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetSourceNumber(v int) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ResetSourceNumber()
+	_u.mutation.SetSourceNumber(v)
+	return _u
+}
+
+// SetNillableSourceNumber sets the "source_number" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableSourceNumber(v *int) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetSourceNumber(*v)
+	}
+	return _u
+}
+
+// AddSourceNumber adds value to the "source_number" field.
+func (_u *RoleTemplateRevisionUpdateOne) AddSourceNumber(v int) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.AddSourceNumber(v)
+	return _u
+}
+
+// ClearSourceNumber clears the value of the "source_number" field.
+func (_u *RoleTemplateRevisionUpdateOne) ClearSourceNumber() *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ClearSourceNumber()
+	return _u
+}
+
+// SetAuthorKind sets the "author_kind" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetAuthorKind(v roletemplaterevision.AuthorKind) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetAuthorKind(v)
+	return _u
+}
+
+// SetNillableAuthorKind sets the "author_kind" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableAuthorKind(v *roletemplaterevision.AuthorKind) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetAuthorKind(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetAuthorID(v string) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableAuthorID(v *string) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (_u *RoleTemplateRevisionUpdateOne) ClearAuthorID() *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ClearAuthorID()
+	return _u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_u *RoleTemplateRevisionUpdateOne) SetAuthorName(v string) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.SetAuthorName(v)
+	return _u
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_u *RoleTemplateRevisionUpdateOne) SetNillableAuthorName(v *string) *RoleTemplateRevisionUpdateOne {
+	if v != nil {
+		_u.SetAuthorName(*v)
+	}
+	return _u
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (_u *RoleTemplateRevisionUpdateOne) ClearAuthorName() *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ClearAuthorName()
+	return _u
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateRevisionUpdateOne) SetRoleTemplate(v *RoleTemplate) *RoleTemplateRevisionUpdateOne {
+	return _u.SetRoleTemplateID(v.ID)
+}
+
+// Mutation returns the RoleTemplateRevisionMutation object of the builder.
+func (_u *RoleTemplateRevisionUpdateOne) Mutation() *RoleTemplateRevisionMutation {
+	return _u.mutation
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateRevisionUpdateOne) ClearRoleTemplate() *RoleTemplateRevisionUpdateOne {
+	_u.mutation.ClearRoleTemplate()
+	return _u
+}
+
+// Where appends a list predicates to the RoleTemplateRevisionUpdate builder.
+func (_u *RoleTemplateRevisionUpdateOne) Where(ps ...predicate.RoleTemplateRevision) *RoleTemplateRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoleTemplateRevisionUpdateOne) Select(field string, fields ...string) *RoleTemplateRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoleTemplateRevision entity.
+func (_u *RoleTemplateRevisionUpdateOne) Save(ctx context.Context) (*RoleTemplateRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTemplateRevisionUpdateOne) SaveX(ctx context.Context) *RoleTemplateRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoleTemplateRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTemplateRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTemplateRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := roletemplaterevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := roletemplaterevision.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlayerCount(); ok {
+		if err := roletemplaterevision.PlayerCountValidator(v); err != nil {
+			return &ValidationError{Name: "player_count", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.player_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := roletemplaterevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorKind(); ok {
+		if err := roletemplaterevision.AuthorKindValidator(v); err != nil {
+			return &ValidationError{Name: "author_kind", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateRevision.author_kind": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleTemplateCleared() && len(_u.mutation.RoleTemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateRevision.role_template"`)
+	}
+	return nil
+}
+
+func (_u *RoleTemplateRevisionUpdateOne) sqlSave(ctx context.Context) (_node *RoleTemplateRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletemplaterevision.Table, roletemplaterevision.Columns, sqlgraph.NewFieldSpec(roletemplaterevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleTemplateRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roletemplaterevision.FieldID)
+		for _, f := range fields {
+			if !roletemplaterevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roletemplaterevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(roletemplaterevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNumber(); ok {
+		_spec.AddField(roletemplaterevision.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(roletemplaterevision.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlayerCount(); ok {
+		_spec.SetField(roletemplaterevision.FieldPlayerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPlayerCount(); ok {
+		_spec.AddField(roletemplaterevision.FieldPlayerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(roletemplaterevision.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(roletemplaterevision.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(roletemplaterevision.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, roletemplaterevision.FieldRoles, value)
+		})
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(roletemplaterevision.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SourceNumber(); ok {
+		_spec.SetField(roletemplaterevision.FieldSourceNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSourceNumber(); ok {
+		_spec.AddField(roletemplaterevision.FieldSourceNumber, field.TypeInt, value)
+	}
+	if _u.mutation.SourceNumberCleared() {
+		_spec.ClearField(roletemplaterevision.FieldSourceNumber, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AuthorKind(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorID, field.TypeString, value)
+	}
+	if _u.mutation.AuthorIDCleared() {
+		_spec.ClearField(roletemplaterevision.FieldAuthorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorName(); ok {
+		_spec.SetField(roletemplaterevision.FieldAuthorName, field.TypeString, value)
+	}
+	if _u.mutation.AuthorNameCleared() {
+		_spec.ClearField(roletemplaterevision.FieldAuthorName, field.TypeString)
+	}
+	if _u.mutation.RoleTemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplaterevision.RoleTemplateTable,
+			Columns: []string{roletemplaterevision.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplaterevision.RoleTemplateTable,
+			Columns: []string{roletemplaterevision.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleTemplateRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletemplaterevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
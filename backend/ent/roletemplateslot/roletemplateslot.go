@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roletemplateslot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the roletemplateslot type in the database.
+	Label = "role_template_slot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoleTemplateID holds the string denoting the role_template_id field in the database.
+	FieldRoleTemplateID = "role_template_id"
+	// FieldRoleID holds the string denoting the role_id field in the database.
+	FieldRoleID = "role_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeRoleTemplate holds the string denoting the role_template edge name in mutations.
+	EdgeRoleTemplate = "role_template"
+	// EdgeRole holds the string denoting the role edge name in mutations.
+	EdgeRole = "role"
+	// Table holds the table name of the roletemplateslot in the database.
+	Table = "role_template_slots"
+	// RoleTemplateTable is the table that holds the role_template relation/edge.
+	RoleTemplateTable = "role_template_slots"
+	// RoleTemplateInverseTable is the table name for the RoleTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "roletemplate" package.
+	RoleTemplateInverseTable = "role_templates"
+	// RoleTemplateColumn is the table column denoting the role_template relation/edge.
+	RoleTemplateColumn = "role_template_id"
+	// RoleTable is the table that holds the role relation/edge.
+	RoleTable = "role_template_slots"
+	// RoleInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RoleInverseTable = "roles"
+	// RoleColumn is the table column denoting the role relation/edge.
+	RoleColumn = "role_id"
+)
+
+// Columns holds all SQL columns for roletemplateslot fields.
+var Columns = []string{
+	FieldID,
+	FieldRoleTemplateID,
+	FieldRoleID,
+	FieldPosition,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the RoleTemplateSlot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoleTemplateID orders the results by the role_template_id field.
+func ByRoleTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleTemplateID, opts...).ToFunc()
+}
+
+// ByRoleID orders the results by the role_id field.
+func ByRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByRoleTemplateField orders the results by role_template field.
+func ByRoleTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleTemplateStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRoleField orders the results by role field.
+func ByRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRoleTemplateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleTemplateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RoleTemplateTable, RoleTemplateColumn),
+	)
+}
+func newRoleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RoleTable, RoleColumn),
+	)
+}
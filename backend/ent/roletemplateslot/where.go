@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roletemplateslot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldLTE(FieldID, id))
+}
+
+// RoleTemplateID applies equality check predicate on the "role_template_id" field. It's identical to RoleTemplateIDEQ.
+func RoleTemplateID(v uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldRoleTemplateID, v))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldRoleID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldPosition, v))
+}
+
+// RoleTemplateIDEQ applies the EQ predicate on the "role_template_id" field.
+func RoleTemplateIDEQ(v uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldRoleTemplateID, v))
+}
+
+// RoleTemplateIDNEQ applies the NEQ predicate on the "role_template_id" field.
+func RoleTemplateIDNEQ(v uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNEQ(FieldRoleTemplateID, v))
+}
+
+// RoleTemplateIDIn applies the In predicate on the "role_template_id" field.
+func RoleTemplateIDIn(vs ...uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldIn(FieldRoleTemplateID, vs...))
+}
+
+// RoleTemplateIDNotIn applies the NotIn predicate on the "role_template_id" field.
+func RoleTemplateIDNotIn(vs ...uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNotIn(FieldRoleTemplateID, vs...))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...uuid.UUID) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.FieldLTE(FieldPosition, v))
+}
+
+// HasRoleTemplate applies the HasEdge predicate on the "role_template" edge.
+func HasRoleTemplate() predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RoleTemplateTable, RoleTemplateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleTemplateWith applies the HasEdge predicate on the "role_template" edge with a given conditions (other predicates).
+func HasRoleTemplateWith(preds ...predicate.RoleTemplate) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(func(s *sql.Selector) {
+		step := newRoleTemplateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRole applies the HasEdge predicate on the "role" edge.
+func HasRole() predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RoleTable, RoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleWith applies the HasEdge predicate on the "role" edge with a given conditions (other predicates).
+func HasRoleWith(preds ...predicate.Role) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(func(s *sql.Selector) {
+		step := newRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleTemplateSlot) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleTemplateSlot) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleTemplateSlot) predicate.RoleTemplateSlot {
+	return predicate.RoleTemplateSlot(sql.NotPredicates(p))
+}
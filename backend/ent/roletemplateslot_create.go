@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+)
+
+// RoleTemplateSlotCreate is the builder for creating a RoleTemplateSlot entity.
+type RoleTemplateSlotCreate struct {
+	config
+	mutation *RoleTemplateSlotMutation
+	hooks    []Hook
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_c *RoleTemplateSlotCreate) SetRoleTemplateID(v uuid.UUID) *RoleTemplateSlotCreate {
+	_c.mutation.SetRoleTemplateID(v)
+	return _c
+}
+
+// SetRoleID sets the "role_id" field.
+func (_c *RoleTemplateSlotCreate) SetRoleID(v uuid.UUID) *RoleTemplateSlotCreate {
+	_c.mutation.SetRoleID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *RoleTemplateSlotCreate) SetPosition(v int) *RoleTemplateSlotCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RoleTemplateSlotCreate) SetID(v uuid.UUID) *RoleTemplateSlotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RoleTemplateSlotCreate) SetNillableID(v *uuid.UUID) *RoleTemplateSlotCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_c *RoleTemplateSlotCreate) SetRoleTemplate(v *RoleTemplate) *RoleTemplateSlotCreate {
+	return _c.SetRoleTemplateID(v.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_c *RoleTemplateSlotCreate) SetRole(v *Role) *RoleTemplateSlotCreate {
+	return _c.SetRoleID(v.ID)
+}
+
+// Mutation returns the RoleTemplateSlotMutation object of the builder.
+func (_c *RoleTemplateSlotCreate) Mutation() *RoleTemplateSlotMutation {
+	return _c.mutation
+}
+
+// Save creates the RoleTemplateSlot in the database.
+func (_c *RoleTemplateSlotCreate) Save(ctx context.Context) (*RoleTemplateSlot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoleTemplateSlotCreate) SaveX(ctx context.Context) *RoleTemplateSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTemplateSlotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTemplateSlotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RoleTemplateSlotCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := roletemplateslot.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoleTemplateSlotCreate) check() error {
+	if _, ok := _c.mutation.RoleTemplateID(); !ok {
+		return &ValidationError{Name: "role_template_id", err: errors.New(`ent: missing required field "RoleTemplateSlot.role_template_id"`)}
+	}
+	if _, ok := _c.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleTemplateSlot.role_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "RoleTemplateSlot.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := roletemplateslot.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateSlot.position": %w`, err)}
+		}
+	}
+	if len(_c.mutation.RoleTemplateIDs()) == 0 {
+		return &ValidationError{Name: "role_template", err: errors.New(`ent: missing required edge "RoleTemplateSlot.role_template"`)}
+	}
+	if len(_c.mutation.RoleIDs()) == 0 {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleTemplateSlot.role"`)}
+	}
+	return nil
+}
+
+func (_c *RoleTemplateSlotCreate) sqlSave(ctx context.Context) (*RoleTemplateSlot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoleTemplateSlotCreate) createSpec() (*RoleTemplateSlot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleTemplateSlot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(roletemplateslot.Table, sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(roletemplateslot.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := _c.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTemplateTable,
+			Columns: []string{roletemplateslot.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleTemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTable,
+			Columns: []string{roletemplateslot.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleTemplateSlotCreateBulk is the builder for creating many RoleTemplateSlot entities in bulk.
+type RoleTemplateSlotCreateBulk struct {
+	config
+	err      error
+	builders []*RoleTemplateSlotCreate
+}
+
+// Save creates the RoleTemplateSlot entities in the database.
+func (_c *RoleTemplateSlotCreateBulk) Save(ctx context.Context) ([]*RoleTemplateSlot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoleTemplateSlot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleTemplateSlotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoleTemplateSlotCreateBulk) SaveX(ctx context.Context) []*RoleTemplateSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTemplateSlotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTemplateSlotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+)
+
+// RoleTemplateSlotDelete is the builder for deleting a RoleTemplateSlot entity.
+type RoleTemplateSlotDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoleTemplateSlotMutation
+}
+
+// Where appends a list predicates to the RoleTemplateSlotDelete builder.
+func (_d *RoleTemplateSlotDelete) Where(ps ...predicate.RoleTemplateSlot) *RoleTemplateSlotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RoleTemplateSlotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RoleTemplateSlotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RoleTemplateSlotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roletemplateslot.Table, sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RoleTemplateSlotDeleteOne is the builder for deleting a single RoleTemplateSlot entity.
+type RoleTemplateSlotDeleteOne struct {
+	_d *RoleTemplateSlotDelete
+}
+
+// Where appends a list predicates to the RoleTemplateSlotDelete builder.
+func (_d *RoleTemplateSlotDeleteOne) Where(ps ...predicate.RoleTemplateSlot) *RoleTemplateSlotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RoleTemplateSlotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roletemplateslot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RoleTemplateSlotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
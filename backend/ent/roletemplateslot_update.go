@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+)
+
+// RoleTemplateSlotUpdate is the builder for updating RoleTemplateSlot entities.
+type RoleTemplateSlotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleTemplateSlotMutation
+}
+
+// Where appends a list predicates to the RoleTemplateSlotUpdate builder.
+func (_u *RoleTemplateSlotUpdate) Where(ps ...predicate.RoleTemplateSlot) *RoleTemplateSlotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_u *RoleTemplateSlotUpdate) SetRoleTemplateID(v uuid.UUID) *RoleTemplateSlotUpdate {
+	_u.mutation.SetRoleTemplateID(v)
+	return _u
+}
+
+// SetNillableRoleTemplateID sets the "role_template_id" field if the given value is not nil.
+func (_u *RoleTemplateSlotUpdate) SetNillableRoleTemplateID(v *uuid.UUID) *RoleTemplateSlotUpdate {
+	if v != nil {
+		_u.SetRoleTemplateID(*v)
+	}
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *RoleTemplateSlotUpdate) SetRoleID(v uuid.UUID) *RoleTemplateSlotUpdate {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *RoleTemplateSlotUpdate) SetNillableRoleID(v *uuid.UUID) *RoleTemplateSlotUpdate {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *RoleTemplateSlotUpdate) SetPosition(v int) *RoleTemplateSlotUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *RoleTemplateSlotUpdate) SetNillablePosition(v *int) *RoleTemplateSlotUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *RoleTemplateSlotUpdate) AddPosition(v int) *RoleTemplateSlotUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateSlotUpdate) SetRoleTemplate(v *RoleTemplate) *RoleTemplateSlotUpdate {
+	return _u.SetRoleTemplateID(v.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_u *RoleTemplateSlotUpdate) SetRole(v *Role) *RoleTemplateSlotUpdate {
+	return _u.SetRoleID(v.ID)
+}
+
+// Mutation returns the RoleTemplateSlotMutation object of the builder.
+func (_u *RoleTemplateSlotUpdate) Mutation() *RoleTemplateSlotMutation {
+	return _u.mutation
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateSlotUpdate) ClearRoleTemplate() *RoleTemplateSlotUpdate {
+	_u.mutation.ClearRoleTemplate()
+	return _u
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (_u *RoleTemplateSlotUpdate) ClearRole() *RoleTemplateSlotUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoleTemplateSlotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTemplateSlotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoleTemplateSlotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTemplateSlotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTemplateSlotUpdate) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := roletemplateslot.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateSlot.position": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleTemplateCleared() && len(_u.mutation.RoleTemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateSlot.role_template"`)
+	}
+	if _u.mutation.RoleCleared() && len(_u.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateSlot.role"`)
+	}
+	return nil
+}
+
+func (_u *RoleTemplateSlotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletemplateslot.Table, roletemplateslot.Columns, sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(roletemplateslot.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(roletemplateslot.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.RoleTemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTemplateTable,
+			Columns: []string{roletemplateslot.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTemplateTable,
+			Columns: []string{roletemplateslot.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTable,
+			Columns: []string{roletemplateslot.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTable,
+			Columns: []string{roletemplateslot.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletemplateslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoleTemplateSlotUpdateOne is the builder for updating a single RoleTemplateSlot entity.
+type RoleTemplateSlotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleTemplateSlotMutation
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_u *RoleTemplateSlotUpdateOne) SetRoleTemplateID(v uuid.UUID) *RoleTemplateSlotUpdateOne {
+	_u.mutation.SetRoleTemplateID(v)
+	return _u
+}
+
+// SetNillableRoleTemplateID sets the "role_template_id" field if the given value is not nil.
+func (_u *RoleTemplateSlotUpdateOne) SetNillableRoleTemplateID(v *uuid.UUID) *RoleTemplateSlotUpdateOne {
+	if v != nil {
+		_u.SetRoleTemplateID(*v)
+	}
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *RoleTemplateSlotUpdateOne) SetRoleID(v uuid.UUID) *RoleTemplateSlotUpdateOne {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *RoleTemplateSlotUpdateOne) SetNillableRoleID(v *uuid.UUID) *RoleTemplateSlotUpdateOne {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *RoleTemplateSlotUpdateOne) SetPosition(v int) *RoleTemplateSlotUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *RoleTemplateSlotUpdateOne) SetNillablePosition(v *int) *RoleTemplateSlotUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *RoleTemplateSlotUpdateOne) AddPosition(v int) *RoleTemplateSlotUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateSlotUpdateOne) SetRoleTemplate(v *RoleTemplate) *RoleTemplateSlotUpdateOne {
+	return _u.SetRoleTemplateID(v.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_u *RoleTemplateSlotUpdateOne) SetRole(v *Role) *RoleTemplateSlotUpdateOne {
+	return _u.SetRoleID(v.ID)
+}
+
+// Mutation returns the RoleTemplateSlotMutation object of the builder.
+func (_u *RoleTemplateSlotUpdateOne) Mutation() *RoleTemplateSlotMutation {
+	return _u.mutation
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateSlotUpdateOne) ClearRoleTemplate() *RoleTemplateSlotUpdateOne {
+	_u.mutation.ClearRoleTemplate()
+	return _u
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (_u *RoleTemplateSlotUpdateOne) ClearRole() *RoleTemplateSlotUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// Where appends a list predicates to the RoleTemplateSlotUpdate builder.
+func (_u *RoleTemplateSlotUpdateOne) Where(ps ...predicate.RoleTemplateSlot) *RoleTemplateSlotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoleTemplateSlotUpdateOne) Select(field string, fields ...string) *RoleTemplateSlotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoleTemplateSlot entity.
+func (_u *RoleTemplateSlotUpdateOne) Save(ctx context.Context) (*RoleTemplateSlot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTemplateSlotUpdateOne) SaveX(ctx context.Context) *RoleTemplateSlot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoleTemplateSlotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTemplateSlotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTemplateSlotUpdateOne) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := roletemplateslot.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateSlot.position": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleTemplateCleared() && len(_u.mutation.RoleTemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateSlot.role_template"`)
+	}
+	if _u.mutation.RoleCleared() && len(_u.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateSlot.role"`)
+	}
+	return nil
+}
+
+func (_u *RoleTemplateSlotUpdateOne) sqlSave(ctx context.Context) (_node *RoleTemplateSlot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletemplateslot.Table, roletemplateslot.Columns, sqlgraph.NewFieldSpec(roletemplateslot.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleTemplateSlot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roletemplateslot.FieldID)
+		for _, f := range fields {
+			if !roletemplateslot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roletemplateslot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(roletemplateslot.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(roletemplateslot.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.RoleTemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTemplateTable,
+			Columns: []string{roletemplateslot.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTemplateTable,
+			Columns: []string{roletemplateslot.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTable,
+			Columns: []string{roletemplateslot.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplateslot.RoleTable,
+			Columns: []string{roletemplateslot.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleTemplateSlot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletemplateslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+)
+
+// RoleTemplateTranslation is the model entity for the RoleTemplateTranslation schema.
+type RoleTemplateTranslation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Reference to the translated role template
+	RoleTemplateID uuid.UUID `json:"role_template_id,omitempty"`
+	// BCP 47 language tag, such as fa or en-GB
+	Locale string `json:"locale,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleTemplateTranslationQuery when eager-loading is set.
+	Edges        RoleTemplateTranslationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleTemplateTranslationEdges holds the relations/edges for other nodes in the graph.
+type RoleTemplateTranslationEdges struct {
+	// RoleTemplate holds the value of the role_template edge.
+	RoleTemplate *RoleTemplate `json:"role_template,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RoleTemplateOrErr returns the RoleTemplate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleTemplateTranslationEdges) RoleTemplateOrErr() (*RoleTemplate, error) {
+	if e.RoleTemplate != nil {
+		return e.RoleTemplate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: roletemplate.Label}
+	}
+	return nil, &NotLoadedError{edge: "role_template"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleTemplateTranslation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roletemplatetranslation.FieldLocale, roletemplatetranslation.FieldDescription:
+			values[i] = new(sql.NullString)
+		case roletemplatetranslation.FieldID, roletemplatetranslation.FieldRoleTemplateID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleTemplateTranslation fields.
+func (_m *RoleTemplateTranslation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roletemplatetranslation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case roletemplatetranslation.FieldRoleTemplateID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field role_template_id", values[i])
+			} else if value != nil {
+				_m.RoleTemplateID = *value
+			}
+		case roletemplatetranslation.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = value.String
+			}
+		case roletemplatetranslation.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleTemplateTranslation.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoleTemplateTranslation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRoleTemplate queries the "role_template" edge of the RoleTemplateTranslation entity.
+func (_m *RoleTemplateTranslation) QueryRoleTemplate() *RoleTemplateQuery {
+	return NewRoleTemplateTranslationClient(_m.config).QueryRoleTemplate(_m)
+}
+
+// Update returns a builder for updating this RoleTemplateTranslation.
+// Note that you need to call RoleTemplateTranslation.Unwrap() before calling this method if this RoleTemplateTranslation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoleTemplateTranslation) Update() *RoleTemplateTranslationUpdateOne {
+	return NewRoleTemplateTranslationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoleTemplateTranslation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoleTemplateTranslation) Unwrap() *RoleTemplateTranslation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleTemplateTranslation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoleTemplateTranslation) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleTemplateTranslation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role_template_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleTemplateID))
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(_m.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleTemplateTranslations is a parsable slice of RoleTemplateTranslation.
+type RoleTemplateTranslations []*RoleTemplateTranslation
@@ -0,0 +1,97 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roletemplatetranslation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the roletemplatetranslation type in the database.
+	Label = "role_template_translation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoleTemplateID holds the string denoting the role_template_id field in the database.
+	FieldRoleTemplateID = "role_template_id"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// EdgeRoleTemplate holds the string denoting the role_template edge name in mutations.
+	EdgeRoleTemplate = "role_template"
+	// Table holds the table name of the roletemplatetranslation in the database.
+	Table = "role_template_translations"
+	// RoleTemplateTable is the table that holds the role_template relation/edge.
+	RoleTemplateTable = "role_template_translations"
+	// RoleTemplateInverseTable is the table name for the RoleTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "roletemplate" package.
+	RoleTemplateInverseTable = "role_templates"
+	// RoleTemplateColumn is the table column denoting the role_template relation/edge.
+	RoleTemplateColumn = "role_template_id"
+)
+
+// Columns holds all SQL columns for roletemplatetranslation fields.
+var Columns = []string{
+	FieldID,
+	FieldRoleTemplateID,
+	FieldLocale,
+	FieldDescription,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	LocaleValidator func(string) error
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the RoleTemplateTranslation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoleTemplateID orders the results by the role_template_id field.
+func ByRoleTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleTemplateID, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByRoleTemplateField orders the results by role_template field.
+func ByRoleTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleTemplateStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRoleTemplateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleTemplateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RoleTemplateTable, RoleTemplateColumn),
+	)
+}
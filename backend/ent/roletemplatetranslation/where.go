@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roletemplatetranslation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldLTE(FieldID, id))
+}
+
+// RoleTemplateID applies equality check predicate on the "role_template_id" field. It's identical to RoleTemplateIDEQ.
+func RoleTemplateID(v uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldRoleTemplateID, v))
+}
+
+// Locale applies equality check predicate on the "locale" field. It's identical to LocaleEQ.
+func Locale(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldLocale, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldDescription, v))
+}
+
+// RoleTemplateIDEQ applies the EQ predicate on the "role_template_id" field.
+func RoleTemplateIDEQ(v uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldRoleTemplateID, v))
+}
+
+// RoleTemplateIDNEQ applies the NEQ predicate on the "role_template_id" field.
+func RoleTemplateIDNEQ(v uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNEQ(FieldRoleTemplateID, v))
+}
+
+// RoleTemplateIDIn applies the In predicate on the "role_template_id" field.
+func RoleTemplateIDIn(vs ...uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldIn(FieldRoleTemplateID, vs...))
+}
+
+// RoleTemplateIDNotIn applies the NotIn predicate on the "role_template_id" field.
+func RoleTemplateIDNotIn(vs ...uuid.UUID) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNotIn(FieldRoleTemplateID, vs...))
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldLocale, v))
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNEQ(FieldLocale, v))
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldIn(FieldLocale, vs...))
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNotIn(FieldLocale, vs...))
+}
+
+// LocaleGT applies the GT predicate on the "locale" field.
+func LocaleGT(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldGT(FieldLocale, v))
+}
+
+// LocaleGTE applies the GTE predicate on the "locale" field.
+func LocaleGTE(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldGTE(FieldLocale, v))
+}
+
+// LocaleLT applies the LT predicate on the "locale" field.
+func LocaleLT(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldLT(FieldLocale, v))
+}
+
+// LocaleLTE applies the LTE predicate on the "locale" field.
+func LocaleLTE(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldLTE(FieldLocale, v))
+}
+
+// LocaleContains applies the Contains predicate on the "locale" field.
+func LocaleContains(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldContains(FieldLocale, v))
+}
+
+// LocaleHasPrefix applies the HasPrefix predicate on the "locale" field.
+func LocaleHasPrefix(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldHasPrefix(FieldLocale, v))
+}
+
+// LocaleHasSuffix applies the HasSuffix predicate on the "locale" field.
+func LocaleHasSuffix(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldHasSuffix(FieldLocale, v))
+}
+
+// LocaleEqualFold applies the EqualFold predicate on the "locale" field.
+func LocaleEqualFold(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEqualFold(FieldLocale, v))
+}
+
+// LocaleContainsFold applies the ContainsFold predicate on the "locale" field.
+func LocaleContainsFold(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldContainsFold(FieldLocale, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// HasRoleTemplate applies the HasEdge predicate on the "role_template" edge.
+func HasRoleTemplate() predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RoleTemplateTable, RoleTemplateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleTemplateWith applies the HasEdge predicate on the "role_template" edge with a given conditions (other predicates).
+func HasRoleTemplateWith(preds ...predicate.RoleTemplate) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(func(s *sql.Selector) {
+		step := newRoleTemplateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleTemplateTranslation) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleTemplateTranslation) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleTemplateTranslation) predicate.RoleTemplateTranslation {
+	return predicate.RoleTemplateTranslation(sql.NotPredicates(p))
+}
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+)
+
+// RoleTemplateTranslationCreate is the builder for creating a RoleTemplateTranslation entity.
+type RoleTemplateTranslationCreate struct {
+	config
+	mutation *RoleTemplateTranslationMutation
+	hooks    []Hook
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_c *RoleTemplateTranslationCreate) SetRoleTemplateID(v uuid.UUID) *RoleTemplateTranslationCreate {
+	_c.mutation.SetRoleTemplateID(v)
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *RoleTemplateTranslationCreate) SetLocale(v string) *RoleTemplateTranslationCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *RoleTemplateTranslationCreate) SetDescription(v string) *RoleTemplateTranslationCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RoleTemplateTranslationCreate) SetID(v uuid.UUID) *RoleTemplateTranslationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RoleTemplateTranslationCreate) SetNillableID(v *uuid.UUID) *RoleTemplateTranslationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_c *RoleTemplateTranslationCreate) SetRoleTemplate(v *RoleTemplate) *RoleTemplateTranslationCreate {
+	return _c.SetRoleTemplateID(v.ID)
+}
+
+// Mutation returns the RoleTemplateTranslationMutation object of the builder.
+func (_c *RoleTemplateTranslationCreate) Mutation() *RoleTemplateTranslationMutation {
+	return _c.mutation
+}
+
+// Save creates the RoleTemplateTranslation in the database.
+func (_c *RoleTemplateTranslationCreate) Save(ctx context.Context) (*RoleTemplateTranslation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoleTemplateTranslationCreate) SaveX(ctx context.Context) *RoleTemplateTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTemplateTranslationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTemplateTranslationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RoleTemplateTranslationCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := roletemplatetranslation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoleTemplateTranslationCreate) check() error {
+	if _, ok := _c.mutation.RoleTemplateID(); !ok {
+		return &ValidationError{Name: "role_template_id", err: errors.New(`ent: missing required field "RoleTemplateTranslation.role_template_id"`)}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "RoleTemplateTranslation.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := roletemplatetranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateTranslation.locale": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "RoleTemplateTranslation.description"`)}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := roletemplatetranslation.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateTranslation.description": %w`, err)}
+		}
+	}
+	if len(_c.mutation.RoleTemplateIDs()) == 0 {
+		return &ValidationError{Name: "role_template", err: errors.New(`ent: missing required edge "RoleTemplateTranslation.role_template"`)}
+	}
+	return nil
+}
+
+func (_c *RoleTemplateTranslationCreate) sqlSave(ctx context.Context) (*RoleTemplateTranslation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoleTemplateTranslationCreate) createSpec() (*RoleTemplateTranslation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleTemplateTranslation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(roletemplatetranslation.Table, sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(roletemplatetranslation.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(roletemplatetranslation.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if nodes := _c.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplatetranslation.RoleTemplateTable,
+			Columns: []string{roletemplatetranslation.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleTemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleTemplateTranslationCreateBulk is the builder for creating many RoleTemplateTranslation entities in bulk.
+type RoleTemplateTranslationCreateBulk struct {
+	config
+	err      error
+	builders []*RoleTemplateTranslationCreate
+}
+
+// Save creates the RoleTemplateTranslation entities in the database.
+func (_c *RoleTemplateTranslationCreateBulk) Save(ctx context.Context) ([]*RoleTemplateTranslation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoleTemplateTranslation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleTemplateTranslationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoleTemplateTranslationCreateBulk) SaveX(ctx context.Context) []*RoleTemplateTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTemplateTranslationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTemplateTranslationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
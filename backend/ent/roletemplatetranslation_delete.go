@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+)
+
+// RoleTemplateTranslationDelete is the builder for deleting a RoleTemplateTranslation entity.
+type RoleTemplateTranslationDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoleTemplateTranslationMutation
+}
+
+// Where appends a list predicates to the RoleTemplateTranslationDelete builder.
+func (_d *RoleTemplateTranslationDelete) Where(ps ...predicate.RoleTemplateTranslation) *RoleTemplateTranslationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RoleTemplateTranslationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RoleTemplateTranslationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RoleTemplateTranslationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roletemplatetranslation.Table, sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RoleTemplateTranslationDeleteOne is the builder for deleting a single RoleTemplateTranslation entity.
+type RoleTemplateTranslationDeleteOne struct {
+	_d *RoleTemplateTranslationDelete
+}
+
+// Where appends a list predicates to the RoleTemplateTranslationDelete builder.
+func (_d *RoleTemplateTranslationDeleteOne) Where(ps ...predicate.RoleTemplateTranslation) *RoleTemplateTranslationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RoleTemplateTranslationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roletemplatetranslation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RoleTemplateTranslationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+)
+
+// RoleTemplateTranslationUpdate is the builder for updating RoleTemplateTranslation entities.
+type RoleTemplateTranslationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleTemplateTranslationMutation
+}
+
+// Where appends a list predicates to the RoleTemplateTranslationUpdate builder.
+func (_u *RoleTemplateTranslationUpdate) Where(ps ...predicate.RoleTemplateTranslation) *RoleTemplateTranslationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_u *RoleTemplateTranslationUpdate) SetRoleTemplateID(v uuid.UUID) *RoleTemplateTranslationUpdate {
+	_u.mutation.SetRoleTemplateID(v)
+	return _u
+}
+
+// SetNillableRoleTemplateID sets the "role_template_id" field if the given value is not nil.
+func (_u *RoleTemplateTranslationUpdate) SetNillableRoleTemplateID(v *uuid.UUID) *RoleTemplateTranslationUpdate {
+	if v != nil {
+		_u.SetRoleTemplateID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *RoleTemplateTranslationUpdate) SetLocale(v string) *RoleTemplateTranslationUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *RoleTemplateTranslationUpdate) SetNillableLocale(v *string) *RoleTemplateTranslationUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoleTemplateTranslationUpdate) SetDescription(v string) *RoleTemplateTranslationUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoleTemplateTranslationUpdate) SetNillableDescription(v *string) *RoleTemplateTranslationUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateTranslationUpdate) SetRoleTemplate(v *RoleTemplate) *RoleTemplateTranslationUpdate {
+	return _u.SetRoleTemplateID(v.ID)
+}
+
+// Mutation returns the RoleTemplateTranslationMutation object of the builder.
+func (_u *RoleTemplateTranslationUpdate) Mutation() *RoleTemplateTranslationMutation {
+	return _u.mutation
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateTranslationUpdate) ClearRoleTemplate() *RoleTemplateTranslationUpdate {
+	_u.mutation.ClearRoleTemplate()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoleTemplateTranslationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTemplateTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoleTemplateTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTemplateTranslationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTemplateTranslationUpdate) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := roletemplatetranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateTranslation.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := roletemplatetranslation.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateTranslation.description": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleTemplateCleared() && len(_u.mutation.RoleTemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateTranslation.role_template"`)
+	}
+	return nil
+}
+
+func (_u *RoleTemplateTranslationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletemplatetranslation.Table, roletemplatetranslation.Columns, sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(roletemplatetranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(roletemplatetranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.RoleTemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplatetranslation.RoleTemplateTable,
+			Columns: []string{roletemplatetranslation.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplatetranslation.RoleTemplateTable,
+			Columns: []string{roletemplatetranslation.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletemplatetranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoleTemplateTranslationUpdateOne is the builder for updating a single RoleTemplateTranslation entity.
+type RoleTemplateTranslationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleTemplateTranslationMutation
+}
+
+// SetRoleTemplateID sets the "role_template_id" field.
+func (_u *RoleTemplateTranslationUpdateOne) SetRoleTemplateID(v uuid.UUID) *RoleTemplateTranslationUpdateOne {
+	_u.mutation.SetRoleTemplateID(v)
+	return _u
+}
+
+// SetNillableRoleTemplateID sets the "role_template_id" field if the given value is not nil.
+func (_u *RoleTemplateTranslationUpdateOne) SetNillableRoleTemplateID(v *uuid.UUID) *RoleTemplateTranslationUpdateOne {
+	if v != nil {
+		_u.SetRoleTemplateID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *RoleTemplateTranslationUpdateOne) SetLocale(v string) *RoleTemplateTranslationUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *RoleTemplateTranslationUpdateOne) SetNillableLocale(v *string) *RoleTemplateTranslationUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoleTemplateTranslationUpdateOne) SetDescription(v string) *RoleTemplateTranslationUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoleTemplateTranslationUpdateOne) SetNillableDescription(v *string) *RoleTemplateTranslationUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetRoleTemplate sets the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateTranslationUpdateOne) SetRoleTemplate(v *RoleTemplate) *RoleTemplateTranslationUpdateOne {
+	return _u.SetRoleTemplateID(v.ID)
+}
+
+// Mutation returns the RoleTemplateTranslationMutation object of the builder.
+func (_u *RoleTemplateTranslationUpdateOne) Mutation() *RoleTemplateTranslationMutation {
+	return _u.mutation
+}
+
+// ClearRoleTemplate clears the "role_template" edge to the RoleTemplate entity.
+func (_u *RoleTemplateTranslationUpdateOne) ClearRoleTemplate() *RoleTemplateTranslationUpdateOne {
+	_u.mutation.ClearRoleTemplate()
+	return _u
+}
+
+// Where appends a list predicates to the RoleTemplateTranslationUpdate builder.
+func (_u *RoleTemplateTranslationUpdateOne) Where(ps ...predicate.RoleTemplateTranslation) *RoleTemplateTranslationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoleTemplateTranslationUpdateOne) Select(field string, fields ...string) *RoleTemplateTranslationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoleTemplateTranslation entity.
+func (_u *RoleTemplateTranslationUpdateOne) Save(ctx context.Context) (*RoleTemplateTranslation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTemplateTranslationUpdateOne) SaveX(ctx context.Context) *RoleTemplateTranslation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoleTemplateTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTemplateTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTemplateTranslationUpdateOne) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := roletemplatetranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateTranslation.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := roletemplatetranslation.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "RoleTemplateTranslation.description": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleTemplateCleared() && len(_u.mutation.RoleTemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTemplateTranslation.role_template"`)
+	}
+	return nil
+}
+
+func (_u *RoleTemplateTranslationUpdateOne) sqlSave(ctx context.Context) (_node *RoleTemplateTranslation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletemplatetranslation.Table, roletemplatetranslation.Columns, sqlgraph.NewFieldSpec(roletemplatetranslation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleTemplateTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roletemplatetranslation.FieldID)
+		for _, f := range fields {
+			if !roletemplatetranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roletemplatetranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(roletemplatetranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(roletemplatetranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.RoleTemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplatetranslation.RoleTemplateTable,
+			Columns: []string{roletemplatetranslation.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletemplatetranslation.RoleTemplateTable,
+			Columns: []string{roletemplatetranslation.RoleTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roletemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleTemplateTranslation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletemplatetranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletranslation"
+)
+
+// RoleTranslation is the model entity for the RoleTranslation schema.
+type RoleTranslation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Reference to the translated role
+	RoleID uuid.UUID `json:"role_id,omitempty"`
+	// BCP 47 language tag, such as fa or en-GB
+	Locale string `json:"locale,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Translated abilities; the role's own abilities are used when empty
+	Abilities []string `json:"abilities,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleTranslationQuery when eager-loading is set.
+	Edges        RoleTranslationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleTranslationEdges holds the relations/edges for other nodes in the graph.
+type RoleTranslationEdges struct {
+	// Role holds the value of the role edge.
+	Role *Role `json:"role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleTranslationEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleTranslation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roletranslation.FieldAbilities:
+			values[i] = new([]byte)
+		case roletranslation.FieldLocale, roletranslation.FieldName, roletranslation.FieldDescription:
+			values[i] = new(sql.NullString)
+		case roletranslation.FieldID, roletranslation.FieldRoleID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleTranslation fields.
+func (_m *RoleTranslation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roletranslation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case roletranslation.FieldRoleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value != nil {
+				_m.RoleID = *value
+			}
+		case roletranslation.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				_m.Locale = value.String
+			}
+		case roletranslation.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case roletranslation.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case roletranslation.FieldAbilities:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field abilities", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Abilities); err != nil {
+					return fmt.Errorf("unmarshal field abilities: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleTranslation.
+// This includes values selected through modifiers, order, etc.
+func (_m *RoleTranslation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRole queries the "role" edge of the RoleTranslation entity.
+func (_m *RoleTranslation) QueryRole() *RoleQuery {
+	return NewRoleTranslationClient(_m.config).QueryRole(_m)
+}
+
+// Update returns a builder for updating this RoleTranslation.
+// Note that you need to call RoleTranslation.Unwrap() before calling this method if this RoleTranslation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RoleTranslation) Update() *RoleTranslationUpdateOne {
+	return NewRoleTranslationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RoleTranslation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RoleTranslation) Unwrap() *RoleTranslation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleTranslation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RoleTranslation) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleTranslation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(_m.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("abilities=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Abilities))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleTranslations is a parsable slice of RoleTranslation.
+type RoleTranslations []*RoleTranslation
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletranslation"
+)
+
+// RoleTranslationCreate is the builder for creating a RoleTranslation entity.
+type RoleTranslationCreate struct {
+	config
+	mutation *RoleTranslationMutation
+	hooks    []Hook
+}
+
+// SetRoleID sets the "role_id" field.
+func (_c *RoleTranslationCreate) SetRoleID(v uuid.UUID) *RoleTranslationCreate {
+	_c.mutation.SetRoleID(v)
+	return _c
+}
+
+// SetLocale sets the "locale" field.
+func (_c *RoleTranslationCreate) SetLocale(v string) *RoleTranslationCreate {
+	_c.mutation.SetLocale(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *RoleTranslationCreate) SetName(v string) *RoleTranslationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *RoleTranslationCreate) SetDescription(v string) *RoleTranslationCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *RoleTranslationCreate) SetNillableDescription(v *string) *RoleTranslationCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetAbilities sets the "abilities" field.
+func (_c *RoleTranslationCreate) SetAbilities(v []string) *RoleTranslationCreate {
+	_c.mutation.SetAbilities(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RoleTranslationCreate) SetID(v uuid.UUID) *RoleTranslationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RoleTranslationCreate) SetNillableID(v *uuid.UUID) *RoleTranslationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_c *RoleTranslationCreate) SetRole(v *Role) *RoleTranslationCreate {
+	return _c.SetRoleID(v.ID)
+}
+
+// Mutation returns the RoleTranslationMutation object of the builder.
+func (_c *RoleTranslationCreate) Mutation() *RoleTranslationMutation {
+	return _c.mutation
+}
+
+// Save creates the RoleTranslation in the database.
+func (_c *RoleTranslationCreate) Save(ctx context.Context) (*RoleTranslation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RoleTranslationCreate) SaveX(ctx context.Context) *RoleTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTranslationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTranslationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RoleTranslationCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := roletranslation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RoleTranslationCreate) check() error {
+	if _, ok := _c.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleTranslation.role_id"`)}
+	}
+	if _, ok := _c.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`ent: missing required field "RoleTranslation.locale"`)}
+	}
+	if v, ok := _c.mutation.Locale(); ok {
+		if err := roletranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "RoleTranslation.locale": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RoleTranslation.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := roletranslation.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoleTranslation.name": %w`, err)}
+		}
+	}
+	if len(_c.mutation.RoleIDs()) == 0 {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleTranslation.role"`)}
+	}
+	return nil
+}
+
+func (_c *RoleTranslationCreate) sqlSave(ctx context.Context) (*RoleTranslation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RoleTranslationCreate) createSpec() (*RoleTranslation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleTranslation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(roletranslation.Table, sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Locale(); ok {
+		_spec.SetField(roletranslation.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(roletranslation.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(roletranslation.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Abilities(); ok {
+		_spec.SetField(roletranslation.FieldAbilities, field.TypeJSON, value)
+		_node.Abilities = value
+	}
+	if nodes := _c.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletranslation.RoleTable,
+			Columns: []string{roletranslation.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoleTranslationCreateBulk is the builder for creating many RoleTranslation entities in bulk.
+type RoleTranslationCreateBulk struct {
+	config
+	err      error
+	builders []*RoleTranslationCreate
+}
+
+// Save creates the RoleTranslation entities in the database.
+func (_c *RoleTranslationCreateBulk) Save(ctx context.Context) ([]*RoleTranslation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RoleTranslation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleTranslationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RoleTranslationCreateBulk) SaveX(ctx context.Context) []*RoleTranslation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RoleTranslationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RoleTranslationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
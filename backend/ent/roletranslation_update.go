@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/predicate"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletranslation"
+)
+
+// RoleTranslationUpdate is the builder for updating RoleTranslation entities.
+type RoleTranslationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleTranslationMutation
+}
+
+// Where appends a list predicates to the RoleTranslationUpdate builder.
+func (_u *RoleTranslationUpdate) Where(ps ...predicate.RoleTranslation) *RoleTranslationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *RoleTranslationUpdate) SetRoleID(v uuid.UUID) *RoleTranslationUpdate {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *RoleTranslationUpdate) SetNillableRoleID(v *uuid.UUID) *RoleTranslationUpdate {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *RoleTranslationUpdate) SetLocale(v string) *RoleTranslationUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *RoleTranslationUpdate) SetNillableLocale(v *string) *RoleTranslationUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoleTranslationUpdate) SetName(v string) *RoleTranslationUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoleTranslationUpdate) SetNillableName(v *string) *RoleTranslationUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoleTranslationUpdate) SetDescription(v string) *RoleTranslationUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoleTranslationUpdate) SetNillableDescription(v *string) *RoleTranslationUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RoleTranslationUpdate) ClearDescription() *RoleTranslationUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAbilities sets the "abilities" field.
+func (_u *RoleTranslationUpdate) SetAbilities(v []string) *RoleTranslationUpdate {
+	_u.mutation.SetAbilities(v)
+	return _u
+}
+
+// AppendAbilities appends value to the "abilities" field.
+func (_u *RoleTranslationUpdate) AppendAbilities(v []string) *RoleTranslationUpdate {
+	_u.mutation.AppendAbilities(v)
+	return _u
+}
+
+// ClearAbilities clears the value of the "abilities" field.
+func (_u *RoleTranslationUpdate) ClearAbilities() *RoleTranslationUpdate {
+	_u.mutation.ClearAbilities()
+	return _u
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_u *RoleTranslationUpdate) SetRole(v *Role) *RoleTranslationUpdate {
+	return _u.SetRoleID(v.ID)
+}
+
+// Mutation returns the RoleTranslationMutation object of the builder.
+func (_u *RoleTranslationUpdate) Mutation() *RoleTranslationMutation {
+	return _u.mutation
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (_u *RoleTranslationUpdate) ClearRole() *RoleTranslationUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RoleTranslationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RoleTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTranslationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTranslationUpdate) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := roletranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "RoleTranslation.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := roletranslation.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoleTranslation.name": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleCleared() && len(_u.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTranslation.role"`)
+	}
+	return nil
+}
+
+func (_u *RoleTranslationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletranslation.Table, roletranslation.Columns, sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(roletranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(roletranslation.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(roletranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(roletranslation.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Abilities(); ok {
+		_spec.SetField(roletranslation.FieldAbilities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbilities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, roletranslation.FieldAbilities, value)
+		})
+	}
+	if _u.mutation.AbilitiesCleared() {
+		_spec.ClearField(roletranslation.FieldAbilities, field.TypeJSON)
+	}
+	if _u.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletranslation.RoleTable,
+			Columns: []string{roletranslation.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletranslation.RoleTable,
+			Columns: []string{roletranslation.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RoleTranslationUpdateOne is the builder for updating a single RoleTranslation entity.
+type RoleTranslationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleTranslationMutation
+}
+
+// SetRoleID sets the "role_id" field.
+func (_u *RoleTranslationUpdateOne) SetRoleID(v uuid.UUID) *RoleTranslationUpdateOne {
+	_u.mutation.SetRoleID(v)
+	return _u
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (_u *RoleTranslationUpdateOne) SetNillableRoleID(v *uuid.UUID) *RoleTranslationUpdateOne {
+	if v != nil {
+		_u.SetRoleID(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *RoleTranslationUpdateOne) SetLocale(v string) *RoleTranslationUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *RoleTranslationUpdateOne) SetNillableLocale(v *string) *RoleTranslationUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RoleTranslationUpdateOne) SetName(v string) *RoleTranslationUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RoleTranslationUpdateOne) SetNillableName(v *string) *RoleTranslationUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RoleTranslationUpdateOne) SetDescription(v string) *RoleTranslationUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RoleTranslationUpdateOne) SetNillableDescription(v *string) *RoleTranslationUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RoleTranslationUpdateOne) ClearDescription() *RoleTranslationUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAbilities sets the "abilities" field.
+func (_u *RoleTranslationUpdateOne) SetAbilities(v []string) *RoleTranslationUpdateOne {
+	_u.mutation.SetAbilities(v)
+	return _u
+}
+
+// AppendAbilities appends value to the "abilities" field.
+func (_u *RoleTranslationUpdateOne) AppendAbilities(v []string) *RoleTranslationUpdateOne {
+	_u.mutation.AppendAbilities(v)
+	return _u
+}
+
+// ClearAbilities clears the value of the "abilities" field.
+func (_u *RoleTranslationUpdateOne) ClearAbilities() *RoleTranslationUpdateOne {
+	_u.mutation.ClearAbilities()
+	return _u
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (_u *RoleTranslationUpdateOne) SetRole(v *Role) *RoleTranslationUpdateOne {
+	return _u.SetRoleID(v.ID)
+}
+
+// Mutation returns the RoleTranslationMutation object of the builder.
+func (_u *RoleTranslationUpdateOne) Mutation() *RoleTranslationMutation {
+	return _u.mutation
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (_u *RoleTranslationUpdateOne) ClearRole() *RoleTranslationUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// Where appends a list predicates to the RoleTranslationUpdate builder.
+func (_u *RoleTranslationUpdateOne) Where(ps ...predicate.RoleTranslation) *RoleTranslationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RoleTranslationUpdateOne) Select(field string, fields ...string) *RoleTranslationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RoleTranslation entity.
+func (_u *RoleTranslationUpdateOne) Save(ctx context.Context) (*RoleTranslation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RoleTranslationUpdateOne) SaveX(ctx context.Context) *RoleTranslation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RoleTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RoleTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RoleTranslationUpdateOne) check() error {
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := roletranslation.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "RoleTranslation.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := roletranslation.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RoleTranslation.name": %w`, err)}
+		}
+	}
+	if _u.mutation.RoleCleared() && len(_u.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RoleTranslation.role"`)
+	}
+	return nil
+}
+
+func (_u *RoleTranslationUpdateOne) sqlSave(ctx context.Context) (_node *RoleTranslation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roletranslation.Table, roletranslation.Columns, sqlgraph.NewFieldSpec(roletranslation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roletranslation.FieldID)
+		for _, f := range fields {
+			if !roletranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roletranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(roletranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(roletranslation.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(roletranslation.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(roletranslation.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Abilities(); ok {
+		_spec.SetField(roletranslation.FieldAbilities, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbilities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, roletranslation.FieldAbilities, value)
+		})
+	}
+	if _u.mutation.AbilitiesCleared() {
+		_spec.ClearField(roletranslation.FieldAbilities, field.TypeJSON)
+	}
+	if _u.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletranslation.RoleTable,
+			Columns: []string{roletranslation.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   roletranslation.RoleTable,
+			Columns: []string{roletranslation.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleTranslation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roletranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,13 +7,24 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/admin"
+	"github.com/mafia-night/backend/ent/chatmessage"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamemoderator"
 	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+	"github.com/mafia-night/backend/ent/nightaction"
 	"github.com/mafia-night/backend/ent/player"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+	"github.com/mafia-night/backend/ent/roletranslation"
 	"github.com/mafia-night/backend/ent/schema"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/ent/vote"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -80,14 +91,54 @@ func init() {
 	adminDescID := adminFields[0].Descriptor()
 	// admin.DefaultID holds the default value on creation for the id field.
 	admin.DefaultID = adminDescID.Default.(func() uuid.UUID)
+	chatmessageFields := schema.ChatMessage{}.Fields()
+	_ = chatmessageFields
+	// chatmessageDescGameID is the schema descriptor for game_id field.
+	chatmessageDescGameID := chatmessageFields[1].Descriptor()
+	// chatmessage.GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
+	chatmessage.GameIDValidator = chatmessageDescGameID.Validators[0].(func(string) error)
+	// chatmessageDescRound is the schema descriptor for round field.
+	chatmessageDescRound := chatmessageFields[3].Descriptor()
+	// chatmessage.DefaultRound holds the default value on creation for the round field.
+	chatmessage.DefaultRound = chatmessageDescRound.Default.(int)
+	// chatmessage.RoundValidator is a validator for the "round" field. It is called by the builders before save.
+	chatmessage.RoundValidator = chatmessageDescRound.Validators[0].(func(int) error)
+	// chatmessageDescSenderName is the schema descriptor for sender_name field.
+	chatmessageDescSenderName := chatmessageFields[6].Descriptor()
+	// chatmessage.SenderNameValidator is a validator for the "sender_name" field. It is called by the builders before save.
+	chatmessage.SenderNameValidator = chatmessageDescSenderName.Validators[0].(func(string) error)
+	// chatmessageDescText is the schema descriptor for text field.
+	chatmessageDescText := chatmessageFields[7].Descriptor()
+	// chatmessage.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	chatmessage.TextValidator = chatmessageDescText.Validators[0].(func(string) error)
+	// chatmessageDescCreatedAt is the schema descriptor for created_at field.
+	chatmessageDescCreatedAt := chatmessageFields[8].Descriptor()
+	// chatmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatmessage.DefaultCreatedAt = chatmessageDescCreatedAt.Default.(func() time.Time)
+	// chatmessageDescID is the schema descriptor for id field.
+	chatmessageDescID := chatmessageFields[0].Descriptor()
+	// chatmessage.DefaultID holds the default value on creation for the id field.
+	chatmessage.DefaultID = chatmessageDescID.Default.(func() uuid.UUID)
 	gameFields := schema.Game{}.Fields()
 	_ = gameFields
 	// gameDescModeratorID is the schema descriptor for moderator_id field.
 	gameDescModeratorID := gameFields[2].Descriptor()
 	// game.ModeratorIDValidator is a validator for the "moderator_id" field. It is called by the builders before save.
 	game.ModeratorIDValidator = gameDescModeratorID.Validators[0].(func(string) error)
+	// gameDescSpectatorDelaySeconds is the schema descriptor for spectator_delay_seconds field.
+	gameDescSpectatorDelaySeconds := gameFields[4].Descriptor()
+	// game.DefaultSpectatorDelaySeconds holds the default value on creation for the spectator_delay_seconds field.
+	game.DefaultSpectatorDelaySeconds = gameDescSpectatorDelaySeconds.Default.(int)
+	// game.SpectatorDelaySecondsValidator is a validator for the "spectator_delay_seconds" field. It is called by the builders before save.
+	game.SpectatorDelaySecondsValidator = gameDescSpectatorDelaySeconds.Validators[0].(func(int) error)
+	// gameDescRound is the schema descriptor for round field.
+	gameDescRound := gameFields[6].Descriptor()
+	// game.DefaultRound holds the default value on creation for the round field.
+	game.DefaultRound = gameDescRound.Default.(int)
+	// game.RoundValidator is a validator for the "round" field. It is called by the builders before save.
+	game.RoundValidator = gameDescRound.Validators[0].(func(int) error)
 	// gameDescCreatedAt is the schema descriptor for created_at field.
-	gameDescCreatedAt := gameFields[3].Descriptor()
+	gameDescCreatedAt := gameFields[7].Descriptor()
 	// game.DefaultCreatedAt holds the default value on creation for the created_at field.
 	game.DefaultCreatedAt = gameDescCreatedAt.Default.(func() time.Time)
 	// gameDescID is the schema descriptor for id field.
@@ -108,6 +159,42 @@ func init() {
 			return nil
 		}
 	}()
+	gamemoderatorFields := schema.GameModerator{}.Fields()
+	_ = gamemoderatorFields
+	// gamemoderatorDescGameID is the schema descriptor for game_id field.
+	gamemoderatorDescGameID := gamemoderatorFields[1].Descriptor()
+	// gamemoderator.GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
+	gamemoderator.GameIDValidator = func() func(string) error {
+		validators := gamemoderatorDescGameID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(game string) error {
+			for _, fn := range fns {
+				if err := fn(game); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// gamemoderatorDescModeratorID is the schema descriptor for moderator_id field.
+	gamemoderatorDescModeratorID := gamemoderatorFields[2].Descriptor()
+	// gamemoderator.ModeratorIDValidator is a validator for the "moderator_id" field. It is called by the builders before save.
+	gamemoderator.ModeratorIDValidator = gamemoderatorDescModeratorID.Validators[0].(func(string) error)
+	// gamemoderatorDescAddedBy is the schema descriptor for added_by field.
+	gamemoderatorDescAddedBy := gamemoderatorFields[4].Descriptor()
+	// gamemoderator.AddedByValidator is a validator for the "added_by" field. It is called by the builders before save.
+	gamemoderator.AddedByValidator = gamemoderatorDescAddedBy.Validators[0].(func(string) error)
+	// gamemoderatorDescCreatedAt is the schema descriptor for created_at field.
+	gamemoderatorDescCreatedAt := gamemoderatorFields[5].Descriptor()
+	// gamemoderator.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gamemoderator.DefaultCreatedAt = gamemoderatorDescCreatedAt.Default.(func() time.Time)
+	// gamemoderatorDescID is the schema descriptor for id field.
+	gamemoderatorDescID := gamemoderatorFields[0].Descriptor()
+	// gamemoderator.DefaultID holds the default value on creation for the id field.
+	gamemoderator.DefaultID = gamemoderatorDescID.Default.(func() uuid.UUID)
 	gameroleFields := schema.GameRole{}.Fields()
 	_ = gameroleFields
 	// gameroleDescGameID is the schema descriptor for game_id field.
@@ -132,6 +219,80 @@ func init() {
 	gameroleDescAssignedAt := gameroleFields[3].Descriptor()
 	// gamerole.DefaultAssignedAt holds the default value on creation for the assigned_at field.
 	gamerole.DefaultAssignedAt = gameroleDescAssignedAt.Default.(func() time.Time)
+	moderatoractionFields := schema.ModeratorAction{}.Fields()
+	_ = moderatoractionFields
+	// moderatoractionDescGameID is the schema descriptor for game_id field.
+	moderatoractionDescGameID := moderatoractionFields[1].Descriptor()
+	// moderatoraction.GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
+	moderatoraction.GameIDValidator = func() func(string) error {
+		validators := moderatoractionDescGameID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(game_id string) error {
+			for _, fn := range fns {
+				if err := fn(game_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// moderatoractionDescModeratorID is the schema descriptor for moderator_id field.
+	moderatoractionDescModeratorID := moderatoractionFields[2].Descriptor()
+	// moderatoraction.ModeratorIDValidator is a validator for the "moderator_id" field. It is called by the builders before save.
+	moderatoraction.ModeratorIDValidator = moderatoractionDescModeratorID.Validators[0].(func(string) error)
+	// moderatoractionDescAction is the schema descriptor for action field.
+	moderatoractionDescAction := moderatoractionFields[3].Descriptor()
+	// moderatoraction.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	moderatoraction.ActionValidator = func() func(string) error {
+		validators := moderatoractionDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// moderatoractionDescCreatedAt is the schema descriptor for created_at field.
+	moderatoractionDescCreatedAt := moderatoractionFields[5].Descriptor()
+	// moderatoraction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderatoraction.DefaultCreatedAt = moderatoractionDescCreatedAt.Default.(func() time.Time)
+	// moderatoractionDescID is the schema descriptor for id field.
+	moderatoractionDescID := moderatoractionFields[0].Descriptor()
+	// moderatoraction.DefaultID holds the default value on creation for the id field.
+	moderatoraction.DefaultID = moderatoractionDescID.Default.(func() uuid.UUID)
+	nightactionFields := schema.NightAction{}.Fields()
+	_ = nightactionFields
+	// nightactionDescGameID is the schema descriptor for game_id field.
+	nightactionDescGameID := nightactionFields[1].Descriptor()
+	// nightaction.GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
+	nightaction.GameIDValidator = nightactionDescGameID.Validators[0].(func(string) error)
+	// nightactionDescRound is the schema descriptor for round field.
+	nightactionDescRound := nightactionFields[2].Descriptor()
+	// nightaction.RoundValidator is a validator for the "round" field. It is called by the builders before save.
+	nightaction.RoundValidator = nightactionDescRound.Validators[0].(func(int) error)
+	// nightactionDescAction is the schema descriptor for action field.
+	nightactionDescAction := nightactionFields[5].Descriptor()
+	// nightaction.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	nightaction.ActionValidator = nightactionDescAction.Validators[0].(func(string) error)
+	// nightactionDescCreatedAt is the schema descriptor for created_at field.
+	nightactionDescCreatedAt := nightactionFields[6].Descriptor()
+	// nightaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	nightaction.DefaultCreatedAt = nightactionDescCreatedAt.Default.(func() time.Time)
+	// nightaction.UpdateDefaultCreatedAt holds the default value on update for the created_at field.
+	nightaction.UpdateDefaultCreatedAt = nightactionDescCreatedAt.UpdateDefault.(func() time.Time)
+	// nightactionDescID is the schema descriptor for id field.
+	nightactionDescID := nightactionFields[0].Descriptor()
+	// nightaction.DefaultID holds the default value on creation for the id field.
+	nightaction.DefaultID = nightactionDescID.Default.(func() uuid.UUID)
 	playerFields := schema.Player{}.Fields()
 	_ = playerFields
 	// playerDescName is the schema descriptor for name field.
@@ -142,8 +303,24 @@ func init() {
 	playerDescGameID := playerFields[2].Descriptor()
 	// player.GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
 	player.GameIDValidator = playerDescGameID.Validators[0].(func(string) error)
+	// playerDescSeat is the schema descriptor for seat field.
+	playerDescSeat := playerFields[3].Descriptor()
+	// player.SeatValidator is a validator for the "seat" field. It is called by the builders before save.
+	player.SeatValidator = playerDescSeat.Validators[0].(func(int) error)
+	// playerDescAlive is the schema descriptor for alive field.
+	playerDescAlive := playerFields[4].Descriptor()
+	// player.DefaultAlive holds the default value on creation for the alive field.
+	player.DefaultAlive = playerDescAlive.Default.(bool)
+	// playerDescReady is the schema descriptor for ready field.
+	playerDescReady := playerFields[5].Descriptor()
+	// player.DefaultReady holds the default value on creation for the ready field.
+	player.DefaultReady = playerDescReady.Default.(bool)
+	// playerDescMuted is the schema descriptor for muted field.
+	playerDescMuted := playerFields[6].Descriptor()
+	// player.DefaultMuted holds the default value on creation for the muted field.
+	player.DefaultMuted = playerDescMuted.Default.(bool)
 	// playerDescCreatedAt is the schema descriptor for created_at field.
-	playerDescCreatedAt := playerFields[3].Descriptor()
+	playerDescCreatedAt := playerFields[7].Descriptor()
 	// player.DefaultCreatedAt holds the default value on creation for the created_at field.
 	player.DefaultCreatedAt = playerDescCreatedAt.Default.(func() time.Time)
 	// playerDescID is the schema descriptor for id field.
@@ -191,25 +368,29 @@ func init() {
 	// roleDescVideo is the schema descriptor for video field.
 	roleDescVideo := roleFields[3].Descriptor()
 	// role.VideoValidator is a validator for the "video" field. It is called by the builders before save.
-	role.VideoValidator = func() func(string) error {
-		validators := roleDescVideo.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(video string) error {
-			for _, fn := range fns {
-				if err := fn(video); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
+	role.VideoValidator = roleDescVideo.Validators[0].(func(string) error)
+	// roleDescImage is the schema descriptor for image field.
+	roleDescImage := roleFields[4].Descriptor()
+	// role.ImageValidator is a validator for the "image" field. It is called by the builders before save.
+	role.ImageValidator = roleDescImage.Validators[0].(func(string) error)
 	// roleDescID is the schema descriptor for id field.
 	roleDescID := roleFields[0].Descriptor()
 	// role.DefaultID holds the default value on creation for the id field.
 	role.DefaultID = roleDescID.Default.(func() uuid.UUID)
+	roleruleFields := schema.RoleRule{}.Fields()
+	_ = roleruleFields
+	// roleruleDescMaxCount is the schema descriptor for max_count field.
+	roleruleDescMaxCount := roleruleFields[5].Descriptor()
+	// rolerule.MaxCountValidator is a validator for the "max_count" field. It is called by the builders before save.
+	rolerule.MaxCountValidator = roleruleDescMaxCount.Validators[0].(func(int) error)
+	// roleruleDescCreatedAt is the schema descriptor for created_at field.
+	roleruleDescCreatedAt := roleruleFields[6].Descriptor()
+	// rolerule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolerule.DefaultCreatedAt = roleruleDescCreatedAt.Default.(func() time.Time)
+	// roleruleDescID is the schema descriptor for id field.
+	roleruleDescID := roleruleFields[0].Descriptor()
+	// rolerule.DefaultID holds the default value on creation for the id field.
+	rolerule.DefaultID = roleruleDescID.Default.(func() uuid.UUID)
 	roletemplateFields := schema.RoleTemplate{}.Fields()
 	_ = roletemplateFields
 	// roletemplateDescName is the schema descriptor for name field.
@@ -234,12 +415,16 @@ func init() {
 	roletemplateDescPlayerCount := roletemplateFields[2].Descriptor()
 	// roletemplate.PlayerCountValidator is a validator for the "player_count" field. It is called by the builders before save.
 	roletemplate.PlayerCountValidator = roletemplateDescPlayerCount.Validators[0].(func(int) error)
+	// roletemplateDescMaxPlayerCount is the schema descriptor for max_player_count field.
+	roletemplateDescMaxPlayerCount := roletemplateFields[3].Descriptor()
+	// roletemplate.DefaultMaxPlayerCount holds the default value on creation for the max_player_count field.
+	roletemplate.DefaultMaxPlayerCount = roletemplateDescMaxPlayerCount.Default.(int)
 	// roletemplateDescCreatedAt is the schema descriptor for created_at field.
-	roletemplateDescCreatedAt := roletemplateFields[4].Descriptor()
+	roletemplateDescCreatedAt := roletemplateFields[9].Descriptor()
 	// roletemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
 	roletemplate.DefaultCreatedAt = roletemplateDescCreatedAt.Default.(func() time.Time)
 	// roletemplateDescUpdatedAt is the schema descriptor for updated_at field.
-	roletemplateDescUpdatedAt := roletemplateFields[5].Descriptor()
+	roletemplateDescUpdatedAt := roletemplateFields[10].Descriptor()
 	// roletemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	roletemplate.DefaultUpdatedAt = roletemplateDescUpdatedAt.Default.(func() time.Time)
 	// roletemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -248,10 +433,164 @@ func init() {
 	roletemplateDescID := roletemplateFields[0].Descriptor()
 	// roletemplate.DefaultID holds the default value on creation for the id field.
 	roletemplate.DefaultID = roletemplateDescID.Default.(func() uuid.UUID)
+	roletemplaterevisionFields := schema.RoleTemplateRevision{}.Fields()
+	_ = roletemplaterevisionFields
+	// roletemplaterevisionDescNumber is the schema descriptor for number field.
+	roletemplaterevisionDescNumber := roletemplaterevisionFields[2].Descriptor()
+	// roletemplaterevision.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	roletemplaterevision.NumberValidator = roletemplaterevisionDescNumber.Validators[0].(func(int) error)
+	// roletemplaterevisionDescName is the schema descriptor for name field.
+	roletemplaterevisionDescName := roletemplaterevisionFields[3].Descriptor()
+	// roletemplaterevision.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	roletemplaterevision.NameValidator = func() func(string) error {
+		validators := roletemplaterevisionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// roletemplaterevisionDescPlayerCount is the schema descriptor for player_count field.
+	roletemplaterevisionDescPlayerCount := roletemplaterevisionFields[4].Descriptor()
+	// roletemplaterevision.PlayerCountValidator is a validator for the "player_count" field. It is called by the builders before save.
+	roletemplaterevision.PlayerCountValidator = roletemplaterevisionDescPlayerCount.Validators[0].(func(int) error)
+	// roletemplaterevisionDescCreatedAt is the schema descriptor for created_at field.
+	roletemplaterevisionDescCreatedAt := roletemplaterevisionFields[12].Descriptor()
+	// roletemplaterevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roletemplaterevision.DefaultCreatedAt = roletemplaterevisionDescCreatedAt.Default.(func() time.Time)
+	// roletemplaterevisionDescID is the schema descriptor for id field.
+	roletemplaterevisionDescID := roletemplaterevisionFields[0].Descriptor()
+	// roletemplaterevision.DefaultID holds the default value on creation for the id field.
+	roletemplaterevision.DefaultID = roletemplaterevisionDescID.Default.(func() uuid.UUID)
 	roletemplateroleFields := schema.RoleTemplateRole{}.Fields()
 	_ = roletemplateroleFields
 	// roletemplateroleDescCount is the schema descriptor for count field.
 	roletemplateroleDescCount := roletemplateroleFields[2].Descriptor()
 	// roletemplaterole.CountValidator is a validator for the "count" field. It is called by the builders before save.
 	roletemplaterole.CountValidator = roletemplateroleDescCount.Validators[0].(func(int) error)
+	roletemplateslotFields := schema.RoleTemplateSlot{}.Fields()
+	_ = roletemplateslotFields
+	// roletemplateslotDescPosition is the schema descriptor for position field.
+	roletemplateslotDescPosition := roletemplateslotFields[3].Descriptor()
+	// roletemplateslot.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	roletemplateslot.PositionValidator = roletemplateslotDescPosition.Validators[0].(func(int) error)
+	// roletemplateslotDescID is the schema descriptor for id field.
+	roletemplateslotDescID := roletemplateslotFields[0].Descriptor()
+	// roletemplateslot.DefaultID holds the default value on creation for the id field.
+	roletemplateslot.DefaultID = roletemplateslotDescID.Default.(func() uuid.UUID)
+	roletemplatetranslationFields := schema.RoleTemplateTranslation{}.Fields()
+	_ = roletemplatetranslationFields
+	// roletemplatetranslationDescLocale is the schema descriptor for locale field.
+	roletemplatetranslationDescLocale := roletemplatetranslationFields[2].Descriptor()
+	// roletemplatetranslation.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	roletemplatetranslation.LocaleValidator = func() func(string) error {
+		validators := roletemplatetranslationDescLocale.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(locale string) error {
+			for _, fn := range fns {
+				if err := fn(locale); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// roletemplatetranslationDescDescription is the schema descriptor for description field.
+	roletemplatetranslationDescDescription := roletemplatetranslationFields[3].Descriptor()
+	// roletemplatetranslation.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	roletemplatetranslation.DescriptionValidator = roletemplatetranslationDescDescription.Validators[0].(func(string) error)
+	// roletemplatetranslationDescID is the schema descriptor for id field.
+	roletemplatetranslationDescID := roletemplatetranslationFields[0].Descriptor()
+	// roletemplatetranslation.DefaultID holds the default value on creation for the id field.
+	roletemplatetranslation.DefaultID = roletemplatetranslationDescID.Default.(func() uuid.UUID)
+	roletranslationFields := schema.RoleTranslation{}.Fields()
+	_ = roletranslationFields
+	// roletranslationDescLocale is the schema descriptor for locale field.
+	roletranslationDescLocale := roletranslationFields[2].Descriptor()
+	// roletranslation.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	roletranslation.LocaleValidator = func() func(string) error {
+		validators := roletranslationDescLocale.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(locale string) error {
+			for _, fn := range fns {
+				if err := fn(locale); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// roletranslationDescName is the schema descriptor for name field.
+	roletranslationDescName := roletranslationFields[3].Descriptor()
+	// roletranslation.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	roletranslation.NameValidator = func() func(string) error {
+		validators := roletranslationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// roletranslationDescID is the schema descriptor for id field.
+	roletranslationDescID := roletranslationFields[0].Descriptor()
+	// roletranslation.DefaultID holds the default value on creation for the id field.
+	roletranslation.DefaultID = roletranslationDescID.Default.(func() uuid.UUID)
+	spectatorFields := schema.Spectator{}.Fields()
+	_ = spectatorFields
+	// spectatorDescName is the schema descriptor for name field.
+	spectatorDescName := spectatorFields[1].Descriptor()
+	// spectator.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	spectator.NameValidator = spectatorDescName.Validators[0].(func(string) error)
+	// spectatorDescGameID is the schema descriptor for game_id field.
+	spectatorDescGameID := spectatorFields[2].Descriptor()
+	// spectator.GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
+	spectator.GameIDValidator = spectatorDescGameID.Validators[0].(func(string) error)
+	// spectatorDescCreatedAt is the schema descriptor for created_at field.
+	spectatorDescCreatedAt := spectatorFields[3].Descriptor()
+	// spectator.DefaultCreatedAt holds the default value on creation for the created_at field.
+	spectator.DefaultCreatedAt = spectatorDescCreatedAt.Default.(func() time.Time)
+	// spectatorDescID is the schema descriptor for id field.
+	spectatorDescID := spectatorFields[0].Descriptor()
+	// spectator.DefaultID holds the default value on creation for the id field.
+	spectator.DefaultID = spectatorDescID.Default.(func() uuid.UUID)
+	voteFields := schema.Vote{}.Fields()
+	_ = voteFields
+	// voteDescGameID is the schema descriptor for game_id field.
+	voteDescGameID := voteFields[1].Descriptor()
+	// vote.GameIDValidator is a validator for the "game_id" field. It is called by the builders before save.
+	vote.GameIDValidator = voteDescGameID.Validators[0].(func(string) error)
+	// voteDescRound is the schema descriptor for round field.
+	voteDescRound := voteFields[2].Descriptor()
+	// vote.RoundValidator is a validator for the "round" field. It is called by the builders before save.
+	vote.RoundValidator = voteDescRound.Validators[0].(func(int) error)
+	// voteDescCreatedAt is the schema descriptor for created_at field.
+	voteDescCreatedAt := voteFields[5].Descriptor()
+	// vote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vote.DefaultCreatedAt = voteDescCreatedAt.Default.(func() time.Time)
+	// vote.UpdateDefaultCreatedAt holds the default value on update for the created_at field.
+	vote.UpdateDefaultCreatedAt = voteDescCreatedAt.UpdateDefault.(func() time.Time)
+	// voteDescID is the schema descriptor for id field.
+	voteDescID := voteFields[0].Descriptor()
+	// vote.DefaultID holds the default value on creation for the id field.
+	vote.DefaultID = voteDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ChatMessage holds the schema definition for the ChatMessage entity.
+// Messages are sent by a player, or by a moderator for announcements, to one
+// of the chat channels of a game.
+type ChatMessage struct {
+	ent.Schema
+}
+
+// Fields of the ChatMessage.
+func (ChatMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("game_id").
+			NotEmpty(),
+		field.Enum("channel").
+			Values("day", "mafia", "dead", "announcements").
+			Comment("Channel the message was sent to, which decides who may read it"),
+		field.Int("round").
+			NonNegative().
+			Default(0).
+			Comment("Round of the game the message was sent in"),
+		field.UUID("player_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Player who sent the message; empty for moderator announcements"),
+		field.String("moderator_id").
+			Optional().
+			Comment("Moderator who sent an announcement"),
+		field.String("sender_name").
+			NotEmpty(),
+		field.String("text").
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ChatMessage.
+func (ChatMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("game", Game.Type).
+			Ref("chat_messages").
+			Field("game_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ChatMessage.
+func (ChatMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game_id", "channel", "created_at"),
+		index.Fields("player_id", "created_at"),
+	}
+}
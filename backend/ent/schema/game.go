@@ -28,6 +28,22 @@ func (Game) Fields() []ent.Field {
 			Default("pending"),
 		field.String("moderator_id").
 			NotEmpty(),
+		field.Enum("spectator_mode").
+			Values("disabled", "enabled", "delayed").
+			Default("enabled").
+			Comment("Whether spectators may watch the game and if their view is delayed"),
+		field.Int("spectator_delay_seconds").
+			NonNegative().
+			Default(0).
+			Comment("Delay applied to spectator updates in delayed mode"),
+		field.Enum("phase").
+			Values("day", "night").
+			Default("day").
+			Comment("Part of the round the game is in while active"),
+		field.Int("round").
+			Positive().
+			Default(1).
+			Comment("One-based round number, advanced after each night"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -41,6 +57,16 @@ func (Game) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("game_roles", GameRole.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("moderators", GameModerator.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("spectators", Spectator.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("votes", Vote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("night_actions", NightAction.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("chat_messages", ChatMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
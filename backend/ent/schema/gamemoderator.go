@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GameModerator holds the schema definition for the GameModerator entity.
+// A game moderator is a co-moderator helping the game owner with a scoped set of permissions.
+type GameModerator struct {
+	ent.Schema
+}
+
+// Fields of the GameModerator.
+func (GameModerator) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("game_id").
+			MaxLen(12).
+			NotEmpty(),
+		field.String("moderator_id").
+			NotEmpty(),
+		field.JSON("permissions", []string{}).
+			Comment("Actions this co-moderator is allowed to perform"),
+		field.String("added_by").
+			NotEmpty().
+			Comment("Moderator who granted access"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GameModerator.
+func (GameModerator) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("game", Game.Type).
+			Ref("moderators").
+			Field("game_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the GameModerator.
+func (GameModerator) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game_id", "moderator_id").Unique(),
+	}
+}
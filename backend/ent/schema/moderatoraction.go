@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ModeratorAction holds the schema definition for the ModeratorAction entity.
+// It is an audit log of moderator actions and intentionally has no foreign key
+// to the game, so the log outlives deleted games.
+type ModeratorAction struct {
+	ent.Schema
+}
+
+// Fields of the ModeratorAction.
+func (ModeratorAction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("game_id").
+			MaxLen(12).
+			NotEmpty(),
+		field.String("moderator_id").
+			NotEmpty(),
+		field.String("action").
+			NotEmpty().
+			MaxLen(50),
+		field.JSON("details", map[string]any{}).
+			Optional().
+			Comment("Action specific details"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ModeratorAction.
+func (ModeratorAction) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the ModeratorAction.
+func (ModeratorAction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game_id", "created_at"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NightAction holds the schema definition for the NightAction entity.
+// It records the target a player chose for their role's ability during a night.
+type NightAction struct {
+	ent.Schema
+}
+
+// Fields of the NightAction.
+func (NightAction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("game_id").
+			NotEmpty(),
+		field.Int("round").
+			Positive().
+			Comment("Night the action was taken on"),
+		field.UUID("player_id", uuid.UUID{}),
+		field.UUID("target_id", uuid.UUID{}),
+		field.String("action").
+			MaxLen(50).
+			Optional().
+			Comment("Which ability was used, for roles with more than one"),
+		field.Time("created_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the NightAction.
+func (NightAction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("game", Game.Type).
+			Ref("night_actions").
+			Field("game_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the NightAction.
+func (NightAction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game_id", "round", "player_id").Unique(),
+	}
+}
@@ -24,6 +24,19 @@ func (Player) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("game_id").
 			NotEmpty(),
+		field.Int("seat").
+			NonNegative().
+			Optional().
+			Comment("Zero-based seat index around the table, set when the player joins"),
+		field.Bool("alive").
+			Default(true).
+			Comment("Whether the player is still in the game"),
+		field.Bool("ready").
+			Default(false).
+			Comment("Whether the player is ready for the game to start"),
+		field.Bool("muted").
+			Default(false).
+			Comment("Whether a moderator has muted the player in chat"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -47,5 +60,6 @@ func (Player) Edges() []ent.Edge {
 func (Player) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("game_id", "name").Unique(),
+		index.Fields("game_id", "seat").Unique(),
 	}
 }
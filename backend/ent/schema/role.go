@@ -3,6 +3,7 @@ package schema
 import (
 	"github.com/google/uuid"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -27,8 +28,12 @@ func (Role) Fields() []ent.Field {
 			MaxLen(100).
 			Unique(),
 		field.String("video").
-			NotEmpty().
-			MaxLen(255),
+			MaxLen(255).
+			Comment("URL of the role's video; empty until one is uploaded"),
+		field.String("image").
+			Optional().
+			MaxLen(255).
+			Comment("URL of the role's card image"),
 		field.Enum("team").
 			Values("mafia", "village", "independent"),
 		field.Text("description").
@@ -36,6 +41,10 @@ func (Role) Fields() []ent.Field {
 		field.JSON("abilities", []string{}).
 			Optional().
 			Comment("List of role abilities"),
+		field.Time("archived_at").
+			Optional().
+			Nillable().
+			Comment("When the role was archived; archived roles stay in past games but are not dealt again"),
 	}
 }
 
@@ -44,6 +53,13 @@ func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("game_roles", GameRole.Type),
 		edge.To("template_roles", RoleTemplateRole.Type),
+		edge.To("template_slots", RoleTemplateSlot.Type),
+		edge.To("translations", RoleTranslation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("rules", RoleRule.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("targeted_by_rules", RoleRule.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -52,5 +68,6 @@ func (Role) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("team"),
 		index.Fields("slug"),
+		index.Fields("archived_at"),
 	}
 }
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleRule holds the schema definition for the RoleRule entity.
+// A role rule limits which roles may be played together: a role requires
+// another role or a team, excludes another role, or appears at most a number
+// of times per game.
+type RoleRule struct {
+	ent.Schema
+}
+
+// Fields of the RoleRule.
+func (RoleRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("role_id", uuid.UUID{}).
+			Comment("Role the rule applies to"),
+		field.Enum("kind").
+			Values("requires", "excludes", "max_count"),
+		field.UUID("target_role_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Role that is required or excluded"),
+		field.Enum("target_team").
+			Values("mafia", "village", "independent").
+			Optional().
+			Nillable().
+			Comment("Team that is required, for requires rules without a target role"),
+		field.Int("max_count").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Most copies of the role per game, for max_count rules"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RoleRule.
+func (RoleRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("role", Role.Type).
+			Ref("rules").
+			Field("role_id").
+			Required().
+			Unique(),
+		edge.From("target_role", Role.Type).
+			Ref("targeted_by_rules").
+			Field("target_role_id").
+			Unique(),
+	}
+}
+
+// Indexes of the RoleRule.
+func (RoleRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_id"),
+		index.Fields("target_role_id"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -23,14 +24,32 @@ func (RoleTemplate) Fields() []ent.Field {
 		field.String("name").
 			NotEmpty().
 			MaxLen(100).
-			Unique().
-			Comment("Name of the role template"),
+			Comment("Name of the role template, unique among its owner's templates or among curated ones"),
 		field.Int("player_count").
 			Positive().
-			Comment("Total number of players this template is designed for"),
+			Comment("Smallest number of players this template is designed for, filled by its core roles"),
+		field.Int("max_player_count").
+			Default(0).
+			Comment("Largest number of players this template covers: player_count plus its optional slots"),
 		field.Text("description").
 			Optional().
 			Comment("Description of the template and its gameplay style"),
+		field.String("owner_id").
+			Optional().
+			Nillable().
+			Comment("Moderator who owns the template; curated templates made by admins have none"),
+		field.Enum("status").
+			Values("private", "pending", "published").
+			Default("published").
+			Comment("Whether the template is listed publicly, awaiting admin review or only seen by its owner"),
+		field.String("share_code").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("Code that lets anyone holding it view the template"),
+		field.Text("review_note").
+			Optional().
+			Comment("Note left by the admin who last reviewed the template"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -44,6 +63,12 @@ func (RoleTemplate) Fields() []ent.Field {
 func (RoleTemplate) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("template_roles", RoleTemplateRole.Type),
+		edge.To("optional_slots", RoleTemplateSlot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("translations", RoleTemplateTranslation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", RoleTemplateRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -51,6 +76,15 @@ func (RoleTemplate) Edges() []ent.Edge {
 func (RoleTemplate) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("player_count"),
+		index.Fields("max_player_count"),
 		index.Fields("name"),
+		// Curated templates have no owner, so their names get an index of their own
+		index.Fields("owner_id", "name").Unique(),
+		index.Fields("name").
+			Unique().
+			Annotations(entsql.IndexWhere("owner_id IS NULL")).
+			StorageKey("roletemplate_curated_name"),
+		index.Fields("owner_id"),
+		index.Fields("status"),
 	}
 }
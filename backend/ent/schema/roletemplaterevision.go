@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RevisionRole is a role of a template as it was at a revision
+type RevisionRole struct {
+	RoleID uuid.UUID `json:"role_id"`
+	// Slug is kept so a revision stays readable if the role changes later
+	Slug     string `json:"slug"`
+	Count    int    `json:"count"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// RoleTemplateRevision holds the schema definition for the RoleTemplateRevision entity.
+// It records the full composition of a role template after each change.
+type RoleTemplateRevision struct {
+	ent.Schema
+}
+
+// Fields of the RoleTemplateRevision.
+func (RoleTemplateRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("role_template_id", uuid.UUID{}).
+			Comment("Reference to the revised role template"),
+		field.Int("number").
+			Positive().
+			Comment("Revision number, counting from 1 for each template"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.Int("player_count").
+			Positive(),
+		field.Text("description").
+			Optional(),
+		field.JSON("roles", []RevisionRole{}).
+			Comment("Core roles ordered by slug, then optional roles in the order they switch on"),
+		field.Enum("action").
+			Values("baseline", "create", "update", "rollback", "clone", "import").
+			Comment("What made the revision; baseline records a template as it was before its first tracked change"),
+		field.Int("source_number").
+			Optional().
+			Nillable().
+			Comment("Revision restored by a rollback"),
+		field.Enum("author_kind").
+			Values("admin", "moderator", "system").
+			Default("system"),
+		field.String("author_id").
+			Optional().
+			Comment("Admin or moderator who made the change"),
+		field.String("author_name").
+			Optional().
+			Comment("Username of the admin who made the change"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RoleTemplateRevision.
+func (RoleTemplateRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("role_template", RoleTemplate.Type).
+			Ref("revisions").
+			Field("role_template_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RoleTemplateRevision.
+func (RoleTemplateRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_template_id", "number").Unique(),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleTemplateSlot holds the schema definition for the RoleTemplateSlot entity.
+// An optional slot adds one player with its role once the lobby has grown
+// past the slots before it.
+type RoleTemplateSlot struct {
+	ent.Schema
+}
+
+// Fields of the RoleTemplateSlot.
+func (RoleTemplateSlot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("role_template_id", uuid.UUID{}).
+			Comment("Reference to the role template"),
+		field.UUID("role_id", uuid.UUID{}).
+			Comment("Reference to the role the slot adds"),
+		field.Int("position").
+			NonNegative().
+			Comment("Order in which the slot switches on, starting at 0"),
+	}
+}
+
+// Edges of the RoleTemplateSlot.
+func (RoleTemplateSlot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("role_template", RoleTemplate.Type).
+			Ref("optional_slots").
+			Field("role_template_id").
+			Required().
+			Unique(),
+		edge.From("role", Role.Type).
+			Ref("template_slots").
+			Field("role_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RoleTemplateSlot.
+func (RoleTemplateSlot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_template_id", "position").Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleTemplateTranslation holds the schema definition for the RoleTemplateTranslation entity.
+// It holds the description of a role template in a locale other than the default one.
+type RoleTemplateTranslation struct {
+	ent.Schema
+}
+
+// Fields of the RoleTemplateTranslation.
+func (RoleTemplateTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("role_template_id", uuid.UUID{}).
+			Comment("Reference to the translated role template"),
+		field.String("locale").
+			NotEmpty().
+			MaxLen(35).
+			Comment("BCP 47 language tag, such as fa or en-GB"),
+		field.Text("description").
+			NotEmpty(),
+	}
+}
+
+// Edges of the RoleTemplateTranslation.
+func (RoleTemplateTranslation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("role_template", RoleTemplate.Type).
+			Ref("translations").
+			Field("role_template_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RoleTemplateTranslation.
+func (RoleTemplateTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_template_id", "locale").Unique(),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleTranslation holds the schema definition for the RoleTranslation entity.
+// The role itself holds its texts in the default locale; translations hold
+// them in any other locale.
+type RoleTranslation struct {
+	ent.Schema
+}
+
+// Fields of the RoleTranslation.
+func (RoleTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("role_id", uuid.UUID{}).
+			Comment("Reference to the translated role"),
+		field.String("locale").
+			NotEmpty().
+			MaxLen(35).
+			Comment("BCP 47 language tag, such as fa or en-GB"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(50),
+		field.Text("description").
+			Optional(),
+		field.JSON("abilities", []string{}).
+			Optional().
+			Comment("Translated abilities; the role's own abilities are used when empty"),
+	}
+}
+
+// Edges of the RoleTranslation.
+func (RoleTranslation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("role", Role.Type).
+			Ref("translations").
+			Field("role_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RoleTranslation.
+func (RoleTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_id", "locale").Unique(),
+		// Role names are unique within a locale, as they are in the default one
+		index.Fields("locale", "name").Unique(),
+	}
+}
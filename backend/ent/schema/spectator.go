@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Spectator holds the schema definition for the Spectator entity.
+// Spectators watch a game without taking part in it, so they never receive a role.
+type Spectator struct {
+	ent.Schema
+}
+
+// Fields of the Spectator.
+func (Spectator) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty(),
+		field.String("game_id").
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Spectator.
+func (Spectator) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("game", Game.Type).
+			Ref("spectators").
+			Field("game_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Spectator.
+func (Spectator) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game_id"),
+	}
+}
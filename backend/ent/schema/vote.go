@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Vote holds the schema definition for the Vote entity.
+// A player casts at most one vote per day; voting again replaces the earlier vote.
+type Vote struct {
+	ent.Schema
+}
+
+// Fields of the Vote.
+func (Vote) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("game_id").
+			NotEmpty(),
+		field.Int("round").
+			Positive().
+			Comment("Day the vote was cast on"),
+		field.UUID("voter_id", uuid.UUID{}),
+		field.UUID("target_id", uuid.UUID{}),
+		field.Time("created_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Vote.
+func (Vote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("game", Game.Type).
+			Ref("votes").
+			Field("game_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Vote.
+func (Vote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("game_id", "round", "voter_id").Unique(),
+	}
+}
@@ -14,18 +14,40 @@ type Tx struct {
 	config
 	// Admin is the client for interacting with the Admin builders.
 	Admin *AdminClient
+	// ChatMessage is the client for interacting with the ChatMessage builders.
+	ChatMessage *ChatMessageClient
 	// Game is the client for interacting with the Game builders.
 	Game *GameClient
+	// GameModerator is the client for interacting with the GameModerator builders.
+	GameModerator *GameModeratorClient
 	// GameRole is the client for interacting with the GameRole builders.
 	GameRole *GameRoleClient
+	// ModeratorAction is the client for interacting with the ModeratorAction builders.
+	ModeratorAction *ModeratorActionClient
+	// NightAction is the client for interacting with the NightAction builders.
+	NightAction *NightActionClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// RoleRule is the client for interacting with the RoleRule builders.
+	RoleRule *RoleRuleClient
 	// RoleTemplate is the client for interacting with the RoleTemplate builders.
 	RoleTemplate *RoleTemplateClient
+	// RoleTemplateRevision is the client for interacting with the RoleTemplateRevision builders.
+	RoleTemplateRevision *RoleTemplateRevisionClient
 	// RoleTemplateRole is the client for interacting with the RoleTemplateRole builders.
 	RoleTemplateRole *RoleTemplateRoleClient
+	// RoleTemplateSlot is the client for interacting with the RoleTemplateSlot builders.
+	RoleTemplateSlot *RoleTemplateSlotClient
+	// RoleTemplateTranslation is the client for interacting with the RoleTemplateTranslation builders.
+	RoleTemplateTranslation *RoleTemplateTranslationClient
+	// RoleTranslation is the client for interacting with the RoleTranslation builders.
+	RoleTranslation *RoleTranslationClient
+	// Spectator is the client for interacting with the Spectator builders.
+	Spectator *SpectatorClient
+	// Vote is the client for interacting with the Vote builders.
+	Vote *VoteClient
 
 	// lazily loaded.
 	client     *Client
@@ -158,12 +180,23 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Admin = NewAdminClient(tx.config)
+	tx.ChatMessage = NewChatMessageClient(tx.config)
 	tx.Game = NewGameClient(tx.config)
+	tx.GameModerator = NewGameModeratorClient(tx.config)
 	tx.GameRole = NewGameRoleClient(tx.config)
+	tx.ModeratorAction = NewModeratorActionClient(tx.config)
+	tx.NightAction = NewNightActionClient(tx.config)
 	tx.Player = NewPlayerClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.RoleRule = NewRoleRuleClient(tx.config)
 	tx.RoleTemplate = NewRoleTemplateClient(tx.config)
+	tx.RoleTemplateRevision = NewRoleTemplateRevisionClient(tx.config)
 	tx.RoleTemplateRole = NewRoleTemplateRoleClient(tx.config)
+	tx.RoleTemplateSlot = NewRoleTemplateSlotClient(tx.config)
+	tx.RoleTemplateTranslation = NewRoleTemplateTranslationClient(tx.config)
+	tx.RoleTranslation = NewRoleTranslationClient(tx.config)
+	tx.Spectator = NewSpectatorClient(tx.config)
+	tx.Vote = NewVoteClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// gameTokenAudience keeps game tokens and admin tokens from being mistaken for each other
+const gameTokenAudience = "game"
+
+// ErrInvalidGameToken is returned when a game token is malformed, expired or for another game
+var ErrInvalidGameToken = errors.New("invalid game token")
+
+// GameTokenRole is who a game token was issued to
+type GameTokenRole string
+
+const (
+	// GameTokenPlayer identifies a player; the token subject is the player ID
+	GameTokenPlayer GameTokenRole = "player"
+	// GameTokenModerator identifies the owner or a co-moderator; the token subject is the moderator ID
+	GameTokenModerator GameTokenRole = "moderator"
+	// GameTokenSpectator identifies a spectator; the token subject is the spectator ID
+	GameTokenSpectator GameTokenRole = "spectator"
+)
+
+// GameTokenClaims represents the claims of a token identifying someone within one game
+type GameTokenClaims struct {
+	GameID string        `json:"game_id"`
+	Role   GameTokenRole `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// GenerateGameToken generates a token identifying subject as role within a game
+func (s *JWTService) GenerateGameToken(gameID string, role GameTokenRole, subject string) (string, error) {
+	claims := GameTokenClaims{
+		GameID: gameID,
+		Role:   role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{gameTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)), // 24 hours
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			Issuer:    s.issuer,
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(s.secretKey)
+}
+
+// ValidateGameToken validates a game token issued for gameID and returns its claims
+func (s *JWTService) ValidateGameToken(tokenString string, gameID string) (*GameTokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &GameTokenClaims{}, func(token *jwt.Token) (any, error) {
+		// Verify signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return s.secretKey, nil
+	}, jwt.WithAudience(gameTokenAudience))
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGameToken, err)
+	}
+
+	claims, ok := token.Claims.(*GameTokenClaims)
+	if !ok || !token.Valid || claims.Subject == "" {
+		return nil, ErrInvalidGameToken
+	}
+	if claims.GameID != gameID {
+		return nil, fmt.Errorf("%w: issued for another game", ErrInvalidGameToken)
+	}
+
+	return claims, nil
+}
@@ -204,3 +204,36 @@ func TestJWTClaims_Structure(t *testing.T) {
 		t.Errorf("Expected Issuer test-issuer, got %s", claims.Issuer)
 	}
 }
+
+func TestGameToken(t *testing.T) {
+	service := NewJWTService("test-secret", "test-issuer")
+	playerID := uuid.New().String()
+
+	token, err := service.GenerateGameToken("ABC123", GameTokenPlayer, playerID)
+	if err != nil {
+		t.Fatalf("GenerateGameToken failed: %v", err)
+	}
+
+	claims, err := service.ValidateGameToken(token, "ABC123")
+	if err != nil {
+		t.Fatalf("ValidateGameToken failed: %v", err)
+	}
+	if claims.Role != GameTokenPlayer {
+		t.Errorf("Expected role %s, got %s", GameTokenPlayer, claims.Role)
+	}
+	if claims.Subject != playerID {
+		t.Errorf("Expected subject %s, got %s", playerID, claims.Subject)
+	}
+
+	if _, err := service.ValidateGameToken(token, "XYZ789"); err == nil {
+		t.Error("Expected token for another game to be rejected")
+	}
+
+	adminToken, err := service.GenerateToken(uuid.New(), "admin")
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+	if _, err := service.ValidateGameToken(adminToken, "ABC123"); err == nil {
+		t.Error("Expected admin token to be rejected as a game token")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent"
 )
 
@@ -49,3 +50,10 @@ func JWTAuthMiddleware(jwtService *JWTService, client *ent.Client) func(http.Han
 		})
 	}
 }
+
+// AdminFromContext returns the admin authenticated by JWTAuthMiddleware
+func AdminFromContext(ctx context.Context) (id uuid.UUID, username string, ok bool) {
+	id, ok = ctx.Value("admin_id").(uuid.UUID)
+	username, _ = ctx.Value("admin_username").(string)
+	return id, username, ok
+}
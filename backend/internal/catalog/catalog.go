@@ -0,0 +1,213 @@
+// Package catalog reads and writes the role catalog as a versioned document
+// holding every role, with its translations and rules, and every role
+// template.
+//
+// Documents name roles by slug and templates by name rather than by ID, so
+// a catalog exported from one server can be imported into another.
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the document version written by Encode and accepted by Decode
+const Version = 1
+
+var (
+	ErrUnsupportedFormat  = errors.New("format must be yaml or json")
+	ErrUnsupportedVersion = errors.New("unsupported catalog version")
+	ErrInvalidDocument    = errors.New("invalid catalog document")
+)
+
+// Format is the encoding of a document
+type Format string
+
+const (
+	YAML Format = "yaml"
+	JSON Format = "json"
+)
+
+// ParseFormat reads a format name, file extension or content type, such as
+// "yml", ".json" or "application/yaml"
+func ParseFormat(s string) (Format, error) {
+	s = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(s), "."))
+	if mediaType, _, err := mime.ParseMediaType(s); err == nil {
+		s = mediaType
+	}
+
+	switch s {
+	case "yaml", "yml", "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return YAML, nil
+	case "json", "application/json":
+		return JSON, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// FormatOf picks the format of a file from its extension, defaulting to YAML
+func FormatOf(path string) Format {
+	if format, err := ParseFormat(filepath.Ext(path)); err == nil {
+		return format
+	}
+	return YAML
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	if f == JSON {
+		return "application/json"
+	}
+	return "application/yaml"
+}
+
+// Document is a complete role catalog
+type Document struct {
+	Version   int        `json:"version" yaml:"version"`
+	Roles     []Role     `json:"roles" yaml:"roles"`
+	Templates []Template `json:"templates" yaml:"templates"`
+}
+
+// Role is a role identified by its slug
+type Role struct {
+	Slug        string   `json:"slug" yaml:"slug"`
+	Name        string   `json:"name" yaml:"name"`
+	Team        string   `json:"team" yaml:"team"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Video       string   `json:"video,omitempty" yaml:"video,omitempty"`
+	Image       string   `json:"image,omitempty" yaml:"image,omitempty"`
+	Abilities   []string `json:"abilities,omitempty" yaml:"abilities,omitempty"`
+	// Translations are keyed by locale
+	Translations map[string]RoleTranslation `json:"translations,omitempty" yaml:"translations,omitempty"`
+	Rules        []Rule                     `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// Archived roles are kept for past games and the templates dealing them
+	Archived bool `json:"archived,omitempty" yaml:"archived,omitempty"`
+}
+
+// RoleTranslation holds the texts of a role in one locale
+type RoleTranslation struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Abilities   []string `json:"abilities,omitempty" yaml:"abilities,omitempty"`
+}
+
+// Rule is a role rule. Requires rules name a role or a team, excludes rules
+// name a role, and max_count rules set MaxCount.
+type Rule struct {
+	Kind string `json:"kind" yaml:"kind"`
+	// Role is the slug of the target role
+	Role     string `json:"role,omitempty" yaml:"role,omitempty"`
+	Team     string `json:"team,omitempty" yaml:"team,omitempty"`
+	MaxCount int    `json:"max_count,omitempty" yaml:"max_count,omitempty"`
+}
+
+// Template is a role template identified by its name
+type Template struct {
+	Name        string         `json:"name" yaml:"name"`
+	PlayerCount int            `json:"player_count" yaml:"player_count"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Roles       []TemplateRole `json:"roles" yaml:"roles"`
+	// Translations map locales to translated descriptions
+	Translations map[string]string `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+// TemplateRole is a role of a template. Optional roles fill the optional
+// slots in the order they are listed.
+type TemplateRole struct {
+	Role     string `json:"role" yaml:"role"`
+	Count    int    `json:"count" yaml:"count"`
+	Optional bool   `json:"optional,omitempty" yaml:"optional,omitempty"`
+}
+
+// Decode reads a document, rejecting unknown fields and other versions
+func Decode(r io.Reader, format Format) (*Document, error) {
+	var doc Document
+	switch format {
+	case YAML:
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+		}
+	case JSON:
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidDocument, err)
+		}
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	if doc.Version != Version {
+		return nil, fmt.Errorf("%w: got %d, want %d", ErrUnsupportedVersion, doc.Version, Version)
+	}
+	return &doc, nil
+}
+
+// Encode writes a document, stamping it with the current version
+func Encode(w io.Writer, doc *Document, format Format) error {
+	doc.Version = Version
+	switch format {
+	case YAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case JSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(doc)
+	}
+	return ErrUnsupportedFormat
+}
+
+// Validate checks that every entry is named and named once, and that
+// templates only use roles of the document or, unless complete is set,
+// roles that may already exist. Field values are checked on import.
+func (d *Document) Validate(complete bool) error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidDocument, fmt.Sprintf(format, args...))
+	}
+
+	slugs := make(map[string]bool, len(d.Roles))
+	for i, r := range d.Roles {
+		if r.Slug == "" {
+			return invalid("role %d has no slug", i+1)
+		}
+		if slugs[r.Slug] {
+			return invalid("role %q is listed twice", r.Slug)
+		}
+		slugs[r.Slug] = true
+	}
+
+	names := make(map[string]bool, len(d.Templates))
+	for i, t := range d.Templates {
+		if t.Name == "" {
+			return invalid("template %d has no name", i+1)
+		}
+		if names[t.Name] {
+			return invalid("template %q is listed twice", t.Name)
+		}
+		names[t.Name] = true
+
+		for _, tr := range t.Roles {
+			if tr.Role == "" {
+				return invalid("template %q has a role without a slug", t.Name)
+			}
+			if complete && !slugs[tr.Role] {
+				return invalid("template %q uses role %q, which is not in the document", t.Name, tr.Role)
+			}
+		}
+	}
+	return nil
+}
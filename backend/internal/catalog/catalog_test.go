@@ -0,0 +1,119 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sample = `version: 1
+roles:
+  - slug: mafia
+    name: Mafia
+    team: mafia
+    abilities: [Kill one player each night]
+    translations:
+      fa:
+        name: مافیا
+    rules:
+      - kind: max_count
+        max_count: 3
+  - slug: villager
+    name: Villager
+    team: village
+templates:
+  - name: Classic
+    player_count: 3
+    roles:
+      - role: mafia
+        count: 1
+      - role: villager
+        count: 2
+      - role: mafia
+        count: 1
+        optional: true
+    translations:
+      fa: کلاسیک
+`
+
+func TestDecode(t *testing.T) {
+	t.Run("reads YAML", func(t *testing.T) {
+		doc, err := Decode(strings.NewReader(sample), YAML)
+		require.NoError(t, err)
+
+		require.Len(t, doc.Roles, 2)
+		assert.Equal(t, "مافیا", doc.Roles[0].Translations["fa"].Name)
+		assert.Equal(t, 3, doc.Roles[0].Rules[0].MaxCount)
+		require.Len(t, doc.Templates, 1)
+		assert.True(t, doc.Templates[0].Roles[2].Optional)
+		assert.Equal(t, "کلاسیک", doc.Templates[0].Translations["fa"])
+	})
+
+	t.Run("round trips through JSON", func(t *testing.T) {
+		doc, err := Decode(strings.NewReader(sample), YAML)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, doc, JSON))
+		again, err := Decode(&buf, JSON)
+		require.NoError(t, err)
+		assert.Equal(t, doc, again)
+	})
+
+	t.Run("rejects other versions", func(t *testing.T) {
+		_, err := Decode(strings.NewReader("version: 2\nroles: []\n"), YAML)
+		assert.ErrorIs(t, err, ErrUnsupportedVersion)
+
+		_, err = Decode(strings.NewReader(`{"roles": []}`), JSON)
+		assert.ErrorIs(t, err, ErrUnsupportedVersion)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		_, err := Decode(strings.NewReader("version: 1\nrolez: []\n"), YAML)
+		assert.ErrorIs(t, err, ErrInvalidDocument)
+
+		_, err = Decode(strings.NewReader(`{"version": 1, "rolez": []}`), JSON)
+		assert.ErrorIs(t, err, ErrInvalidDocument)
+	})
+}
+
+func TestParseFormat(t *testing.T) {
+	for input, want := range map[string]Format{
+		"yaml":                            YAML,
+		".yml":                            YAML,
+		"application/x-yaml":              YAML,
+		"JSON":                            JSON,
+		"application/json; charset=utf-8": JSON,
+	} {
+		got, err := ParseFormat(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	_, err := ParseFormat("toml")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	assert.Equal(t, JSON, FormatOf("backup/catalog.json"))
+	assert.Equal(t, YAML, FormatOf("catalog"))
+}
+
+func TestDocument_Validate(t *testing.T) {
+	doc := &Document{
+		Version: Version,
+		Roles:   []Role{{Slug: "mafia", Name: "Mafia", Team: "mafia"}},
+		Templates: []Template{{
+			Name:        "Solo",
+			PlayerCount: 2,
+			Roles:       []TemplateRole{{Role: "mafia", Count: 1}, {Role: "villager", Count: 1}},
+		}},
+	}
+
+	assert.NoError(t, doc.Validate(false))
+	assert.ErrorIs(t, doc.Validate(true), ErrInvalidDocument, "a complete document must hold every role its templates use")
+
+	doc.Roles = append(doc.Roles, Role{Slug: "mafia", Name: "Mafia Again", Team: "mafia"})
+	assert.ErrorContains(t, doc.Validate(false), `role "mafia" is listed twice`)
+}
@@ -6,6 +6,7 @@ import (
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/mafia-night/backend/ent"
 	_ "github.com/lib/pq"
 )
@@ -44,9 +45,10 @@ func NewEntClient(cfg Config) (*ent.Client, error) {
 	return client, nil
 }
 
-// CreateSchema creates all tables using Ent's auto-migration
+// CreateSchema creates all tables using Ent's auto-migration, numbering the
+// seats of existing players before the seat index is added
 func CreateSchema(ctx context.Context, client *ent.Client) error {
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := client.Schema.Create(ctx, schema.WithApplyHook(backfillSeats)); err != nil {
 		return fmt.Errorf("failed to create schema: %w", err)
 	}
 	return nil
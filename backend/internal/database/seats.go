@@ -0,0 +1,50 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// seatIndex is the unique index on the seats of a game's players
+const seatIndex = "player_game_id_seat"
+
+// backfillSeats numbers the seats of existing players before the unique seat
+// index is created. Players who joined before seats were assigned all sit in
+// seat 0, so they take seats in the order they joined.
+func backfillSeats(next schema.Applier) schema.Applier {
+	return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+		if needsSeatBackfill(plan) {
+			query := `UPDATE players SET seat = (
+				SELECT COUNT(*) FROM players AS earlier
+				WHERE earlier.game_id = players.game_id
+				AND (earlier.created_at < players.created_at
+					OR (earlier.created_at = players.created_at AND earlier.id < players.id))
+			)`
+			if err := conn.Exec(ctx, query, []any{}, nil); err != nil {
+				return fmt.Errorf("failed to backfill player seats: %w", err)
+			}
+		}
+		return next.Apply(ctx, conn, plan)
+	})
+}
+
+// needsSeatBackfill tells whether a migration plan adds the seat index to an
+// existing players table
+func needsSeatBackfill(plan *migrate.Plan) bool {
+	addsIndex := false
+	for _, c := range plan.Changes {
+		// A new table has no players to seat
+		if strings.Contains(c.Cmd, `CREATE TABLE "players"`) || strings.Contains(c.Cmd, "CREATE TABLE `players`") {
+			return false
+		}
+		if strings.Contains(c.Cmd, seatIndex) {
+			addsIndex = true
+		}
+	}
+	return addsIndex
+}
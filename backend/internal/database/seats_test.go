@@ -0,0 +1,36 @@
+package database
+
+import (
+	"testing"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeedsSeatBackfill(t *testing.T) {
+	plan := func(cmds ...string) *migrate.Plan {
+		p := &migrate.Plan{}
+		for _, cmd := range cmds {
+			p.Changes = append(p.Changes, &migrate.Change{Cmd: cmd})
+		}
+		return p
+	}
+
+	t.Run("seat index added to existing players", func(t *testing.T) {
+		assert.True(t, needsSeatBackfill(plan(
+			`ALTER TABLE "players" ADD COLUMN "seat" bigint NULL`,
+			`CREATE UNIQUE INDEX "player_game_id_seat" ON "players" ("game_id", "seat")`,
+		)))
+	})
+
+	t.Run("new players table", func(t *testing.T) {
+		assert.False(t, needsSeatBackfill(plan(
+			`CREATE TABLE "players" ("id" uuid NOT NULL, "seat" bigint NULL, PRIMARY KEY ("id"))`,
+			`CREATE UNIQUE INDEX "player_game_id_seat" ON "players" ("game_id", "seat")`,
+		)))
+	})
+
+	t.Run("index already in place", func(t *testing.T) {
+		assert.False(t, needsSeatBackfill(plan(`ALTER TABLE "games" ADD COLUMN "phase" character varying NULL`)))
+	})
+}
@@ -29,7 +29,7 @@ func SetupTestDB(t *testing.T) *ent.Client {
 	CleanupTestDB(t, client)
 	
 	// Run migrations (create tables if they don't exist)
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := CreateSchema(ctx, client); err != nil {
 		t.Fatalf("failed creating schema: %v", err)
 	}
 
@@ -48,10 +48,21 @@ func CleanupTestDB(t *testing.T, client *ent.Client) {
 
 	// Delete all data in reverse order of dependencies
 	_, _ = client.GameRole.Delete().Exec(ctx)
+	_, _ = client.GameModerator.Delete().Exec(ctx)
+	_, _ = client.ModeratorAction.Delete().Exec(ctx)
+	_, _ = client.Vote.Delete().Exec(ctx)
+	_, _ = client.NightAction.Delete().Exec(ctx)
+	_, _ = client.ChatMessage.Delete().Exec(ctx)
 	_, _ = client.Player.Delete().Exec(ctx)
+	_, _ = client.Spectator.Delete().Exec(ctx)
 	_, _ = client.Game.Delete().Exec(ctx)
+	_, _ = client.RoleTemplateSlot.Delete().Exec(ctx)
 	_, _ = client.RoleTemplateRole.Delete().Exec(ctx)
+	_, _ = client.RoleTemplateTranslation.Delete().Exec(ctx)
+	_, _ = client.RoleTemplateRevision.Delete().Exec(ctx)
 	_, _ = client.RoleTemplate.Delete().Exec(ctx)
+	_, _ = client.RoleTranslation.Delete().Exec(ctx)
+	_, _ = client.RoleRule.Delete().Exec(ctx)
 	_, _ = client.Role.Delete().Exec(ctx)
 	_, _ = client.Admin.Delete().Exec(ctx)
 }
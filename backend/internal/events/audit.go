@@ -0,0 +1,30 @@
+package events
+
+import "log"
+
+// AuditLog returns a subscriber writing every event to logger
+// Only the kind of event, the game and the kind of actor are logged; the
+// events themselves carry IDs and private chat that do not belong in logs.
+func AuditLog(logger *log.Logger) Handler {
+	return func(e Event) {
+		logger.Printf("[Audit] %s game=%s actor=%s", e.EventType(), e.EventGameID(), actorKind(e))
+	}
+}
+
+// actorKind tells whether a player or a moderator caused an event
+func actorKind(e Event) string {
+	switch e := e.(type) {
+	case PlayerJoined, VoteCast, NightActionSubmitted:
+		return "player"
+	case PlayerUpdated:
+		// Players mark themselves ready; moderators kill, revive and mute them
+		return "player_or_moderator"
+	case ChatMessageSent:
+		if e.Message.ModeratorID != "" {
+			return "moderator"
+		}
+		return "player"
+	default:
+		return "moderator"
+	}
+}
@@ -0,0 +1,107 @@
+package events
+
+import (
+	"log"
+	"sync"
+)
+
+// Number of events waiting for a subscriber before new ones are dropped
+const subscriberQueueSize = 1024
+
+// Handler reacts to an event
+type Handler func(Event)
+
+// Bus delivers events to every subscriber within the process
+//
+// Each subscriber has its own queue and goroutine, so publishing never waits
+// for a subscriber and a slow one does not hold up the others. A subscriber
+// sees events in the order they were published. A nil bus drops every event,
+// so services work without one.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers []*subscriber
+}
+
+// subscriber is a handler with the events queued for it
+type subscriber struct {
+	handle Handler
+	queue  chan delivery
+}
+
+// delivery is a queued event, or a flush marker closed once every event
+// queued before it was handled
+type delivery struct {
+	event   Event
+	flushed chan struct{}
+}
+
+// NewBus creates an event bus without subscribers
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe registers a handler for every event published from now on
+func (b *Bus) Subscribe(h Handler) {
+	s := &subscriber{handle: h, queue: make(chan delivery, subscriberQueueSize)}
+	go s.run()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, s)
+}
+
+// Publish queues an event for every subscriber
+// Events for a subscriber whose queue is full are dropped and logged.
+func (b *Bus) Publish(e Event) {
+	if b == nil {
+		return
+	}
+
+	for _, s := range b.snapshot() {
+		select {
+		case s.queue <- delivery{event: e}:
+		default:
+			log.Printf("[Events] Queue full, dropping %s for game %s", e.EventType(), e.EventGameID())
+		}
+	}
+}
+
+// Flush waits until every subscriber has handled the events published so far
+func (b *Bus) Flush() {
+	if b == nil {
+		return
+	}
+
+	for _, s := range b.snapshot() {
+		flushed := make(chan struct{})
+		s.queue <- delivery{flushed: flushed}
+		<-flushed
+	}
+}
+
+func (b *Bus) snapshot() []*subscriber {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.subscribers
+}
+
+func (s *subscriber) run() {
+	for d := range s.queue {
+		if d.flushed != nil {
+			close(d.flushed)
+			continue
+		}
+		deliver(s.handle, d.event)
+	}
+}
+
+// deliver hands an event to a subscriber
+// A subscriber that panics is logged and keeps receiving events.
+func deliver(h Handler, e Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[Events] Subscriber panicked handling %s for game %s: %v", e.EventType(), e.EventGameID(), r)
+		}
+	}()
+	h(e)
+}
@@ -0,0 +1,132 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mafia-night/backend/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus(t *testing.T) {
+	t.Run("delivers events to every subscriber in order", func(t *testing.T) {
+		bus := NewBus()
+
+		var first, second []Type
+		bus.Subscribe(func(e Event) { first = append(first, e.EventType()) })
+		bus.Subscribe(func(e Event) { second = append(second, e.EventType()) })
+
+		bus.Publish(GameDeleted{GameID: "ABC123"})
+		bus.Publish(RolesDistributed{GameID: "ABC123"})
+		bus.Flush()
+
+		want := []Type{TypeGameDeleted, TypeRolesDistributed}
+		assert.Equal(t, want, first)
+		assert.Equal(t, want, second)
+	})
+
+	t.Run("a panicking subscriber does not stop the others", func(t *testing.T) {
+		bus := NewBus()
+
+		delivered := false
+		bus.Subscribe(func(e Event) { panic("boom") })
+		bus.Subscribe(func(e Event) { delivered = true })
+
+		bus.Publish(GameDeleted{GameID: "ABC123"})
+		bus.Flush()
+		assert.True(t, delivered)
+	})
+
+	t.Run("publishing does not wait for subscribers", func(t *testing.T) {
+		bus := NewBus()
+
+		release := make(chan struct{})
+		bus.Subscribe(func(e Event) { <-release })
+
+		published := make(chan struct{})
+		go func() {
+			bus.Publish(GameDeleted{GameID: "ABC123"})
+			bus.Publish(GameDeleted{GameID: "ABC123"})
+			close(published)
+		}()
+
+		select {
+		case <-published:
+		case <-time.After(time.Second):
+			t.Fatal("Publish blocked on a slow subscriber")
+		}
+		close(release)
+		bus.Flush()
+	})
+
+	t.Run("a nil bus drops events", func(t *testing.T) {
+		var bus *Bus
+		assert.NotPanics(t, func() { bus.Publish(GameDeleted{GameID: "ABC123"}) })
+	})
+}
+
+func TestAuditLog(t *testing.T) {
+	var buf bytes.Buffer
+	audit := AuditLog(log.New(&buf, "", 0))
+
+	audit(ChatMessageSent{Message: &ent.ChatMessage{GameID: "ABC123", SenderName: "Alice", Text: "I am the doctor"}})
+	audit(ModeratorAdded{GameID: "ABC123", ModeratorID: "co-mod"})
+
+	assert.Equal(t, "[Audit] chat_message game=ABC123 actor=player\n[Audit] moderator_added game=ABC123 actor=moderator\n", buf.String())
+}
+
+func TestWebhooks(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	webhooks := NewWebhooks([]string{server.URL}, "secret")
+	webhooks.Handle(GameDeleted{GameID: "ABC123"})
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		assert.Equal(t, Sign([]byte("secret"), body), r.Header.Get("X-Mafia-Signature"))
+
+		var payload struct {
+			Type   Type            `json:"type"`
+			GameID string          `json:"game_id"`
+			Data   json.RawMessage `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, TypeGameDeleted, payload.Type)
+		assert.Equal(t, "ABC123", payload.GameID)
+		assert.JSONEq(t, `{"game_id":"ABC123"}`, string(payload.Data))
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+}
+
+func TestWithoutModeratorIDs(t *testing.T) {
+	game := &ent.Game{ID: "ABC123", ModeratorID: "mod-123"}
+
+	for _, e := range []Event{
+		GameCreated{Game: game},
+		OwnershipTransferred{Game: game, PreviousOwner: "mod-123"},
+		ModeratorAdded{GameID: "ABC123", ModeratorID: "co-mod"},
+		ChatMessageSent{Message: &ent.ChatMessage{GameID: "ABC123", ModeratorID: "mod-123", Text: "Night falls"}},
+	} {
+		body, err := json.Marshal(withoutModeratorIDs(e))
+		require.NoError(t, err)
+		assert.NotContains(t, string(body), "mod-123", e.EventType())
+		assert.NotContains(t, string(body), "co-mod", e.EventType())
+	}
+	assert.Equal(t, "mod-123", game.ModeratorID, "the published event is left alone")
+}
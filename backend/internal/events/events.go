@@ -0,0 +1,171 @@
+// Package events carries domain events from the services to whoever wants to
+// react to them, such as the WebSocket hub, the audit log and webhooks.
+package events
+
+import (
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+)
+
+// Type names a kind of event
+type Type string
+
+const (
+	TypeGameCreated              Type = "game_created"
+	TypeGameStatusChanged        Type = "game_status_changed"
+	TypeGameDeleted              Type = "game_deleted"
+	TypeSpectatorSettingsChanged Type = "spectator_settings_changed"
+	TypePhaseChanged             Type = "phase_changed"
+	TypePlayerJoined             Type = "player_joined"
+	TypePlayerLeft               Type = "player_left"
+	TypePlayerUpdated            Type = "player_updated"
+	TypeSeatsUpdated             Type = "seats_updated"
+	TypeRolesDistributed         Type = "roles_distributed"
+	TypeVoteCast                 Type = "vote_cast"
+	TypeNightActionSubmitted     Type = "night_action_submitted"
+	TypeChatMessageSent          Type = "chat_message"
+	TypeModeratorAdded           Type = "moderator_added"
+	TypeModeratorUpdated         Type = "moderator_updated"
+	TypeModeratorRemoved         Type = "moderator_removed"
+	TypeOwnershipTransferred     Type = "ownership_transferred"
+)
+
+// Event is something that happened in a game
+// Events are published after the change is committed.
+type Event interface {
+	EventType() Type
+	EventGameID() string
+}
+
+// GameCreated is published when a moderator creates a game
+type GameCreated struct {
+	Game *ent.Game `json:"game"`
+}
+
+// GameStatusChanged is published when a game starts, ends or changes status otherwise
+type GameStatusChanged struct {
+	Game *ent.Game `json:"game"`
+}
+
+// GameDeleted is published when a game is deleted
+type GameDeleted struct {
+	GameID string `json:"game_id"`
+}
+
+// SpectatorSettingsChanged is published when a moderator changes who may watch a game
+type SpectatorSettingsChanged struct {
+	Game *ent.Game `json:"game"`
+}
+
+// PhaseChanged is published when a game moves between day and night
+type PhaseChanged struct {
+	Game *ent.Game `json:"game"`
+}
+
+// PlayerJoined is published when a player joins a game
+type PlayerJoined struct {
+	Player *ent.Player `json:"player"`
+}
+
+// PlayerLeft is published when a player is removed from a game
+type PlayerLeft struct {
+	GameID   string    `json:"game_id"`
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+// PlayerUpdated is published when a player becomes ready, dies or is revived
+type PlayerUpdated struct {
+	Player *ent.Player `json:"player"`
+}
+
+// SeatsUpdated is published when the seating order of a game changes
+type SeatsUpdated struct {
+	GameID  string        `json:"game_id"`
+	Players []*ent.Player `json:"players"`
+}
+
+// RolesDistributed is published when roles are assigned to the players of a game
+// The assignments themselves are secret and have to be loaded by those allowed to see them.
+type RolesDistributed struct {
+	GameID string `json:"game_id"`
+}
+
+// VoteCast is published when a player votes during the day
+type VoteCast struct {
+	Vote *ent.Vote `json:"vote"`
+}
+
+// NightActionSubmitted is published when a player acts at night
+type NightActionSubmitted struct {
+	Action *ent.NightAction `json:"action"`
+}
+
+// ChatMessageSent is published when a player or moderator sends a chat message
+// Recipients lists the players who may read a private channel, such as the
+// mafia or dead channel; it is nil when everyone in the game may read it.
+type ChatMessageSent struct {
+	Message    *ent.ChatMessage `json:"message"`
+	Recipients []uuid.UUID      `json:"recipients,omitempty"`
+}
+
+// ModeratorAdded is published when the owner of a game adds a co-moderator
+type ModeratorAdded struct {
+	GameID      string   `json:"game_id"`
+	ModeratorID string   `json:"moderator_id,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+// ModeratorUpdated is published when the owner of a game changes a co-moderator's permissions
+type ModeratorUpdated struct {
+	GameID      string   `json:"game_id"`
+	ModeratorID string   `json:"moderator_id,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+// ModeratorRemoved is published when a co-moderator is removed or steps down
+type ModeratorRemoved struct {
+	GameID      string `json:"game_id"`
+	ModeratorID string `json:"moderator_id,omitempty"`
+}
+
+// OwnershipTransferred is published when a game gets a new owner
+type OwnershipTransferred struct {
+	Game          *ent.Game `json:"game"`
+	PreviousOwner string    `json:"previous_owner,omitempty"`
+}
+
+func (e GameCreated) EventType() Type              { return TypeGameCreated }
+func (e GameStatusChanged) EventType() Type        { return TypeGameStatusChanged }
+func (e GameDeleted) EventType() Type              { return TypeGameDeleted }
+func (e SpectatorSettingsChanged) EventType() Type { return TypeSpectatorSettingsChanged }
+func (e PhaseChanged) EventType() Type             { return TypePhaseChanged }
+func (e PlayerJoined) EventType() Type             { return TypePlayerJoined }
+func (e PlayerLeft) EventType() Type               { return TypePlayerLeft }
+func (e PlayerUpdated) EventType() Type            { return TypePlayerUpdated }
+func (e SeatsUpdated) EventType() Type             { return TypeSeatsUpdated }
+func (e RolesDistributed) EventType() Type         { return TypeRolesDistributed }
+func (e VoteCast) EventType() Type                 { return TypeVoteCast }
+func (e NightActionSubmitted) EventType() Type     { return TypeNightActionSubmitted }
+func (e ChatMessageSent) EventType() Type          { return TypeChatMessageSent }
+func (e ModeratorAdded) EventType() Type           { return TypeModeratorAdded }
+func (e ModeratorUpdated) EventType() Type         { return TypeModeratorUpdated }
+func (e ModeratorRemoved) EventType() Type         { return TypeModeratorRemoved }
+func (e OwnershipTransferred) EventType() Type     { return TypeOwnershipTransferred }
+
+func (e GameCreated) EventGameID() string              { return e.Game.ID }
+func (e GameStatusChanged) EventGameID() string        { return e.Game.ID }
+func (e GameDeleted) EventGameID() string              { return e.GameID }
+func (e SpectatorSettingsChanged) EventGameID() string { return e.Game.ID }
+func (e PhaseChanged) EventGameID() string             { return e.Game.ID }
+func (e PlayerJoined) EventGameID() string             { return e.Player.GameID }
+func (e PlayerLeft) EventGameID() string               { return e.GameID }
+func (e PlayerUpdated) EventGameID() string            { return e.Player.GameID }
+func (e SeatsUpdated) EventGameID() string             { return e.GameID }
+func (e RolesDistributed) EventGameID() string         { return e.GameID }
+func (e VoteCast) EventGameID() string                 { return e.Vote.GameID }
+func (e NightActionSubmitted) EventGameID() string     { return e.Action.GameID }
+func (e ChatMessageSent) EventGameID() string          { return e.Message.GameID }
+func (e ModeratorAdded) EventGameID() string           { return e.GameID }
+func (e ModeratorUpdated) EventGameID() string         { return e.GameID }
+func (e ModeratorRemoved) EventGameID() string         { return e.GameID }
+func (e OwnershipTransferred) EventGameID() string     { return e.Game.ID }
@@ -0,0 +1,159 @@
+package events
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/mafia-night/backend/ent"
+)
+
+const (
+	// Number of webhook deliveries waiting to be sent before new ones are dropped
+	webhookQueueSize = 1024
+
+	// Time allowed for a webhook endpoint to answer
+	webhookTimeout = 10 * time.Second
+)
+
+// WebhookPayload is the body posted to webhook endpoints
+type WebhookPayload struct {
+	Type       Type      `json:"type"`
+	GameID     string    `json:"game_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       Event     `json:"data"`
+}
+
+// Webhooks posts every event to a list of URLs
+//
+// Deliveries happen in the background, one at a time, so a slow endpoint never
+// holds up the services. When a secret is set, each request carries an
+// X-Mafia-Signature header with the hex HMAC-SHA256 of the body.
+type Webhooks struct {
+	urls   []string
+	secret []byte
+	client *http.Client
+	queue  chan []byte
+}
+
+// NewWebhooks starts delivering events to urls
+func NewWebhooks(urls []string, secret string) *Webhooks {
+	w := &Webhooks{
+		urls:   urls,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: webhookTimeout},
+		queue:  make(chan []byte, webhookQueueSize),
+	}
+	go w.run()
+	return w
+}
+
+// Handle queues an event for delivery; subscribe it to a bus
+func (w *Webhooks) Handle(e Event) {
+	body, err := json.Marshal(WebhookPayload{
+		Type:       e.EventType(),
+		GameID:     e.EventGameID(),
+		OccurredAt: time.Now(),
+		Data:       withoutModeratorIDs(e),
+	})
+	if err != nil {
+		log.Printf("[Webhook] Error marshaling %s: %v", e.EventType(), err)
+		return
+	}
+
+	select {
+	case w.queue <- body:
+	default:
+		log.Printf("[Webhook] Queue full, dropping %s for game %s", e.EventType(), e.EventGameID())
+	}
+}
+
+// withoutModeratorIDs strips the moderator IDs from an event. A moderator ID
+// is all it takes to run a game or edit the moderator's templates, so it never
+// leaves the server.
+func withoutModeratorIDs(e Event) Event {
+	switch e := e.(type) {
+	case GameCreated:
+		e.Game = gameWithoutModerator(e.Game)
+		return e
+	case GameStatusChanged:
+		e.Game = gameWithoutModerator(e.Game)
+		return e
+	case SpectatorSettingsChanged:
+		e.Game = gameWithoutModerator(e.Game)
+		return e
+	case PhaseChanged:
+		e.Game = gameWithoutModerator(e.Game)
+		return e
+	case OwnershipTransferred:
+		e.Game = gameWithoutModerator(e.Game)
+		e.PreviousOwner = ""
+		return e
+	case ChatMessageSent:
+		message := *e.Message
+		message.ModeratorID = ""
+		e.Message = &message
+		return e
+	case ModeratorAdded:
+		e.ModeratorID = ""
+		return e
+	case ModeratorUpdated:
+		e.ModeratorID = ""
+		return e
+	case ModeratorRemoved:
+		e.ModeratorID = ""
+		return e
+	}
+	return e
+}
+
+func gameWithoutModerator(g *ent.Game) *ent.Game {
+	game := *g
+	game.ModeratorID = ""
+	return &game
+}
+
+func (w *Webhooks) run() {
+	for body := range w.queue {
+		for _, url := range w.urls {
+			if err := w.post(url, body); err != nil {
+				log.Printf("[Webhook] Delivery to %s failed: %v", url, err)
+			}
+		}
+	}
+}
+
+func (w *Webhooks) post(url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) > 0 {
+		req.Header.Set("X-Mafia-Signature", Sign(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body, as sent in X-Mafia-Signature
+func Sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
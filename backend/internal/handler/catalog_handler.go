@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mafia-night/backend/internal/catalog"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+// maxCatalogSize is the largest catalog document accepted for import
+const maxCatalogSize = 10 << 20
+
+// CatalogHandler handles exporting and importing the role catalog
+type CatalogHandler struct {
+	catalogService *service.CatalogService
+}
+
+// NewCatalogHandler creates a new catalog handler
+func NewCatalogHandler(catalogService *service.CatalogService) *CatalogHandler {
+	return &CatalogHandler{catalogService: catalogService}
+}
+
+// ExportCatalog handles GET /api/admin/catalog
+// The format query parameter picks yaml or json; without it, YAML is sent
+// when the Accept header asks for it and JSON otherwise.
+func (h *CatalogHandler) ExportCatalog(w http.ResponseWriter, r *http.Request) {
+	format := catalog.JSON
+	if name := r.URL.Query().Get("format"); name != "" {
+		parsed, err := catalog.ParseFormat(name)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		format = parsed
+	} else if strings.Contains(r.Header.Get("Accept"), "yaml") {
+		format = catalog.YAML
+	}
+
+	doc, err := h.catalogService.Export(r.Context())
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to export catalog")
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="catalog.`+string(format)+`"`)
+	w.WriteHeader(http.StatusOK)
+	catalog.Encode(w, doc, format)
+}
+
+// ImportCatalog handles POST /api/admin/catalog/import
+// The body is a YAML or JSON document, told apart by the format query
+// parameter or the Content-Type header. dry_run=true reports the changes
+// without keeping them; prune=true deletes what the document leaves out.
+func (h *CatalogHandler) ImportCatalog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	format := catalog.JSON
+	if name := query.Get("format"); name != "" {
+		parsed, err := catalog.ParseFormat(name)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		format = parsed
+	} else if parsed, err := catalog.ParseFormat(r.Header.Get("Content-Type")); err == nil {
+		format = parsed
+	}
+
+	var opts service.ImportOptions
+	for name, target := range map[string]*bool{"dry_run": &opts.DryRun, "prune": &opts.Prune} {
+		if value := query.Get(name); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				ErrorResponse(w, http.StatusBadRequest, "invalid "+name+" parameter")
+				return
+			}
+			*target = parsed
+		}
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCatalogSize))
+	if err != nil {
+		ErrorResponse(w, http.StatusRequestEntityTooLarge, "catalog is too large")
+		return
+	}
+
+	doc, err := catalog.Decode(bytes.NewReader(body), format)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := h.catalogService.Import(revisionContext(r), doc, opts)
+	if err != nil {
+		if errors.Is(err, catalog.ErrInvalidDocument) {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, service.ErrCatalogConflict) {
+			ErrorResponse(w, http.StatusConflict, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, "failed to import catalog")
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, importReportToJSON(report))
+}
+
+// importReportToJSON converts an import report to JSON
+func importReportToJSON(report *service.ImportReport) map[string]any {
+	changes := make([]map[string]any, len(report.Changes))
+	counts := map[service.CatalogAction]int{}
+	for i, c := range report.Changes {
+		change := map[string]any{
+			"kind":   c.Kind,
+			"key":    c.Key,
+			"action": c.Action,
+		}
+		if len(c.Fields) > 0 {
+			change["fields"] = c.Fields
+		}
+		changes[i] = change
+		counts[c.Action]++
+	}
+
+	return map[string]any{
+		"dry_run":   report.DryRun,
+		"changes":   changes,
+		"created":   counts[service.CatalogCreate],
+		"updated":   counts[service.CatalogUpdate],
+		"deleted":   counts[service.CatalogDelete],
+		"unchanged": report.Unchanged,
+	}
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/internal/catalog"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	handler := NewCatalogHandler(service.NewCatalogService(client))
+	ctx := context.Background()
+
+	_, err := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "", role.TeamMafia, nil)
+	require.NoError(t, err)
+
+	t.Run("exports YAML when asked", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/admin/catalog?format=yaml", nil)
+		w := httptest.NewRecorder()
+		handler.ExportCatalog(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/yaml", w.Header().Get("Content-Type"))
+
+		doc, err := catalog.Decode(w.Body, catalog.YAML)
+		require.NoError(t, err)
+		require.Len(t, doc.Roles, 1)
+		assert.Equal(t, "mafia", doc.Roles[0].Slug)
+	})
+
+	t.Run("imports YAML with a dry run", func(t *testing.T) {
+		body := "version: 1\nroles:\n  - slug: mafia\n    name: Mafia\n    team: mafia\n    video: video\n  - slug: villager\n    name: Villager\n    team: village\n"
+		req := httptest.NewRequest(http.MethodPost, "/api/admin/catalog/import?dry_run=true", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/yaml")
+		w := httptest.NewRecorder()
+		handler.ImportCatalog(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var report map[string]any
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+		assert.Equal(t, true, report["dry_run"])
+		assert.Equal(t, float64(1), report["created"])
+		assert.Equal(t, float64(1), report["unchanged"])
+
+		roles, err := roleService.GetAllRoles(ctx)
+		require.NoError(t, err)
+		assert.Len(t, roles, 1)
+	})
+
+	t.Run("rejects bad documents", func(t *testing.T) {
+		for body, want := range map[string]string{
+			`{"version": 7}`: "unsupported catalog version",
+			`{"version": 1, "roles": [{"slug": "ghost", "name": "Ghost", "team": "spirits"}]}`: "team must be",
+			`not json`: "invalid catalog document",
+		} {
+			req := httptest.NewRequest(http.MethodPost, "/api/admin/catalog/import", strings.NewReader(body))
+			w := httptest.NewRecorder()
+			handler.ImportCatalog(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+			assert.Contains(t, w.Body.String(), want, body)
+		}
+	})
+}
@@ -4,21 +4,39 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/projection"
 	"github.com/mafia-night/backend/internal/service"
 )
 
 // GameHandler handles game-related HTTP requests
 type GameHandler struct {
 	gameService *service.GameService
+	tokens      *auth.JWTService
+	presence    PresenceTracker
+}
+
+// PresenceTracker reports which players of a game are connected
+type PresenceTracker interface {
+	Presence(gameID string) map[uuid.UUID]Presence
 }
 
 // NewGameHandler creates a new game handler
-func NewGameHandler(gameService *service.GameService) *GameHandler {
-	return &GameHandler{gameService: gameService}
+// tokens issues the game tokens that authenticate WebSocket connections.
+func NewGameHandler(gameService *service.GameService, tokens *auth.JWTService) *GameHandler {
+	return &GameHandler{gameService: gameService, tokens: tokens}
+}
+
+// WithPresence makes the player list show who is connected, as seen by tracker
+func (h *GameHandler) WithPresence(tracker PresenceTracker) *GameHandler {
+	h.presence = tracker
+	return h
 }
 
 // CreateGame handles POST /api/games
@@ -35,7 +53,72 @@ func (h *GameHandler) CreateGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	JSONResponse(w, http.StatusCreated, gameToJSON(game))
+	token, err := h.tokens.GenerateGameToken(game.ID, auth.GameTokenModerator, moderatorID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to issue moderator token")
+		return
+	}
+
+	response := projection.Game(game, projection.ModeratorViewer(moderatorID, true))
+	response["token"] = token
+
+	JSONResponse(w, http.StatusCreated, response)
+}
+
+// RefreshToken handles POST /api/games/{id}/token
+// Game tokens are handed out when creating, joining or spectating a game, and
+// to co-moderators when they are added. This exchanges a valid one, sent as a
+// bearer token, for a fresh token for the same player, moderator or spectator.
+func (h *GameHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+
+	token := bearerToken(r)
+	if token == "" {
+		ErrorResponse(w, http.StatusUnauthorized, "game token required")
+		return
+	}
+
+	claims, err := h.tokens.ValidateGameToken(token, gameID)
+	if err != nil {
+		ErrorResponse(w, http.StatusUnauthorized, "invalid game token")
+		return
+	}
+
+	game, err := h.gameService.GetGameByID(r.Context(), gameID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "game not found")
+		return
+	}
+
+	moderatorID, playerID, spectatorID, ok := tokenIdentity(claims)
+	if !ok {
+		ErrorResponse(w, http.StatusUnauthorized, "invalid game token")
+		return
+	}
+
+	// The token may outlive the identity, such as a removed player or co-moderator
+	if _, err := h.gameService.ResolveViewer(r.Context(), game, moderatorID, playerID, spectatorID); err != nil {
+		if errors.Is(err, service.ErrNotAuthorized) ||
+			errors.Is(err, service.ErrPlayerNotInGame) ||
+			errors.Is(err, service.ErrSpectatorNotFound) ||
+			ent.IsNotFound(err) {
+			ErrorResponse(w, http.StatusForbidden, "not allowed to join this game")
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	refreshed, err := h.tokens.GenerateGameToken(game.ID, claims.Role, claims.Subject)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to issue token")
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, map[string]any{
+		"token": refreshed,
+		"role":  claims.Role,
+	})
 }
 
 // GetGame handles GET /api/games/{id}
@@ -52,7 +135,7 @@ func (h *GameHandler) GetGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	JSONResponse(w, http.StatusOK, gameToJSON(game))
+	JSONResponse(w, http.StatusOK, projection.Game(game, projection.PublicViewer()))
 }
 
 // UpdateGameStatus handles PATCH /api/games/{id}
@@ -88,7 +171,7 @@ func (h *GameHandler) UpdateGameStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	JSONResponse(w, http.StatusOK, gameToJSON(updated))
+	JSONResponse(w, http.StatusOK, projection.Game(updated, projection.ModeratorViewer(moderatorID, true)))
 }
 
 // DeleteGame handles DELETE /api/games/{id}
@@ -131,7 +214,7 @@ func (h *GameHandler) JoinGame(w http.ResponseWriter, r *http.Request) {
 
 	player, err := h.gameService.JoinGame(r.Context(), gameID, req.Name)
 	if err != nil {
-		if errors.Is(err, service.ErrPlayerNameExists) {
+		if errors.Is(err, service.ErrPlayerNameExists) || errors.Is(err, service.ErrTableBusy) {
 			ErrorResponse(w, http.StatusConflict, err.Error())
 			return
 		}
@@ -151,14 +234,24 @@ func (h *GameHandler) JoinGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	JSONResponse(w, http.StatusOK, playerToJSON(player))
+	token, err := h.tokens.GenerateGameToken(gameID, auth.GameTokenPlayer, player.ID.String())
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to issue player token")
+		return
+	}
+
+	// The token is only ever returned to the player who joined
+	response := playerView(player, projection.PlayerViewer(player.ID))
+	response["token"] = token
+
+	JSONResponse(w, http.StatusOK, response)
 }
 
 // GetPlayers handles GET /api/games/{id}/players
 func (h *GameHandler) GetPlayers(w http.ResponseWriter, r *http.Request) {
 	gameID := chi.URLParam(r, "id")
 
-	players, err := h.gameService.GetPlayers(r.Context(), gameID)
+	state, err := h.gameService.GetGameState(r.Context(), gameID)
 	if err != nil {
 		if errors.Is(err, service.ErrEmptyGameID) {
 			ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -168,12 +261,300 @@ func (h *GameHandler) GetPlayers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	playersJSON := make([]map[string]any, len(players))
-	for i, player := range players {
-		playersJSON[i] = playerToJSON(player)
+	viewer, ok := h.resolveViewer(w, r, state.Game)
+	if !ok {
+		return
+	}
+
+	players := projection.Players(state, viewer)
+	if h.presence != nil {
+		players = withPresence(players, h.presence.Presence(gameID))
+	}
+
+	JSONResponse(w, http.StatusOK, players)
+}
+
+// JoinAsSpectator handles POST /api/games/{id}/spectate
+func (h *GameHandler) JoinAsSpectator(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	var req struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	spectator, err := h.gameService.JoinAsSpectator(r.Context(), gameID, req.Name)
+	if err != nil {
+		if errors.Is(err, service.ErrSpectatorsDisabled) {
+			ErrorResponse(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, service.ErrEmptyGameID) || errors.Is(err, service.ErrEmptyUserID) {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusNotFound, "game not found")
+		return
+	}
+
+	token, err := h.tokens.GenerateGameToken(gameID, auth.GameTokenSpectator, spectator.ID.String())
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to issue spectator token")
+		return
+	}
+
+	response := spectatorToJSON(spectator)
+	response["token"] = token
+
+	JSONResponse(w, http.StatusCreated, response)
+}
+
+// GetSpectators handles GET /api/games/{id}/spectators
+func (h *GameHandler) GetSpectators(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	spectators, err := h.gameService.GetSpectators(r.Context(), gameID, moderatorID)
+	if err != nil {
+		writeSpectatorError(w, err)
+		return
+	}
+
+	spectatorsJSON := make([]map[string]any, len(spectators))
+	for i, spectator := range spectators {
+		spectatorsJSON[i] = spectatorToJSON(spectator)
+	}
+
+	JSONResponse(w, http.StatusOK, spectatorsJSON)
+}
+
+// RemoveSpectator handles DELETE /api/games/{id}/spectators/{spectator_id}
+// Moderators who may manage players remove any spectator; a spectator may
+// only remove themselves.
+func (h *GameHandler) RemoveSpectator(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	spectatorID := chi.URLParam(r, "spectator_id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+	requesterID := r.Header.Get("X-Spectator-ID")
+
+	var err error
+	switch {
+	case moderatorID != "":
+		err = h.gameService.RemoveSpectator(r.Context(), gameID, moderatorID, spectatorID)
+	case requesterID != "":
+		if requesterID != spectatorID {
+			ErrorResponse(w, http.StatusForbidden, "spectators can only remove themselves")
+			return
+		}
+		err = h.gameService.LeaveAsSpectator(r.Context(), gameID, spectatorID)
+	default:
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID or X-Spectator-ID header is required")
+		return
+	}
+	if err != nil {
+		writeSpectatorError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeSpectatorError maps spectator errors to HTTP responses
+func writeSpectatorError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrNotAuthorized) {
+		ErrorResponse(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, service.ErrEmptyGameID) ||
+		errors.Is(err, service.ErrEmptyModeratorID) ||
+		errors.Is(err, service.ErrEmptySpectatorID) {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	ErrorResponse(w, http.StatusNotFound, "game or spectator not found")
+}
+
+// UpdateSpectatorSettings handles PATCH /api/games/{id}/spectator-settings
+func (h *GameHandler) UpdateSpectatorSettings(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req struct {
+		Mode         game.SpectatorMode `json:"spectator_mode"`
+		DelaySeconds int                `json:"spectator_delay_seconds"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	delay := time.Duration(req.DelaySeconds) * time.Second
+	updated, err := h.gameService.UpdateSpectatorSettings(r.Context(), gameID, moderatorID, req.Mode, delay)
+	if err != nil {
+		if errors.Is(err, service.ErrNotAuthorized) {
+			ErrorResponse(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, service.ErrInvalidSpectatorMode) ||
+			errors.Is(err, service.ErrInvalidSpectatorDelay) ||
+			errors.Is(err, service.ErrEmptyGameID) ||
+			errors.Is(err, service.ErrEmptyModeratorID) {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusNotFound, "game not found")
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, projection.Game(updated, projection.ModeratorViewer(moderatorID, true)))
+}
+
+// ArrangeSeats handles PUT /api/games/{id}/seats
+func (h *GameHandler) ArrangeSeats(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
 	}
 
-	JSONResponse(w, http.StatusOK, playersJSON)
+	var req struct {
+		PlayerIDs []string `json:"player_ids"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	players, err := h.gameService.ArrangeSeats(r.Context(), gameID, moderatorID, req.PlayerIDs)
+	if err != nil {
+		writeSeatError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, playersView(players, projection.ModeratorViewer(moderatorID, false)))
+}
+
+// RandomizeSeats handles POST /api/games/{id}/seats/randomize
+func (h *GameHandler) RandomizeSeats(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	players, err := h.gameService.RandomizeSeats(r.Context(), gameID, moderatorID)
+	if err != nil {
+		writeSeatError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, playersView(players, projection.ModeratorViewer(moderatorID, false)))
+}
+
+// writeSeatError maps seating errors to HTTP responses
+func writeSeatError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrNotAuthorized) {
+		ErrorResponse(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, service.ErrInvalidSeatOrder) ||
+		errors.Is(err, service.ErrEmptyGameID) ||
+		errors.Is(err, service.ErrEmptyModeratorID) {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	ErrorResponse(w, http.StatusNotFound, "game not found")
+}
+
+// GetNeighbors handles GET /api/games/{id}/players/{player_id}/neighbors
+func (h *GameHandler) GetNeighbors(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	playerID := chi.URLParam(r, "player_id")
+
+	left, right, err := h.gameService.GetNeighbors(r.Context(), gameID, playerID)
+	if err != nil {
+		if errors.Is(err, service.ErrEmptyGameID) || errors.Is(err, service.ErrEmptyPlayerID) {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusNotFound, "game or player not found")
+		return
+	}
+
+	response := map[string]any{
+		"left":  nil,
+		"right": nil,
+	}
+	if left != nil {
+		response["left"] = playerView(left, projection.PublicViewer())
+	}
+	if right != nil {
+		response["right"] = playerView(right, projection.PublicViewer())
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// UpdatePlayer handles PATCH /api/games/{id}/players/{player_id}
+func (h *GameHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	playerID := chi.URLParam(r, "player_id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req struct {
+		Alive *bool `json:"alive"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Alive == nil {
+		ErrorResponse(w, http.StatusBadRequest, "alive is required")
+		return
+	}
+
+	updated, err := h.gameService.SetPlayerAlive(r.Context(), gameID, moderatorID, playerID, *req.Alive)
+	if err != nil {
+		if errors.Is(err, service.ErrNotAuthorized) {
+			ErrorResponse(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, service.ErrEmptyGameID) ||
+			errors.Is(err, service.ErrEmptyModeratorID) ||
+			errors.Is(err, service.ErrEmptyPlayerID) {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusNotFound, "game or player not found")
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, playerView(updated, projection.ModeratorViewer(moderatorID, false)))
 }
 
 // RemovePlayer handles DELETE /api/games/{id}/players/{player_id}
@@ -194,23 +575,30 @@ func (h *GameHandler) RemovePlayer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// gameToJSON converts an ent.Game to a JSON-serializable map
-func gameToJSON(g *ent.Game) map[string]any {
+func spectatorToJSON(s *ent.Spectator) map[string]any {
 	return map[string]any{
-		"id":           g.ID,
-		"moderator_id": g.ModeratorID,
-		"status":       g.Status,
-		"created_at":   g.CreatedAt,
+		"id":         s.ID,
+		"name":       s.Name,
+		"game_id":    s.GameID,
+		"created_at": s.CreatedAt,
 	}
 }
 
-func playerToJSON(p *ent.Player) map[string]any {
-	return map[string]any{
-		"id":     p.ID,
-		"name":   p.Name,
-		"game_id": p.GameID,
-		"created_at": p.CreatedAt,
-	}
+// resolveViewer works out who is making a request from its game token.
+// It writes an error response and reports false if the token is not valid for the game.
+func (h *GameHandler) resolveViewer(w http.ResponseWriter, r *http.Request, g *ent.Game) (projection.Viewer, bool) {
+	return authenticateViewer(w, r, h.tokens, h.gameService, g)
+}
+
+// playerView projects a player outside the context of a full game state,
+// so no role is ever included
+func playerView(p *ent.Player, v projection.Viewer) map[string]any {
+	return projection.Player(&projection.State{Players: []*ent.Player{p}}, p, v)
+}
+
+// playersView projects players outside the context of a full game state
+func playersView(players []*ent.Player, v projection.Viewer) []map[string]any {
+	return projection.Players(&projection.State{Players: players}, v)
 }
 
 // DistributeRoles handles POST /api/games/{id}/distribute-roles
@@ -238,7 +626,9 @@ func (h *GameHandler) DistributeRoles(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusForbidden, err.Error())
 			return
 		}
-		if errors.Is(err, service.ErrInvalidRoleCount) {
+		if errors.Is(err, service.ErrInvalidRoleCount) ||
+			errors.Is(err, service.ErrRoleRuleViolated) ||
+			errors.Is(err, service.ErrRoleArchived) {
 			ErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
@@ -260,37 +650,49 @@ func (h *GameHandler) DistributeRoles(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetPlayerRole handles GET /api/games/{id}/players/{player_id}/role
+// The viewer comes from the game token; requests without one see no roles.
 func (h *GameHandler) GetPlayerRole(w http.ResponseWriter, r *http.Request) {
 	gameID := chi.URLParam(r, "id")
 	playerID := chi.URLParam(r, "player_id")
 
-	gameRole, err := h.gameService.GetPlayerRole(r.Context(), gameID, playerID)
+	if gameID == "" {
+		ErrorResponse(w, http.StatusBadRequest, service.ErrEmptyGameID.Error())
+		return
+	}
+	if playerID == "" {
+		ErrorResponse(w, http.StatusBadRequest, service.ErrEmptyPlayerID.Error())
+		return
+	}
+
+	playerUUID, err := uuid.Parse(playerID)
 	if err != nil {
-		if errors.Is(err, service.ErrEmptyGameID) || errors.Is(err, service.ErrEmptyPlayerID) {
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
 		ErrorResponse(w, http.StatusNotFound, "role not assigned or player not found")
 		return
 	}
 
-	// Get the role information
-	role := gameRole.Edges.Role
-	if role == nil {
-		ErrorResponse(w, http.StatusInternalServerError, "role information not found")
+	state, err := h.gameService.GetGameState(r.Context(), gameID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "role not assigned or player not found")
 		return
 	}
 
-	JSONResponse(w, http.StatusOK, map[string]any{
-		"id":          role.ID,
-		"name":        role.Name,
-		"slug":        role.Slug,
-		"video":       role.Video,
-		"description": role.Description,
-		"team":        role.Team,
-		"abilities":   role.Abilities,
-		"assigned_at": gameRole.AssignedAt,
-	})
+	viewer, ok := h.resolveViewer(w, r, state.Game)
+	if !ok {
+		return
+	}
+
+	if !projection.CanSeeRole(state, viewer, playerUUID) {
+		ErrorResponse(w, http.StatusForbidden, "not allowed to view this role")
+		return
+	}
+
+	role, ok := projection.PlayerRole(state, viewer, playerUUID)
+	if !ok {
+		ErrorResponse(w, http.StatusNotFound, "role not assigned or player not found")
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, role)
 }
 
 // GetGameRoles handles GET /api/games/{id}/roles (moderator view)
@@ -303,8 +705,8 @@ func (h *GameHandler) GetGameRoles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	gameRoles, err := h.gameService.GetGameRoles(r.Context(), gameID, moderatorID)
-	if err != nil {
+	// GetGameRoles checks the moderator may view roles
+	if _, err := h.gameService.GetGameRoles(r.Context(), gameID, moderatorID); err != nil {
 		if errors.Is(err, service.ErrNotAuthorized) {
 			ErrorResponse(w, http.StatusForbidden, err.Error())
 			return
@@ -317,26 +719,11 @@ func (h *GameHandler) GetGameRoles(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Transform to JSON response grouped by team
-	response := make([]map[string]any, 0, len(gameRoles))
-	for _, gameRole := range gameRoles {
-		player := gameRole.Edges.Player
-		role := gameRole.Edges.Role
-
-		if player != nil && role != nil {
-			response = append(response, map[string]any{
-				"player_id":   player.ID,
-				"player_name": player.Name,
-				"role_id":     role.ID,
-				"role_name":   role.Name,
-				"role_slug":   role.Slug,
-				"video":       role.Video,
-				"team":        role.Team,
-				"assigned_at": gameRole.AssignedAt,
-			})
-		}
+	state, err := h.gameService.GetGameState(r.Context(), gameID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "game not found")
+		return
 	}
 
-	JSONResponse(w, http.StatusOK, response)
+	JSONResponse(w, http.StatusOK, projection.Assignments(state, projection.ModeratorViewer(moderatorID, true)))
 }
-
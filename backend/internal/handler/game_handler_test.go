@@ -2,23 +2,31 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/internal/auth"
 	"github.com/mafia-night/backend/internal/database"
 	"github.com/mafia-night/backend/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// testTokens issues and validates game tokens in handler tests
+var testTokens = auth.NewJWTService("test-secret", "test")
+
 func TestCreateGameHandler(t *testing.T) {
 	client := database.SetupTestDB(t)
 	gameService := service.NewGameService(client)
-	handler := NewGameHandler(gameService)
+	handler := NewGameHandler(gameService, testTokens)
 
 	t.Run("creates game successfully", func(t *testing.T) {
 		req := httptest.NewRequest("POST", "/api/games", nil)
@@ -56,7 +64,7 @@ func TestCreateGameHandler(t *testing.T) {
 func TestGetGameHandler(t *testing.T) {
 	client := database.SetupTestDB(t)
 	gameService := service.NewGameService(client)
-	handler := NewGameHandler(gameService)
+	handler := NewGameHandler(gameService, testTokens)
 
 	t.Run("retrieves game successfully", func(t *testing.T) {
 		// Create a game first
@@ -80,7 +88,7 @@ func TestGetGameHandler(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, created.ID, response["id"])
-		assert.Equal(t, "mod-123", response["moderator_id"])
+		assert.NotContains(t, response, "moderator_id", "the moderator ID is not public")
 	})
 
 	t.Run("returns 404 for non-existent game", func(t *testing.T) {
@@ -99,7 +107,7 @@ func TestGetGameHandler(t *testing.T) {
 func TestUpdateGameStatusHandler(t *testing.T) {
 	client := database.SetupTestDB(t)
 	gameService := service.NewGameService(client)
-	handler := NewGameHandler(gameService)
+	handler := NewGameHandler(gameService, testTokens)
 
 	t.Run("updates game status successfully", func(t *testing.T) {
 		// Create a game
@@ -173,7 +181,7 @@ func TestUpdateGameStatusHandler(t *testing.T) {
 func TestDeleteGameHandler(t *testing.T) {
 	client := database.SetupTestDB(t)
 	gameService := service.NewGameService(client)
-	handler := NewGameHandler(gameService)
+	handler := NewGameHandler(gameService, testTokens)
 
 	t.Run("deletes game successfully", func(t *testing.T) {
 		req := httptest.NewRequest("POST", "/", nil)
@@ -229,7 +237,7 @@ func TestDeleteGameHandler(t *testing.T) {
 func TestJoinGameHandler(t *testing.T) {
 	client := database.SetupTestDB(t)
 	gameService := service.NewGameService(client)
-	handler := NewGameHandler(gameService)
+	handler := NewGameHandler(gameService, testTokens)
 
 	t.Run("joins game successfully", func(t *testing.T) {
 		req := httptest.NewRequest("POST", "/", nil)
@@ -253,6 +261,11 @@ func TestJoinGameHandler(t *testing.T) {
 		var response map[string]any
 		json.NewDecoder(rr.Body).Decode(&response)
 		assert.Equal(t, "player1", response["name"])
+
+		token, _ := response["token"].(string)
+		claims, err := testTokens.ValidateGameToken(token, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, response["id"], claims.Subject)
 	})
 
 	t.Run("fails without player name", func(t *testing.T) {
@@ -324,7 +337,7 @@ func TestJoinGameHandler(t *testing.T) {
 func TestGetPlayersHandler(t *testing.T) {
 	client := database.SetupTestDB(t)
 	gameService := service.NewGameService(client)
-	handler := NewGameHandler(gameService)
+	handler := NewGameHandler(gameService, testTokens)
 
 	t.Run("returns all players in a game", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/", nil)
@@ -385,12 +398,57 @@ func TestGetPlayersHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusNotFound, rr.Code)
 	})
+
+	t.Run("includes presence", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+		online, err := gameService.JoinGame(req.Context(), created.ID, "player1")
+		require.NoError(t, err)
+		_, err = gameService.JoinGame(req.Context(), created.ID, "player2")
+		require.NoError(t, err)
+
+		seen := time.Now().UTC().Truncate(time.Second)
+		tracker := staticPresence{online.ID: {Online: true, LastSeen: seen}}
+
+		r := chi.NewRouter()
+		r.Get("/api/games/{id}/players", NewGameHandler(gameService, testTokens).WithPresence(tracker).GetPlayers)
+
+		req = httptest.NewRequest("GET", "/api/games/"+created.ID+"/players", nil)
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response []map[string]any
+		err = json.NewDecoder(rr.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Len(t, response, 2)
+
+		for _, p := range response {
+			if p["id"] == online.ID.String() {
+				assert.Equal(t, true, p["online"])
+				assert.Equal(t, seen.Format(time.RFC3339), p["last_seen_at"])
+			} else {
+				assert.Equal(t, false, p["online"])
+				assert.Nil(t, p["last_seen_at"])
+			}
+		}
+	})
+}
+
+// staticPresence reports a fixed presence for every game
+type staticPresence map[uuid.UUID]Presence
+
+func (p staticPresence) Presence(gameID string) map[uuid.UUID]Presence {
+	return p
 }
 
 func TestRemovePlayerHandler(t *testing.T) {
 	client := database.SetupTestDB(t)
 	gameService := service.NewGameService(client)
-	handler := NewGameHandler(gameService)
+	handler := NewGameHandler(gameService, testTokens)
 
 	t.Run("removes player successfully", func(t *testing.T) {
 		req := httptest.NewRequest("DELETE", "/", nil)
@@ -445,3 +503,389 @@ func TestRemovePlayerHandler(t *testing.T) {
 	})
 }
 
+
+func TestArrangeSeatsHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	handler := NewGameHandler(gameService, testTokens)
+
+	t.Run("arranges seats successfully", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		p1, err := gameService.JoinGame(req.Context(), created.ID, "player1")
+		require.NoError(t, err)
+		p2, err := gameService.JoinGame(req.Context(), created.ID, "player2")
+		require.NoError(t, err)
+
+		body := map[string][]string{"player_ids": {p2.ID.String(), p1.ID.String()}}
+		bodyBytes, _ := json.Marshal(body)
+
+		r := chi.NewRouter()
+		r.Put("/api/games/{id}/seats", handler.ArrangeSeats)
+
+		req = httptest.NewRequest("PUT", "/api/games/"+created.ID+"/seats", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Moderator-ID", "mod-123")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response []map[string]any
+		err = json.NewDecoder(rr.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Len(t, response, 2)
+		assert.Equal(t, "player2", response[0]["name"])
+		assert.Equal(t, float64(0), response[0]["seat"])
+		assert.Equal(t, "player1", response[1]["name"])
+	})
+
+	t.Run("fails with wrong moderator", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		bodyBytes, _ := json.Marshal(map[string][]string{"player_ids": {}})
+
+		r := chi.NewRouter()
+		r.Put("/api/games/{id}/seats", handler.ArrangeSeats)
+
+		req = httptest.NewRequest("PUT", "/api/games/"+created.ID+"/seats", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Moderator-ID", "wrong-mod")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("fails with invalid seat order", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		_, err = gameService.JoinGame(req.Context(), created.ID, "player1")
+		require.NoError(t, err)
+
+		bodyBytes, _ := json.Marshal(map[string][]string{"player_ids": {"not-a-player"}})
+
+		r := chi.NewRouter()
+		r.Put("/api/games/{id}/seats", handler.ArrangeSeats)
+
+		req = httptest.NewRequest("PUT", "/api/games/"+created.ID+"/seats", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Moderator-ID", "mod-123")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestJoinAsSpectatorHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	handler := NewGameHandler(gameService, testTokens)
+
+	t.Run("joins as spectator successfully", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		bodyBytes, _ := json.Marshal(map[string]string{"name": "friend"})
+
+		r := chi.NewRouter()
+		r.Post("/api/games/{id}/spectate", handler.JoinAsSpectator)
+
+		req = httptest.NewRequest("POST", "/api/games/"+created.ID+"/spectate", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+
+		var response map[string]any
+		json.NewDecoder(rr.Body).Decode(&response)
+		assert.Equal(t, "friend", response["name"])
+		assert.NotEmpty(t, response["id"])
+	})
+
+	t.Run("fails when spectators are disabled", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		_, err = gameService.UpdateSpectatorSettings(req.Context(), created.ID, "mod-123", game.SpectatorModeDisabled, 0)
+		require.NoError(t, err)
+
+		bodyBytes, _ := json.Marshal(map[string]string{"name": "friend"})
+
+		r := chi.NewRouter()
+		r.Post("/api/games/{id}/spectate", handler.JoinAsSpectator)
+
+		req = httptest.NewRequest("POST", "/api/games/"+created.ID+"/spectate", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestSpectatorsHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	handler := NewGameHandler(gameService, testTokens)
+	ctx := context.Background()
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/api/games/{id}/spectators", handler.GetSpectators)
+	r.Delete("/api/games/{id}/spectators/{spectator_id}", handler.RemoveSpectator)
+
+	do := func(method, url string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, url, nil)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		return rr
+	}
+	url := "/api/games/" + created.ID + "/spectators"
+
+	t.Run("lists spectators for the moderator only", func(t *testing.T) {
+		_, err := gameService.JoinAsSpectator(ctx, created.ID, "friend")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, do("GET", url, nil).Code)
+		assert.Equal(t, http.StatusForbidden, do("GET", url, map[string]string{"X-Moderator-ID": "different-mod"}).Code)
+
+		rr := do("GET", url, map[string]string{"X-Moderator-ID": "mod-123"})
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("only the moderator removes other spectators", func(t *testing.T) {
+		spectator, err := gameService.JoinAsSpectator(ctx, created.ID, "friend")
+		require.NoError(t, err)
+		other, err := gameService.JoinAsSpectator(ctx, created.ID, "stranger")
+		require.NoError(t, err)
+		spectatorURL := url + "/" + spectator.ID.String()
+
+		assert.Equal(t, http.StatusBadRequest, do("DELETE", spectatorURL, nil).Code)
+		assert.Equal(t, http.StatusForbidden, do("DELETE", spectatorURL, map[string]string{"X-Moderator-ID": "different-mod"}).Code)
+		assert.Equal(t, http.StatusForbidden, do("DELETE", spectatorURL, map[string]string{"X-Spectator-ID": other.ID.String()}).Code)
+
+		assert.Equal(t, http.StatusNoContent, do("DELETE", spectatorURL, map[string]string{"X-Moderator-ID": "mod-123"}).Code)
+	})
+
+	t.Run("spectators remove themselves", func(t *testing.T) {
+		spectator, err := gameService.JoinAsSpectator(ctx, created.ID, "leaver")
+		require.NoError(t, err)
+		spectatorURL := url + "/" + spectator.ID.String()
+
+		assert.Equal(t, http.StatusNoContent, do("DELETE", spectatorURL, map[string]string{"X-Spectator-ID": spectator.ID.String()}).Code)
+		assert.Equal(t, http.StatusNotFound, do("DELETE", spectatorURL, map[string]string{"X-Spectator-ID": spectator.ID.String()}).Code)
+	})
+}
+
+func TestRefreshTokenHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	handler := NewGameHandler(gameService, testTokens)
+
+	req := httptest.NewRequest("POST", "/", nil)
+	created, err := gameService.CreateGame(req.Context(), "mod-123")
+	require.NoError(t, err)
+	player, err := gameService.JoinGame(req.Context(), created.ID, "Alice")
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Post("/api/games/{id}/token", handler.RefreshToken)
+
+	refresh := func(gameID string, token string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/api/games/"+gameID+"/token", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		return rr
+	}
+
+	playerToken, err := testTokens.GenerateGameToken(created.ID, auth.GameTokenPlayer, player.ID.String())
+	require.NoError(t, err)
+
+	t.Run("refreshes a player token", func(t *testing.T) {
+		rr := refresh(created.ID, playerToken, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response map[string]string
+		json.NewDecoder(rr.Body).Decode(&response)
+		assert.Equal(t, string(auth.GameTokenPlayer), response["role"])
+
+		claims, err := testTokens.ValidateGameToken(response["token"], created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, player.ID.String(), claims.Subject)
+	})
+
+	t.Run("identity headers do not get a token", func(t *testing.T) {
+		rr := refresh(created.ID, "", map[string]string{"X-Player-ID": player.ID.String()})
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		rr = refresh(created.ID, "", map[string]string{"X-Moderator-ID": "mod-123"})
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("fails with an invalid token", func(t *testing.T) {
+		rr := refresh(created.ID, "not-a-token", nil)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("fails with a token for another game", func(t *testing.T) {
+		other, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		rr := refresh(other.ID, playerToken, nil)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("fails once the player was removed", func(t *testing.T) {
+		removed, err := gameService.JoinGame(req.Context(), created.ID, "Bob")
+		require.NoError(t, err)
+		token, err := testTokens.GenerateGameToken(created.ID, auth.GameTokenPlayer, removed.ID.String())
+		require.NoError(t, err)
+		require.NoError(t, gameService.RemovePlayer(req.Context(), created.ID, removed.ID.String()))
+
+		rr := refresh(created.ID, token, nil)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestGetPlayerRoleHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	roleService := service.NewRoleService(client)
+	handler := NewGameHandler(gameService, testTokens)
+
+	req := httptest.NewRequest("GET", "/", nil)
+	ctx := req.Context()
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	alice, err := gameService.JoinGame(ctx, created.ID, "Alice")
+	require.NoError(t, err)
+	bob, err := gameService.JoinGame(ctx, created.ID, "Bob")
+	require.NoError(t, err)
+
+	villager, err := roleService.CreateRole(ctx, "Projected Villager", "projected-villager", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	err = gameService.DistributeRoles(ctx, created.ID, "mod-123", []service.RoleSelection{
+		{RoleID: villager.ID.String(), Count: 2},
+	})
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/api/games/{id}/players/{player_id}/role", handler.GetPlayerRole)
+	r.Get("/api/games/{id}/players", handler.GetPlayers)
+
+	bearer := func(role auth.GameTokenRole, subject string) string {
+		token, err := testTokens.GenerateGameToken(created.ID, role, subject)
+		require.NoError(t, err)
+		return "Bearer " + token
+	}
+
+	t.Run("player sees their own role", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/players/"+alice.ID.String()+"/role", nil)
+		req.Header.Set("Authorization", bearer(auth.GameTokenPlayer, alice.ID.String()))
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response map[string]any
+		json.NewDecoder(rr.Body).Decode(&response)
+		assert.Equal(t, "projected-villager", response["slug"])
+	})
+
+	t.Run("player cannot see another villager's role", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/players/"+bob.ID.String()+"/role", nil)
+		req.Header.Set("Authorization", bearer(auth.GameTokenPlayer, alice.ID.String()))
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("player ID headers are not an identity", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/players/"+alice.ID.String()+"/role", nil)
+		req.Header.Set("X-Player-ID", alice.ID.String())
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("rejects invalid tokens", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/players/"+alice.ID.String()+"/role", nil)
+		req.Header.Set("Authorization", "Bearer "+alice.ID.String())
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("anonymous requests cannot see roles", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/players/"+alice.ID.String()+"/role", nil)
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("player list hides roles from anonymous viewers", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/players", nil)
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response []map[string]any
+		json.NewDecoder(rr.Body).Decode(&response)
+		require.Len(t, response, 2)
+		assert.NotContains(t, response[0], "role")
+	})
+
+	t.Run("player list shows roles to the moderator", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/players", nil)
+		req.Header.Set("Authorization", bearer(auth.GameTokenModerator, "mod-123"))
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response []map[string]any
+		json.NewDecoder(rr.Body).Decode(&response)
+		require.Len(t, response, 2)
+		assert.Contains(t, response[0], "role")
+	})
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+// bearerToken returns the game token sent as "Authorization: Bearer <token>"
+func bearerToken(r *http.Request) string {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return token
+}
+
+// tokenIdentity returns the moderator, player or spectator ID a game token
+// was issued to; the other two are empty
+func tokenIdentity(claims *auth.GameTokenClaims) (moderatorID, playerID, spectatorID string, ok bool) {
+	switch claims.Role {
+	case auth.GameTokenPlayer:
+		return "", claims.Subject, "", true
+	case auth.GameTokenModerator:
+		return claims.Subject, "", "", true
+	case auth.GameTokenSpectator:
+		return "", "", claims.Subject, true
+	}
+	return "", "", "", false
+}
+
+// authenticateViewer works out who is making a request from the game token
+// it carries. Requests without a token are anonymous. It writes an error
+// response and reports false if the token is not valid for the game.
+func authenticateViewer(w http.ResponseWriter, r *http.Request, tokens *auth.JWTService, gameService *service.GameService, g *ent.Game) (projection.Viewer, bool) {
+	token := bearerToken(r)
+	if token == "" {
+		return projection.PublicViewer(), true
+	}
+
+	claims, err := tokens.ValidateGameToken(token, g.ID)
+	if err != nil {
+		ErrorResponse(w, http.StatusUnauthorized, "invalid game token")
+		return projection.Viewer{}, false
+	}
+	moderatorID, playerID, spectatorID, ok := tokenIdentity(claims)
+	if !ok {
+		ErrorResponse(w, http.StatusUnauthorized, "invalid game token")
+		return projection.Viewer{}, false
+	}
+
+	// The token may outlive the identity, such as a removed player or co-moderator
+	viewer, err := gameService.ResolveViewer(r.Context(), g, moderatorID, playerID, spectatorID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotAuthorized) ||
+			errors.Is(err, service.ErrPlayerNotInGame) ||
+			errors.Is(err, service.ErrSpectatorNotFound) ||
+			ent.IsNotFound(err) {
+			ErrorResponse(w, http.StatusForbidden, "not allowed to view this game")
+			return projection.Viewer{}, false
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return projection.Viewer{}, false
+	}
+	return viewer, true
+}
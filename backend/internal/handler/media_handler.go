@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mafia-night/backend/internal/media"
+)
+
+// MediaHandler serves uploaded role videos and images
+type MediaHandler struct {
+	library *media.Library
+}
+
+// NewMediaHandler creates a new media handler
+func NewMediaHandler(library *media.Library) *MediaHandler {
+	return &MediaHandler{library: library}
+}
+
+// ServeMedia handles GET /api/media/*
+// Files on the local filesystem are served directly; files in a bucket are
+// redirected to a signed URL.
+func (h *MediaHandler) ServeMedia(w http.ResponseWriter, r *http.Request) {
+	h.library.Serve(w, r, chi.URLParam(r, "*"))
+}
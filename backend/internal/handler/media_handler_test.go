@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/media"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleHandler_UploadRoleMedia(t *testing.T) {
+	client := database.SetupTestDB(t)
+	store, err := media.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	library := media.NewLibrary(store, "http://localhost:8080/api/media", media.Limits{MaxImageSize: 1024})
+	roleService := service.NewRoleService(client).WithMedia(library)
+	ctx := context.Background()
+
+	created, err := roleService.CreateRole(ctx, "Sculptor", "sculptor", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+
+	roleHandler := NewRoleHandler(roleService)
+	r := chi.NewRouter()
+	r.Put("/api/admin/roles/{id}/image", roleHandler.UploadRoleImage)
+	r.Get("/api/media/*", NewMediaHandler(library).ServeMedia)
+
+	upload := func(t *testing.T, content []byte) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, err := form.CreateFormFile("file", "card.png")
+		require.NoError(t, err)
+		part.Write(content)
+		require.NoError(t, form.Close())
+
+		req := httptest.NewRequest(http.MethodPut, "/api/admin/roles/"+created.ID.String()+"/image", &body)
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	t.Run("uploads an image and serves it", func(t *testing.T) {
+		w := upload(t, png)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var response map[string]any
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		image := response["image"].(string)
+		require.True(t, strings.HasPrefix(image, "http://localhost:8080/api/media/images/"))
+
+		req := httptest.NewRequest(http.MethodGet, strings.TrimPrefix(image, "http://localhost:8080"), nil)
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, png, w.Body.Bytes())
+	})
+
+	t.Run("rejects other file types", func(t *testing.T) {
+		w := upload(t, []byte("<svg onload=alert(1)></svg>"))
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("rejects files over the limit", func(t *testing.T) {
+		w := upload(t, append(append([]byte{}, png...), make([]byte, 2048)...))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("unknown media is not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/media/images/missing.png", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+// ModeratorHandler handles co-moderator and moderator audit HTTP requests
+type ModeratorHandler struct {
+	moderatorService *service.ModeratorService
+	tokens           *auth.JWTService
+}
+
+// NewModeratorHandler creates a new moderator handler
+func NewModeratorHandler(moderatorService *service.ModeratorService, tokens *auth.JWTService) *ModeratorHandler {
+	return &ModeratorHandler{moderatorService: moderatorService, tokens: tokens}
+}
+
+// ListModerators handles GET /api/games/{id}/moderators
+func (h *ModeratorHandler) ListModerators(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	moderators, err := h.moderatorService.ListModerators(r.Context(), gameID, moderatorID)
+	if err != nil {
+		writeModeratorError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(moderators))
+	for i, m := range moderators {
+		response[i] = moderatorToJSON(m)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// AddModerator handles POST /api/games/{id}/moderators
+func (h *ModeratorHandler) AddModerator(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	ownerID := r.Header.Get("X-Moderator-ID")
+
+	if ownerID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req struct {
+		ModeratorID string               `json:"moderator_id"`
+		Permissions []service.Permission `json:"permissions"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	moderator, err := h.moderatorService.AddModerator(r.Context(), gameID, ownerID, req.ModeratorID, req.Permissions)
+	if err != nil {
+		writeModeratorError(w, err)
+		return
+	}
+
+	// The owner passes the token on, so the co-moderator can connect to the game
+	token, err := h.tokens.GenerateGameToken(gameID, auth.GameTokenModerator, moderator.ModeratorID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to issue moderator token")
+		return
+	}
+
+	response := moderatorToJSON(*moderator)
+	response["token"] = token
+
+	JSONResponse(w, http.StatusCreated, response)
+}
+
+// UpdateModerator handles PATCH /api/games/{id}/moderators/{moderator_id}
+func (h *ModeratorHandler) UpdateModerator(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := chi.URLParam(r, "moderator_id")
+	ownerID := r.Header.Get("X-Moderator-ID")
+
+	if ownerID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req struct {
+		Permissions []service.Permission `json:"permissions"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	moderator, err := h.moderatorService.UpdateModeratorPermissions(r.Context(), gameID, ownerID, moderatorID, req.Permissions)
+	if err != nil {
+		writeModeratorError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, moderatorToJSON(*moderator))
+}
+
+// RemoveModerator handles DELETE /api/games/{id}/moderators/{moderator_id}
+func (h *ModeratorHandler) RemoveModerator(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := chi.URLParam(r, "moderator_id")
+	actorID := r.Header.Get("X-Moderator-ID")
+
+	if actorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	err := h.moderatorService.RemoveModerator(r.Context(), gameID, actorID, moderatorID)
+	if err != nil {
+		writeModeratorError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TransferOwnership handles POST /api/games/{id}/transfer
+func (h *ModeratorHandler) TransferOwnership(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	ownerID := r.Header.Get("X-Moderator-ID")
+
+	if ownerID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req struct {
+		ModeratorID string `json:"moderator_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.moderatorService.TransferOwnership(r.Context(), gameID, ownerID, req.ModeratorID)
+	if err != nil {
+		writeModeratorError(w, err)
+		return
+	}
+
+	// The new owner may not have moderated the game before, so they get a token to connect with
+	token, err := h.tokens.GenerateGameToken(gameID, auth.GameTokenModerator, req.ModeratorID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to issue moderator token")
+		return
+	}
+
+	response := projection.Game(updated, projection.ModeratorViewer(ownerID, true))
+	response["token"] = token
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// GetActions handles GET /api/games/{id}/actions
+func (h *ModeratorHandler) GetActions(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	actions, err := h.moderatorService.GetActions(r.Context(), gameID, moderatorID)
+	if err != nil {
+		writeModeratorError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(actions))
+	for i, a := range actions {
+		response[i] = moderatorActionToJSON(a)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// writeModeratorError maps moderator service errors to HTTP responses
+func writeModeratorError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotAuthorized):
+		ErrorResponse(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrModeratorExists):
+		ErrorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrModeratorNotFound):
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidPermission),
+		errors.Is(err, service.ErrCannotChangeOwner),
+		errors.Is(err, service.ErrEmptyTargetModeratorID),
+		errors.Is(err, service.ErrEmptyGameID),
+		errors.Is(err, service.ErrEmptyModeratorID):
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	case ent.IsNotFound(err):
+		ErrorResponse(w, http.StatusNotFound, "game not found")
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func moderatorToJSON(m service.GameModeratorInfo) map[string]any {
+	return map[string]any{
+		"moderator_id": m.ModeratorID,
+		"owner":        m.Owner,
+		"permissions":  m.Permissions,
+	}
+}
+
+func moderatorActionToJSON(a *ent.ModeratorAction) map[string]any {
+	return map[string]any{
+		"id":           a.ID,
+		"game_id":      a.GameID,
+		"moderator_id": a.ModeratorID,
+		"action":       a.Action,
+		"details":      a.Details,
+		"created_at":   a.CreatedAt,
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddModeratorHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	handler := NewModeratorHandler(service.NewModeratorService(client), testTokens)
+
+	t.Run("adds co-moderator successfully", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		body := map[string]any{"moderator_id": "co-mod", "permissions": []string{"run_votes"}}
+		bodyBytes, _ := json.Marshal(body)
+
+		r := chi.NewRouter()
+		r.Post("/api/games/{id}/moderators", handler.AddModerator)
+
+		req = httptest.NewRequest("POST", "/api/games/"+created.ID+"/moderators", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Moderator-ID", "mod-123")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+
+		var response map[string]any
+		err = json.NewDecoder(rr.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.Equal(t, "co-mod", response["moderator_id"])
+		assert.Equal(t, false, response["owner"])
+
+		claims, err := testTokens.ValidateGameToken(response["token"].(string), created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, auth.GameTokenModerator, claims.Role)
+		assert.Equal(t, "co-mod", claims.Subject)
+	})
+
+	t.Run("fails for non-owner", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		bodyBytes, _ := json.Marshal(map[string]any{"moderator_id": "co-mod"})
+
+		r := chi.NewRouter()
+		r.Post("/api/games/{id}/moderators", handler.AddModerator)
+
+		req = httptest.NewRequest("POST", "/api/games/"+created.ID+"/moderators", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Moderator-ID", "wrong-mod")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("fails with unknown permission", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		body := map[string]any{"moderator_id": "co-mod", "permissions": []string{"everything"}}
+		bodyBytes, _ := json.Marshal(body)
+
+		r := chi.NewRouter()
+		r.Post("/api/games/{id}/moderators", handler.AddModerator)
+
+		req = httptest.NewRequest("POST", "/api/games/"+created.ID+"/moderators", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Moderator-ID", "mod-123")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestTransferOwnershipHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	handler := NewModeratorHandler(service.NewModeratorService(client), testTokens)
+
+	t.Run("transfers ownership successfully", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/", nil)
+		created, err := gameService.CreateGame(req.Context(), "mod-123")
+		require.NoError(t, err)
+
+		bodyBytes, _ := json.Marshal(map[string]string{"moderator_id": "new-host"})
+
+		r := chi.NewRouter()
+		r.Post("/api/games/{id}/transfer", handler.TransferOwnership)
+
+		req = httptest.NewRequest("POST", "/api/games/"+created.ID+"/transfer", bytes.NewReader(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Moderator-ID", "mod-123")
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response map[string]any
+		err = json.NewDecoder(rr.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.NotContains(t, response, "moderator_id", "only the new owner sees the moderator ID")
+
+		transferred, err := gameService.GetGameByID(req.Context(), created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "new-host", transferred.ModeratorID)
+	})
+}
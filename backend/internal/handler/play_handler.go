@@ -0,0 +1,423 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+// PlayHandler handles in-game player and phase HTTP requests.
+// Players act with the game token they got when joining, like on the WebSocket.
+type PlayHandler struct {
+	playService *service.PlayService
+	tokens      *auth.JWTService
+}
+
+// NewPlayHandler creates a new play handler
+func NewPlayHandler(playService *service.PlayService, tokens *auth.JWTService) *PlayHandler {
+	return &PlayHandler{playService: playService, tokens: tokens}
+}
+
+// gameToken validates the game token a request carries, if any.
+// It writes an error response and reports false if the token is not valid for the game.
+func (h *PlayHandler) gameToken(w http.ResponseWriter, r *http.Request, gameID string) (*auth.GameTokenClaims, bool) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, true
+	}
+
+	claims, err := h.tokens.ValidateGameToken(token, gameID)
+	if err != nil {
+		ErrorResponse(w, http.StatusUnauthorized, "invalid game token")
+		return nil, false
+	}
+	return claims, true
+}
+
+// tokenPlayer returns the player a request's game token was issued to.
+// It writes an error response and reports false without a valid player token.
+func (h *PlayHandler) tokenPlayer(w http.ResponseWriter, r *http.Request, gameID string) (string, bool) {
+	claims, ok := h.gameToken(w, r, gameID)
+	if !ok {
+		return "", false
+	}
+	if claims == nil {
+		ErrorResponse(w, http.StatusUnauthorized, "game token required")
+		return "", false
+	}
+	if claims.Role != auth.GameTokenPlayer {
+		ErrorResponse(w, http.StatusForbidden, "only players may do this")
+		return "", false
+	}
+	return claims.Subject, true
+}
+
+// SetReady handles POST /api/games/{id}/players/{player_id}/ready
+func (h *PlayHandler) SetReady(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	playerID := chi.URLParam(r, "player_id")
+
+	tokenPlayerID, ok := h.tokenPlayer(w, r, gameID)
+	if !ok {
+		return
+	}
+	if tokenPlayerID != playerID {
+		ErrorResponse(w, http.StatusForbidden, "players may only mark themselves ready")
+		return
+	}
+
+	var req struct {
+		Ready *bool `json:"ready"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Ready == nil {
+		ErrorResponse(w, http.StatusBadRequest, "ready is required")
+		return
+	}
+
+	player, err := h.playService.SetReady(r.Context(), gameID, playerID, *req.Ready)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, playerView(player, projection.PlayerViewer(player.ID)))
+}
+
+// AdvancePhase handles POST /api/games/{id}/phase
+func (h *PlayHandler) AdvancePhase(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	updated, err := h.playService.AdvancePhase(r.Context(), gameID, moderatorID)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, projection.Game(updated, projection.ModeratorViewer(moderatorID, true)))
+}
+
+// CastVote handles POST /api/games/{id}/votes
+func (h *PlayHandler) CastVote(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	playerID, ok := h.tokenPlayer(w, r, gameID)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TargetID string `json:"target_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	v, err := h.playService.CastVote(r.Context(), gameID, playerID, req.TargetID)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, voteToJSON(v))
+}
+
+// GetVotes handles GET /api/games/{id}/votes
+func (h *PlayHandler) GetVotes(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+
+	votes, err := h.playService.GetVotes(r.Context(), gameID)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	votesJSON := make([]map[string]any, len(votes))
+	tally := make(map[uuid.UUID]int)
+	for i, v := range votes {
+		votesJSON[i] = voteToJSON(v)
+		tally[v.TargetID]++
+	}
+
+	JSONResponse(w, http.StatusOK, map[string]any{
+		"votes": votesJSON,
+		"tally": tally,
+	})
+}
+
+// SubmitNightAction handles POST /api/games/{id}/night-actions
+func (h *PlayHandler) SubmitNightAction(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	playerID, ok := h.tokenPlayer(w, r, gameID)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TargetID string `json:"target_id"`
+		Action   string `json:"action"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	a, err := h.playService.SubmitNightAction(r.Context(), gameID, playerID, req.TargetID, req.Action)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, nightActionToJSON(a))
+}
+
+// GetNightActions handles GET /api/games/{id}/night-actions
+func (h *PlayHandler) GetNightActions(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	actions, err := h.playService.GetNightActions(r.Context(), gameID, moderatorID)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(actions))
+	for i, a := range actions {
+		response[i] = nightActionToJSON(a)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// SendChat handles POST /api/games/{id}/chat
+// The channel defaults to the day channel.
+func (h *PlayHandler) SendChat(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	playerID, ok := h.tokenPlayer(w, r, gameID)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Channel chatmessage.Channel `json:"channel"`
+		Text    string              `json:"text"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Channel == "" {
+		req.Channel = chatmessage.ChannelDay
+	}
+
+	message, err := h.playService.SendChat(r.Context(), gameID, playerID, req.Channel, req.Text)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusCreated, chatMessageToJSON(message))
+}
+
+// GetChat handles GET /api/games/{id}/chat?channel=day
+// Players and moderators identify themselves with their game token;
+// anyone else may only read announcements.
+func (h *PlayHandler) GetChat(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+
+	channel := chatmessage.Channel(r.URL.Query().Get("channel"))
+	if channel == "" {
+		channel = chatmessage.ChannelDay
+	}
+
+	claims, ok := h.gameToken(w, r, gameID)
+	if !ok {
+		return
+	}
+	var reader service.ChatReader
+	if claims != nil {
+		reader.ModeratorID, reader.PlayerID, _, _ = tokenIdentity(claims)
+	}
+
+	messages, err := h.playService.GetChat(r.Context(), gameID, channel, reader)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(messages))
+	for i, m := range messages {
+		response[i] = chatMessageToJSON(m)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// SendAnnouncement handles POST /api/games/{id}/announcements
+func (h *PlayHandler) SendAnnouncement(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req struct {
+		Text string `json:"text"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	message, err := h.playService.SendAnnouncement(r.Context(), gameID, moderatorID, req.Text)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusCreated, chatMessageToJSON(message))
+}
+
+// SetMuted handles POST /api/games/{id}/players/{player_id}/mute
+func (h *PlayHandler) SetMuted(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	playerID := chi.URLParam(r, "player_id")
+	moderatorID := r.Header.Get("X-Moderator-ID")
+
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req struct {
+		Muted *bool `json:"muted"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Muted == nil {
+		ErrorResponse(w, http.StatusBadRequest, "muted is required")
+		return
+	}
+
+	player, err := h.playService.SetMuted(r.Context(), gameID, moderatorID, playerID, *req.Muted)
+	if err != nil {
+		writePlayError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, playerView(player, projection.ModeratorViewer(moderatorID, false)))
+}
+
+// writePlayError maps play service errors to HTTP responses
+func writePlayError(w http.ResponseWriter, err error) {
+	status := playErrorStatus(err)
+	if status == http.StatusNotFound {
+		ErrorResponse(w, status, "game not found")
+		return
+	}
+	ErrorResponse(w, status, err.Error())
+}
+
+// playErrorStatus returns the HTTP status matching a play service error
+func playErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrNotAuthorized),
+		errors.Is(err, service.ErrPlayerNotInGame),
+		errors.Is(err, service.ErrPlayerDead),
+		errors.Is(err, service.ErrNoRoleAssigned),
+		errors.Is(err, service.ErrChannelNotAllowed),
+		errors.Is(err, service.ErrPlayerMuted):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrChatRateLimited):
+		return http.StatusTooManyRequests
+	case errors.Is(err, service.ErrGameNotActive),
+		errors.Is(err, service.ErrWrongPhase),
+		errors.Is(err, service.ErrGameAlreadyStarted):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrInvalidTarget),
+		errors.Is(err, service.ErrEmptyChatMessage),
+		errors.Is(err, service.ErrChatMessageTooLong),
+		errors.Is(err, service.ErrInvalidChannel),
+		errors.Is(err, service.ErrEmptyTargetPlayerID),
+		errors.Is(err, service.ErrEmptyGameID),
+		errors.Is(err, service.ErrEmptyPlayerID),
+		errors.Is(err, service.ErrEmptyModeratorID):
+		return http.StatusBadRequest
+	case ent.IsNotFound(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func voteToJSON(v *ent.Vote) map[string]any {
+	return map[string]any{
+		"id":         v.ID,
+		"game_id":    v.GameID,
+		"round":      v.Round,
+		"voter_id":   v.VoterID,
+		"target_id":  v.TargetID,
+		"created_at": v.CreatedAt,
+	}
+}
+
+func nightActionToJSON(a *ent.NightAction) map[string]any {
+	return map[string]any{
+		"id":         a.ID,
+		"game_id":    a.GameID,
+		"round":      a.Round,
+		"player_id":  a.PlayerID,
+		"target_id":  a.TargetID,
+		"action":     a.Action,
+		"created_at": a.CreatedAt,
+	}
+}
+
+// chatMessageToJSON projects a chat message. Announcements only say they
+// come from a moderator, since the moderator ID lets its holder run the game.
+func chatMessageToJSON(m *ent.ChatMessage) map[string]any {
+	return map[string]any{
+		"id":              m.ID,
+		"game_id":         m.GameID,
+		"channel":         m.Channel,
+		"round":           m.Round,
+		"player_id":       m.PlayerID,
+		"is_announcement": m.ModeratorID != "",
+		"sender_name":     m.SenderName,
+		"text":            m.Text,
+		"sent_at":         m.CreatedAt,
+	}
+}
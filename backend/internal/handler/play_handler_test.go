@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCastVoteHandler(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	roleService := service.NewRoleService(client)
+	handler := NewPlayHandler(service.NewPlayService(client), testTokens)
+
+	req := httptest.NewRequest("POST", "/", nil)
+	ctx := req.Context()
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	alice, err := gameService.JoinGame(ctx, created.ID, "Alice")
+	require.NoError(t, err)
+	bob, err := gameService.JoinGame(ctx, created.ID, "Bob")
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Post("/api/games/{id}/votes", handler.CastVote)
+	r.Get("/api/games/{id}/votes", handler.GetVotes)
+	r.Post("/api/games/{id}/players/{player_id}/ready", handler.SetReady)
+	r.Get("/api/games/{id}/chat", handler.GetChat)
+
+	aliceToken, err := testTokens.GenerateGameToken(created.ID, auth.GameTokenPlayer, alice.ID.String())
+	require.NoError(t, err)
+
+	t.Run("fails before the game starts", func(t *testing.T) {
+		bodyBytes, _ := json.Marshal(map[string]string{"target_id": bob.ID.String()})
+		req := httptest.NewRequest("POST", "/api/games/"+created.ID+"/votes", bytes.NewReader(bodyBytes))
+		req.Header.Set("Authorization", "Bearer "+aliceToken)
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("casts a vote and tallies it", func(t *testing.T) {
+		// Distributing roles starts the game
+		villager, err := roleService.CreateRole(ctx, "Voting Villager", "voting-villager", "video", "", role.TeamVillage, nil)
+		require.NoError(t, err)
+		err = gameService.DistributeRoles(ctx, created.ID, "mod-123", []service.RoleSelection{
+			{RoleID: villager.ID.String(), Count: 2},
+		})
+		require.NoError(t, err)
+
+		bodyBytes, _ := json.Marshal(map[string]string{"target_id": bob.ID.String()})
+		req := httptest.NewRequest("POST", "/api/games/"+created.ID+"/votes", bytes.NewReader(bodyBytes))
+		req.Header.Set("Authorization", "Bearer "+aliceToken)
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		req = httptest.NewRequest("GET", "/api/games/"+created.ID+"/votes", nil)
+		rr = httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var response struct {
+			Votes []map[string]any `json:"votes"`
+			Tally map[string]int   `json:"tally"`
+		}
+		json.NewDecoder(rr.Body).Decode(&response)
+		assert.Len(t, response.Votes, 1)
+		assert.Equal(t, 1, response.Tally[bob.ID.String()])
+	})
+
+	t.Run("requires a player token", func(t *testing.T) {
+		bodyBytes, _ := json.Marshal(map[string]string{"target_id": alice.ID.String()})
+		req := httptest.NewRequest("POST", "/api/games/"+created.ID+"/votes", bytes.NewReader(bodyBytes))
+		req.Header.Set("X-Player-ID", bob.ID.String())
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code, "player IDs are public, so they are no identity")
+
+		moderatorToken, err := testTokens.GenerateGameToken(created.ID, auth.GameTokenModerator, "mod-123")
+		require.NoError(t, err)
+		req = httptest.NewRequest("POST", "/api/games/"+created.ID+"/votes", bytes.NewReader(bodyBytes))
+		req.Header.Set("Authorization", "Bearer "+moderatorToken)
+		rr = httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("players only mark themselves ready", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/games/"+created.ID+"/players/"+bob.ID.String()+"/ready", bytes.NewReader([]byte(`{"ready": true}`)))
+		req.Header.Set("Authorization", "Bearer "+aliceToken)
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("private chat needs a token", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/games/"+created.ID+"/chat?channel=dead", nil)
+		req.Header.Set("X-Player-ID", alice.ID.String())
+		rr := httptest.NewRecorder()
+
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestChatMessageToJSON(t *testing.T) {
+	announcement := chatMessageToJSON(&ent.ChatMessage{GameID: "ABC123", Channel: chatmessage.ChannelAnnouncements, ModeratorID: "mod-123", Text: "Night falls"})
+	assert.NotContains(t, announcement, "moderator_id")
+	assert.Equal(t, true, announcement["is_announcement"])
+
+	message := chatMessageToJSON(&ent.ChatMessage{GameID: "ABC123", Channel: chatmessage.ChannelDay, Text: "Hi"})
+	assert.Equal(t, false, message["is_announcement"])
+}
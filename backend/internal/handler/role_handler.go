@@ -3,12 +3,17 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+	"github.com/mafia-night/backend/internal/media"
 	"github.com/mafia-night/backend/internal/service"
 )
 
@@ -22,19 +27,54 @@ func NewRoleHandler(roleService *service.RoleService) *RoleHandler {
 	return &RoleHandler{roleService: roleService}
 }
 
-// GetRoles handles GET /api/roles
+// GetRoles handles GET /api/roles and GET /api/admin/roles
+// Texts are in the locale picked by the locale query parameter or the
+// Accept-Language header, falling back to the default locale.
+// Query parameters:
+//   - team: keep roles of these teams (repeated or comma-separated)
+//   - ability: keep roles with an ability mentioning this text
+//   - q: keep roles whose name or description contains this text
+//   - sort: name (default), slug or team, prefixed with - to sort descending
+//   - limit: page size up to 100; without it every role is returned
+//   - cursor: continue from a previous page
+//   - archived: true to also list archived roles
+//
+// When there are more roles, the Link header holds the URL of the next page.
 func (h *RoleHandler) GetRoles(w http.ResponseWriter, r *http.Request) {
-	roles, err := h.roleService.GetAllRoles(r.Context())
+	opts, err := roleListOptions(r)
 	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.roleService.ListRoles(r.Context(), opts)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRoleSort) ||
+			errors.Is(err, service.ErrInvalidCursor) ||
+			errors.Is(err, service.ErrInvalidLimit) ||
+			errors.Is(err, service.ErrInvalidTeam) {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		ErrorResponse(w, http.StatusInternalServerError, "failed to fetch roles")
 		return
 	}
 
-	rolesJSON := make([]map[string]any, len(roles))
-	for i, role := range roles {
-		rolesJSON[i] = roleToJSON(role)
+	localizer := h.roleService.Localizer(localePreferences(r))
+	rolesJSON := make([]map[string]any, len(page.Roles))
+	for i, role := range page.Roles {
+		rolesJSON[i] = localizedRoleToJSON(role, localizer)
 	}
 
+	if page.NextCursor != "" {
+		next := *r.URL
+		query := next.Query()
+		query.Set("cursor", page.NextCursor)
+		next.RawQuery = query.Encode()
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.RequestURI()))
+	}
+
+	w.Header().Add("Vary", "Accept-Language")
 	JSONResponse(w, http.StatusOK, rolesJSON)
 }
 
@@ -52,7 +92,8 @@ func (h *RoleHandler) GetRoleBySlug(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	JSONResponse(w, http.StatusOK, roleToJSON(role))
+	w.Header().Add("Vary", "Accept-Language")
+	JSONResponse(w, http.StatusOK, localizedRoleToJSON(role, h.roleService.Localizer(localePreferences(r))))
 }
 
 // CreateRole handles POST /api/admin/roles
@@ -193,13 +234,440 @@ func (h *RoleHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
+		if errors.Is(err, service.ErrRoleInUse) {
+			ErrorResponse(w, http.StatusConflict, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArchiveRole handles POST /api/admin/roles/{id}/archive
+// The role is no longer offered for new templates and distributions.
+func (h *RoleHandler) ArchiveRole(w http.ResponseWriter, r *http.Request) {
+	h.setRoleArchived(w, r, true)
+}
+
+// RestoreRole handles DELETE /api/admin/roles/{id}/archive
+func (h *RoleHandler) RestoreRole(w http.ResponseWriter, r *http.Request) {
+	h.setRoleArchived(w, r, false)
+}
+
+func (h *RoleHandler) setRoleArchived(w http.ResponseWriter, r *http.Request, archived bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	var updated *ent.Role
+	if archived {
+		updated, err = h.roleService.ArchiveRole(r.Context(), id)
+	} else {
+		updated, err = h.roleService.RestoreRole(r.Context(), id)
+	}
+	if err != nil {
+		if errors.Is(err, service.ErrRoleNotFound) {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, roleToJSON(updated))
+}
+
+// GetRoleUsage handles GET /api/admin/roles/{id}/usage
+// It lists the templates dealing the role and counts the games it was dealt
+// in, to check before archiving or deleting it.
+func (h *RoleHandler) GetRoleUsage(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	usage, err := h.roleService.GetRoleUsage(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrRoleNotFound) {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	templates := make([]map[string]any, len(usage.Templates))
+	for i, u := range usage.Templates {
+		templates[i] = map[string]any{
+			"id":             u.Template.ID,
+			"name":           u.Template.Name,
+			"source":         service.SourceOf(u.Template),
+			"status":         u.Template.Status,
+			"count":          u.Count,
+			"optional_count": u.OptionalCount,
+		}
+	}
+
+	JSONResponse(w, http.StatusOK, map[string]any{
+		"role_id":   id,
+		"templates": templates,
+		"games":     usage.Games,
+		"in_use":    usage.InUse(),
+	})
+}
+
+// UploadRoleVideo handles PUT /api/admin/roles/{id}/video
+// The video is sent as the "file" field of a multipart form.
+func (h *RoleHandler) UploadRoleVideo(w http.ResponseWriter, r *http.Request) {
+	h.uploadRoleMedia(w, r, media.Video)
+}
+
+// UploadRoleImage handles PUT /api/admin/roles/{id}/image
+// The image is sent as the "file" field of a multipart form.
+func (h *RoleHandler) UploadRoleImage(w http.ResponseWriter, r *http.Request) {
+	h.uploadRoleMedia(w, r, media.Image)
+}
+
+func (h *RoleHandler) uploadRoleMedia(w http.ResponseWriter, r *http.Request, kind media.Kind) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	maxSize := h.roleService.MaxMediaSize(kind)
+	if maxSize == 0 {
+		ErrorResponse(w, http.StatusServiceUnavailable, service.ErrMediaNotConfigured.Error())
+		return
+	}
+
+	// Leave room for the multipart framing around the file
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize+1<<20)
+	if err := r.ParseMultipartForm(8 << 20); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			ErrorResponse(w, http.StatusRequestEntityTooLarge, media.ErrTooLarge.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusBadRequest, "expected a multipart form with a file field")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "expected a multipart form with a file field")
+		return
+	}
+	defer file.Close()
+
+	updatedRole, err := h.roleService.UploadRoleMedia(r.Context(), id, kind, file, header.Size)
+	if err != nil {
+		writeMediaError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, roleToJSON(updatedRole))
+}
+
+// RemoveRoleImage handles DELETE /api/admin/roles/{id}/image
+func (h *RoleHandler) RemoveRoleImage(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	updatedRole, err := h.roleService.RemoveRoleImage(r.Context(), id)
+	if err != nil {
+		writeMediaError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, roleToJSON(updatedRole))
+}
+
+// writeMediaError maps upload errors to HTTP responses
+func writeMediaError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrRoleNotFound):
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, media.ErrUnsupportedType):
+		ErrorResponse(w, http.StatusUnsupportedMediaType, err.Error())
+	case errors.Is(err, media.ErrTooLarge):
+		ErrorResponse(w, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, media.ErrEmptyFile):
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrMediaNotConfigured):
+		ErrorResponse(w, http.StatusServiceUnavailable, err.Error())
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// GetRoleRules handles GET /api/admin/roles/{id}/rules
+func (h *RoleHandler) GetRoleRules(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	rules, err := h.roleService.GetRoleRules(r.Context(), id)
+	if err != nil {
+		writeRoleRuleError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(rules))
+	for i, rule := range rules {
+		response[i] = roleRuleToJSON(rule)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// AddRoleRule handles POST /api/admin/roles/{id}/rules
+// The body is {"kind": "requires", "target_role_id": "..."}, {"kind": "requires",
+// "target_team": "mafia"}, {"kind": "excludes", "target_role_id": "..."} or
+// {"kind": "max_count", "max_count": 2}.
+func (h *RoleHandler) AddRoleRule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	var req struct {
+		Kind         string     `json:"kind"`
+		TargetRoleID *uuid.UUID `json:"target_role_id"`
+		TargetTeam   *string    `json:"target_team"`
+		MaxCount     *int       `json:"max_count"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	in := service.RoleRuleInput{
+		Kind:         rolerule.Kind(req.Kind),
+		TargetRoleID: req.TargetRoleID,
+		MaxCount:     req.MaxCount,
+	}
+	if req.TargetTeam != nil {
+		team := rolerule.TargetTeam(*req.TargetTeam)
+		in.TargetTeam = &team
+	}
+
+	rule, err := h.roleService.AddRoleRule(r.Context(), id, in)
+	if err != nil {
+		writeRoleRuleError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusCreated, roleRuleToJSON(rule))
+}
+
+// DeleteRoleRule handles DELETE /api/admin/roles/{id}/rules/{rule_id}
+func (h *RoleHandler) DeleteRoleRule(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+	ruleID, err := uuid.Parse(chi.URLParam(r, "rule_id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid rule ID")
+		return
+	}
+
+	if err := h.roleService.DeleteRoleRule(r.Context(), id, ruleID); err != nil {
+		writeRoleRuleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeRoleRuleError maps role rule errors to HTTP responses
+func writeRoleRuleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrRoleNotFound),
+		errors.Is(err, service.ErrRoleRuleNotFound):
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidRoleRule):
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrRoleRuleExists):
+		ErrorResponse(w, http.StatusConflict, err.Error())
+	default:
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func roleRuleToJSON(r *ent.RoleRule) map[string]any {
+	return map[string]any{
+		"id":             r.ID,
+		"role_id":        r.RoleID,
+		"kind":           r.Kind,
+		"target_role_id": r.TargetRoleID,
+		"target_team":    r.TargetTeam,
+		"max_count":      r.MaxCount,
+		"description":    service.DescribeRoleRule(r),
+	}
+}
+
+// GetRoleTranslations handles GET /api/admin/roles/{id}/translations
+func (h *RoleHandler) GetRoleTranslations(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	translations, err := h.roleService.GetRoleTranslations(r.Context(), id)
+	if err != nil {
+		writeTranslationError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(translations))
+	for i, t := range translations {
+		response[i] = roleTranslationToJSON(t)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// SetRoleTranslation handles PUT /api/admin/roles/{id}/translations/{locale}
+func (h *RoleHandler) SetRoleTranslation(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	var req struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Abilities   []string `json:"abilities"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	translation, err := h.roleService.SetRoleTranslation(r.Context(), id, chi.URLParam(r, "locale"), req.Name, req.Description, req.Abilities)
+	if err != nil {
+		writeTranslationError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, roleTranslationToJSON(translation))
+}
+
+// DeleteRoleTranslation handles DELETE /api/admin/roles/{id}/translations/{locale}
+func (h *RoleHandler) DeleteRoleTranslation(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
+		return
+	}
+
+	if err := h.roleService.DeleteRoleTranslation(r.Context(), id, chi.URLParam(r, "locale")); err != nil {
+		writeTranslationError(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// roleListOptions reads the filters, sorting and paging of a role listing
+// from the query string
+func roleListOptions(r *http.Request) (service.RoleListOptions, error) {
+	query := r.URL.Query()
+	opts := service.RoleListOptions{
+		Ability: query.Get("ability"),
+		Search:  query.Get("q"),
+		Sort:    query.Get("sort"),
+		Cursor:  query.Get("cursor"),
+
+		IncludeArchived: query.Get("archived") == "true",
+	}
+
+	for _, teams := range query["team"] {
+		for _, team := range strings.Split(teams, ",") {
+			if team = strings.TrimSpace(team); team != "" {
+				opts.Teams = append(opts.Teams, role.Team(team))
+			}
+		}
+	}
+
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			return opts, service.ErrInvalidLimit
+		}
+		opts.Limit = limit
+	}
+
+	return opts, nil
+}
+
+// localePreferences reads the locales a request asks for from the locale query
+// parameter or the Accept-Language header
+func localePreferences(r *http.Request) service.LocalePreferences {
+	return service.ParseLocalePreferences(r.URL.Query().Get("locale"), r.Header.Get("Accept-Language"))
+}
+
+// writeTranslationError maps translation errors of roles and templates to HTTP responses
+func writeTranslationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrRoleNotFound),
+		errors.Is(err, service.ErrTemplateNotFound),
+		errors.Is(err, service.ErrTranslationNotFound):
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidLocale),
+		errors.Is(err, service.ErrDefaultLocaleTranslation),
+		errors.Is(err, service.ErrEmptyTranslationName),
+		errors.Is(err, service.ErrEmptyTranslatedDescription):
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrRoleNameExists):
+		ErrorResponse(w, http.StatusConflict, err.Error())
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// localizedRoleToJSON converts an ent.Role to a JSON-serializable map with
+// its texts in the reader's locale
+func localizedRoleToJSON(r *ent.Role, localizer service.Localizer) map[string]any {
+	text := localizer.Role(r)
+
+	result := roleToJSON(r)
+	result["name"] = text.Name
+	result["description"] = text.Description
+	result["abilities"] = text.Abilities
+	result["locale"] = text.Locale
+	return result
+}
+
+func roleTranslationToJSON(t *ent.RoleTranslation) map[string]any {
+	return map[string]any{
+		"role_id":     t.RoleID,
+		"locale":      t.Locale,
+		"name":        t.Name,
+		"description": t.Description,
+		"abilities":   t.Abilities,
+	}
+}
+
 // roleToJSON converts an ent.Role to a JSON-serializable map
 func roleToJSON(r *ent.Role) map[string]any {
 	return map[string]any{
@@ -207,8 +675,10 @@ func roleToJSON(r *ent.Role) map[string]any {
 		"name":        r.Name,
 		"slug":        r.Slug,
 		"video":       r.Video,
+		"image":       r.Image,
 		"description": r.Description,
 		"team":        r.Team,
 		"abilities":   r.Abilities,
+		"archived_at": r.ArchivedAt,
 	}
 }
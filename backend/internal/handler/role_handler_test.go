@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleHandler_GetRoles(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	ctx := context.Background()
+
+	for _, r := range []struct {
+		name, slug string
+		team       role.Team
+	}{
+		{"Detective", "detective", role.TeamVillage},
+		{"Doctor", "doctor", role.TeamVillage},
+		{"Godfather", "godfather", role.TeamMafia},
+		{"Villager", "villager", role.TeamVillage},
+	} {
+		_, err := roleService.CreateRole(ctx, r.name, r.slug, "video", "", r.team, nil)
+		require.NoError(t, err)
+	}
+
+	r := chi.NewRouter()
+	r.Get("/api/roles", NewRoleHandler(roleService).GetRoles)
+
+	get := func(url string) (*httptest.ResponseRecorder, []string) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+
+		var roles []map[string]any
+		json.NewDecoder(w.Body).Decode(&roles)
+		var names []string
+		for _, role := range roles {
+			names = append(names, role["name"].(string))
+		}
+		return w, names
+	}
+
+	t.Run("lists every role without parameters", func(t *testing.T) {
+		w, names := get("/api/roles")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"Detective", "Doctor", "Godfather", "Villager"}, names)
+		assert.Empty(t, w.Header().Get("Link"))
+	})
+
+	t.Run("follows the next link through the pages", func(t *testing.T) {
+		w, names := get("/api/roles?team=village&sort=-name&limit=2")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"Villager", "Doctor"}, names)
+
+		link := regexp.MustCompile(`^<([^>]+)>; rel="next"$`).FindStringSubmatch(w.Header().Get("Link"))
+		require.Len(t, link, 2)
+
+		w, names = get(link[1])
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"Detective"}, names)
+		assert.Empty(t, w.Header().Get("Link"))
+	})
+
+	t.Run("searches and rejects bad parameters", func(t *testing.T) {
+		_, names := get("/api/roles?q=doc")
+		assert.Equal(t, []string{"Doctor"}, names)
+
+		for _, url := range []string{"/api/roles?limit=0", "/api/roles?limit=500", "/api/roles?sort=power", "/api/roles?team=pirates", "/api/roles?cursor=xyz"} {
+			w, _ := get(url)
+			assert.Equal(t, http.StatusBadRequest, w.Code, url)
+		}
+	})
+}
+
+func TestRoleHandler_RoleRules(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	templateService := service.NewRoleTemplateService(client)
+	ctx := context.Background()
+
+	ocean, err := roleService.CreateRole(ctx, "Ocean", "ocean", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	friend, err := roleService.CreateRole(ctx, "Ocean's Friend", "oceans-friend", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+
+	roleHandler := NewRoleHandler(roleService)
+	r := chi.NewRouter()
+	r.Get("/api/admin/roles/{id}/rules", roleHandler.GetRoleRules)
+	r.Post("/api/admin/roles/{id}/rules", roleHandler.AddRoleRule)
+	r.Delete("/api/admin/roles/{id}/rules/{rule_id}", roleHandler.DeleteRoleRule)
+	r.Post("/api/admin/role-templates", NewRoleTemplateHandler(templateService).CreateRoleTemplate)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, url, bytes.NewBufferString(body)))
+		return w
+	}
+	rulesURL := "/api/admin/roles/" + ocean.ID.String() + "/rules"
+
+	w := do(http.MethodPost, rulesURL, `{"kind":"requires","target_role_id":"`+friend.ID.String()+`"}`)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var rule map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&rule))
+	assert.Equal(t, `"Ocean" requires "Ocean's Friend"`, rule["description"])
+
+	assert.Equal(t, http.StatusConflict, do(http.MethodPost, rulesURL, `{"kind":"requires","target_role_id":"`+friend.ID.String()+`"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, rulesURL, `{"kind":"requires","target_team":"pirates"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, rulesURL, `{"kind":"max_count"}`).Code)
+
+	t.Run("a template breaking a rule names it", func(t *testing.T) {
+		w := do(http.MethodPost, "/api/admin/role-templates", `{"name":"Solo","player_count":1,"roles":[{"role_id":"`+ocean.ID.String()+`","count":1}]}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `Ocean\" requires \"Ocean's Friend`)
+	})
+
+	w = do(http.MethodGet, rulesURL, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var rules []map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&rules))
+	require.Len(t, rules, 1)
+
+	assert.Equal(t, http.StatusNoContent, do(http.MethodDelete, rulesURL+"/"+rule["id"].(string), "").Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodDelete, rulesURL+"/"+rule["id"].(string), "").Code)
+}
+
+func TestRoleHandler_ArchiveRole(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	templateService := service.NewRoleTemplateService(client)
+	ctx := context.Background()
+
+	mafia, err := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "", role.TeamMafia, nil)
+	require.NoError(t, err)
+	villager, err := roleService.CreateRole(ctx, "Villager", "villager", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	_, err = templateService.CreateRoleTemplate(ctx, "Duel", 2, "", []service.RoleAssignment{
+		{RoleID: mafia.ID, Count: 1},
+		{RoleID: villager.ID, Count: 1},
+	})
+	require.NoError(t, err)
+
+	roleHandler := NewRoleHandler(roleService)
+	r := chi.NewRouter()
+	r.Get("/api/admin/roles", roleHandler.GetRoles)
+	r.Delete("/api/admin/roles/{id}", roleHandler.DeleteRole)
+	r.Get("/api/admin/roles/{id}/usage", roleHandler.GetRoleUsage)
+	r.Post("/api/admin/roles/{id}/archive", roleHandler.ArchiveRole)
+	r.Delete("/api/admin/roles/{id}/archive", roleHandler.RestoreRole)
+	r.Post("/api/admin/role-templates", NewRoleTemplateHandler(templateService).CreateRoleTemplate)
+
+	do := func(method, url, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, url, bytes.NewBufferString(body)))
+		return w
+	}
+	roleURL := "/api/admin/roles/" + villager.ID.String()
+
+	w := do(http.MethodGet, roleURL+"/usage", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var usage map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&usage))
+	assert.Equal(t, true, usage["in_use"])
+	templates := usage["templates"].([]any)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "Duel", templates[0].(map[string]any)["name"])
+	assert.Equal(t, float64(1), templates[0].(map[string]any)["count"])
+
+	w = do(http.MethodDelete, roleURL, "")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "archive it instead")
+
+	w = do(http.MethodPost, roleURL+"/archive", "")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var archived map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&archived))
+	assert.NotNil(t, archived["archived_at"])
+
+	var listed []any
+	require.NoError(t, json.NewDecoder(do(http.MethodGet, "/api/admin/roles", "").Body).Decode(&listed))
+	assert.Len(t, listed, 1)
+	require.NoError(t, json.NewDecoder(do(http.MethodGet, "/api/admin/roles?archived=true", "").Body).Decode(&listed))
+	assert.Len(t, listed, 2)
+
+	w = do(http.MethodPost, "/api/admin/role-templates", `{"name":"Again","player_count":2,"roles":[{"role_id":"`+mafia.ID.String()+`","count":1},{"role_id":"`+villager.ID.String()+`","count":1}]}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "role is archived")
+
+	assert.Equal(t, http.StatusOK, do(http.MethodDelete, roleURL+"/archive", "").Code)
+	require.NoError(t, json.NewDecoder(do(http.MethodGet, "/api/admin/roles", "").Body).Decode(&listed))
+	assert.Len(t, listed, 2)
+}
@@ -22,34 +22,129 @@ func NewRoleTemplateHandler(roleTemplateService *service.RoleTemplateService) *R
 	return &RoleTemplateHandler{roleTemplateService: roleTemplateService}
 }
 
+// playerCountParam parses the optional player_count query parameter
+func playerCountParam(r *http.Request) (*int, bool) {
+	playerCountStr := r.URL.Query().Get("player_count")
+	if playerCountStr == "" {
+		return nil, true
+	}
+	count, err := strconv.Atoi(playerCountStr)
+	if err != nil || count <= 0 {
+		return nil, false
+	}
+	return &count, true
+}
+
+// roleAssignmentRequest is a role of a template in a request body
+type roleAssignmentRequest struct {
+	RoleID   string `json:"role_id"`
+	Count    int    `json:"count"`
+	Optional bool   `json:"optional"`
+}
+
+// createTemplateRequest is the body that creates a template
+type createTemplateRequest struct {
+	Name        string                  `json:"name"`
+	PlayerCount int                     `json:"player_count"`
+	Description string                  `json:"description"`
+	Roles       []roleAssignmentRequest `json:"roles"`
+}
+
+// updateTemplateRequest is the body that updates a template; missing fields stay as they are
+type updateTemplateRequest struct {
+	Name        *string                  `json:"name"`
+	PlayerCount *int                     `json:"player_count"`
+	Description *string                  `json:"description"`
+	Roles       *[]roleAssignmentRequest `json:"roles"`
+}
+
+// parseRoleAssignments converts the roles of a request body
+func parseRoleAssignments(req []roleAssignmentRequest) ([]service.RoleAssignment, error) {
+	roles := make([]service.RoleAssignment, len(req))
+	for i, r := range req {
+		roleID, err := uuid.Parse(r.RoleID)
+		if err != nil {
+			return nil, errors.New("invalid role ID")
+		}
+		roles[i] = service.RoleAssignment{
+			RoleID:   roleID,
+			Count:    r.Count,
+			Optional: r.Optional,
+		}
+	}
+	return roles, nil
+}
+
+// writeTemplateError maps an error of a template change to its response
+func writeTemplateError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrTemplateNotFound):
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrEmptyTemplateName),
+		errors.Is(err, service.ErrInvalidPlayerCount),
+		errors.Is(err, service.ErrEmptyRoles),
+		errors.Is(err, service.ErrInvalidTemplateRoleCount),
+		errors.Is(err, service.ErrPlayerCountMismatch),
+		errors.Is(err, service.ErrRoleRuleViolated),
+		errors.Is(err, service.ErrRoleArchived),
+		errors.Is(err, service.ErrEmptyModeratorID):
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrTemplateNameExists),
+		errors.Is(err, service.ErrTemplateNotPrivate),
+		errors.Is(err, service.ErrTemplateNotPending):
+		ErrorResponse(w, http.StatusConflict, err.Error())
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
 // GetRoleTemplates handles GET /api/role-templates
+// Descriptions and roles are in the locale picked by the locale query
+// parameter or the Accept-Language header, falling back to the default locale.
+// With player_count, only the templates covering that count are listed, each
+// with the lineup it deals to a lobby of that size.
+// Curated templates come before community ones; source=curated or
+// source=community lists only one of them.
 func (h *RoleTemplateHandler) GetRoleTemplates(w http.ResponseWriter, r *http.Request) {
 	// Optional player count filter
-	var playerCount *int
-	if playerCountStr := r.URL.Query().Get("player_count"); playerCountStr != "" {
-		count, err := strconv.Atoi(playerCountStr)
-		if err != nil || count <= 0 {
-			ErrorResponse(w, http.StatusBadRequest, "invalid player_count parameter")
-			return
-		}
-		playerCount = &count
+	playerCount, ok := playerCountParam(r)
+	if !ok {
+		ErrorResponse(w, http.StatusBadRequest, "invalid player_count parameter")
+		return
 	}
 
-	templates, err := h.roleTemplateService.GetAllRoleTemplates(r.Context(), playerCount)
+	source := service.TemplateSource(r.URL.Query().Get("source"))
+	templates, err := h.roleTemplateService.ListRoleTemplates(r.Context(), playerCount, source)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidTemplateSource) {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		ErrorResponse(w, http.StatusInternalServerError, "failed to fetch role templates")
 		return
 	}
 
+	localizer := h.roleTemplateService.Localizer(localePreferences(r))
 	templatesJSON := make([]map[string]any, len(templates))
 	for i, template := range templates {
-		templatesJSON[i] = roleTemplateToJSON(template)
+		templatesJSON[i] = roleTemplateToJSON(template, localizer)
+		if playerCount != nil {
+			lineup, err := service.TemplateLineup(template, *playerCount)
+			if err != nil {
+				ErrorResponse(w, http.StatusInternalServerError, "failed to fetch role templates")
+				return
+			}
+			templatesJSON[i]["lineup"] = lineupToJSON(template, lineup, localizer)
+		}
 	}
 
+	w.Header().Add("Vary", "Accept-Language")
 	JSONResponse(w, http.StatusOK, templatesJSON)
 }
 
 // GetRoleTemplateByID handles GET /api/role-templates/{id}
+// With player_count, the response includes the lineup for a lobby of that size.
+// Unpublished templates are only shown to their owner, named by X-Moderator-ID.
 func (h *RoleTemplateHandler) GetRoleTemplateByID(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
@@ -58,7 +153,14 @@ func (h *RoleTemplateHandler) GetRoleTemplateByID(w http.ResponseWriter, r *http
 		return
 	}
 
-	template, err := h.roleTemplateService.GetRoleTemplateByID(r.Context(), id)
+	playerCount, ok := playerCountParam(r)
+	if !ok {
+		ErrorResponse(w, http.StatusBadRequest, "invalid player_count parameter")
+		return
+	}
+
+	moderatorID := r.Header.Get("X-Moderator-ID")
+	template, err := h.roleTemplateService.GetVisibleRoleTemplate(r.Context(), id, moderatorID)
 	if err != nil {
 		if errors.Is(err, service.ErrTemplateNotFound) {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
@@ -68,66 +170,51 @@ func (h *RoleTemplateHandler) GetRoleTemplateByID(w http.ResponseWriter, r *http
 		return
 	}
 
-	JSONResponse(w, http.StatusOK, roleTemplateToJSON(template))
+	localizer := h.roleTemplateService.Localizer(localePreferences(r))
+	result := roleTemplateToJSON(template, localizer)
+	if moderatorID != "" && template.OwnerID != nil && *template.OwnerID == moderatorID {
+		result = ownedTemplateToJSON(template, localizer)
+	}
+	if playerCount != nil {
+		lineup, err := service.TemplateLineup(template, *playerCount)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		result["lineup"] = lineupToJSON(template, lineup, localizer)
+	}
+
+	w.Header().Add("Vary", "Accept-Language")
+	JSONResponse(w, http.StatusOK, result)
 }
 
 // CreateRoleTemplate handles POST /api/admin/role-templates
 func (h *RoleTemplateHandler) CreateRoleTemplate(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		Name        string `json:"name"`
-		PlayerCount int    `json:"player_count"`
-		Description string `json:"description"`
-		Roles       []struct {
-			RoleID string `json:"role_id"`
-			Count  int    `json:"count"`
-		} `json:"roles"`
-	}
-
+	var req createTemplateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
-	// Parse role assignments
-	roles := make([]service.RoleAssignment, len(req.Roles))
-	for i, r := range req.Roles {
-		roleID, err := uuid.Parse(r.RoleID)
-		if err != nil {
-			ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
-			return
-		}
-		roles[i] = service.RoleAssignment{
-			RoleID: roleID,
-			Count:  r.Count,
-		}
+	roles, err := parseRoleAssignments(req.Roles)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	template, err := h.roleTemplateService.CreateRoleTemplate(
-		r.Context(),
+		revisionContext(r),
 		req.Name,
 		req.PlayerCount,
 		req.Description,
 		roles,
 	)
-
 	if err != nil {
-		if errors.Is(err, service.ErrEmptyTemplateName) ||
-			errors.Is(err, service.ErrInvalidPlayerCount) ||
-			errors.Is(err, service.ErrEmptyRoles) ||
-			errors.Is(err, service.ErrInvalidTemplateRoleCount) ||
-			errors.Is(err, service.ErrPlayerCountMismatch) {
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		if errors.Is(err, service.ErrTemplateNameExists) {
-			ErrorResponse(w, http.StatusConflict, err.Error())
-			return
-		}
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		writeTemplateError(w, err)
 		return
 	}
 
-	JSONResponse(w, http.StatusCreated, roleTemplateToJSON(template))
+	JSONResponse(w, http.StatusCreated, roleTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
 }
 
 // UpdateRoleTemplate handles PATCH /api/admin/role-templates/{id}
@@ -139,16 +226,7 @@ func (h *RoleTemplateHandler) UpdateRoleTemplate(w http.ResponseWriter, r *http.
 		return
 	}
 
-	var req struct {
-		Name        *string `json:"name"`
-		PlayerCount *int    `json:"player_count"`
-		Description *string `json:"description"`
-		Roles       *[]struct {
-			RoleID string `json:"role_id"`
-			Count  int    `json:"count"`
-		} `json:"roles"`
-	}
-
+	var req updateTemplateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
 		return
@@ -157,51 +235,27 @@ func (h *RoleTemplateHandler) UpdateRoleTemplate(w http.ResponseWriter, r *http.
 	// Parse role assignments if provided
 	var roles []service.RoleAssignment
 	if req.Roles != nil {
-		roles = make([]service.RoleAssignment, len(*req.Roles))
-		for i, r := range *req.Roles {
-			roleID, err := uuid.Parse(r.RoleID)
-			if err != nil {
-				ErrorResponse(w, http.StatusBadRequest, "invalid role ID")
-				return
-			}
-			roles[i] = service.RoleAssignment{
-				RoleID: roleID,
-				Count:  r.Count,
-			}
+		roles, err = parseRoleAssignments(*req.Roles)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 	}
 
 	template, err := h.roleTemplateService.UpdateRoleTemplate(
-		r.Context(),
+		revisionContext(r),
 		id,
 		req.Name,
 		req.PlayerCount,
 		req.Description,
 		roles,
 	)
-
 	if err != nil {
-		if errors.Is(err, service.ErrTemplateNotFound) {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-			return
-		}
-		if errors.Is(err, service.ErrEmptyTemplateName) ||
-			errors.Is(err, service.ErrInvalidPlayerCount) ||
-			errors.Is(err, service.ErrEmptyRoles) ||
-			errors.Is(err, service.ErrInvalidTemplateRoleCount) ||
-			errors.Is(err, service.ErrPlayerCountMismatch) {
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		if errors.Is(err, service.ErrTemplateNameExists) {
-			ErrorResponse(w, http.StatusConflict, err.Error())
-			return
-		}
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		writeTemplateError(w, err)
 		return
 	}
 
-	JSONResponse(w, http.StatusOK, roleTemplateToJSON(template))
+	JSONResponse(w, http.StatusOK, roleTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
 }
 
 // DeleteRoleTemplate handles DELETE /api/admin/role-templates/{id}
@@ -226,15 +280,93 @@ func (h *RoleTemplateHandler) DeleteRoleTemplate(w http.ResponseWriter, r *http.
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// GetTemplateTranslations handles GET /api/admin/role-templates/{id}/translations
+func (h *RoleTemplateHandler) GetTemplateTranslations(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+
+	translations, err := h.roleTemplateService.GetTemplateTranslations(r.Context(), id)
+	if err != nil {
+		writeTranslationError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(translations))
+	for i, t := range translations {
+		response[i] = templateTranslationToJSON(t)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// SetTemplateTranslation handles PUT /api/admin/role-templates/{id}/translations/{locale}
+func (h *RoleTemplateHandler) SetTemplateTranslation(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+
+	var req struct {
+		Description string `json:"description"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	translation, err := h.roleTemplateService.SetTemplateTranslation(r.Context(), id, chi.URLParam(r, "locale"), req.Description)
+	if err != nil {
+		writeTranslationError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, templateTranslationToJSON(translation))
+}
+
+// DeleteTemplateTranslation handles DELETE /api/admin/role-templates/{id}/translations/{locale}
+func (h *RoleTemplateHandler) DeleteTemplateTranslation(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+
+	if err := h.roleTemplateService.DeleteTemplateTranslation(r.Context(), id, chi.URLParam(r, "locale")); err != nil {
+		writeTranslationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func templateTranslationToJSON(t *ent.RoleTemplateTranslation) map[string]any {
+	return map[string]any{
+		"role_template_id": t.RoleTemplateID,
+		"locale":           t.Locale,
+		"description":      t.Description,
+	}
+}
+
 // roleTemplateToJSON converts an ent.RoleTemplate to a JSON-serializable map
-func roleTemplateToJSON(t *ent.RoleTemplate) map[string]any {
+// with its description and roles in the reader's locale
+func roleTemplateToJSON(t *ent.RoleTemplate, localizer service.Localizer) map[string]any {
+	locale, description := localizer.TemplateDescription(t)
 	result := map[string]any{
-		"id":           t.ID,
-		"name":         t.Name,
-		"player_count": t.PlayerCount,
-		"description":  t.Description,
-		"created_at":   t.CreatedAt,
-		"updated_at":   t.UpdatedAt,
+		"id":               t.ID,
+		"name":             t.Name,
+		"player_count":     t.PlayerCount,
+		"max_player_count": service.MaxPlayerCount(t),
+		"source":           service.SourceOf(t),
+		"status":           t.Status,
+		"description":      description,
+		"locale":           locale,
+		"created_at":       t.CreatedAt,
+		"updated_at":       t.UpdatedAt,
 	}
 
 	// Include roles if loaded
@@ -247,15 +379,7 @@ func roleTemplateToJSON(t *ent.RoleTemplate) map[string]any {
 
 			// Include full role details if loaded
 			if tr.Edges.Role != nil {
-				roleData["role"] = map[string]any{
-					"id":          tr.Edges.Role.ID,
-					"name":        tr.Edges.Role.Name,
-					"slug":        tr.Edges.Role.Slug,
-					"video":       tr.Edges.Role.Video,
-					"description": tr.Edges.Role.Description,
-					"team":        tr.Edges.Role.Team,
-					"abilities":   tr.Edges.Role.Abilities,
-				}
+				roleData["role"] = localizedRoleToJSON(tr.Edges.Role, localizer)
 			} else {
 				roleData["role_id"] = tr.RoleID
 			}
@@ -265,5 +389,64 @@ func roleTemplateToJSON(t *ent.RoleTemplate) map[string]any {
 		result["roles"] = roles
 	}
 
+	// Include optional slots in the order they switch on
+	if t.Edges.OptionalSlots != nil {
+		slots := make([]map[string]any, len(t.Edges.OptionalSlots))
+		for i, slot := range t.Edges.OptionalSlots {
+			slotData := map[string]any{
+				"position":     slot.Position,
+				"player_count": t.PlayerCount + i + 1,
+			}
+			if slot.Edges.Role != nil {
+				slotData["role"] = localizedRoleToJSON(slot.Edges.Role, localizer)
+			} else {
+				slotData["role_id"] = slot.RoleID
+			}
+			slots[i] = slotData
+		}
+		result["optional_roles"] = slots
+	}
+
+	return result
+}
+
+// ownedTemplateToJSON adds what only the owner of a template may see: how
+// it is shared and what the admins said when reviewing it
+func ownedTemplateToJSON(t *ent.RoleTemplate, localizer service.Localizer) map[string]any {
+	result := roleTemplateToJSON(t, localizer)
+	result["share_code"] = t.ShareCode
+	result["share_url"] = nil
+	if t.ShareCode != nil {
+		result["share_url"] = "/api/role-templates/shared/" + *t.ShareCode
+	}
+	result["review_note"] = t.ReviewNote
+	return result
+}
+
+// lineupToJSON converts the roles a template deals to a lobby to JSON,
+// taking the role details from the template's loaded roles and slots
+func lineupToJSON(t *ent.RoleTemplate, lineup []service.RoleAssignment, localizer service.Localizer) []map[string]any {
+	roles := make(map[uuid.UUID]*ent.Role)
+	for _, tr := range t.Edges.TemplateRoles {
+		if tr.Edges.Role != nil {
+			roles[tr.RoleID] = tr.Edges.Role
+		}
+	}
+	for _, slot := range t.Edges.OptionalSlots {
+		if slot.Edges.Role != nil {
+			roles[slot.RoleID] = slot.Edges.Role
+		}
+	}
+
+	result := make([]map[string]any, len(lineup))
+	for i, assignment := range lineup {
+		entry := map[string]any{"count": assignment.Count}
+		if role, ok := roles[assignment.RoleID]; ok {
+			entry["role"] = localizedRoleToJSON(role, localizer)
+		} else {
+			entry["role_id"] = assignment.RoleID
+		}
+		result[i] = entry
+	}
 	return result
 }
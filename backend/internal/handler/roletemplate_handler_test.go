@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/role"
 	"github.com/mafia-night/backend/internal/database"
 	"github.com/mafia-night/backend/internal/service"
@@ -381,3 +382,391 @@ func TestRoleTemplateHandler_DeleteRoleTemplate(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
 	})
 }
+
+func TestRoleTemplateHandler_Localized(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	templateService := service.NewRoleTemplateService(client)
+	ctx := context.Background()
+
+	mafia, err := roleService.CreateRole(ctx, "Godfather", "godfather", "video", "Leads the mafia", role.TeamMafia, nil)
+	require.NoError(t, err)
+	_, err = roleService.SetRoleTranslation(ctx, mafia.ID, "fa", "پدرخوانده", "رئیس مافیا", nil)
+	require.NoError(t, err)
+
+	template, err := templateService.CreateRoleTemplate(ctx, "Solo", 1, "Just the godfather", []service.RoleAssignment{{RoleID: mafia.ID, Count: 1}})
+	require.NoError(t, err)
+	_, err = templateService.SetTemplateTranslation(ctx, template.ID, "fa", "فقط پدرخوانده")
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/api/roles", NewRoleHandler(roleService).GetRoles)
+	r.Get("/api/role-templates/{id}", NewRoleTemplateHandler(templateService).GetRoleTemplateByID)
+	r.Put("/api/admin/roles/{id}/translations/{locale}", NewRoleHandler(roleService).SetRoleTranslation)
+
+	get := func(url, acceptLanguage string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("picks the locale from Accept-Language", func(t *testing.T) {
+		w := get("/api/roles", "fa-IR, en;q=0.5")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Values("Vary"), "Accept-Language")
+
+		var roles []map[string]any
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&roles))
+		require.Len(t, roles, 1)
+		assert.Equal(t, "پدرخوانده", roles[0]["name"])
+		assert.Equal(t, "fa", roles[0]["locale"])
+	})
+
+	t.Run("the locale parameter wins and falls back to the default", func(t *testing.T) {
+		w := get("/api/roles?locale=de", "fa")
+
+		var roles []map[string]any
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&roles))
+		assert.Equal(t, "Godfather", roles[0]["name"])
+		assert.Equal(t, "en", roles[0]["locale"])
+	})
+
+	t.Run("localizes templates and their roles", func(t *testing.T) {
+		w := get("/api/role-templates/"+template.ID.String()+"?locale=fa", "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]any
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "فقط پدرخوانده", response["description"])
+		roles := response["roles"].([]any)
+		assert.Equal(t, "پدرخوانده", roles[0].(map[string]any)["role"].(map[string]any)["name"])
+	})
+
+	t.Run("admin translation errors", func(t *testing.T) {
+		put := func(url string, body string) int {
+			req := httptest.NewRequest(http.MethodPut, url, bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w.Code
+		}
+
+		assert.Equal(t, http.StatusBadRequest, put("/api/admin/roles/"+mafia.ID.String()+"/translations/en", `{"name":"Godfather"}`))
+		assert.Equal(t, http.StatusNotFound, put("/api/admin/roles/"+template.ID.String()+"/translations/de", `{"name":"Pate"}`))
+		assert.Equal(t, http.StatusOK, put("/api/admin/roles/"+mafia.ID.String()+"/translations/de", `{"name":"Pate"}`))
+	})
+}
+
+func TestRoleTemplateHandler_OptionalSlots(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	templateService := service.NewRoleTemplateService(client)
+	handler := NewRoleTemplateHandler(templateService)
+	ctx := context.Background()
+
+	mafia, _ := roleService.CreateRole(ctx, "Mafia Slots", "mafia-slots", "video", "desc", role.TeamMafia, nil)
+	villager, _ := roleService.CreateRole(ctx, "Villager Slots", "villager-slots", "video", "desc", role.TeamVillage, nil)
+	doctor, _ := roleService.CreateRole(ctx, "Doctor Slots", "doctor-slots", "video", "desc", role.TeamVillage, nil)
+
+	r := chi.NewRouter()
+	r.Post("/api/admin/role-templates", handler.CreateRoleTemplate)
+	r.Get("/api/role-templates", handler.GetRoleTemplates)
+	r.Get("/api/role-templates/{id}", handler.GetRoleTemplateByID)
+
+	do := func(method, url string, body any) (*httptest.ResponseRecorder, map[string]any) {
+		var reader *bytes.Buffer
+		if body != nil {
+			data, _ := json.Marshal(body)
+			reader = bytes.NewBuffer(data)
+		} else {
+			reader = &bytes.Buffer{}
+		}
+		req := httptest.NewRequest(method, url, reader)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var response map[string]any
+		_ = json.Unmarshal(w.Body.Bytes(), &response)
+		return w, response
+	}
+
+	w, created := do(http.MethodPost, "/api/admin/role-templates", map[string]any{
+		"name":         "Growing",
+		"player_count": 5,
+		"roles": []map[string]any{
+			{"role_id": mafia.ID.String(), "count": 1},
+			{"role_id": villager.ID.String(), "count": 4},
+			{"role_id": doctor.ID.String(), "count": 1, "optional": true},
+			{"role_id": mafia.ID.String(), "count": 1, "optional": true},
+		},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	id := created["id"].(string)
+
+	t.Run("reports the player range and optional roles", func(t *testing.T) {
+		assert.Equal(t, float64(5), created["player_count"])
+		assert.Equal(t, float64(7), created["max_player_count"])
+
+		slots := created["optional_roles"].([]any)
+		require.Len(t, slots, 2)
+		first := slots[0].(map[string]any)
+		assert.Equal(t, float64(6), first["player_count"])
+		assert.Equal(t, "Doctor Slots", first["role"].(map[string]any)["name"])
+	})
+
+	t.Run("lists the template with its lineup for a covered count", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/role-templates?player_count=6", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var templates []map[string]any
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&templates))
+		require.Len(t, templates, 1)
+
+		lineup := templates[0]["lineup"].([]any)
+		require.Len(t, lineup, 3)
+		last := lineup[2].(map[string]any)
+		assert.Equal(t, float64(1), last["count"])
+		assert.Equal(t, "Doctor Slots", last["role"].(map[string]any)["name"])
+	})
+
+	t.Run("rejects a lineup outside the range", func(t *testing.T) {
+		w, _ := do(http.MethodGet, "/api/role-templates/"+id+"?player_count=8", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w, response := do(http.MethodGet, "/api/role-templates/"+id+"?player_count=7", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, response["lineup"].([]any), 3)
+	})
+}
+
+func TestRoleTemplateHandler_ModeratorTemplates(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	templateService := service.NewRoleTemplateService(client)
+	handler := NewRoleTemplateHandler(templateService)
+	ctx := context.Background()
+
+	mafia, _ := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "desc", role.TeamMafia, nil)
+	villager, _ := roleService.CreateRole(ctx, "Villager", "villager", "video", "desc", role.TeamVillage, nil)
+	_, err := templateService.CreateRoleTemplate(ctx, "Curated", 4, "", []service.RoleAssignment{
+		{RoleID: mafia.ID, Count: 1},
+		{RoleID: villager.ID, Count: 3},
+	})
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/api/role-templates", handler.GetRoleTemplates)
+	r.Get("/api/role-templates/shared/{code}", handler.GetSharedTemplate)
+	r.Get("/api/role-templates/{id}", handler.GetRoleTemplateByID)
+	r.Get("/api/moderator/role-templates", handler.GetModeratorTemplates)
+	r.Post("/api/moderator/role-templates", handler.CreateModeratorTemplate)
+	r.Post("/api/moderator/role-templates/{id}/share", handler.ShareModeratorTemplate)
+	r.Post("/api/moderator/role-templates/{id}/submit", handler.SubmitModeratorTemplate)
+	r.Get("/api/admin/role-templates/reviews", handler.GetPendingTemplates)
+	r.Post("/api/admin/role-templates/{id}/approve", handler.ApproveTemplate)
+
+	do := func(method, url, moderatorID string, body any) (*httptest.ResponseRecorder, any) {
+		var reader bytes.Buffer
+		if body != nil {
+			_ = json.NewEncoder(&reader).Encode(body)
+		}
+		req := httptest.NewRequest(method, url, &reader)
+		if moderatorID != "" {
+			req.Header.Set("X-Moderator-ID", moderatorID)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var response any
+		_ = json.Unmarshal(w.Body.Bytes(), &response)
+		return w, response
+	}
+
+	t.Run("requires the moderator header", func(t *testing.T) {
+		w, _ := do(http.MethodGet, "/api/moderator/role-templates", "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	w, response := do(http.MethodPost, "/api/moderator/role-templates", "alice", map[string]any{
+		"name":         "Alice's Night",
+		"player_count": 4,
+		"roles": []map[string]any{
+			{"role_id": mafia.ID.String(), "count": 1},
+			{"role_id": villager.ID.String(), "count": 3},
+		},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	created := response.(map[string]any)
+	assert.Equal(t, "private", created["status"])
+	assert.Equal(t, "community", created["source"])
+	assert.NotContains(t, created, "owner_id")
+	id := created["id"].(string)
+
+	t.Run("private templates are only shown to their owner", func(t *testing.T) {
+		w, _ := do(http.MethodGet, "/api/role-templates/"+id, "", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		w, _ = do(http.MethodGet, "/api/role-templates/"+id, "bob", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w, response := do(http.MethodGet, "/api/role-templates/"+id, "alice", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, response.(map[string]any), "share_code")
+	})
+
+	t.Run("shares by link", func(t *testing.T) {
+		w, response := do(http.MethodPost, "/api/moderator/role-templates/"+id+"/share", "alice", nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		shareURL := response.(map[string]any)["share_url"].(string)
+
+		w, response = do(http.MethodGet, shareURL, "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		shared := response.(map[string]any)
+		assert.Equal(t, "Alice's Night", shared["name"])
+		assert.NotContains(t, shared, "share_code")
+		assert.NotContains(t, shared, "owner_id")
+
+		w, _ = do(http.MethodPost, "/api/moderator/role-templates/"+id+"/share", "bob", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("publishes into the community listing", func(t *testing.T) {
+		w, _ := do(http.MethodPost, "/api/moderator/role-templates/"+id+"/submit", "alice", nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		w, _ = do(http.MethodPost, "/api/moderator/role-templates/"+id+"/submit", "alice", nil)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w, response := do(http.MethodGet, "/api/admin/role-templates/reviews", "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, response, 1)
+
+		w, _ = do(http.MethodPost, "/api/admin/role-templates/"+id+"/approve", "", map[string]any{"note": "Nice one"})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w, response = do(http.MethodGet, "/api/role-templates", "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		listed := response.([]any)
+		require.Len(t, listed, 2)
+		assert.Equal(t, "curated", listed[0].(map[string]any)["source"])
+		assert.Equal(t, "community", listed[1].(map[string]any)["source"])
+
+		w, response = do(http.MethodGet, "/api/role-templates?source=curated", "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, response, 1)
+
+		w, _ = do(http.MethodGet, "/api/role-templates?source=mine", "", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w, response = do(http.MethodGet, "/api/moderator/role-templates", "alice", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		owned := response.([]any)
+		require.Len(t, owned, 1)
+		assert.Equal(t, "published", owned[0].(map[string]any)["status"])
+		assert.Equal(t, "Nice one", owned[0].(map[string]any)["review_note"])
+	})
+}
+
+func TestRoleTemplateHandler_Revisions(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := service.NewRoleService(client)
+	templateService := service.NewRoleTemplateService(client)
+	handler := NewRoleTemplateHandler(templateService)
+	ctx := context.Background()
+
+	mafia, _ := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "desc", role.TeamMafia, nil)
+	villager, _ := roleService.CreateRole(ctx, "Villager", "villager", "video", "desc", role.TeamVillage, nil)
+
+	r := chi.NewRouter()
+	// Stands in for the JWT middleware, which puts the admin in the context
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), "admin_id", uuid.New())
+			ctx = context.WithValue(ctx, "admin_username", "root")
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	r.Post("/api/admin/role-templates", handler.CreateRoleTemplate)
+	r.Patch("/api/admin/role-templates/{id}", handler.UpdateRoleTemplate)
+	r.Post("/api/admin/role-templates/{id}/clone", handler.CloneTemplate)
+	r.Get("/api/admin/role-templates/{id}/revisions", handler.GetTemplateRevisions)
+	r.Get("/api/admin/role-templates/{id}/revisions/diff", handler.DiffTemplateRevisions)
+	r.Get("/api/admin/role-templates/{id}/revisions/{number}", handler.GetTemplateRevision)
+	r.Post("/api/admin/role-templates/{id}/revisions/{number}/rollback", handler.RollbackTemplate)
+
+	do := func(method, url string, body any) (*httptest.ResponseRecorder, any) {
+		var reader bytes.Buffer
+		if body != nil {
+			_ = json.NewEncoder(&reader).Encode(body)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, url, &reader))
+
+		var response any
+		_ = json.Unmarshal(w.Body.Bytes(), &response)
+		return w, response
+	}
+
+	w, response := do(http.MethodPost, "/api/admin/role-templates", map[string]any{
+		"name":         "Classic",
+		"player_count": 3,
+		"roles": []map[string]any{
+			{"role_id": mafia.ID.String(), "count": 1},
+			{"role_id": villager.ID.String(), "count": 2},
+		},
+	})
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	url := "/api/admin/role-templates/" + response.(map[string]any)["id"].(string)
+
+	w, _ = do(http.MethodPatch, url, map[string]any{
+		"player_count": 4,
+		"roles": []map[string]any{
+			{"role_id": mafia.ID.String(), "count": 1},
+			{"role_id": villager.ID.String(), "count": 3},
+		},
+	})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	t.Run("lists revisions with their author", func(t *testing.T) {
+		w, response := do(http.MethodGet, url+"/revisions", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		revisions := response.([]any)
+		require.Len(t, revisions, 2)
+		latest := revisions[0].(map[string]any)
+		assert.Equal(t, float64(2), latest["number"])
+		assert.Equal(t, "update", latest["action"])
+		assert.Equal(t, "root", latest["author"].(map[string]any)["name"])
+
+		w, _ = do(http.MethodGet, url+"/revisions/7", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("diffs revisions", func(t *testing.T) {
+		w, response := do(http.MethodGet, url+"/revisions/diff?from=1&to=2", nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		diff := response.(map[string]any)
+		assert.Equal(t, []any{map[string]any{"field": "player_count", "from": float64(3), "to": float64(4)}}, diff["fields"])
+		roles := diff["roles"].([]any)
+		require.Len(t, roles, 1)
+		assert.Equal(t, "villager", roles[0].(map[string]any)["slug"])
+
+		w, _ = do(http.MethodGet, url+"/revisions/diff?from=1", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("rolls back and clones", func(t *testing.T) {
+		w, response := do(http.MethodPost, url+"/revisions/1/rollback", nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, float64(3), response.(map[string]any)["player_count"])
+
+		w, response = do(http.MethodPost, url+"/clone", map[string]any{"name": "Classic Copy"})
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		assert.Equal(t, "Classic Copy", response.(map[string]any)["name"])
+
+		w, _ = do(http.MethodPost, url+"/clone", map[string]any{"name": "Classic"})
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
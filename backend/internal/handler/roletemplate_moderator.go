@@ -0,0 +1,269 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+// Moderators have no accounts, so their templates belong to the moderator ID
+// sent as X-Moderator-ID. The ID is a secret like a password: whoever knows it
+// can edit and publish the moderator's templates and run their games. It is
+// never shown to anyone else, including co-moderators, webhooks and the logs.
+
+// moderatorTemplateID reads the moderator and template ID of a request
+// on a moderator's own template, writing the error response when one is missing
+func moderatorTemplateID(w http.ResponseWriter, r *http.Request) (string, uuid.UUID, bool) {
+	moderatorID := r.Header.Get("X-Moderator-ID")
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return "", uuid.Nil, false
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return "", uuid.Nil, false
+	}
+	return moderatorID, id, true
+}
+
+// GetModeratorTemplates handles GET /api/moderator/role-templates
+// It lists the templates of the moderator named by the secret X-Moderator-ID,
+// whatever their status.
+func (h *RoleTemplateHandler) GetModeratorTemplates(w http.ResponseWriter, r *http.Request) {
+	moderatorID := r.Header.Get("X-Moderator-ID")
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	templates, err := h.roleTemplateService.GetModeratorTemplates(r.Context(), moderatorID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to fetch role templates")
+		return
+	}
+
+	localizer := h.roleTemplateService.Localizer(localePreferences(r))
+	templatesJSON := make([]map[string]any, len(templates))
+	for i, template := range templates {
+		templatesJSON[i] = ownedTemplateToJSON(template, localizer)
+	}
+
+	w.Header().Add("Vary", "Accept-Language")
+	JSONResponse(w, http.StatusOK, templatesJSON)
+}
+
+// CreateModeratorTemplate handles POST /api/moderator/role-templates
+// The template belongs to the secret X-Moderator-ID and stays private to the
+// moderator until it is shared or published.
+func (h *RoleTemplateHandler) CreateModeratorTemplate(w http.ResponseWriter, r *http.Request) {
+	moderatorID := r.Header.Get("X-Moderator-ID")
+	if moderatorID == "" {
+		ErrorResponse(w, http.StatusBadRequest, "X-Moderator-ID header is required")
+		return
+	}
+
+	var req createTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	roles, err := parseRoleAssignments(req.Roles)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	template, err := h.roleTemplateService.CreateModeratorTemplate(
+		r.Context(),
+		moderatorID,
+		req.Name,
+		req.PlayerCount,
+		req.Description,
+		roles,
+	)
+	if err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusCreated, ownedTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
+}
+
+// UpdateModeratorTemplate handles PATCH /api/moderator/role-templates/{id}
+// A changed template goes back to private and must be submitted again.
+func (h *RoleTemplateHandler) UpdateModeratorTemplate(w http.ResponseWriter, r *http.Request) {
+	moderatorID, id, ok := moderatorTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	var req updateTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var roles []service.RoleAssignment
+	if req.Roles != nil {
+		var err error
+		roles, err = parseRoleAssignments(*req.Roles)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	template, err := h.roleTemplateService.UpdateModeratorTemplate(
+		r.Context(),
+		moderatorID,
+		id,
+		req.Name,
+		req.PlayerCount,
+		req.Description,
+		roles,
+	)
+	if err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, ownedTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
+}
+
+// DeleteModeratorTemplate handles DELETE /api/moderator/role-templates/{id}
+func (h *RoleTemplateHandler) DeleteModeratorTemplate(w http.ResponseWriter, r *http.Request) {
+	moderatorID, id, ok := moderatorTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.roleTemplateService.DeleteModeratorTemplate(r.Context(), moderatorID, id); err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ShareModeratorTemplate handles POST /api/moderator/role-templates/{id}/share
+// Anyone with the returned share code or link can read the template.
+func (h *RoleTemplateHandler) ShareModeratorTemplate(w http.ResponseWriter, r *http.Request) {
+	moderatorID, id, ok := moderatorTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	template, err := h.roleTemplateService.ShareTemplate(r.Context(), moderatorID, id)
+	if err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, ownedTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
+}
+
+// UnshareModeratorTemplate handles DELETE /api/moderator/role-templates/{id}/share
+func (h *RoleTemplateHandler) UnshareModeratorTemplate(w http.ResponseWriter, r *http.Request) {
+	moderatorID, id, ok := moderatorTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.roleTemplateService.UnshareTemplate(r.Context(), moderatorID, id); err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SubmitModeratorTemplate handles POST /api/moderator/role-templates/{id}/submit
+// The template waits for an admin to publish it to the community catalog.
+func (h *RoleTemplateHandler) SubmitModeratorTemplate(w http.ResponseWriter, r *http.Request) {
+	moderatorID, id, ok := moderatorTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	template, err := h.roleTemplateService.SubmitTemplate(r.Context(), moderatorID, id)
+	if err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, ownedTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
+}
+
+// GetSharedTemplate handles GET /api/role-templates/shared/{code}
+func (h *RoleTemplateHandler) GetSharedTemplate(w http.ResponseWriter, r *http.Request) {
+	template, err := h.roleTemplateService.GetSharedTemplate(r.Context(), chi.URLParam(r, "code"))
+	if err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	w.Header().Add("Vary", "Accept-Language")
+	JSONResponse(w, http.StatusOK, roleTemplateToJSON(template, h.roleTemplateService.Localizer(localePreferences(r))))
+}
+
+// GetPendingTemplates handles GET /api/admin/role-templates/reviews
+func (h *RoleTemplateHandler) GetPendingTemplates(w http.ResponseWriter, r *http.Request) {
+	templates, err := h.roleTemplateService.GetPendingTemplates(r.Context())
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "failed to fetch role templates")
+		return
+	}
+
+	localizer := h.roleTemplateService.Localizer(nil)
+	templatesJSON := make([]map[string]any, len(templates))
+	for i, template := range templates {
+		templatesJSON[i] = roleTemplateToJSON(template, localizer)
+	}
+
+	JSONResponse(w, http.StatusOK, templatesJSON)
+}
+
+// ApproveTemplate handles POST /api/admin/role-templates/{id}/approve
+func (h *RoleTemplateHandler) ApproveTemplate(w http.ResponseWriter, r *http.Request) {
+	h.reviewTemplate(w, r, true)
+}
+
+// RejectTemplate handles POST /api/admin/role-templates/{id}/reject
+// The note tells the owner what to change before submitting again.
+func (h *RoleTemplateHandler) RejectTemplate(w http.ResponseWriter, r *http.Request) {
+	h.reviewTemplate(w, r, false)
+}
+
+func (h *RoleTemplateHandler) reviewTemplate(w http.ResponseWriter, r *http.Request, approve bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+
+	// The body with the review note is optional
+	var req struct {
+		Note string `json:"note"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	template, err := h.roleTemplateService.ReviewTemplate(r.Context(), id, approve, req.Note)
+	if err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	result := roleTemplateToJSON(template, h.roleTemplateService.Localizer(nil))
+	result["review_note"] = template.ReviewNote
+	JSONResponse(w, http.StatusOK, result)
+}
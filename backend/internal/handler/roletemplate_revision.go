@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+// revisionContext returns the context of a request, recording the template
+// changes it makes as made by the signed in admin
+func revisionContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if id, username, ok := auth.AdminFromContext(ctx); ok {
+		ctx = service.WithRevisionAuthor(ctx, service.RevisionAuthor{
+			Kind: roletemplaterevision.AuthorKindAdmin,
+			ID:   id.String(),
+			Name: username,
+		})
+	}
+	return ctx
+}
+
+// revisionNumber parses a revision number from a URL parameter or query value
+func revisionNumber(raw string) (int, bool) {
+	number, err := strconv.Atoi(raw)
+	return number, err == nil && number > 0
+}
+
+// GetTemplateRevisions handles GET /api/admin/role-templates/{id}/revisions
+// Revisions are listed newest first.
+func (h *RoleTemplateHandler) GetTemplateRevisions(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+
+	revisions, err := h.roleTemplateService.GetTemplateRevisions(r.Context(), id)
+	if err != nil {
+		writeRevisionError(w, err)
+		return
+	}
+
+	response := make([]map[string]any, len(revisions))
+	for i, revision := range revisions {
+		response[i] = revisionToJSON(revision)
+	}
+
+	JSONResponse(w, http.StatusOK, response)
+}
+
+// GetTemplateRevision handles GET /api/admin/role-templates/{id}/revisions/{number}
+func (h *RoleTemplateHandler) GetTemplateRevision(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+	number, ok := revisionNumber(chi.URLParam(r, "number"))
+	if !ok {
+		ErrorResponse(w, http.StatusBadRequest, "invalid revision number")
+		return
+	}
+
+	revision, err := h.roleTemplateService.GetTemplateRevision(r.Context(), id, number)
+	if err != nil {
+		writeRevisionError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, revisionToJSON(revision))
+}
+
+// DiffTemplateRevisions handles GET /api/admin/role-templates/{id}/revisions/diff?from=1&to=2
+func (h *RoleTemplateHandler) DiffTemplateRevisions(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+	from, ok := revisionNumber(r.URL.Query().Get("from"))
+	if !ok {
+		ErrorResponse(w, http.StatusBadRequest, "invalid from revision number")
+		return
+	}
+	to, ok := revisionNumber(r.URL.Query().Get("to"))
+	if !ok {
+		ErrorResponse(w, http.StatusBadRequest, "invalid to revision number")
+		return
+	}
+
+	diff, err := h.roleTemplateService.DiffTemplateRevisions(r.Context(), id, from, to)
+	if err != nil {
+		writeRevisionError(w, err)
+		return
+	}
+
+	fields := make([]map[string]any, len(diff.Fields))
+	for i, field := range diff.Fields {
+		change := map[string]any{"field": field}
+		switch field {
+		case "name":
+			change["from"], change["to"] = diff.From.Name, diff.To.Name
+		case "player_count":
+			change["from"], change["to"] = diff.From.PlayerCount, diff.To.PlayerCount
+		case "description":
+			change["from"], change["to"] = diff.From.Description, diff.To.Description
+		}
+		fields[i] = change
+	}
+
+	roles := make([]map[string]any, len(diff.Roles))
+	for i, c := range diff.Roles {
+		roles[i] = map[string]any{
+			"role_id":  c.RoleID,
+			"slug":     c.Slug,
+			"optional": c.Optional,
+			"from":     c.From,
+			"to":       c.To,
+		}
+	}
+
+	JSONResponse(w, http.StatusOK, map[string]any{
+		"from":                   diff.From.Number,
+		"to":                     diff.To.Number,
+		"fields":                 fields,
+		"roles":                  roles,
+		"optional_order_changed": diff.OptionalOrderChanged,
+	})
+}
+
+// RollbackTemplate handles POST /api/admin/role-templates/{id}/revisions/{number}/rollback
+// The template takes the name, player count, description and roles of the
+// revision; the rollback itself becomes the newest revision.
+func (h *RoleTemplateHandler) RollbackTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+	number, ok := revisionNumber(chi.URLParam(r, "number"))
+	if !ok {
+		ErrorResponse(w, http.StatusBadRequest, "invalid revision number")
+		return
+	}
+
+	template, err := h.roleTemplateService.RollbackTemplate(revisionContext(r), id, number)
+	if err != nil {
+		writeRevisionError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusOK, roleTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
+}
+
+// CloneTemplate handles POST /api/admin/role-templates/{id}/clone
+// The copy is a curated template named by the request body.
+func (h *RoleTemplateHandler) CloneTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid template ID")
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	template, err := h.roleTemplateService.CloneTemplate(revisionContext(r), id, req.Name)
+	if err != nil {
+		writeTemplateError(w, err)
+		return
+	}
+
+	JSONResponse(w, http.StatusCreated, roleTemplateToJSON(template, h.roleTemplateService.Localizer(nil)))
+}
+
+// writeRevisionError maps revision errors to HTTP responses
+func writeRevisionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrRevisionNotFound):
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrRoleNotFound):
+		// A role of the revision was deleted since
+		ErrorResponse(w, http.StatusConflict, err.Error())
+	default:
+		writeTemplateError(w, err)
+	}
+}
+
+// revisionToJSON converts an ent.RoleTemplateRevision to a JSON-serializable map.
+// Moderator IDs are credentials, so only admins are named.
+func revisionToJSON(revision *ent.RoleTemplateRevision) map[string]any {
+	author := map[string]any{"kind": revision.AuthorKind}
+	if revision.AuthorKind == roletemplaterevision.AuthorKindAdmin {
+		author["id"] = revision.AuthorID
+		author["name"] = revision.AuthorName
+	}
+
+	roles := make([]map[string]any, len(revision.Roles))
+	for i, r := range revision.Roles {
+		roles[i] = map[string]any{
+			"role_id":  r.RoleID,
+			"slug":     r.Slug,
+			"count":    r.Count,
+			"optional": r.Optional,
+		}
+	}
+
+	return map[string]any{
+		"number":        revision.Number,
+		"name":          revision.Name,
+		"player_count":  revision.PlayerCount,
+		"description":   revision.Description,
+		"roles":         roles,
+		"action":        revision.Action,
+		"source_number": revision.SourceNumber,
+		"author":        author,
+		"created_at":    revision.CreatedAt,
+	}
+}
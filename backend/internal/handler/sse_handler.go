@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// HandleEvents streams the updates of a game as Server-Sent Events for clients
+// that cannot open a WebSocket, such as behind proxies that block upgrades.
+//
+// The stream carries the same updates as the WebSocket, filtered for the same
+// audiences, and takes the same query parameters to identify the client.
+// It is receive-only; commands are sent through the REST endpoints instead.
+// Clients pick the message schema version with the v query parameter; the
+// stream is always JSON.
+// Sequenced updates have an event ID that the browser sends back in the
+// Last-Event-ID header when it reconnects, so missed updates are replayed.
+func (h *WebSocketHub) HandleEvents(w http.ResponseWriter, r *http.Request) {
+	gameID := chi.URLParam(r, "id")
+	if gameID == "" {
+		http.Error(w, "game ID required", http.StatusBadRequest)
+		return
+	}
+
+	remoteAddr := r.RemoteAddr
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		remoteAddr = forwarded
+	}
+
+	format, err := queryFormat(r)
+	if err != nil || format.binary() {
+		http.Error(w, "unsupported protocol; event streams support versions "+
+			strconv.Itoa(OldestProtocol)+" to "+strconv.Itoa(CurrentProtocol)+" in JSON", http.StatusBadRequest)
+		return
+	}
+
+	audience, viewer, spectatorID, ok := h.authorizeConnection(w, r, gameID)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Keep nginx from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		log.Printf("[SSE] Streaming not supported for game %s, addr %s: %v", gameID, remoteAddr, err)
+		return
+	}
+
+	client := &Client{
+		hub:         h,
+		stop:        cancel,
+		send:        make(chan outgoing, h.config.SendBufferSize),
+		gameID:      gameID,
+		audience:    audience,
+		spectatorID: spectatorID,
+		viewer:      viewer,
+		format:      format,
+		resume:      eventsResumeParams(r),
+		slowPolicy:  h.slowConsumerPolicy(r),
+		remoteAddr:  remoteAddr,
+		connectedAt: time.Now(),
+	}
+
+	h.register <- client
+	defer func() {
+		h.unregister <- client
+	}()
+
+	client.writeEvents(ctx, w, rc)
+}
+
+// writeEvents writes the updates queued for a client until either side hangs up
+func (c *Client) writeEvents(ctx context.Context, w io.Writer, rc *http.ResponseController) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	stream := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case queued, ok := <-c.send:
+			if !ok {
+				// The hub dropped the client
+				return
+			}
+			rc.SetWriteDeadline(time.Now().Add(writeWait))
+			if _, err := w.Write(sseEvent(queued.message, &stream)); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			// Comments keep proxies from closing an idle stream
+			rc.SetWriteDeadline(time.Now().Add(writeWait))
+			if _, err := io.WriteString(w, ": ping\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sseEvent formats a message as a Server-Sent Event.
+// Sequenced updates get the ID "<stream>:<seq>"; stream tracks the stream ID
+// announced by the initial state or a resume.
+func sseEvent(message []byte, stream *string) []byte {
+	var update struct {
+		Type    GameUpdateType  `json:"type"`
+		Seq     uint64          `json:"seq"`
+		Payload json.RawMessage `json:"payload"`
+		// Data replaces Payload from protocol version 2
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(message, &update); err != nil {
+		return fmt.Appendf(nil, "data: %s\n\n", message)
+	}
+	if update.Payload == nil {
+		update.Payload = update.Data
+	}
+
+	seq := update.Seq
+	if update.Type == "initial_state" || update.Type == "resumed" {
+		var position struct {
+			Stream string `json:"stream"`
+			Seq    uint64 `json:"seq"`
+		}
+		if json.Unmarshal(update.Payload, &position) == nil && position.Stream != "" {
+			*stream = position.Stream
+			seq = position.Seq
+		}
+	}
+
+	if seq == 0 || *stream == "" {
+		return fmt.Appendf(nil, "data: %s\n\n", message)
+	}
+	return fmt.Appendf(nil, "id: %s:%d\ndata: %s\n\n", *stream, seq, message)
+}
+
+// eventsResumeParams reads where a reconnecting event stream left off, from the
+// Last-Event-ID header browsers send or a last_event_id query parameter
+func eventsResumeParams(r *http.Request) *resumePoint {
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+	if lastEventID == "" {
+		return resumeParams(r)
+	}
+
+	stream, seqText, ok := strings.Cut(lastEventID, ":")
+	if !ok || stream == "" {
+		return nil
+	}
+	seq, err := strconv.ParseUint(seqText, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &resumePoint{stream: stream, seq: seq}
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readEvent reads the next event from a Server-Sent Events stream, skipping comments
+func readEvent(t *testing.T, r *bufio.Reader) (id string, data string) {
+	t.Helper()
+
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+
+		switch {
+		case line == "" && data != "":
+			return id, data
+		case strings.HasPrefix(line, "id: "):
+			id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestHandleEvents(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	hub := NewWebSocketHub(gameService, nil, testTokens, nil, HubConfig{})
+	ctx := context.Background()
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	token, err := testTokens.GenerateGameToken(created.ID, auth.GameTokenModerator, "mod-123")
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/api/games/{id}/events", hub.HandleEvents)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	t.Run("requires a token", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/api/games/" + created.ID + "/events")
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("streams sequenced updates", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/api/games/" + created.ID + "/events?token=" + token)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		body := bufio.NewReader(resp.Body)
+		_, data := readEvent(t, body)
+
+		var initial struct {
+			Type    GameUpdateType `json:"type"`
+			Payload struct {
+				Stream string `json:"stream"`
+			} `json:"payload"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(data), &initial))
+		assert.Equal(t, GameUpdateType("initial_state"), initial.Type)
+
+		hub.BroadcastToGame(created.ID, PlayerJoined, map[string]any{"name": "Alice"})
+
+		id, data := readEvent(t, body)
+		assert.Equal(t, initial.Payload.Stream+":1", id)
+		assert.Contains(t, data, `"type":"player_joined"`)
+
+		// Hanging up unregisters the client
+		resp.Body.Close()
+		assert.Eventually(t, func() bool {
+			hub.mu.RLock()
+			defer hub.mu.RUnlock()
+			return len(hub.clients[created.ID]) == 0
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestEventsResumeParams(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/games/ABC123/events", nil)
+	assert.Nil(t, eventsResumeParams(req))
+
+	req.Header.Set("Last-Event-ID", "stream-1:42")
+	assert.Equal(t, &resumePoint{stream: "stream-1", seq: 42}, eventsResumeParams(req))
+
+	req.Header.Set("Last-Event-ID", "garbage")
+	assert.Nil(t, eventsResumeParams(req))
+
+	req = httptest.NewRequest(http.MethodGet, "/api/games/ABC123/events?last_event_id=stream-1:7", nil)
+	assert.Equal(t, &resumePoint{stream: "stream-1", seq: 7}, eventsResumeParams(req))
+}
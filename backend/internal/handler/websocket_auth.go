@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+const (
+	// wsProtocol is the subprotocol the server agrees to
+	wsProtocol = "mafia-night"
+
+	// Browsers cannot set headers on WebSocket requests, so clients may pass
+	// their game token as an extra subprotocol "token.<game token>" next to
+	// wsProtocol. Unlike the query string, it does not end up in access logs.
+	wsTokenProtocolPrefix = "token."
+)
+
+// checkOrigin reports whether a browser on the request's origin may connect
+// Requests without an Origin header come from outside a browser and are allowed.
+func (h *WebSocketHub) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	if len(h.config.AllowedOrigins) == 0 {
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+
+	for _, allowed := range h.config.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestToken returns the game token from the token query parameter or a
+// "token." subprotocol
+func requestToken(r *http.Request) string {
+	if token := r.URL.Query().Get("token"); token != "" {
+		return token
+	}
+	for _, protocol := range websocket.Subprotocols(r) {
+		if token, ok := strings.CutPrefix(protocol, wsTokenProtocolPrefix); ok {
+			return token
+		}
+	}
+	return ""
+}
+
+// authorizeConnection works out who is connecting to a game from their game
+// token, writing an error response if they may not connect.
+// It returns the audience, the viewer and, for spectators, the spectator ID.
+func (h *WebSocketHub) authorizeConnection(w http.ResponseWriter, r *http.Request, gameID string) (Audience, projection.Viewer, string, bool) {
+	token := requestToken(r)
+	if token == "" {
+		http.Error(w, "game token required", http.StatusUnauthorized)
+		return "", projection.Viewer{}, "", false
+	}
+
+	claims, err := h.tokens.ValidateGameToken(token, gameID)
+	if err != nil {
+		http.Error(w, "invalid game token", http.StatusUnauthorized)
+		return "", projection.Viewer{}, "", false
+	}
+
+	moderatorID, playerID, spectatorID, ok := tokenIdentity(claims)
+	if !ok {
+		http.Error(w, "invalid game token", http.StatusUnauthorized)
+		return "", projection.Viewer{}, "", false
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	g, err := h.gameService.GetGameByID(ctx, gameID)
+	if err != nil {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return "", projection.Viewer{}, "", false
+	}
+
+	// The token may outlive the identity, such as a removed player or co-moderator
+	viewer, err := h.gameService.ResolveViewer(ctx, g, moderatorID, playerID, spectatorID)
+	if err != nil {
+		http.Error(w, "not allowed to join this game", http.StatusForbidden)
+		return "", projection.Viewer{}, "", false
+	}
+
+	audience := AudiencePlayers
+	if viewer.Kind == projection.KindSpectator {
+		if g.SpectatorMode == game.SpectatorModeDisabled {
+			http.Error(w, service.ErrSpectatorsDisabled.Error(), http.StatusForbidden)
+			return "", projection.Viewer{}, "", false
+		}
+
+		h.ensureSpectatorView(g)
+		audience = AudienceSpectators
+	}
+
+	return audience, viewer, spectatorID, true
+}
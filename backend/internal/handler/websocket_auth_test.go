@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketHub_CheckOrigin(t *testing.T) {
+	hub := &WebSocketHub{config: HubConfig{AllowedOrigins: []string{"https://mafia.example.com"}}}
+
+	request := func(origin string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "http://api.example.com/api/games/ABC123/ws", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return r
+	}
+
+	assert.True(t, hub.checkOrigin(request("https://mafia.example.com")))
+	assert.False(t, hub.checkOrigin(request("https://evil.example.com")))
+	assert.True(t, hub.checkOrigin(request("")), "non-browser clients send no origin")
+
+	sameOrigin := &WebSocketHub{}
+	assert.True(t, sameOrigin.checkOrigin(request("http://api.example.com")))
+	assert.False(t, sameOrigin.checkOrigin(request("https://mafia.example.com")))
+}
+
+func TestHandleWebSocket_Auth(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := service.NewGameService(client)
+	hub := NewWebSocketHub(gameService, nil, testTokens, nil, HubConfig{AllowedOrigins: []string{"https://mafia.example.com"}})
+	ctx := context.Background()
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	player, err := gameService.JoinGame(ctx, created.ID, "Alice")
+	require.NoError(t, err)
+	playerToken, err := testTokens.GenerateGameToken(created.ID, auth.GameTokenPlayer, player.ID.String())
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Get("/api/games/{id}/ws", hub.HandleWebSocket)
+	server := httptest.NewServer(r)
+	defer server.Close()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/games/"
+
+	dial := func(gameID string, header http.Header) (*websocket.Conn, int) {
+		conn, resp, err := websocket.DefaultDialer.Dial(wsURL+gameID+"/ws", header)
+		if err != nil {
+			require.NotNil(t, resp, err)
+			return nil, resp.StatusCode
+		}
+		return conn, resp.StatusCode
+	}
+
+	t.Run("rejects connections without a token", func(t *testing.T) {
+		_, status := dial(created.ID, nil)
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("rejects tokens for another game", func(t *testing.T) {
+		other, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, status := dial(other.ID, http.Header{"Sec-WebSocket-Protocol": {wsProtocol + ", " + wsTokenProtocolPrefix + playerToken}})
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("rejects games that do not exist", func(t *testing.T) {
+		token, err := testTokens.GenerateGameToken("NOPE42", auth.GameTokenModerator, "mod-123")
+		require.NoError(t, err)
+
+		_, status := dial("NOPE42", http.Header{"Sec-WebSocket-Protocol": {wsProtocol + ", " + wsTokenProtocolPrefix + token}})
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("rejects other origins", func(t *testing.T) {
+		_, status := dial(created.ID, http.Header{
+			"Origin":                 {"https://evil.example.com"},
+			"Sec-WebSocket-Protocol": {wsProtocol + ", " + wsTokenProtocolPrefix + playerToken},
+		})
+		assert.Equal(t, http.StatusForbidden, status)
+	})
+
+	t.Run("accepts a token passed as a subprotocol", func(t *testing.T) {
+		conn, status := dial(created.ID, http.Header{
+			"Origin":                 {"https://mafia.example.com"},
+			"Sec-WebSocket-Protocol": {wsProtocol + ", " + wsTokenProtocolPrefix + playerToken},
+		})
+		require.NotNil(t, conn)
+		defer conn.Close()
+
+		assert.Equal(t, http.StatusSwitchingProtocols, status)
+		assert.Equal(t, wsProtocol, conn.Subprotocol(), "the token is never echoed back")
+
+		// Updates such as the player coming online may arrive ahead of the snapshot
+		var update GameUpdate
+		for update.Type != "initial_state" {
+			require.NoError(t, conn.ReadJSON(&update))
+		}
+		assert.Equal(t, player.ID.String(), update.Payload.(map[string]any)["you"].(map[string]any)["id"])
+	})
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/mafia-night/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubConfig_WithDefaults(t *testing.T) {
+	config := HubConfig{MaxTotalConnections: 10, SlowConsumerPolicy: "bogus"}.withDefaults()
+
+	assert.Equal(t, 10, config.MaxTotalConnections)
+	assert.Equal(t, defaultMaxConnectionsPerGame, config.MaxConnectionsPerGame)
+	assert.Equal(t, int64(defaultMaxMessageSize), config.MaxMessageSize)
+	assert.Equal(t, defaultSendBufferSize, config.SendBufferSize)
+	assert.Equal(t, SlowConsumerDisconnect, config.SlowConsumerPolicy)
+}
+
+func TestWebSocketHub_SlowConsumers(t *testing.T) {
+	// slowClient adds a client with a two message buffer that nobody reads
+	slowClient := func(hub *WebSocketHub, policy SlowConsumerPolicy) *Client {
+		client := addTestClient(hub, "ABC123", projection.PlayerViewer(uuid.New()), nil)
+		client.send = make(chan outgoing, 2)
+		client.slowPolicy = policy
+		return client
+	}
+
+	send := func(hub *WebSocketHub, client *Client, message string) bool {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return hub.trySend(client, []byte(message))
+	}
+
+	t.Run("disconnect", func(t *testing.T) {
+		hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+		client := slowClient(hub, SlowConsumerDisconnect)
+
+		assert.True(t, send(hub, client, "1"))
+		assert.True(t, send(hub, client, "2"))
+		assert.False(t, send(hub, client, "3"))
+
+		hub.mu.RLock()
+		assert.False(t, hub.clients["ABC123"][client])
+		hub.mu.RUnlock()
+		assert.Equal(t, int64(1), hub.GetConnectionStats()["slow_consumer_disconnects"])
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+		client := slowClient(hub, SlowConsumerDropOldest)
+
+		for _, message := range []string{"1", "2", "3", "4"} {
+			assert.True(t, send(hub, client, message))
+		}
+
+		assert.Equal(t, "3", string((<-client.send).message))
+		assert.Equal(t, "4", string((<-client.send).message))
+		assert.Equal(t, 2, client.dropped)
+		assert.Equal(t, int64(2), hub.GetConnectionStats()["dropped_messages"])
+	})
+
+	t.Run("coalesce without a stream to snapshot disconnects", func(t *testing.T) {
+		hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+		client := slowClient(hub, SlowConsumerCoalesce)
+
+		assert.True(t, send(hub, client, "1"))
+		assert.True(t, send(hub, client, "2"))
+		assert.False(t, send(hub, client, "3"), "snapshots need a game service")
+
+		stats := hub.GetConnectionStats()
+		assert.Equal(t, int64(3), stats["coalesced_messages"])
+		assert.Equal(t, int64(1), stats["slow_consumer_disconnects"])
+	})
+
+	t.Run("coalesced clients skip updates until their snapshot", func(t *testing.T) {
+		hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+		client := slowClient(hub, SlowConsumerCoalesce)
+		client.awaitingSnapshot = true
+
+		require.True(t, send(hub, client, "1"))
+		assert.Empty(t, client.send)
+		assert.Equal(t, 1, client.coalesced)
+	})
+
+	t.Run("coalescing keeps replies and targeted updates", func(t *testing.T) {
+		hub := NewWebSocketHub(service.NewGameService(database.SetupTestDB(t)), nil, nil, nil, HubConfig{})
+		hub.streams["ABC123"] = newEventStream()
+		client := slowClient(hub, SlowConsumerCoalesce)
+
+		hub.mu.Lock()
+		require.True(t, hub.sendDirect(client, []byte("ack")))
+		require.True(t, hub.trySend(client, []byte("1")))
+		require.True(t, hub.trySend(client, []byte("2")))
+		require.True(t, client.awaitingSnapshot)
+		require.True(t, hub.trySend(client, []byte("3")))
+		require.True(t, hub.sendDirect(client, []byte("private")))
+		hub.mu.Unlock()
+
+		assert.Equal(t, "ack", string((<-client.send).message))
+		assert.Equal(t, "private", string((<-client.send).message))
+		assert.Empty(t, client.send)
+		assert.Equal(t, 3, client.coalesced)
+	})
+}
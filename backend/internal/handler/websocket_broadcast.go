@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Broadcaster carries game updates to the hubs of every server instance.
+//
+// A hub publishes the updates it is asked to send instead of delivering them
+// itself, and delivers whatever the broadcaster hands back, including its own
+// updates. That way every connection of a game gets an update no matter which
+// instance it is connected to or which instance handled the request.
+type Broadcaster interface {
+	// Publish sends an update to every subscribed hub
+	Publish(update GameUpdate) error
+	// Subscribe sets the function updates are delivered to
+	Subscribe(deliver func(GameUpdate))
+	// Close stops delivering updates
+	Close() error
+}
+
+// MemoryBroadcaster delivers updates within a single server instance
+type MemoryBroadcaster struct {
+	deliver func(GameUpdate)
+}
+
+// NewMemoryBroadcaster creates a broadcaster for running a single instance
+func NewMemoryBroadcaster() *MemoryBroadcaster {
+	return &MemoryBroadcaster{}
+}
+
+func (b *MemoryBroadcaster) Publish(update GameUpdate) error {
+	if b.deliver != nil {
+		b.deliver(update)
+	}
+	return nil
+}
+
+func (b *MemoryBroadcaster) Subscribe(deliver func(GameUpdate)) {
+	b.deliver = deliver
+}
+
+func (b *MemoryBroadcaster) Close() error {
+	return nil
+}
+
+const (
+	// Postgres channel game updates are sent on
+	notifyChannel = "mafia_game_updates"
+
+	// Postgres rejects NOTIFY payloads of 8000 bytes or more, so larger
+	// updates are split into chunks of at most this many encoded bytes
+	notifyChunkSize = 7000
+
+	// How long the first chunks of an update wait for the rest
+	chunkTimeout = time.Minute
+
+	// Idle listener connections are pinged to notice when they go away
+	listenerPingInterval = 90 * time.Second
+)
+
+// envelope is an update as it travels between instances
+// The target of a private update is not part of the update's JSON, which is
+// what clients see, so it travels next to it.
+type envelope struct {
+	Update GameUpdate    `json:"update"`
+	Target *updateTarget `json:"target,omitempty"`
+}
+
+// PostgresBroadcaster fans updates out to every instance sharing a database
+// using LISTEN/NOTIFY.
+//
+// Updates are delivered at most once: an instance that loses its listener
+// connection misses what is sent until it reconnects. Sequence numbers are
+// assigned by each instance, so a client that reconnects to another instance
+// gets a fresh snapshot instead of a replay.
+type PostgresBroadcaster struct {
+	db       *sql.DB
+	listener *pq.Listener
+	deliver  func(GameUpdate)
+	mu       sync.Mutex
+	done     chan struct{}
+}
+
+// NewPostgresBroadcaster connects to the database at dsn and starts listening for updates
+func NewPostgresBroadcaster(dsn string) (*PostgresBroadcaster, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening broadcast connection: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed opening broadcast connection: %w", err)
+	}
+
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("[WebSocket] Broadcast listener: %v", err)
+		}
+	})
+	if err := listener.Listen(notifyChannel); err != nil {
+		listener.Close()
+		db.Close()
+		return nil, fmt.Errorf("failed listening for updates: %w", err)
+	}
+
+	b := &PostgresBroadcaster{
+		db:       db,
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+	go b.listen()
+
+	return b, nil
+}
+
+// Publish sends an update to every instance
+// The chunks of an update are sent in one transaction, so they arrive together.
+func (b *PostgresBroadcaster) Publish(update GameUpdate) error {
+	data, err := json.Marshal(envelope{Update: update, Target: update.target})
+	if err != nil {
+		return err
+	}
+	chunks := encodeChunks(uuid.NewString(), data, notifyChunkSize)
+
+	tx, err := b.db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, chunk := range chunks {
+		if _, err := tx.Exec("SELECT pg_notify($1, $2)", notifyChannel, chunk); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (b *PostgresBroadcaster) Subscribe(deliver func(GameUpdate)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.deliver = deliver
+}
+
+func (b *PostgresBroadcaster) Close() error {
+	close(b.done)
+	err := b.listener.Close()
+	if dbErr := b.db.Close(); err == nil {
+		err = dbErr
+	}
+	return err
+}
+
+// listen delivers the updates received from the database until closed
+func (b *PostgresBroadcaster) listen() {
+	chunks := newChunkAssembler()
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.done:
+			return
+
+		case n, ok := <-b.listener.Notify:
+			if !ok {
+				return
+			}
+			if n == nil {
+				// The connection was re-established; anything sent meanwhile is lost
+				log.Printf("[WebSocket] Broadcast listener reconnected, updates may have been missed")
+				chunks = newChunkAssembler()
+				continue
+			}
+
+			data, err := chunks.add(n.Extra)
+			if err != nil {
+				log.Printf("[WebSocket] Dropping malformed broadcast: %v", err)
+				continue
+			}
+			if data == nil {
+				continue
+			}
+
+			var e envelope
+			if err := json.Unmarshal(data, &e); err != nil {
+				log.Printf("[WebSocket] Dropping malformed broadcast: %v", err)
+				continue
+			}
+			e.Update.target = e.Target
+
+			b.mu.Lock()
+			deliver := b.deliver
+			b.mu.Unlock()
+			if deliver != nil {
+				deliver(e.Update)
+			}
+
+		case <-ticker.C:
+			go b.listener.Ping()
+		}
+	}
+}
+
+// encodeChunks splits a message into NOTIFY payloads of the form
+// "<message id> <index> <total> <base64 data>"
+// Base64 keeps chunk boundaries from splitting multi-byte characters.
+func encodeChunks(id string, data []byte, size int) []string {
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	total := (len(encoded) + size - 1) / size
+	if total == 0 {
+		total = 1
+	}
+
+	chunks := make([]string, 0, total)
+	for i := 0; i < total; i++ {
+		end := min((i+1)*size, len(encoded))
+		chunks = append(chunks, fmt.Sprintf("%s %d %d %s", id, i, total, encoded[i*size:end]))
+	}
+	return chunks
+}
+
+var errMalformedChunk = errors.New("malformed broadcast chunk")
+
+// partialMessage collects the chunks of a message received so far
+type partialMessage struct {
+	parts    []string
+	received int
+	started  time.Time
+}
+
+// chunkAssembler puts chunked messages back together
+type chunkAssembler struct {
+	pending map[string]*partialMessage
+}
+
+func newChunkAssembler() *chunkAssembler {
+	return &chunkAssembler{pending: make(map[string]*partialMessage)}
+}
+
+// add records a chunk and returns the message once all of its chunks arrived
+// It returns nil while chunks are still missing.
+func (a *chunkAssembler) add(chunk string) ([]byte, error) {
+	fields := strings.SplitN(chunk, " ", 4)
+	if len(fields) != 4 {
+		return nil, errMalformedChunk
+	}
+	id, data := fields[0], fields[3]
+	index, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, errMalformedChunk
+	}
+	total, err := strconv.Atoi(fields[2])
+	if err != nil || total < 1 || index < 0 || index >= total {
+		return nil, errMalformedChunk
+	}
+
+	if total == 1 {
+		return decodeChunkData(data)
+	}
+
+	// Forget messages whose remaining chunks never arrived
+	for pendingID, msg := range a.pending {
+		if time.Since(msg.started) > chunkTimeout {
+			delete(a.pending, pendingID)
+		}
+	}
+
+	msg, ok := a.pending[id]
+	if !ok {
+		msg = &partialMessage{parts: make([]string, total), started: time.Now()}
+		a.pending[id] = msg
+	}
+	if len(msg.parts) != total {
+		return nil, errMalformedChunk
+	}
+	if msg.parts[index] == "" {
+		msg.parts[index] = data
+		msg.received++
+	}
+	if msg.received < total {
+		return nil, nil
+	}
+
+	delete(a.pending, id)
+	return decodeChunkData(strings.Join(msg.parts, ""))
+}
+
+func decodeChunkData(data string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, errMalformedChunk
+	}
+	return decoded, nil
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkAssembler(t *testing.T) {
+	t.Run("reassembles chunks arriving interleaved", func(t *testing.T) {
+		first := []byte(`{"name":"` + strings.Repeat("é", 40) + `"}`)
+		second := []byte(`{"name":"short"}`)
+
+		firstChunks := encodeChunks("first", first, 16)
+		secondChunks := encodeChunks("second", second, 16)
+		require.Greater(t, len(firstChunks), 2)
+
+		a := newChunkAssembler()
+		for _, chunk := range firstChunks[:len(firstChunks)-1] {
+			data, err := a.add(chunk)
+			require.NoError(t, err)
+			assert.Nil(t, data)
+		}
+		for i, chunk := range secondChunks {
+			data, err := a.add(chunk)
+			require.NoError(t, err)
+			if i == len(secondChunks)-1 {
+				assert.Equal(t, second, data)
+			}
+		}
+
+		data, err := a.add(firstChunks[len(firstChunks)-1])
+		require.NoError(t, err)
+		assert.Equal(t, first, data)
+		assert.Empty(t, a.pending)
+	})
+
+	t.Run("rejects malformed chunks", func(t *testing.T) {
+		a := newChunkAssembler()
+		for _, chunk := range []string{"", "id 0", "id x 1 e30=", "id 1 1 e30=", "id 0 1 !!"} {
+			_, err := a.add(chunk)
+			assert.ErrorIs(t, err, errMalformedChunk, chunk)
+		}
+	})
+}
+
+func TestEnvelope_KeepsTarget(t *testing.T) {
+	alice := uuid.New()
+	update := GameUpdate{
+		Type:    RoleAssigned,
+		GameID:  "ABC123",
+		Payload: map[string]any{"slug": "mafia"},
+		target:  &updateTarget{Players: []uuid.UUID{alice}},
+	}
+
+	data, err := json.Marshal(envelope{Update: update, Target: update.target})
+	require.NoError(t, err)
+
+	var e envelope
+	require.NoError(t, json.Unmarshal(data, &e))
+	require.NotNil(t, e.Target)
+
+	assert.True(t, e.Target.matches(&Client{viewer: projection.PlayerViewer(alice)}))
+	assert.False(t, e.Target.matches(&Client{viewer: projection.PlayerViewer(uuid.New())}))
+	assert.False(t, e.Target.matches(&Client{viewer: projection.ModeratorViewer("mod-123", true)}))
+
+	// Clients never see who else an update was meant for
+	message, err := json.Marshal(e.Update)
+	require.NoError(t, err)
+	assert.NotContains(t, string(message), alice.String())
+}
+
+func TestWebSocketHub_PublishesThroughBroadcaster(t *testing.T) {
+	broadcaster := &recordingBroadcaster{}
+	hub := NewWebSocketHub(nil, nil, nil, broadcaster, HubConfig{})
+	client := addTestClient(hub, "ABC123", projection.PublicViewer(), nil)
+
+	hub.BroadcastToGame("ABC123", PlayerJoined, nil)
+	assert.Empty(t, receive(t, client), "updates are only delivered once the broadcaster hands them back")
+	require.Len(t, broadcaster.published, 1)
+
+	// An update published by another instance
+	broadcaster.deliver(GameUpdate{Type: SeatsUpdated, GameID: "ABC123"})
+	assert.Equal(t, SeatsUpdated, receive(t, client))
+}
+
+// recordingBroadcaster keeps published updates instead of delivering them
+type recordingBroadcaster struct {
+	published []GameUpdate
+	deliver   func(GameUpdate)
+}
+
+func (b *recordingBroadcaster) Publish(update GameUpdate) error {
+	b.published = append(b.published, update)
+	return nil
+}
+
+func (b *recordingBroadcaster) Subscribe(deliver func(GameUpdate)) {
+	b.deliver = deliver
+}
+
+func (b *recordingBroadcaster) Close() error {
+	return nil
+}
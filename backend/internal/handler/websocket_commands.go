@@ -0,0 +1,362 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/mafia-night/backend/internal/service"
+)
+
+// commandTimeout bounds how long a single command may spend in the services
+const commandTimeout = 5 * time.Second
+
+// CommandType identifies a command sent by a client over its WebSocket connection
+type CommandType string
+
+const (
+	// Player commands
+	CommandReady       CommandType = "ready"
+	CommandVote        CommandType = "vote"
+	CommandNightAction CommandType = "night_action"
+	CommandChat        CommandType = "chat"
+
+	// Moderator commands
+	CommandUpdateStatus    CommandType = "update_status"
+	CommandAdvancePhase    CommandType = "advance_phase"
+	CommandSetPlayerAlive  CommandType = "set_player_alive"
+	CommandDistributeRoles CommandType = "distribute_roles"
+	CommandAnnounce        CommandType = "announce"
+	CommandMutePlayer      CommandType = "mute_player"
+)
+
+// Command is a message sent by a client over its WebSocket connection
+// Every command carries a request ID that is echoed in its ack or error reply.
+type Command struct {
+	Type      CommandType     `json:"type"`
+	RequestID string          `json:"request_id"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	// Data carries the arguments instead of Payload from protocol version 2
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Reply types sent back to the client that issued a command
+const (
+	ReplyAck   = "ack"
+	ReplyError = "error"
+)
+
+// CommandReply answers a single command
+type CommandReply struct {
+	Type      string `json:"type"`
+	RequestID string `json:"request_id"`
+	Payload   any    `json:"payload,omitempty"`
+}
+
+// CommandError describes why a command failed
+type CommandError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// errInvalidPayload is returned when a command payload cannot be decoded
+var errInvalidPayload = errors.New("invalid command payload")
+
+// commandHandler runs a command for a client and returns the ack payload
+type commandHandler struct {
+	// kind is the kind of viewer allowed to send the command
+	kind projection.Kind
+	run  func(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error)
+}
+
+var commandHandlers = map[CommandType]commandHandler{
+	CommandReady:           {kind: projection.KindPlayer, run: runReady},
+	CommandVote:            {kind: projection.KindPlayer, run: runVote},
+	CommandNightAction:     {kind: projection.KindPlayer, run: runNightAction},
+	CommandChat:            {kind: projection.KindPlayer, run: runChat},
+	CommandUpdateStatus:    {kind: projection.KindModerator, run: runUpdateStatus},
+	CommandAdvancePhase:    {kind: projection.KindModerator, run: runAdvancePhase},
+	CommandSetPlayerAlive:  {kind: projection.KindModerator, run: runSetPlayerAlive},
+	CommandDistributeRoles: {kind: projection.KindModerator, run: runDistributeRoles},
+	CommandAnnounce:        {kind: projection.KindModerator, run: runAnnounce},
+	CommandMutePlayer:      {kind: projection.KindModerator, run: runMutePlayer},
+}
+
+// handleCommand validates a command from a client, runs it and replies to the client
+func (h *WebSocketHub) handleCommand(c *Client, message []byte) {
+	var cmd Command
+	if err := json.Unmarshal(message, &cmd); err != nil {
+		h.replyError(c, "", "invalid_command", "command must be a JSON object")
+		return
+	}
+	if cmd.RequestID == "" {
+		h.replyError(c, "", "invalid_command", "request_id is required")
+		return
+	}
+
+	handler, ok := commandHandlers[cmd.Type]
+	if !ok {
+		h.replyError(c, cmd.RequestID, "unknown_command", "unknown command type")
+		return
+	}
+	if c.viewer.Kind != handler.kind {
+		h.replyError(c, cmd.RequestID, "forbidden", "command requires a "+string(handler.kind)+" connection")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	payload := cmd.Payload
+	if c.format.version >= ProtocolV2 {
+		payload = cmd.Data
+	}
+
+	result, err := handler.run(ctx, h, c, payload)
+	if err != nil {
+		code, message := commandErrorCode(err)
+		h.replyError(c, cmd.RequestID, code, message)
+		return
+	}
+
+	h.reply(c, CommandReply{Type: ReplyAck, RequestID: cmd.RequestID, Payload: result})
+}
+
+// reply sends a reply to a single client if it is still connected
+func (h *WebSocketHub) reply(c *Client, reply CommandReply) {
+	message, err := c.format.encodeReply(reply)
+	if err != nil {
+		log.Printf("[WebSocket] Error marshaling reply: %v", err)
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.clients[c.gameID][c] {
+		h.sendDirect(c, message)
+	}
+}
+
+func (h *WebSocketHub) replyError(c *Client, requestID string, code string, message string) {
+	h.reply(c, CommandReply{
+		Type:      ReplyError,
+		RequestID: requestID,
+		Payload:   CommandError{Code: code, Message: message},
+	})
+}
+
+// commandErrorCode maps a service error to an error code and message for the client
+func commandErrorCode(err error) (string, string) {
+	if errors.Is(err, errInvalidPayload) {
+		return "invalid_request", err.Error()
+	}
+
+	status := playErrorStatus(err)
+	switch {
+	case errors.Is(err, service.ErrInvalidRoleCount),
+		errors.Is(err, service.ErrRoleRuleViolated),
+		errors.Is(err, service.ErrRoleArchived):
+		status = http.StatusBadRequest
+	case errors.Is(err, service.ErrRolesAlreadyAssigned):
+		status = http.StatusConflict
+	}
+
+	switch status {
+	case http.StatusBadRequest:
+		return "invalid_request", err.Error()
+	case http.StatusForbidden:
+		return "forbidden", err.Error()
+	case http.StatusNotFound:
+		return "not_found", "game or player not found"
+	case http.StatusConflict:
+		return "conflict", err.Error()
+	case http.StatusTooManyRequests:
+		return "rate_limited", err.Error()
+	default:
+		log.Printf("[WebSocket] Command failed: %v", err)
+		return "internal", "internal error"
+	}
+}
+
+// decodePayload decodes a command payload into v
+func decodePayload(payload json.RawMessage, v any) error {
+	if len(payload) == 0 {
+		return errInvalidPayload
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return errInvalidPayload
+	}
+	return nil
+}
+
+func runReady(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		Ready bool `json:"ready"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+
+	player, err := h.playService.SetReady(ctx, c.gameID, c.viewer.PlayerID.String(), req.Ready)
+	if err != nil {
+		return nil, err
+	}
+
+	return playerView(player, c.viewer), nil
+}
+
+func runVote(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		TargetID string `json:"target_id"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+
+	v, err := h.playService.CastVote(ctx, c.gameID, c.viewer.PlayerID.String(), req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return voteToJSON(v), nil
+}
+
+func runNightAction(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		TargetID string `json:"target_id"`
+		Action   string `json:"action"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+
+	a, err := h.playService.SubmitNightAction(ctx, c.gameID, c.viewer.PlayerID.String(), req.TargetID, req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	return nightActionToJSON(a), nil
+}
+
+func runChat(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		Channel chatmessage.Channel `json:"channel"`
+		Text    string              `json:"text"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+	if req.Channel == "" {
+		req.Channel = chatmessage.ChannelDay
+	}
+
+	message, err := h.playService.SendChat(ctx, c.gameID, c.viewer.PlayerID.String(), req.Channel, req.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	return chatMessageToJSON(message), nil
+}
+
+func runUpdateStatus(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		Status game.Status `json:"status"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.gameService.UpdateGameStatus(ctx, c.gameID, req.Status, c.viewer.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return projection.Game(updated, c.viewer), nil
+}
+
+func runAdvancePhase(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	updated, err := h.playService.AdvancePhase(ctx, c.gameID, c.viewer.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return projection.Game(updated, c.viewer), nil
+}
+
+func runSetPlayerAlive(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		PlayerID string `json:"player_id"`
+		Alive    *bool  `json:"alive"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+	if req.Alive == nil {
+		return nil, errInvalidPayload
+	}
+
+	player, err := h.gameService.SetPlayerAlive(ctx, c.gameID, c.viewer.ModeratorID, req.PlayerID, *req.Alive)
+	if err != nil {
+		return nil, err
+	}
+
+	return playerView(player, c.viewer), nil
+}
+
+func runDistributeRoles(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		Roles []service.RoleSelection `json:"roles"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+
+	if err := h.gameService.DistributeRoles(ctx, c.gameID, c.viewer.ModeratorID, req.Roles); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"message": "roles distributed successfully"}, nil
+}
+
+func runAnnounce(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		Text string `json:"text"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+
+	message, err := h.playService.SendAnnouncement(ctx, c.gameID, c.viewer.ModeratorID, req.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	return chatMessageToJSON(message), nil
+}
+
+func runMutePlayer(ctx context.Context, h *WebSocketHub, c *Client, payload json.RawMessage) (any, error) {
+	var req struct {
+		PlayerID string `json:"player_id"`
+		Muted    *bool  `json:"muted"`
+	}
+	if err := decodePayload(payload, &req); err != nil {
+		return nil, err
+	}
+	if req.Muted == nil {
+		return nil, errInvalidPayload
+	}
+
+	player, err := h.playService.SetMuted(ctx, c.gameID, c.viewer.ModeratorID, req.PlayerID, *req.Muted)
+	if err != nil {
+		return nil, err
+	}
+
+	return playerView(player, c.viewer), nil
+}
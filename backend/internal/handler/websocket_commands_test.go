@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCommandClient registers a client with a hub that has no services or goroutines
+func newTestCommandClient(viewer projection.Viewer) (*WebSocketHub, *Client) {
+	hub := &WebSocketHub{clients: make(map[string]map[*Client]bool)}
+	client := &Client{hub: hub, send: make(chan outgoing, 8), gameID: "ABC123", viewer: viewer}
+	hub.clients[client.gameID] = map[*Client]bool{client: true}
+	return hub, client
+}
+
+// readReply decodes the next reply queued for a client
+func readReply(t *testing.T, c *Client) (CommandReply, CommandError) {
+	t.Helper()
+
+	var reply struct {
+		CommandReply
+		Payload CommandError `json:"payload"`
+	}
+	select {
+	case queued := <-c.send:
+		require.NoError(t, json.Unmarshal(queued.message, &reply))
+	default:
+		t.Fatal("expected a reply")
+	}
+	return reply.CommandReply, reply.Payload
+}
+
+func TestHandleCommand(t *testing.T) {
+	t.Run("rejects malformed commands", func(t *testing.T) {
+		hub, client := newTestCommandClient(projection.PlayerViewer(uuid.New()))
+
+		hub.handleCommand(client, []byte("not json"))
+
+		reply, cmdErr := readReply(t, client)
+		assert.Equal(t, ReplyError, reply.Type)
+		assert.Equal(t, "invalid_command", cmdErr.Code)
+	})
+
+	t.Run("requires a request ID", func(t *testing.T) {
+		hub, client := newTestCommandClient(projection.PlayerViewer(uuid.New()))
+
+		hub.handleCommand(client, []byte(`{"type":"vote","payload":{}}`))
+
+		reply, cmdErr := readReply(t, client)
+		assert.Equal(t, ReplyError, reply.Type)
+		assert.Equal(t, "invalid_command", cmdErr.Code)
+	})
+
+	t.Run("rejects unknown commands", func(t *testing.T) {
+		hub, client := newTestCommandClient(projection.PlayerViewer(uuid.New()))
+
+		hub.handleCommand(client, []byte(`{"type":"dance","request_id":"r1"}`))
+
+		reply, cmdErr := readReply(t, client)
+		assert.Equal(t, "r1", reply.RequestID)
+		assert.Equal(t, "unknown_command", cmdErr.Code)
+	})
+
+	t.Run("rejects moderator commands from players", func(t *testing.T) {
+		hub, client := newTestCommandClient(projection.PlayerViewer(uuid.New()))
+
+		hub.handleCommand(client, []byte(`{"type":"advance_phase","request_id":"r2"}`))
+
+		reply, cmdErr := readReply(t, client)
+		assert.Equal(t, "r2", reply.RequestID)
+		assert.Equal(t, "forbidden", cmdErr.Code)
+	})
+
+	t.Run("rejects player commands from spectators", func(t *testing.T) {
+		hub, client := newTestCommandClient(projection.SpectatorViewer(uuid.New()))
+
+		hub.handleCommand(client, []byte(`{"type":"chat","request_id":"r3","payload":{"text":"hi"}}`))
+
+		_, cmdErr := readReply(t, client)
+		assert.Equal(t, "forbidden", cmdErr.Code)
+	})
+
+	t.Run("rejects commands without a payload", func(t *testing.T) {
+		hub, client := newTestCommandClient(projection.PlayerViewer(uuid.New()))
+
+		hub.handleCommand(client, []byte(`{"type":"vote","request_id":"r4"}`))
+
+		reply, cmdErr := readReply(t, client)
+		assert.Equal(t, "r4", reply.RequestID)
+		assert.Equal(t, "invalid_request", cmdErr.Code)
+	})
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"github.com/mafia-night/backend/internal/events"
+)
+
+// HandleEvent turns a domain event into updates for the connections of its game.
+// Subscribe it to the event bus the services publish on.
+func (h *WebSocketHub) HandleEvent(e events.Event) {
+	switch e := e.(type) {
+	case events.GameStatusChanged:
+		h.broadcastGame(GameStatusChanged, e.Game)
+	case events.SpectatorSettingsChanged:
+		h.broadcastGame(SpectatorSettingsChanged, e.Game)
+	case events.PhaseChanged:
+		h.broadcastGame(PhaseChanged, e.Game)
+	case events.GameDeleted:
+		h.BroadcastToGame(e.GameID, GameDeleted, nil)
+	case events.PlayerJoined:
+		h.broadcastPlayer(e.Player.GameID, PlayerJoined, e.Player.ID.String())
+	case events.PlayerLeft:
+		h.BroadcastToGame(e.GameID, PlayerLeft, map[string]string{"player_id": e.PlayerID.String()})
+	case events.PlayerUpdated:
+		h.broadcastPlayer(e.Player.GameID, PlayerUpdated, e.Player.ID.String())
+	case events.SeatsUpdated:
+		// Seats are broadcast with the public view of each player
+		if _, players, ok := h.publicPlayers(e.GameID); ok {
+			h.BroadcastToGame(e.GameID, SeatsUpdated, map[string]any{"players": players})
+		}
+	case events.RolesDistributed:
+		h.BroadcastToGame(e.GameID, RolesDistributed, nil)
+		h.sendRoleAssignments(e.GameID)
+	case events.VoteCast:
+		h.BroadcastToGame(e.Vote.GameID, VoteCast, voteToJSON(e.Vote))
+	case events.NightActionSubmitted:
+		// Night actions are secret, so only the moderators hear about them
+		h.SendToModerators(e.Action.GameID, NightActionSubmitted, nightActionToJSON(e.Action))
+	case events.ChatMessageSent:
+		// Private channels only reach the players allowed to read them and the moderators
+		if e.Recipients == nil {
+			h.BroadcastToGame(e.Message.GameID, ChatMessageSent, chatMessageToJSON(e.Message))
+		} else {
+			h.sendTo(e.Message.GameID, ChatMessageSent, chatMessageToJSON(e.Message), &updateTarget{Players: e.Recipients, Moderators: true})
+		}
+	}
+}
@@ -5,12 +5,20 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"slices"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/internal/auth"
+	"github.com/mafia-night/backend/internal/projection"
 	"github.com/mafia-night/backend/internal/service"
 )
 
@@ -24,32 +32,133 @@ const (
 	// Send pings to peer with this period (must be less than pongWait)
 	pingPeriod = (pongWait * 9) / 10
 
-	// Maximum message size allowed from peer
-	maxMessageSize = 512
+	// Default maximum message size allowed from peer, large enough for a chat command
+	defaultMaxMessageSize = 4096
 
-	// Maximum connections per game (increased for e2e tests)
-	maxConnectionsPerGame = 200
+	// Default maximum connections per game (increased for e2e tests)
+	defaultMaxConnectionsPerGame = 200
 
-	// Maximum total connections across all games
-	maxTotalConnections = 2000
+	// Default maximum total connections across all games
+	defaultMaxTotalConnections = 2000
+
+	// Default number of messages queued for a connection before it counts as slow
+	defaultSendBufferSize = 256
+)
+
+// SlowConsumerPolicy decides what happens when a connection's send buffer is full
+type SlowConsumerPolicy string
+
+const (
+	// SlowConsumerDisconnect closes the connection; the client reconnects and resumes
+	SlowConsumerDisconnect SlowConsumerPolicy = "disconnect"
+	// SlowConsumerDropOldest discards the oldest queued message to make room.
+	// Clients notice the gap in sequence numbers and may reconnect to resume.
+	SlowConsumerDropOldest SlowConsumerPolicy = "drop_oldest"
+	// SlowConsumerCoalesce discards everything queued and sends a fresh snapshot instead
+	SlowConsumerCoalesce SlowConsumerPolicy = "coalesce"
 )
 
-var upgrader = websocket.Upgrader{
-	CheckOrigin: func(r *http.Request) bool {
-		return true // Allow all origins for now (controlled by CORS middleware)
-	},
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
+// Valid reports whether p is a known policy
+func (p SlowConsumerPolicy) Valid() bool {
+	switch p {
+	case SlowConsumerDisconnect, SlowConsumerDropOldest, SlowConsumerCoalesce:
+		return true
+	default:
+		return false
+	}
+}
+
+// HubConfig holds the hub settings chosen at startup
+// Zero values fall back to the defaults.
+type HubConfig struct {
+	// AllowedOrigins lists the origins browsers may connect from, as for CORS.
+	// "*" allows any origin; when empty, only same-origin connections are allowed.
+	AllowedOrigins []string
+
+	MaxConnectionsPerGame int
+	MaxTotalConnections   int
+	// MaxMessageSize is the largest message accepted from a client, in bytes
+	MaxMessageSize int64
+	// SendBufferSize is how many messages may be queued for a connection
+	SendBufferSize int
+	// SlowConsumerPolicy applies to connections that do not pick their own
+	// with the slow_consumer query parameter. Defaults to disconnecting them.
+	SlowConsumerPolicy SlowConsumerPolicy
+}
+
+// withDefaults fills in the settings left unset
+func (c HubConfig) withDefaults() HubConfig {
+	if c.MaxConnectionsPerGame <= 0 {
+		c.MaxConnectionsPerGame = defaultMaxConnectionsPerGame
+	}
+	if c.MaxTotalConnections <= 0 {
+		c.MaxTotalConnections = defaultMaxTotalConnections
+	}
+	if c.MaxMessageSize <= 0 {
+		c.MaxMessageSize = defaultMaxMessageSize
+	}
+	if c.SendBufferSize <= 0 {
+		c.SendBufferSize = defaultSendBufferSize
+	}
+	if !c.SlowConsumerPolicy.Valid() {
+		c.SlowConsumerPolicy = SlowConsumerDisconnect
+	}
+	return c
 }
 
+// Audience identifies who is on the other end of a connection
+type Audience string
+
+const (
+	// AudiencePlayers is the default audience of players and moderators
+	AudiencePlayers Audience = "players"
+	// AudienceSpectators receives a filtered, possibly delayed view of the game
+	AudienceSpectators Audience = "spectators"
+)
+
 // Client is a middleman between the websocket connection and the hub.
+// Server-Sent Events connections are clients too; they have no conn and are
+// ended through stop instead.
 type Client struct {
 	hub         *WebSocketHub
 	conn        *websocket.Conn
-	send        chan []byte
+	stop        context.CancelFunc
+	send        chan outgoing
 	gameID      string
-	remoteAddr  string
-	connectedAt time.Time
+	audience    Audience
+	spectatorID string
+	viewer      projection.Viewer
+	// format is the message schema version and encoding the client negotiated
+	format wireFormat
+	// resume is set when the client reconnects and asks for the updates it missed
+	resume *resumePoint
+	// slowPolicy decides what happens when send is full
+	slowPolicy SlowConsumerPolicy
+	// awaitingSnapshot is set while a coalesced client waits for its snapshot;
+	// broadcast updates are skipped meanwhile since the snapshot covers them
+	awaitingSnapshot bool
+	dropped          int
+	coalesced        int
+	remoteAddr       string
+	connectedAt      time.Time
+}
+
+// outgoing is a message queued for a client
+type outgoing struct {
+	message []byte
+	// direct marks command replies and updates targeted at the client, which
+	// a snapshot does not restore, so coalescing keeps them
+	direct bool
+}
+
+// close ends the client's connection
+func (c *Client) close() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+	if c.stop != nil {
+		c.stop()
+	}
 }
 
 // readPump pumps messages from the websocket connection to the hub.
@@ -62,17 +171,23 @@ func (c *Client) readPump() {
 		c.hub.unregister <- c
 		c.conn.Close()
 	}()
-	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadLimit(c.hub.config.MaxMessageSize)
 	c.conn.SetReadDeadline(time.Now().Add(pongWait))
 	c.conn.SetPongHandler(func(string) error { c.conn.SetReadDeadline(time.Now().Add(pongWait)); return nil })
 	for {
-		_, _, err := c.conn.ReadMessage()
+		messageType, message, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("[WebSocket] Unexpected close error: %v", err)
 			}
 			break
 		}
+		command, err := c.format.decodeCommand(messageType, message)
+		if err != nil {
+			c.hub.replyError(c, "", "invalid_command", "command could not be decoded as "+string(c.format.encoding))
+			continue
+		}
+		c.hub.handleCommand(c, command)
 	}
 }
 
@@ -89,7 +204,7 @@ func (c *Client) writePump() {
 	}()
 	for {
 		select {
-		case message, ok := <-c.send:
+		case queued, ok := <-c.send:
 			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if !ok {
 				// The hub closed the channel.
@@ -97,16 +212,24 @@ func (c *Client) writePump() {
 				return
 			}
 
+			if !c.format.batched() {
+				// Later versions send every message in a frame of its own
+				if err := c.writeFrames(queued.message); err != nil {
+					return
+				}
+				continue
+			}
+
 			w, err := c.conn.NextWriter(websocket.TextMessage)
 			if err != nil {
 				return
 			}
-			w.Write(message)
+			w.Write(queued.message)
 
 			// Add queued chat messages to the current websocket message.
 			n := len(c.send)
 			for i := 0; i < n; i++ {
-				w.Write(<-c.send)
+				w.Write((<-c.send).message)
 			}
 
 			if err := w.Close(); err != nil {
@@ -121,6 +244,25 @@ func (c *Client) writePump() {
 	}
 }
 
+// writeFrames writes a message and the ones queued behind it, one per frame
+func (c *Client) writeFrames(message []byte) error {
+	frameType := websocket.TextMessage
+	if c.format.binary() {
+		frameType = websocket.BinaryMessage
+	}
+
+	n := len(c.send)
+	for i := 0; ; i++ {
+		if err := c.conn.WriteMessage(frameType, message); err != nil {
+			return err
+		}
+		if i == n {
+			return nil
+		}
+		message = (<-c.send).message
+	}
+}
+
 type GameUpdateType string
 
 const (
@@ -128,33 +270,148 @@ const (
 	PlayerLeft       GameUpdateType = "player_left"
 	RolesDistributed GameUpdateType = "roles_distributed"
 	GameDeleted      GameUpdateType = "game_deleted"
+	SeatsUpdated     GameUpdateType = "seats_updated"
+	PlayerUpdated    GameUpdateType = "player_updated"
+	PhaseChanged     GameUpdateType = "phase_changed"
+	VoteCast         GameUpdateType = "vote_cast"
+	ChatMessageSent  GameUpdateType = "chat_message"
+	PresenceChanged  GameUpdateType = "presence_changed"
+
+	// Private updates, only ever sent to the connections they concern
+	RoleAssigned         GameUpdateType = "role_assigned"
+	TeamRevealed         GameUpdateType = "team_revealed"
+	RoleAssignments      GameUpdateType = "role_assignments"
+	NightActionSubmitted GameUpdateType = "night_action_submitted"
+
+	GameStatusChanged        GameUpdateType = "game_status_changed"
+	SpectatorSettingsChanged GameUpdateType = "spectator_settings_changed"
 )
 
+// spectatorVisibleUpdates lists the updates spectators receive while a game is
+// running. Anything else, such as roles or night actions, is held back until
+// the game has ended.
+var spectatorVisibleUpdates = map[GameUpdateType]bool{
+	PlayerJoined:             true,
+	PlayerLeft:               true,
+	RolesDistributed:         true,
+	GameDeleted:              true,
+	SeatsUpdated:             true,
+	PlayerUpdated:            true,
+	PhaseChanged:             true,
+	VoteCast:                 true,
+	PresenceChanged:          true,
+	GameStatusChanged:        true,
+	SpectatorSettingsChanged: true,
+}
+
+// spectatorView tracks what the spectators of a game may currently see
+type spectatorView struct {
+	mode      game.SpectatorMode
+	delay     time.Duration
+	completed bool
+}
+
+// delayedMessage is a spectator message waiting for its delay to pass
+type delayedMessage struct {
+	client  *Client
+	message []byte
+	// snapshot is set for a held back snapshot, which ends the client's wait for one
+	snapshot bool
+}
+
+// resumePoint is the last update a reconnecting client saw
+type resumePoint struct {
+	stream string
+	seq    uint64
+}
+
 type GameUpdate struct {
 	Type    GameUpdateType `json:"type"`
 	GameID  string         `json:"game_id"`
+	Seq     uint64         `json:"seq,omitempty"`
 	Payload interface{}    `json:"payload,omitempty"`
+
+	// target limits a private update to the connections it matches; nil means everyone
+	target *updateTarget
+}
+
+// updateTarget picks the connections a private update is meant for.
+// It is plain data rather than a function so it can be sent to other instances.
+type updateTarget struct {
+	Players    []uuid.UUID `json:"players,omitempty"`
+	Moderators bool        `json:"moderators,omitempty"`
+}
+
+// matches reports whether a private update is meant for a client.
+// Moderators without the view_roles permission are left out, since
+// moderator-only updates reveal secret information.
+func (t *updateTarget) matches(c *Client) bool {
+	switch c.viewer.Kind {
+	case projection.KindPlayer:
+		return slices.Contains(t.Players, c.viewer.PlayerID)
+	case projection.KindModerator:
+		return t.Moderators && c.viewer.CanViewRoles
+	default:
+		return false
+	}
 }
 
 type WebSocketHub struct {
 	gameService      *service.GameService
-	clients          map[string]map[*Client]bool // gameID -> clients
+	playService      *service.PlayService
+	tokens           *auth.JWTService
+	broadcaster      Broadcaster
+	config           HubConfig
+	upgrader         websocket.Upgrader
+	clients          map[string]map[*Client]bool              // gameID -> clients
+	spectatorViews   map[string]*spectatorView                // gameID -> spectator visibility
+	streams          map[string]*eventStream                  // gameID -> recent sequenced updates
+	presence         map[string]map[uuid.UUID]*playerPresence // gameID -> player presence
 	broadcast        chan GameUpdate
+	delayed          chan delayedMessage
 	register         chan *Client
 	unregister       chan *Client
 	mu               sync.RWMutex
 	totalConnections int64 // atomic counter for total connections
+
+	// Slow consumer counters, reported in the connection stats
+	droppedMessages   int64
+	coalescedMessages int64
+	slowDisconnects   int64
 }
 
-func NewWebSocketHub(gameService *service.GameService) *WebSocketHub {
+// NewWebSocketHub creates a hub sending updates through broadcaster.
+// A nil broadcaster keeps updates within this instance.
+func NewWebSocketHub(gameService *service.GameService, playService *service.PlayService, tokens *auth.JWTService, broadcaster Broadcaster, config HubConfig) *WebSocketHub {
+	if broadcaster == nil {
+		broadcaster = NewMemoryBroadcaster()
+	}
+
 	hub := &WebSocketHub{
 		gameService:      gameService,
+		playService:      playService,
+		tokens:           tokens,
+		broadcaster:      broadcaster,
+		config:           config.withDefaults(),
 		clients:          make(map[string]map[*Client]bool),
+		spectatorViews:   make(map[string]*spectatorView),
+		streams:          make(map[string]*eventStream),
+		presence:         make(map[string]map[uuid.UUID]*playerPresence),
 		broadcast:        make(chan GameUpdate, 256),
+		delayed:          make(chan delayedMessage, 256),
 		register:         make(chan *Client),
 		unregister:       make(chan *Client),
 		totalConnections: 0,
 	}
+	hub.upgrader = websocket.Upgrader{
+		CheckOrigin:     hub.checkOrigin,
+		Subprotocols:    formatSubprotocols(),
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+	}
+	broadcaster.Subscribe(func(update GameUpdate) {
+		hub.broadcast <- update
+	})
 	go hub.run()
 
 	// Start periodic logging of connection stats
@@ -192,19 +449,34 @@ func (h *WebSocketHub) GetConnectionStats() map[string]interface{} {
 
 	totalConns := atomic.LoadInt64(&h.totalConnections)
 	gameStats := make(map[string]int)
+	spectators := 0
 
 	for gameID, clients := range h.clients {
 		gameStats[gameID] = len(clients)
+		for client := range clients {
+			if client.audience == AudienceSpectators {
+				spectators++
+			}
+		}
 	}
 
 	return map[string]interface{}{
 		"total_connections": totalConns,
+		"spectators":        spectators,
 		"active_games":      len(h.clients),
 		"games":             gameStats,
+
+		"dropped_messages":          atomic.LoadInt64(&h.droppedMessages),
+		"coalesced_messages":        atomic.LoadInt64(&h.coalescedMessages),
+		"slow_consumer_disconnects": atomic.LoadInt64(&h.slowDisconnects),
+		"slow_consumer_policy":      h.config.SlowConsumerPolicy,
 	}
 }
 
 func (h *WebSocketHub) run() {
+	pruneTicker := time.NewTicker(streamPruneInterval)
+	defer pruneTicker.Stop()
+
 	for {
 		select {
 		case client := <-h.register:
@@ -212,22 +484,22 @@ func (h *WebSocketHub) run() {
 
 			// Check total connection limit
 			currentTotal := atomic.LoadInt64(&h.totalConnections)
-			if currentTotal >= maxTotalConnections {
+			if currentTotal >= int64(h.config.MaxTotalConnections) {
 				h.mu.Unlock()
 				log.Printf("[WebSocket] Connection limit reached (%d), rejecting new connection from %s for game %s",
-					maxTotalConnections, client.remoteAddr, client.gameID)
-				client.conn.Close()
+					h.config.MaxTotalConnections, client.remoteAddr, client.gameID)
+				client.close()
 				continue
 			}
 
 			// Check per-game connection limit
 			if h.clients[client.gameID] == nil {
 				h.clients[client.gameID] = make(map[*Client]bool)
-			} else if len(h.clients[client.gameID]) >= maxConnectionsPerGame {
+			} else if len(h.clients[client.gameID]) >= h.config.MaxConnectionsPerGame {
 				h.mu.Unlock()
 				log.Printf("[WebSocket] Game connection limit reached (%d) for game %s, rejecting connection from %s",
-					maxConnectionsPerGame, client.gameID, client.remoteAddr)
-				client.conn.Close()
+					h.config.MaxConnectionsPerGame, client.gameID, client.remoteAddr)
+				client.close()
 				continue
 			}
 
@@ -241,76 +513,491 @@ func (h *WebSocketHub) run() {
 			log.Printf("[WebSocket] Client connected: game=%s, addr=%s, gameConns=%d, totalConns=%d",
 				client.gameID, client.remoteAddr, gameConns, totalConns)
 
+			// Replay what a reconnecting client missed, or start it off with a snapshot
+			stream := h.streams[client.gameID]
+			if stream == nil {
+				stream = newEventStream()
+				h.streams[client.gameID] = stream
+			}
+			if !h.replay(client, stream) {
+				go h.sendSnapshot(client, stream.id, stream.seq)
+			}
+
+			presence, changed := h.trackPresence(client, 1)
 			h.mu.Unlock()
 
+			// Publishing may block on the broadcaster, so it happens outside the run loop
+			if changed {
+				go h.publish(presence)
+			}
+
 		case client := <-h.unregister:
 			h.mu.Lock()
+			var presence GameUpdate
+			var changed bool
 			if clients, ok := h.clients[client.gameID]; ok {
 				if _, ok := clients[client]; ok {
 					duration := time.Since(client.connectedAt)
 					delete(clients, client)
 					close(client.send)
 					atomic.AddInt64(&h.totalConnections, -1)
+					presence, changed = h.trackPresence(client, -1)
 
 					totalConns := atomic.LoadInt64(&h.totalConnections)
 					gameConns := len(clients)
 
-					log.Printf("[WebSocket] Client disconnected: game=%s, addr=%s, duration=%v, gameConns=%d, totalConns=%d",
-						client.gameID, client.remoteAddr, duration, gameConns, totalConns)
+					log.Printf("[WebSocket] Client disconnected: game=%s, addr=%s, duration=%v, dropped=%d, coalesced=%d, gameConns=%d, totalConns=%d",
+						client.gameID, client.remoteAddr, duration, client.dropped, client.coalesced, gameConns, totalConns)
 
 					// Clean up empty game entries
 					if len(clients) == 0 {
 						delete(h.clients, client.gameID)
+						delete(h.spectatorViews, client.gameID)
 						log.Printf("[WebSocket] Game %s has no more connections, cleaning up", client.gameID)
 					}
 				}
 			}
 			h.mu.Unlock()
 
+			if changed {
+				go h.publish(presence)
+			}
+
 		case update := <-h.broadcast:
-			h.mu.RLock()
+			h.mu.Lock()
 			clients := h.clients[update.GameID]
-			h.mu.RUnlock()
+			stream := h.streams[update.GameID]
 
-			if len(clients) == 0 {
+			// Updates are kept for reconnecting clients once anyone has connected
+			if len(clients) == 0 && stream == nil {
+				h.mu.Unlock()
 				continue
 			}
+			if stream == nil {
+				stream = newEventStream()
+				h.streams[update.GameID] = stream
+			}
+			update.Seq = stream.next()
 
-			// Marshal once
-			message, err := json.Marshal(update)
-			if err != nil {
+			// Encode once per wire format in use
+			encoded := newEncodedUpdate(update)
+			if _, err := encoded.in(wireFormat{}); err != nil {
+				h.mu.Unlock()
 				log.Printf("[WebSocket] Error marshaling update: %v", err)
 				continue
 			}
+			stream.add(update, encoded)
+			if update.Type == GameDeleted {
+				delete(h.streams, update.GameID)
+				delete(h.presence, update.GameID)
+			}
+			h.applySpectatorSettings(update)
+			h.applyPresence(update)
 
 			successCount := 0
 			failCount := 0
 
 			for client := range clients {
-				select {
-				case client.send <- message:
+				if update.target != nil && !update.target.matches(client) {
+					continue
+				}
+				message, err := encoded.in(client.format)
+				if err != nil {
+					log.Printf("[WebSocket] Error encoding update as %s: %v", client.format.subprotocol(), err)
+					failCount++
+					continue
+				}
+				if update.target == nil && client.audience == AudienceSpectators {
+					h.sendToSpectator(client, update, message)
+					continue
+				}
+				send := h.trySend
+				if update.target != nil {
+					send = h.sendDirect
+				}
+				if send(client, message) {
 					successCount++
-				default:
+				} else {
 					failCount++
-					close(client.send)
-					delete(clients, client)
 				}
 			}
+			h.mu.Unlock()
 
 			if failCount > 0 || successCount > 0 {
 				log.Printf("[WebSocket] Broadcast %s to game %s: success=%d, failed=%d",
 					update.Type, update.GameID, successCount, failCount)
 			}
+
+		case <-pruneTicker.C:
+			h.mu.Lock()
+			for gameID, stream := range h.streams {
+				if len(h.clients[gameID]) == 0 && time.Since(stream.lastActivity) > streamIdleTimeout {
+					delete(h.streams, gameID)
+				}
+			}
+			for gameID := range h.presence {
+				if len(h.clients[gameID]) == 0 && h.streams[gameID] == nil {
+					delete(h.presence, gameID)
+				}
+			}
+			h.mu.Unlock()
+
+		case delayed := <-h.delayed:
+			h.mu.Lock()
+			// The spectator may have disconnected while the message was delayed
+			if h.clients[delayed.client.gameID][delayed.client] {
+				if delayed.snapshot {
+					delayed.client.awaitingSnapshot = false
+				}
+				h.trySend(delayed.client, delayed.message)
+			}
+			h.mu.Unlock()
 		}
 	}
 }
 
-func (h *WebSocketHub) BroadcastToGame(gameID string, updateType GameUpdateType, payload interface{}) {
-	h.broadcast <- GameUpdate{
+// trySend queues a broadcast message for a client, applying the client's
+// slow consumer policy if its buffer is full. It reports whether the message
+// was queued. The caller must hold h.mu.
+func (h *WebSocketHub) trySend(client *Client, message []byte) bool {
+	return h.enqueue(client, outgoing{message: message})
+}
+
+// sendDirect queues a command reply or an update targeted at the client.
+// Coalescing keeps these, since the snapshot replacing the queue would not
+// restore them. The caller must hold h.mu.
+func (h *WebSocketHub) sendDirect(client *Client, message []byte) bool {
+	return h.enqueue(client, outgoing{message: message, direct: true})
+}
+
+// enqueue queues a message for a client, applying the client's slow consumer
+// policy if its buffer is full. The caller must hold h.mu.
+func (h *WebSocketHub) enqueue(client *Client, queued outgoing) bool {
+	if client.awaitingSnapshot && !queued.direct {
+		client.coalesced++
+		atomic.AddInt64(&h.coalescedMessages, 1)
+		return true
+	}
+
+	select {
+	case client.send <- queued:
+		return true
+	default:
+	}
+
+	switch client.slowPolicy {
+	case SlowConsumerDropOldest:
+		// The write pump may empty the buffer meanwhile, so neither step blocks
+		select {
+		case <-client.send:
+			client.dropped++
+			atomic.AddInt64(&h.droppedMessages, 1)
+		default:
+		}
+		select {
+		case client.send <- queued:
+			return true
+		default:
+			client.dropped++
+			atomic.AddInt64(&h.droppedMessages, 1)
+			return false
+		}
+
+	case SlowConsumerCoalesce:
+		// Broadcast updates queued are replaced by a snapshot reflecting them,
+		// while replies and targeted updates stay queued in order
+		var kept []outgoing
+		coalesced := 0
+		if !queued.direct {
+			coalesced++
+		}
+	drain:
+		for {
+			select {
+			case earlier := <-client.send:
+				if earlier.direct {
+					kept = append(kept, earlier)
+				} else {
+					coalesced++
+				}
+			default:
+				break drain
+			}
+		}
+		if queued.direct {
+			kept = append(kept, queued)
+		}
+		client.coalesced += coalesced
+		atomic.AddInt64(&h.coalescedMessages, int64(coalesced))
+
+		stream := h.streams[client.gameID]
+		if stream == nil || h.gameService == nil || len(kept) > cap(client.send) {
+			h.dropSlowClient(client)
+			return false
+		}
+		for _, direct := range kept {
+			client.send <- direct
+		}
+		client.awaitingSnapshot = true
+		go h.sendSnapshot(client, stream.id, stream.seq)
+		return true
+
+	default:
+		h.dropSlowClient(client)
+		return false
+	}
+}
+
+// dropSlowClient disconnects a client that cannot keep up.
+// The caller must hold h.mu.
+func (h *WebSocketHub) dropSlowClient(client *Client) {
+	if !h.clients[client.gameID][client] {
+		return
+	}
+
+	log.Printf("[WebSocket] Disconnecting slow client: game=%s, addr=%s, buffer=%d",
+		client.gameID, client.remoteAddr, cap(client.send))
+	close(client.send)
+	delete(h.clients[client.gameID], client)
+	atomic.AddInt64(&h.totalConnections, -1)
+	atomic.AddInt64(&h.slowDisconnects, 1)
+
+	// The client will not come back through unregister, so it goes offline now
+	if presence, changed := h.trackPresence(client, -1); changed {
+		go h.publish(presence)
+	}
+}
+
+// sendToSpectator delivers an update to a spectator according to the game's
+// spectator settings. The caller must hold h.mu.
+func (h *WebSocketHub) sendToSpectator(client *Client, update GameUpdate, message []byte) {
+	view := h.spectatorViews[client.gameID]
+	if view == nil || view.mode == game.SpectatorModeDisabled {
+		return
+	}
+	if !view.completed && !spectatorVisibleUpdates[update.Type] {
+		return
+	}
+
+	if view.mode == game.SpectatorModeDelayed && view.delay > 0 {
+		time.AfterFunc(view.delay, func() {
+			h.delayed <- delayedMessage{client: client, message: message}
+		})
+		return
+	}
+
+	h.trySend(client, message)
+}
+
+// replayToSpectator resends a missed update to a reconnecting spectator,
+// holding it back until its delay has passed. The caller must hold h.mu.
+func (h *WebSocketHub) replayToSpectator(client *Client, event bufferedEvent) {
+	view := h.spectatorViews[client.gameID]
+	if view == nil || view.mode == game.SpectatorModeDisabled {
+		return
+	}
+	if !view.completed && !spectatorVisibleUpdates[event.update.Type] {
+		return
+	}
+
+	message, err := event.encoded.in(client.format)
+	if err != nil {
+		return
+	}
+
+	if view.mode == game.SpectatorModeDelayed {
+		if remaining := view.delay - time.Since(event.sentAt); remaining > 0 {
+			time.AfterFunc(remaining, func() {
+				h.delayed <- delayedMessage{client: client, message: message}
+			})
+			return
+		}
+	}
+
+	h.trySend(client, message)
+}
+
+// ensureSpectatorView starts tracking spectator visibility for a game
+func (h *WebSocketHub) ensureSpectatorView(g *ent.Game) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.spectatorViews[g.ID]; ok {
+		return
+	}
+	h.spectatorViews[g.ID] = &spectatorView{
+		mode:      g.SpectatorMode,
+		delay:     time.Duration(g.SpectatorDelaySeconds) * time.Second,
+		completed: g.Status == game.StatusCompleted,
+	}
+}
+
+// applySpectatorSettings updates the spectator view from a game status or
+// settings change. Every instance applies the changes it receives, so the
+// spectators connected to it follow along. The caller must hold h.mu.
+func (h *WebSocketHub) applySpectatorSettings(update GameUpdate) {
+	switch update.Type {
+	case GameStatusChanged, SpectatorSettingsChanged, PhaseChanged:
+	default:
+		return
+	}
+
+	var settings struct {
+		Status                game.Status        `json:"status"`
+		SpectatorMode         game.SpectatorMode `json:"spectator_mode"`
+		SpectatorDelaySeconds int                `json:"spectator_delay_seconds"`
+	}
+	raw, err := json.Marshal(update.Payload)
+	if err != nil || json.Unmarshal(raw, &settings) != nil || settings.SpectatorMode == "" {
+		return
+	}
+	h.updateSpectatorView(update.GameID, settings.Status, settings.SpectatorMode, time.Duration(settings.SpectatorDelaySeconds)*time.Second)
+}
+
+// updateSpectatorView applies a game status or spectator settings change.
+// Spectators are disconnected when spectating gets disabled.
+// The caller must hold h.mu.
+func (h *WebSocketHub) updateSpectatorView(gameID string, status game.Status, mode game.SpectatorMode, delay time.Duration) {
+	view, ok := h.spectatorViews[gameID]
+	if !ok {
+		// Nobody is spectating; the view is loaded when the first spectator connects
+		return
+	}
+
+	view.mode = mode
+	view.delay = delay
+	view.completed = status == game.StatusCompleted
+
+	if mode == game.SpectatorModeDisabled {
+		for client := range h.clients[gameID] {
+			if client.audience == AudienceSpectators {
+				client.close()
+			}
+		}
+	}
+}
+
+// publicPlayers loads the players of a game as anyone may see them
+func (h *WebSocketHub) publicPlayers(gameID string) (*projection.State, []map[string]any, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	state, err := h.gameService.GetGameState(ctx, gameID)
+	if err != nil {
+		log.Printf("[WebSocket] Error loading state of game %s: %v", gameID, err)
+		return nil, nil, false
+	}
+	return state, projection.Players(state, projection.PublicViewer()), true
+}
+
+// broadcastPlayer sends the public view of one player to everyone in the game
+func (h *WebSocketHub) broadcastPlayer(gameID string, updateType GameUpdateType, playerID string) {
+	state, players, ok := h.publicPlayers(gameID)
+	if !ok {
+		return
+	}
+	for i, p := range state.Players {
+		if p.ID.String() == playerID {
+			h.BroadcastToGame(gameID, updateType, players[i])
+		}
+	}
+}
+
+// publish hands an update to the broadcaster for delivery on every instance
+func (h *WebSocketHub) publish(update GameUpdate) {
+	if err := h.broadcaster.Publish(update); err != nil {
+		log.Printf("[WebSocket] Error publishing %s to game %s: %v", update.Type, update.GameID, err)
+	}
+}
+
+// sendTo sends an update to the connections of a game matching target
+func (h *WebSocketHub) sendTo(gameID string, updateType GameUpdateType, payload interface{}, target *updateTarget) {
+	h.publish(GameUpdate{
 		Type:    updateType,
 		GameID:  gameID,
 		Payload: payload,
+		target:  target,
+	})
+}
+
+// SendToPlayer sends an update only to the connections of one player
+func (h *WebSocketHub) SendToPlayer(gameID string, playerID uuid.UUID, updateType GameUpdateType, payload interface{}) {
+	h.sendTo(gameID, updateType, payload, &updateTarget{Players: []uuid.UUID{playerID}})
+}
+
+// SendToTeam sends an update only to the connections of players whose role is on team
+func (h *WebSocketHub) SendToTeam(gameID string, team role.Team, updateType GameUpdateType, payload interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	state, err := h.gameService.GetGameState(ctx, gameID)
+	if err != nil {
+		log.Printf("[WebSocket] Error loading state of game %s: %v", gameID, err)
+		return
+	}
+
+	h.sendTo(gameID, updateType, payload, &updateTarget{Players: teamMembers(state, team)})
+}
+
+// SendToModerators sends an update only to moderator connections
+// Moderators without the view_roles permission are left out, since
+// moderator-only updates reveal secret information.
+func (h *WebSocketHub) SendToModerators(gameID string, updateType GameUpdateType, payload interface{}) {
+	h.sendTo(gameID, updateType, payload, &updateTarget{Moderators: true})
+}
+
+// teamMembers returns the IDs of the players whose role is on team
+func teamMembers(state *projection.State, team role.Team) []uuid.UUID {
+	members := make([]uuid.UUID, 0)
+	for _, gr := range state.Roles {
+		if gr.Edges.Role != nil && gr.Edges.Role.Team == team {
+			members = append(members, gr.PlayerID)
+		}
+	}
+	return members
+}
+
+// sendRoleAssignments privately tells each player their role, introduces the
+// mafia to each other and gives moderators the full list of assignments
+func (h *WebSocketHub) sendRoleAssignments(gameID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	state, err := h.gameService.GetGameState(ctx, gameID)
+	if err != nil {
+		log.Printf("[WebSocket] Error loading state of game %s: %v", gameID, err)
+		return
+	}
+
+	for _, p := range state.Players {
+		if r, ok := projection.PlayerRole(state, projection.PlayerViewer(p.ID), p.ID); ok {
+			h.SendToPlayer(gameID, p.ID, RoleAssigned, r)
+		}
 	}
+
+	all := projection.Assignments(state, projection.ModeratorViewer("", true))
+	mafia := make([]map[string]any, 0)
+	for _, a := range all {
+		if a["team"] == role.TeamMafia {
+			mafia = append(mafia, a)
+		}
+	}
+	h.sendTo(gameID, TeamRevealed, map[string]any{"team": role.TeamMafia, "members": mafia}, &updateTarget{Players: teamMembers(state, role.TeamMafia)})
+
+	h.SendToModerators(gameID, RoleAssignments, all)
+}
+
+// broadcastGame sends a changed game; the spectator view follows it on delivery
+func (h *WebSocketHub) broadcastGame(updateType GameUpdateType, g *ent.Game) {
+	h.BroadcastToGame(g.ID, updateType, projection.Game(g, projection.PublicViewer()))
+}
+
+func (h *WebSocketHub) BroadcastToGame(gameID string, updateType GameUpdateType, payload interface{}) {
+	h.publish(GameUpdate{
+		Type:    updateType,
+		GameID:  gameID,
+		Payload: payload,
+	})
 }
 
 func (h *WebSocketHub) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
@@ -328,17 +1015,44 @@ func (h *WebSocketHub) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 
 	log.Printf("[WebSocket] Upgrade request: game=%s, addr=%s", gameID, remoteAddr)
 
-	conn, err := upgrader.Upgrade(w, r, nil)
+	if !h.checkOrigin(r) {
+		log.Printf("[WebSocket] Rejected origin %q for game %s, addr %s", r.Header.Get("Origin"), gameID, remoteAddr)
+		http.Error(w, "origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	format, err := requestedFormat(r)
+	if err != nil {
+		http.Error(w, unsupportedProtocolMessage(), http.StatusBadRequest)
+		return
+	}
+
+	audience, viewer, spectatorID, ok := h.authorizeConnection(w, r, gameID)
+	if !ok {
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Printf("[WebSocket] Upgrade error for game %s, addr %s: %v", gameID, remoteAddr, err)
 		return
 	}
+	// The upgrader picks the server's most preferred of the subprotocols offered
+	if negotiated, ok := parseSubprotocol(conn.Subprotocol()); ok {
+		format = negotiated
+	}
 
 	client := &Client{
 		hub:         h,
 		conn:        conn,
-		send:        make(chan []byte, 256),
+		send:        make(chan outgoing, h.config.SendBufferSize),
 		gameID:      gameID,
+		audience:    audience,
+		spectatorID: spectatorID,
+		viewer:      viewer,
+		format:      format,
+		resume:      resumeParams(r),
+		slowPolicy:  h.slowConsumerPolicy(r),
 		remoteAddr:  remoteAddr,
 		connectedAt: time.Now(),
 	}
@@ -350,42 +1064,143 @@ func (h *WebSocketHub) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	// new goroutines.
 	go client.writePump()
 	go client.readPump()
+}
 
-	// Send initial game state
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-
-		players, err := h.gameService.GetPlayers(ctx, gameID)
-		if err == nil {
-			playersJSON := make([]map[string]any, len(players))
-			for i, player := range players {
-				playersJSON[i] = map[string]any{
-					"id":         player.ID,
-					"name":       player.Name,
-					"game_id":    player.GameID,
-					"created_at": player.CreatedAt,
-				}
-			}
-			
-			update := GameUpdate{
-				Type:    "initial_state",
-				GameID:  gameID,
-				Payload: map[string]interface{}{"players": playersJSON},
-			}
-			
-			if msg, err := json.Marshal(update); err == nil {
-				select {
-				case client.send <- msg:
-					log.Printf("[WebSocket] Sent initial state to game %s, addr %s: %d players", gameID, remoteAddr, len(players))
-				default:
-					log.Printf("[WebSocket] Failed to send initial state (buffer full)")
-				}
+// slowConsumerPolicy returns the policy a connection asked for with the
+// slow_consumer query parameter, or the hub's default
+func (h *WebSocketHub) slowConsumerPolicy(r *http.Request) SlowConsumerPolicy {
+	if policy := SlowConsumerPolicy(r.URL.Query().Get("slow_consumer")); policy.Valid() {
+		return policy
+	}
+	return h.config.SlowConsumerPolicy
+}
+
+// resumeParams reads the stream ID and last sequence number a reconnecting client saw
+func resumeParams(r *http.Request) *resumePoint {
+	query := r.URL.Query()
+	stream := query.Get("stream")
+	seq, err := strconv.ParseUint(query.Get("resume_from"), 10, 64)
+	if stream == "" || err != nil {
+		return nil
+	}
+	return &resumePoint{stream: stream, seq: seq}
+}
+
+// replay sends a reconnecting client the updates it missed.
+// It reports false if the client did not ask to resume or the updates are no
+// longer available, in which case the client needs a snapshot instead.
+// The caller must hold h.mu.
+func (h *WebSocketHub) replay(client *Client, stream *eventStream) bool {
+	if client.resume == nil || client.resume.stream != stream.id {
+		return false
+	}
+
+	missed, ok := stream.since(client.resume.seq)
+	// Leave room in the send buffer for updates arriving during the replay
+	if !ok || len(missed) > cap(client.send)/2 {
+		return false
+	}
+
+	resumed, err := client.format.encodeUpdate(GameUpdate{
+		Type:   "resumed",
+		GameID: client.gameID,
+		Payload: map[string]any{
+			"stream": stream.id,
+			"from":   client.resume.seq,
+			"to":     stream.seq,
+		},
+	})
+	if err != nil || !h.trySend(client, resumed) {
+		return true
+	}
+
+	for _, event := range missed {
+		if event.update.target != nil && !event.update.target.matches(client) {
+			continue
+		}
+		if event.update.target == nil && client.audience == AudienceSpectators {
+			h.replayToSpectator(client, event)
+			continue
+		}
+		message, err := event.encoded.in(client.format)
+		if err != nil {
+			return true
+		}
+		send := h.trySend
+		if event.update.target != nil {
+			send = h.sendDirect
+		}
+		if !send(client, message) {
+			return true
+		}
+	}
+
+	log.Printf("[WebSocket] Resumed game %s for addr %s from seq %d to %d",
+		client.gameID, client.remoteAddr, client.resume.seq, stream.seq)
+	return true
+}
+
+// sendSnapshot sends a client the full state of the game as it sees it.
+// The snapshot reflects at least every update up to seq.
+func (h *WebSocketHub) sendSnapshot(client *Client, streamID string, seq uint64) {
+	requested := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	state, err := h.gameService.GetGameState(ctx, client.gameID)
+	if err != nil {
+		log.Printf("[WebSocket] Error fetching initial state for game %s: %v", client.gameID, err)
+		return
+	}
+
+	snapshot := projection.Snapshot(state, client.viewer)
+	snapshot["players"] = withPresence(snapshot["players"].([]map[string]any), h.Presence(client.gameID))
+	snapshot["audience"] = client.audience
+	snapshot["stream"] = streamID
+	snapshot["seq"] = seq
+
+	msg, err := client.format.encodeUpdate(GameUpdate{
+		Type:    "initial_state",
+		GameID:  client.gameID,
+		Payload: snapshot,
+	})
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// The client may have disconnected while the state was loading
+	if !h.clients[client.gameID][client] {
+		return
+	}
+	if h.deliverSnapshot(client, msg, requested) {
+		log.Printf("[WebSocket] Sent initial state to game %s, addr %s: %d players", client.gameID, client.remoteAddr, len(state.Players))
+	} else {
+		log.Printf("[WebSocket] Failed to send initial state (buffer full)")
+	}
+}
+
+// deliverSnapshot queues a snapshot of the state at requested. Spectators of
+// a delayed game get it once the delay has passed since then, so it shows
+// no more than the updates they would have seen by that time.
+// The caller must hold h.mu.
+func (h *WebSocketHub) deliverSnapshot(client *Client, message []byte, requested time.Time) bool {
+	if client.audience == AudienceSpectators {
+		view := h.spectatorViews[client.gameID]
+		if view != nil && view.mode == game.SpectatorModeDelayed {
+			if remaining := view.delay - time.Since(requested); remaining > 0 {
+				time.AfterFunc(remaining, func() {
+					h.delayed <- delayedMessage{client: client, message: message, snapshot: true}
+				})
+				return true
 			}
-		} else {
-			log.Printf("[WebSocket] Error fetching initial players for game %s: %v", gameID, err)
 		}
-	}()
+	}
+
+	client.awaitingSnapshot = false
+	return h.trySend(client, message)
 }
 
 type WebSocketHandler struct {
@@ -393,9 +1208,9 @@ type WebSocketHandler struct {
 	gameService *service.GameService
 }
 
-func NewWebSocketHandler(gameService *service.GameService) *WebSocketHandler {
+func NewWebSocketHandler(gameService *service.GameService, playService *service.PlayService, tokens *auth.JWTService, broadcaster Broadcaster, config HubConfig) *WebSocketHandler {
 	return &WebSocketHandler{
-		hub:         NewWebSocketHub(gameService),
+		hub:         NewWebSocketHub(gameService, playService, tokens, broadcaster, config),
 		gameService: gameService,
 	}
 }
@@ -408,6 +1223,16 @@ func (h *WebSocketHandler) HandleGameWebSocket(w http.ResponseWriter, r *http.Re
 	h.hub.HandleWebSocket(w, r)
 }
 
+// HandleGameEvents streams game updates as Server-Sent Events
+func (h *WebSocketHandler) HandleGameEvents(w http.ResponseWriter, r *http.Request) {
+	h.hub.HandleEvents(w, r)
+}
+
+// HandleProtocol describes the message schema versions clients may negotiate
+func (h *WebSocketHandler) HandleProtocol(w http.ResponseWriter, r *http.Request) {
+	JSONResponse(w, http.StatusOK, ProtocolDescription())
+}
+
 // HandleWebSocketStats returns current WebSocket connection statistics
 func (h *WebSocketHandler) HandleWebSocketStats(w http.ResponseWriter, r *http.Request) {
 	stats := h.hub.GetConnectionStats()
@@ -415,70 +1240,3 @@ func (h *WebSocketHandler) HandleWebSocketStats(w http.ResponseWriter, r *http.R
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(stats)
 }
-
-// BroadcastPlayerJoined sends a player joined update to all clients
-func (h *WebSocketHandler) BroadcastPlayerJoined(gameID string, player map[string]any) {
-	h.hub.BroadcastToGame(gameID, PlayerJoined, player)
-}
-
-// BroadcastPlayerLeft sends a player left update to all clients
-func (h *WebSocketHandler) BroadcastPlayerLeft(gameID string, playerID string) {
-	h.hub.BroadcastToGame(gameID, PlayerLeft, map[string]string{"player_id": playerID})
-}
-
-// BroadcastRolesDistributed sends a roles distributed update
-func (h *WebSocketHandler) BroadcastRolesDistributed(gameID string) {
-	h.hub.BroadcastToGame(gameID, RolesDistributed, nil)
-}
-
-// BroadcastGameDeleted sends a game deleted update
-func (h *WebSocketHandler) BroadcastGameDeleted(gameID string) {
-	h.hub.BroadcastToGame(gameID, GameDeleted, nil)
-}
-
-// NotifyPlayerUpdate wraps game handler methods to send WebSocket updates
-func NotifyPlayerUpdate(handler http.HandlerFunc, wsHandler *WebSocketHandler, updateType GameUpdateType) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		gameID := chi.URLParam(r, "id")
-
-		// Capture response
-		rec := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
-		handler(rec, r)
-
-		// If successful, broadcast update
-		if rec.statusCode < 400 && gameID != "" {
-			switch updateType {
-			case PlayerJoined:
-				if rec.body != nil {
-					var player map[string]any
-					if err := json.Unmarshal(rec.body, &player); err == nil {
-						wsHandler.BroadcastPlayerJoined(gameID, player)
-					}
-				}
-			case PlayerLeft:
-				playerID := chi.URLParam(r, "player_id")
-				wsHandler.BroadcastPlayerLeft(gameID, playerID)
-			case RolesDistributed:
-				wsHandler.BroadcastRolesDistributed(gameID)
-			case GameDeleted:
-				wsHandler.BroadcastGameDeleted(gameID)
-			}
-		}
-	}
-}
-
-type responseRecorder struct {
-	http.ResponseWriter
-	statusCode int
-	body       []byte
-}
-
-func (r *responseRecorder) WriteHeader(statusCode int) {
-	r.statusCode = statusCode
-	r.ResponseWriter.WriteHeader(statusCode)
-}
-
-func (r *responseRecorder) Write(b []byte) (int, error) {
-	r.body = append(r.body, b...)
-	return r.ResponseWriter.Write(b)
-}
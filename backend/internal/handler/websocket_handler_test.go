@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/internal/events"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receive returns the type of the next update queued for a client, or "" if none arrives
+func receive(t *testing.T, c *Client) GameUpdateType {
+	t.Helper()
+
+	select {
+	case queued := <-c.send:
+		var update GameUpdate
+		require.NoError(t, json.Unmarshal(queued.message, &update))
+		return update.Type
+	case <-time.After(100 * time.Millisecond):
+		return ""
+	}
+}
+
+// addTestClient adds a client to a hub directly, skipping the initial snapshot
+// that would need a database
+func addTestClient(hub *WebSocketHub, gameID string, viewer projection.Viewer, resume *resumePoint) *Client {
+	client := &Client{hub: hub, send: make(chan outgoing, 16), gameID: gameID, audience: AudiencePlayers, viewer: viewer, resume: resume}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if hub.clients[gameID] == nil {
+		hub.clients[gameID] = make(map[*Client]bool)
+	}
+	hub.clients[gameID][client] = true
+	if hub.streams[gameID] == nil {
+		hub.streams[gameID] = newEventStream()
+	}
+	hub.replay(client, hub.streams[gameID])
+	return client
+}
+
+func TestWebSocketHub_TargetedUpdates(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+
+	alice := uuid.New()
+	connect := func(viewer projection.Viewer) *Client {
+		return addTestClient(hub, "ABC123", viewer, nil)
+	}
+
+	aliceConn := connect(projection.PlayerViewer(alice))
+	bobConn := connect(projection.PlayerViewer(uuid.New()))
+	modConn := connect(projection.ModeratorViewer("mod-123", true))
+	coModConn := connect(projection.ModeratorViewer("co-mod", false))
+	publicConn := connect(projection.PublicViewer())
+
+	t.Run("sends to one player", func(t *testing.T) {
+		hub.SendToPlayer("ABC123", alice, RoleAssigned, map[string]any{"slug": "mafia"})
+
+		assert.Equal(t, RoleAssigned, receive(t, aliceConn))
+		assert.Empty(t, receive(t, bobConn))
+		assert.Empty(t, receive(t, modConn))
+		assert.Empty(t, receive(t, publicConn))
+	})
+
+	t.Run("sends to moderators who may view roles", func(t *testing.T) {
+		hub.SendToModerators("ABC123", NightActionSubmitted, nil)
+
+		assert.Equal(t, NightActionSubmitted, receive(t, modConn))
+		assert.Empty(t, receive(t, coModConn))
+		assert.Empty(t, receive(t, aliceConn))
+	})
+
+	t.Run("broadcasts reach everyone", func(t *testing.T) {
+		hub.BroadcastToGame("ABC123", PlayerJoined, nil)
+
+		for _, c := range []*Client{aliceConn, bobConn, modConn, coModConn, publicConn} {
+			assert.Equal(t, PlayerJoined, receive(t, c))
+		}
+	})
+}
+
+func TestWebSocketHub_Resume(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+	alice := uuid.New()
+
+	first := addTestClient(hub, "ABC123", projection.PlayerViewer(alice), nil)
+	hub.BroadcastToGame("ABC123", PlayerJoined, nil)
+	assert.Equal(t, PlayerJoined, receive(t, first))
+
+	hub.mu.RLock()
+	stream := hub.streams["ABC123"]
+	streamID, seq := stream.id, stream.seq
+	hub.mu.RUnlock()
+	require.Equal(t, uint64(1), seq)
+
+	// The phone sleeps and misses two updates, one of them private to someone else
+	hub.mu.Lock()
+	delete(hub.clients["ABC123"], first)
+	hub.mu.Unlock()
+
+	hub.SendToPlayer("ABC123", uuid.New(), RoleAssigned, nil)
+	hub.BroadcastToGame("ABC123", SeatsUpdated, nil)
+	hub.SendToPlayer("ABC123", alice, RoleAssigned, nil)
+	time.Sleep(50 * time.Millisecond)
+
+	t.Run("replays missed updates meant for the client", func(t *testing.T) {
+		client := addTestClient(hub, "ABC123", projection.PlayerViewer(alice), &resumePoint{stream: streamID, seq: seq})
+
+		assert.Equal(t, GameUpdateType("resumed"), receive(t, client))
+		assert.Equal(t, SeatsUpdated, receive(t, client))
+		assert.Equal(t, RoleAssigned, receive(t, client))
+		assert.Empty(t, receive(t, client))
+	})
+
+	t.Run("needs a snapshot after a restart", func(t *testing.T) {
+		hub.mu.Lock()
+		ok := hub.replay(&Client{gameID: "ABC123", send: make(chan outgoing, 16), resume: &resumePoint{stream: "old", seq: seq}}, hub.streams["ABC123"])
+		hub.mu.Unlock()
+
+		assert.False(t, ok)
+	})
+}
+
+func TestWebSocketHub_SpectatorSnapshot(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+	hub.spectatorViews["ABC123"] = &spectatorView{mode: game.SpectatorModeDelayed, delay: 300 * time.Millisecond}
+
+	spectator := addTestClient(hub, "ABC123", projection.SpectatorViewer(uuid.New()), nil)
+	spectator.audience = AudienceSpectators
+	spectator.awaitingSnapshot = true
+	player := addTestClient(hub, "ABC123", projection.PlayerViewer(uuid.New()), nil)
+
+	snapshot, err := json.Marshal(GameUpdate{Type: "initial_state", GameID: "ABC123"})
+	require.NoError(t, err)
+
+	hub.mu.Lock()
+	require.True(t, hub.deliverSnapshot(player, snapshot, time.Now()))
+	require.True(t, hub.deliverSnapshot(spectator, snapshot, time.Now()))
+	hub.mu.Unlock()
+
+	t.Run("players get the snapshot at once", func(t *testing.T) {
+		assert.Equal(t, GameUpdateType("initial_state"), receive(t, player))
+	})
+
+	t.Run("delayed spectators get it once the delay has passed", func(t *testing.T) {
+		assert.Empty(t, receive(t, spectator))
+		time.Sleep(250 * time.Millisecond)
+		assert.Equal(t, GameUpdateType("initial_state"), receive(t, spectator))
+
+		hub.mu.RLock()
+		assert.False(t, spectator.awaitingSnapshot)
+		hub.mu.RUnlock()
+	})
+}
+
+func TestEventStream_Since(t *testing.T) {
+	stream := newEventStream()
+	for i := 0; i < eventBufferSize+10; i++ {
+		update := GameUpdate{Type: PlayerJoined, Seq: stream.next()}
+		stream.add(update, nil)
+	}
+
+	events, ok := stream.since(stream.seq - 5)
+	require.True(t, ok)
+	require.Len(t, events, 5)
+	assert.Equal(t, stream.seq-4, events[0].update.Seq)
+
+	events, ok = stream.since(stream.seq)
+	assert.True(t, ok)
+	assert.Empty(t, events)
+
+	_, ok = stream.since(5)
+	assert.False(t, ok, "updates that fell out of the buffer cannot be replayed")
+
+	_, ok = stream.since(stream.seq + 1)
+	assert.False(t, ok)
+}
+
+func TestWebSocketHub_HandleEvent(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+
+	playerConn := addTestClient(hub, "ABC123", projection.PlayerViewer(uuid.New()), nil)
+	modConn := addTestClient(hub, "ABC123", projection.ModeratorViewer("mod-123", true), nil)
+
+	hub.HandleEvent(events.VoteCast{Vote: &ent.Vote{GameID: "ABC123"}})
+	assert.Equal(t, VoteCast, receive(t, playerConn))
+	assert.Equal(t, VoteCast, receive(t, modConn))
+
+	hub.HandleEvent(events.NightActionSubmitted{Action: &ent.NightAction{GameID: "ABC123"}})
+	assert.Equal(t, NightActionSubmitted, receive(t, modConn))
+	assert.Empty(t, receive(t, playerConn), "night actions are secret")
+
+	mafioso := uuid.New()
+	mafiaConn := addTestClient(hub, "ABC123", projection.PlayerViewer(mafioso), nil)
+	hub.HandleEvent(events.ChatMessageSent{
+		Message:    &ent.ChatMessage{GameID: "ABC123", Channel: chatmessage.ChannelMafia},
+		Recipients: []uuid.UUID{mafioso},
+	})
+	assert.Equal(t, ChatMessageSent, receive(t, mafiaConn))
+	assert.Equal(t, ChatMessageSent, receive(t, modConn))
+	assert.Empty(t, receive(t, playerConn), "the mafia channel is private")
+
+	hub.HandleEvent(events.ChatMessageSent{Message: &ent.ChatMessage{GameID: "ABC123", Channel: chatmessage.ChannelDay}})
+	assert.Equal(t, ChatMessageSent, receive(t, playerConn))
+	assert.Equal(t, ChatMessageSent, receive(t, mafiaConn))
+
+	hub.HandleEvent(events.GameCreated{Game: &ent.Game{ID: "ABC123"}})
+	assert.Empty(t, receive(t, playerConn))
+}
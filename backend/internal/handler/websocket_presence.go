@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/internal/projection"
+)
+
+// Presence is whether a player is connected to their game and when they were last seen
+type Presence struct {
+	Online bool `json:"online"`
+	// LastSeen is when the player last connected or disconnected
+	LastSeen time.Time `json:"last_seen_at"`
+}
+
+// playerPresence is a player's presence along with their connections to this instance
+type playerPresence struct {
+	Presence
+	connections int
+}
+
+// presenceUpdate is the payload of a PresenceChanged update
+type presenceUpdate struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	Presence
+}
+
+// trackPresence counts a player connecting (delta 1) or disconnecting (delta -1).
+// It returns the update to publish when the player comes online or goes offline.
+// The caller must hold h.mu.
+func (h *WebSocketHub) trackPresence(client *Client, delta int) (GameUpdate, bool) {
+	if client.viewer.Kind != projection.KindPlayer {
+		return GameUpdate{}, false
+	}
+
+	players := h.presence[client.gameID]
+	if players == nil {
+		players = make(map[uuid.UUID]*playerPresence)
+		h.presence[client.gameID] = players
+	}
+	p := players[client.viewer.PlayerID]
+	if p == nil {
+		p = &playerPresence{}
+		players[client.viewer.PlayerID] = p
+	}
+
+	p.connections += delta
+	online := p.connections > 0
+	if online == p.Online {
+		return GameUpdate{}, false
+	}
+	p.Online = online
+	p.LastSeen = time.Now()
+
+	return GameUpdate{
+		Type:    PresenceChanged,
+		GameID:  client.gameID,
+		Payload: presenceUpdate{PlayerID: client.viewer.PlayerID, Presence: p.Presence},
+	}, true
+}
+
+// applyPresence records a presence change published by any instance, so every
+// instance can tell who is connected. Changes older than what is already known
+// are ignored, since they may be delivered out of order. The caller must hold h.mu.
+func (h *WebSocketHub) applyPresence(update GameUpdate) {
+	if update.Type != PresenceChanged {
+		return
+	}
+
+	var change presenceUpdate
+	raw, err := json.Marshal(update.Payload)
+	if err != nil || json.Unmarshal(raw, &change) != nil || change.PlayerID == uuid.Nil {
+		return
+	}
+
+	players := h.presence[update.GameID]
+	if players == nil {
+		players = make(map[uuid.UUID]*playerPresence)
+		h.presence[update.GameID] = players
+	}
+	p := players[change.PlayerID]
+	if p == nil {
+		p = &playerPresence{}
+		players[change.PlayerID] = p
+	}
+	if change.LastSeen.Before(p.LastSeen) {
+		return
+	}
+	// A player still connected here stays online whatever other instances say
+	p.Online = change.Online || p.connections > 0
+	p.LastSeen = change.LastSeen
+}
+
+// Presence returns the presence of the players of a game who have connected
+// since this instance started
+func (h *WebSocketHub) Presence(gameID string) map[uuid.UUID]Presence {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	presence := make(map[uuid.UUID]Presence, len(h.presence[gameID]))
+	for id, p := range h.presence[gameID] {
+		presence[id] = p.Presence
+	}
+	return presence
+}
+
+// withPresence adds each player's presence to their projection.
+// Players who never connected are offline and have no last seen time.
+func withPresence(players []map[string]any, presence map[uuid.UUID]Presence) []map[string]any {
+	for _, player := range players {
+		id, _ := player["id"].(uuid.UUID)
+		p, ok := presence[id]
+		player["online"] = p.Online
+		if ok {
+			player["last_seen_at"] = p.LastSeen
+		} else {
+			player["last_seen_at"] = nil
+		}
+	}
+	return players
+}
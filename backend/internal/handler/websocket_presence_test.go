@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketHub_TrackPresence(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+	alice := uuid.New()
+	phone := &Client{gameID: "ABC123", viewer: projection.PlayerViewer(alice)}
+	laptop := &Client{gameID: "ABC123", viewer: projection.PlayerViewer(alice)}
+	moderator := &Client{gameID: "ABC123", viewer: projection.ModeratorViewer("mod-123", true)}
+
+	track := func(c *Client, delta int) (GameUpdate, bool) {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return hub.trackPresence(c, delta)
+	}
+
+	update, changed := track(phone, 1)
+	require.True(t, changed)
+	assert.Equal(t, PresenceChanged, update.Type)
+	assert.Equal(t, alice, update.Payload.(presenceUpdate).PlayerID)
+	assert.True(t, update.Payload.(presenceUpdate).Online)
+
+	_, changed = track(laptop, 1)
+	assert.False(t, changed, "a second connection does not change anything")
+	_, changed = track(phone, -1)
+	assert.False(t, changed, "the player is still connected from the laptop")
+
+	update, changed = track(laptop, -1)
+	require.True(t, changed)
+	assert.False(t, update.Payload.(presenceUpdate).Online)
+
+	_, changed = track(moderator, 1)
+	assert.False(t, changed, "only players have presence")
+
+	presence := hub.Presence("ABC123")
+	require.Contains(t, presence, alice)
+	assert.False(t, presence[alice].Online)
+	assert.WithinDuration(t, time.Now(), presence[alice].LastSeen, time.Second)
+}
+
+func TestWebSocketHub_ApplyPresence(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+	client := addTestClient(hub, "ABC123", projection.ModeratorViewer("mod-123", true), nil)
+	alice := uuid.New()
+	seen := time.Now()
+
+	// A change published by another instance reaches everyone and is remembered
+	hub.publish(GameUpdate{
+		Type:    PresenceChanged,
+		GameID:  "ABC123",
+		Payload: presenceUpdate{PlayerID: alice, Presence: Presence{Online: true, LastSeen: seen}},
+	})
+	assert.Equal(t, PresenceChanged, receive(t, client))
+	assert.True(t, hub.Presence("ABC123")[alice].Online)
+
+	// An older change arriving late is ignored
+	hub.publish(GameUpdate{
+		Type:    PresenceChanged,
+		GameID:  "ABC123",
+		Payload: presenceUpdate{PlayerID: alice, Presence: Presence{Online: false, LastSeen: seen.Add(-time.Minute)}},
+	})
+	assert.Equal(t, PresenceChanged, receive(t, client))
+	assert.True(t, hub.Presence("ABC123")[alice].Online)
+}
+
+func TestWithPresence(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	seen := time.Now()
+
+	players := withPresence(
+		[]map[string]any{{"id": alice}, {"id": bob}},
+		map[uuid.UUID]Presence{alice: {Online: true, LastSeen: seen}},
+	)
+
+	assert.Equal(t, true, players[0]["online"])
+	assert.Equal(t, seen, players[0]["last_seen_at"])
+	assert.Equal(t, false, players[1]["online"])
+	assert.Nil(t, players[1]["last_seen_at"])
+
+	_, err := json.Marshal(players)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,344 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gorilla/websocket"
+)
+
+// Versions of the message schema. The server speaks the current and the
+// previous version side by side, so clients can upgrade at their own pace.
+const (
+	// ProtocolV1 sends {"type", "game_id", "seq", "payload"} objects, several of
+	// which may share one frame when they were queued together
+	ProtocolV1 = 1
+	// ProtocolV2 wraps every message in a versioned envelope
+	// {"v", "type", "game_id", "seq", "request_id", "data"}, one per frame.
+	// Commands carry their arguments in "data" instead of "payload".
+	ProtocolV2 = 2
+
+	// CurrentProtocol is the newest version
+	CurrentProtocol = ProtocolV2
+	// OldestProtocol is the oldest version still supported
+	OldestProtocol = ProtocolV1
+)
+
+// Encoding is how messages are serialized on the wire
+type Encoding string
+
+const (
+	EncodingJSON Encoding = "json"
+	// EncodingCBOR carries the same data as the JSON encoding in binary frames.
+	// UUIDs and times are strings, as in JSON.
+	EncodingCBOR Encoding = "cbor"
+)
+
+// wireFormat is how messages are written to and read from one connection.
+// The zero value is version 1 in JSON, for clients that do not negotiate.
+type wireFormat struct {
+	version  int
+	encoding Encoding
+}
+
+// supportedFormats lists the formats the server offers, most preferred first
+var supportedFormats = []wireFormat{
+	{version: ProtocolV2, encoding: EncodingCBOR},
+	{version: ProtocolV2, encoding: EncodingJSON},
+	{version: ProtocolV1, encoding: EncodingJSON},
+}
+
+// errUnsupportedProtocol is returned when a client asks only for versions or
+// encodings the server does not speak
+var errUnsupportedProtocol = errors.New("unsupported protocol")
+
+// subprotocol returns the WebSocket subprotocol selecting the format,
+// such as "mafia-night.v2.cbor"
+func (f wireFormat) subprotocol() string {
+	return fmt.Sprintf("%s.v%d.%s", wsProtocol, f.version, f.encoding)
+}
+
+func (f wireFormat) binary() bool {
+	return f.encoding == EncodingCBOR
+}
+
+// batched reports whether queued messages may share a frame, as version 1 did
+func (f wireFormat) batched() bool {
+	return !f.binary() && f.version < ProtocolV2
+}
+
+// formatSubprotocols lists the subprotocols the upgrader may agree to, most
+// preferred first. Plain wsProtocol stands for version 1 in JSON.
+func formatSubprotocols() []string {
+	protocols := make([]string, 0, len(supportedFormats)+1)
+	for _, f := range supportedFormats {
+		protocols = append(protocols, f.subprotocol())
+	}
+	return append(protocols, wsProtocol)
+}
+
+// parseSubprotocol returns the format a subprotocol selects
+func parseSubprotocol(protocol string) (wireFormat, bool) {
+	for _, f := range supportedFormats {
+		if protocol == f.subprotocol() {
+			return f, true
+		}
+	}
+	return wireFormat{}, false
+}
+
+// requestedFormat checks that a client asked for a format the server speaks,
+// before the connection is upgraded. Clients pick a format with a
+// "mafia-night.v<version>.<encoding>" subprotocol, or with the v and encoding
+// query parameters. Clients asking for nothing get version 1 in JSON.
+func requestedFormat(r *http.Request) (wireFormat, error) {
+	offered := false
+	for _, protocol := range websocket.Subprotocols(r) {
+		if f, ok := parseSubprotocol(protocol); ok {
+			return f, nil
+		}
+		if strings.HasPrefix(protocol, wsProtocol+".v") {
+			offered = true
+		}
+	}
+	if offered {
+		return wireFormat{}, errUnsupportedProtocol
+	}
+
+	return queryFormat(r)
+}
+
+// queryFormat reads the format from the v and encoding query parameters
+func queryFormat(r *http.Request) (wireFormat, error) {
+	query := r.URL.Query()
+	f := wireFormat{version: ProtocolV1, encoding: EncodingJSON}
+
+	if v := query.Get("v"); v != "" {
+		version, err := strconv.Atoi(v)
+		if err != nil || version < OldestProtocol || version > CurrentProtocol {
+			return wireFormat{}, errUnsupportedProtocol
+		}
+		f.version = version
+	}
+	if encoding := query.Get("encoding"); encoding != "" {
+		f.encoding = Encoding(encoding)
+	}
+
+	for _, supported := range supportedFormats {
+		if f == supported {
+			return f, nil
+		}
+	}
+	return wireFormat{}, errUnsupportedProtocol
+}
+
+// unsupportedProtocolMessage tells a client which formats it may ask for
+func unsupportedProtocolMessage() string {
+	return fmt.Sprintf("unsupported protocol; supported versions are %d to %d, subprotocols %s",
+		OldestProtocol, CurrentProtocol, strings.Join(formatSubprotocols(), ", "))
+}
+
+// messageV2 is the envelope of every version 2 message
+type messageV2 struct {
+	Version   int    `json:"v"`
+	Type      string `json:"type"`
+	GameID    string `json:"game_id,omitempty"`
+	Seq       uint64 `json:"seq,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	Data      any    `json:"data,omitempty"`
+}
+
+// encodeUpdate writes an update in the format
+func (f wireFormat) encodeUpdate(update GameUpdate) ([]byte, error) {
+	if f.version < ProtocolV2 {
+		return f.encode(update)
+	}
+	return f.encode(messageV2{
+		Version: ProtocolV2,
+		Type:    string(update.Type),
+		GameID:  update.GameID,
+		Seq:     update.Seq,
+		Data:    update.Payload,
+	})
+}
+
+// encodeReply writes a command reply in the format
+func (f wireFormat) encodeReply(reply CommandReply) ([]byte, error) {
+	if f.version < ProtocolV2 {
+		return f.encode(reply)
+	}
+	return f.encode(messageV2{
+		Version:   ProtocolV2,
+		Type:      reply.Type,
+		RequestID: reply.RequestID,
+		Data:      reply.Payload,
+	})
+}
+
+// encode marshals a message as JSON, converting it to CBOR for binary formats.
+// Going through JSON keeps both encodings carrying exactly the same data.
+func (f wireFormat) encode(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || !f.binary() {
+		return data, err
+	}
+	return jsonToCBOR(data)
+}
+
+// decodeCommand turns a message received from a client into JSON for handleCommand
+func (f wireFormat) decodeCommand(messageType int, data []byte) ([]byte, error) {
+	if messageType == websocket.BinaryMessage {
+		if !f.binary() {
+			return nil, errUnsupportedProtocol
+		}
+		return cborToJSON(data)
+	}
+	return data, nil
+}
+
+// cborDecMode decodes CBOR maps with string keys, so they can be written as JSON
+var cborDecMode, _ = cbor.DecOptions{
+	DefaultMapType: reflect.TypeOf(map[string]any(nil)),
+}.DecMode()
+
+// jsonToCBOR converts a JSON document to CBOR, keeping integers as integers
+func jsonToCBOR(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	return cbor.Marshal(cborValue(v))
+}
+
+// cborValue replaces the JSON numbers in a decoded document with Go numbers
+func cborValue(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for k, item := range v {
+			v[k] = cborValue(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = cborValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// cborToJSON converts a CBOR document to JSON
+func cborToJSON(data []byte) ([]byte, error) {
+	var v any
+	if err := cborDecMode.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// encodedUpdate encodes an update at most once per wire format.
+// It is not safe for concurrent use; the hub only uses it while holding h.mu.
+type encodedUpdate struct {
+	update   GameUpdate
+	messages map[wireFormat][]byte
+}
+
+func newEncodedUpdate(update GameUpdate) *encodedUpdate {
+	return &encodedUpdate{update: update, messages: make(map[wireFormat][]byte)}
+}
+
+// in returns the update encoded in format f
+func (e *encodedUpdate) in(f wireFormat) ([]byte, error) {
+	if message, ok := e.messages[f]; ok {
+		return message, nil
+	}
+	message, err := f.encodeUpdate(e.update)
+	if err != nil {
+		return nil, err
+	}
+	e.messages[f] = message
+	return message, nil
+}
+
+// messageTypes lists every type of message the server sends
+var messageTypes = []GameUpdateType{
+	"initial_state", "resumed",
+	PlayerJoined, PlayerLeft, RolesDistributed, GameDeleted, SeatsUpdated,
+	PlayerUpdated, PhaseChanged, VoteCast, ChatMessageSent, PresenceChanged,
+	RoleAssigned, TeamRevealed, RoleAssignments, NightActionSubmitted,
+	GameStatusChanged, SpectatorSettingsChanged,
+	ReplyAck, ReplyError,
+}
+
+// ProtocolDescription describes the supported message schemas, with a JSON
+// Schema of each version's messages for clients to validate against
+func ProtocolDescription() map[string]any {
+	subprotocols := make(map[string]any, len(supportedFormats))
+	for _, f := range supportedFormats {
+		subprotocols[f.subprotocol()] = map[string]any{"version": f.version, "encoding": f.encoding}
+	}
+
+	commands := make([]CommandType, 0, len(commandHandlers))
+	for command := range commandHandlers {
+		commands = append(commands, command)
+	}
+	slices.Sort(commands)
+
+	return map[string]any{
+		"current":      CurrentProtocol,
+		"supported":    []int{ProtocolV1, ProtocolV2},
+		"subprotocols": subprotocols,
+		"commands":     commands,
+		"schemas": map[string]any{
+			strconv.Itoa(ProtocolV1): messageSchema(ProtocolV1),
+			strconv.Itoa(ProtocolV2): messageSchema(ProtocolV2),
+		},
+	}
+}
+
+// messageSchema returns the JSON Schema of the messages the server sends in a version
+func messageSchema(version int) map[string]any {
+	dataKey := "payload"
+	properties := map[string]any{
+		"type":       map[string]any{"enum": messageTypes},
+		"game_id":    map[string]any{"type": "string"},
+		"seq":        map[string]any{"type": "integer", "minimum": 1},
+		"request_id": map[string]any{"type": "string"},
+	}
+	if version >= ProtocolV2 {
+		dataKey = "data"
+		properties["v"] = map[string]any{"const": version}
+	}
+	properties[dataKey] = map[string]any{}
+
+	required := []string{"type"}
+	if version >= ProtocolV2 {
+		required = append(required, "v")
+	}
+
+	return map[string]any{
+		"$schema":    "https://json-schema.org/draft/2020-12/schema",
+		"title":      fmt.Sprintf("Mafia Night game message, version %d", version),
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
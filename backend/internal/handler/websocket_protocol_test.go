@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/mafia-night/backend/internal/projection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	formatV1     = wireFormat{version: ProtocolV1, encoding: EncodingJSON}
+	formatV2JSON = wireFormat{version: ProtocolV2, encoding: EncodingJSON}
+	formatV2CBOR = wireFormat{version: ProtocolV2, encoding: EncodingCBOR}
+)
+
+func TestRequestedFormat(t *testing.T) {
+	tests := []struct {
+		name         string
+		subprotocols string
+		query        string
+		want         wireFormat
+		wantErr      bool
+	}{
+		{name: "nothing asked for", want: formatV1},
+		{name: "legacy subprotocol", subprotocols: "mafia-night, token.abc", want: formatV1},
+		{name: "binary subprotocol", subprotocols: "mafia-night.v2.cbor, token.abc", want: formatV2CBOR},
+		{name: "first known subprotocol", subprotocols: "mafia-night.v9.json, mafia-night.v2.json", want: formatV2JSON},
+		{name: "only unknown versions", subprotocols: "mafia-night.v9.json", wantErr: true},
+		{name: "version query", query: "v=2", want: formatV2JSON},
+		{name: "encoding query", query: "v=2&encoding=cbor", want: formatV2CBOR},
+		{name: "binary version 1", query: "v=1&encoding=cbor", wantErr: true},
+		{name: "future version query", query: "v=3", wantErr: true},
+		{name: "unknown encoding", query: "encoding=xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/games/ABC123/ws?"+tt.query, nil)
+			if tt.subprotocols != "" {
+				r.Header.Set("Sec-WebSocket-Protocol", tt.subprotocols)
+			}
+
+			got, err := requestedFormat(r)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUnsupportedProtocol)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWebSocketHub_NegotiatesSubprotocol(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{AllowedOrigins: []string{"*"}})
+
+	negotiated := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := hub.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		negotiated <- conn.Subprotocol()
+		conn.Close()
+	}))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	dialer := websocket.Dialer{Subprotocols: []string{"mafia-night.v1.json", "mafia-night.v2.cbor", "token.abc"}}
+	conn, _, err := dialer.Dial(url, nil)
+	require.NoError(t, err)
+	conn.Close()
+
+	// The server's preference wins over the order the client offered
+	protocol := <-negotiated
+	assert.Equal(t, "mafia-night.v2.cbor", protocol)
+	f, ok := parseSubprotocol(protocol)
+	require.True(t, ok)
+	assert.Equal(t, formatV2CBOR, f)
+}
+
+func TestWireFormat_EncodeUpdate(t *testing.T) {
+	playerID := uuid.New()
+	update := GameUpdate{
+		Type:    PlayerJoined,
+		GameID:  "ABC123",
+		Seq:     7,
+		Payload: map[string]any{"id": playerID, "name": "Alice", "seat": 3},
+	}
+
+	t.Run("version 1 is unchanged", func(t *testing.T) {
+		message, err := formatV1.encodeUpdate(update)
+		require.NoError(t, err)
+
+		legacy, err := json.Marshal(update)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(legacy), string(message))
+	})
+
+	t.Run("version 2 wraps the update", func(t *testing.T) {
+		message, err := formatV2JSON.encodeUpdate(update)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"v": 2,
+			"type": "player_joined",
+			"game_id": "ABC123",
+			"seq": 7,
+			"data": {"id": "`+playerID.String()+`", "name": "Alice", "seat": 3}
+		}`, string(message))
+	})
+
+	t.Run("binary carries the same data", func(t *testing.T) {
+		binary, err := formatV2CBOR.encodeUpdate(update)
+		require.NoError(t, err)
+		text, err := formatV2JSON.encodeUpdate(update)
+		require.NoError(t, err)
+
+		var decoded map[string]any
+		require.NoError(t, cbor.Unmarshal(binary, &decoded))
+		assert.EqualValues(t, 2, decoded["v"])
+		assert.EqualValues(t, 7, decoded["seq"])
+
+		converted, err := cborToJSON(binary)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(text), string(converted))
+	})
+}
+
+func TestWebSocketHub_MixedFormats(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+
+	legacy := addTestClient(hub, "ABC123", projection.PlayerViewer(uuid.New()), nil)
+	binary := addTestClient(hub, "ABC123", projection.PlayerViewer(uuid.New()), nil)
+	binary.format = formatV2CBOR
+
+	hub.BroadcastToGame("ABC123", PlayerLeft, map[string]string{"player_id": "p-1"})
+
+	assert.Equal(t, PlayerLeft, receive(t, legacy))
+
+	var message struct {
+		Version int               `cbor:"v"`
+		Type    GameUpdateType    `cbor:"type"`
+		Seq     uint64            `cbor:"seq"`
+		Data    map[string]string `cbor:"data"`
+	}
+	require.NoError(t, cbor.Unmarshal((<-binary.send).message, &message))
+	assert.Equal(t, ProtocolV2, message.Version)
+	assert.Equal(t, PlayerLeft, message.Type)
+	assert.Equal(t, uint64(1), message.Seq)
+	assert.Equal(t, "p-1", message.Data["player_id"])
+
+	t.Run("replays in the client's format", func(t *testing.T) {
+		stream := hub.streams["ABC123"]
+		resumed := addTestClient(hub, "ABC123", projection.PlayerViewer(uuid.New()), nil)
+		resumed.format = formatV2JSON
+		resumed.resume = &resumePoint{stream: stream.id, seq: 0}
+
+		hub.mu.Lock()
+		require.True(t, hub.replay(resumed, stream))
+		hub.mu.Unlock()
+
+		for _, want := range []GameUpdateType{"resumed", PlayerLeft} {
+			var envelope messageV2
+			require.NoError(t, json.Unmarshal((<-resumed.send).message, &envelope))
+			assert.Equal(t, ProtocolV2, envelope.Version)
+			assert.Equal(t, string(want), envelope.Type)
+		}
+	})
+}
+
+func TestWebSocketHub_BinaryCommands(t *testing.T) {
+	hub := NewWebSocketHub(nil, nil, nil, nil, HubConfig{})
+	client := addTestClient(hub, "ABC123", projection.PlayerViewer(uuid.New()), nil)
+	client.format = formatV2CBOR
+
+	command, err := cbor.Marshal(map[string]any{"type": "fly", "request_id": "req-1", "data": map[string]any{}})
+	require.NoError(t, err)
+	decoded, err := client.format.decodeCommand(websocket.BinaryMessage, command)
+	require.NoError(t, err)
+	hub.handleCommand(client, decoded)
+
+	var reply struct {
+		Version   int          `cbor:"v"`
+		Type      string       `cbor:"type"`
+		RequestID string       `cbor:"request_id"`
+		Data      CommandError `cbor:"data"`
+	}
+	require.NoError(t, cbor.Unmarshal((<-client.send).message, &reply))
+	assert.Equal(t, ProtocolV2, reply.Version)
+	assert.Equal(t, ReplyError, reply.Type)
+	assert.Equal(t, "req-1", reply.RequestID)
+	assert.Equal(t, "unknown_command", reply.Data.Code)
+
+	_, err = formatV1.decodeCommand(websocket.BinaryMessage, command)
+	assert.ErrorIs(t, err, errUnsupportedProtocol, "JSON connections do not accept binary frames")
+}
+
+func TestSSEEvent_Version2(t *testing.T) {
+	stream := ""
+	message, err := formatV2JSON.encodeUpdate(GameUpdate{
+		Type:    "initial_state",
+		GameID:  "ABC123",
+		Payload: map[string]any{"stream": "s-1", "seq": 4},
+	})
+	require.NoError(t, err)
+
+	event := string(sseEvent(message, &stream))
+	assert.True(t, strings.HasPrefix(event, "id: s-1:4\n"), event)
+	assert.Equal(t, "s-1", stream)
+}
+
+func TestProtocolDescription(t *testing.T) {
+	description := ProtocolDescription()
+
+	assert.Equal(t, CurrentProtocol, description["current"])
+	assert.Contains(t, description["subprotocols"], "mafia-night.v2.cbor")
+
+	schemas := description["schemas"].(map[string]any)
+	v2 := schemas["2"].(map[string]any)
+	assert.Contains(t, v2["required"], "v")
+	assert.Contains(t, v2["properties"], "data")
+	assert.NotContains(t, schemas["1"].(map[string]any)["properties"], "data")
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Number of recent updates kept per game for clients that reconnect
+	eventBufferSize = 128
+
+	// How long the updates of a game without connections are kept
+	streamIdleTimeout = 30 * time.Minute
+
+	// How often idle streams are dropped
+	streamPruneInterval = time.Minute
+)
+
+// bufferedEvent is a sequenced update kept for replaying to reconnecting clients
+type bufferedEvent struct {
+	update  GameUpdate
+	encoded *encodedUpdate
+	sentAt  time.Time
+}
+
+// eventStream numbers the updates of one game and keeps the most recent ones
+//
+// Sequence numbers are per game, so a client sees gaps for private updates
+// that were not meant for it. The stream ID changes whenever the sequence
+// starts over, such as after a server restart, so clients can tell that
+// their last sequence number no longer applies.
+type eventStream struct {
+	id           string
+	seq          uint64
+	events       []bufferedEvent
+	lastActivity time.Time
+}
+
+func newEventStream() *eventStream {
+	return &eventStream{
+		id:           uuid.NewString(),
+		lastActivity: time.Now(),
+	}
+}
+
+// next returns the sequence number of the next update
+func (s *eventStream) next() uint64 {
+	s.seq++
+	s.lastActivity = time.Now()
+	return s.seq
+}
+
+// add keeps an update, dropping the oldest one once the buffer is full
+func (s *eventStream) add(update GameUpdate, encoded *encodedUpdate) {
+	if len(s.events) == eventBufferSize {
+		copy(s.events, s.events[1:])
+		s.events = s.events[:len(s.events)-1]
+	}
+	s.events = append(s.events, bufferedEvent{update: update, encoded: encoded, sentAt: time.Now()})
+}
+
+// since returns the updates after seq
+// It reports false if some of them are no longer buffered or seq is from the future.
+func (s *eventStream) since(seq uint64) ([]bufferedEvent, bool) {
+	if seq > s.seq {
+		return nil, false
+	}
+	if seq == s.seq {
+		return nil, true
+	}
+	if len(s.events) == 0 || s.events[0].update.Seq > seq+1 {
+		return nil, false
+	}
+
+	start := len(s.events) - int(s.seq-seq)
+	return s.events[start:], true
+}
@@ -0,0 +1,170 @@
+// Package media stores the videos and images of roles uploaded by admins.
+//
+// Files go to a pluggable Store, the local filesystem by default or an
+// S3-compatible bucket. Roles keep a stable URL under the Library's public
+// URL; the API serves local files at that URL and redirects to a signed
+// URL for buckets, so links never expire in the database.
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnsupportedType = errors.New("unsupported media type")
+	ErrTooLarge        = errors.New("file is too large")
+	ErrEmptyFile       = errors.New("file is empty")
+)
+
+// Kind is what an uploaded file is used for
+type Kind string
+
+const (
+	Video Kind = "video"
+	Image Kind = "image"
+)
+
+// Valid reports whether the kind is known
+func (k Kind) Valid() bool {
+	return k == Video || k == Image
+}
+
+// allowedTypes maps the content types accepted for each kind to the file
+// extension they are stored with
+var allowedTypes = map[Kind]map[string]string{
+	Video: {
+		"video/webm": ".webm",
+		"video/mp4":  ".mp4",
+	},
+	Image: {
+		"image/png":  ".png",
+		"image/jpeg": ".jpg",
+		"image/webp": ".webp",
+		"image/gif":  ".gif",
+	},
+}
+
+// validKey matches the keys of stored files, such as "videos/<uuid>.webm"
+var validKey = regexp.MustCompile(`^[a-z0-9-]+/[A-Za-z0-9_-][A-Za-z0-9_.-]*$`)
+
+// Store keeps uploaded files by key
+type Store interface {
+	// Put stores size bytes read from body
+	Put(ctx context.Context, key, contentType string, body io.Reader, size int64) error
+	// Delete removes a file; removing a missing file is not an error
+	Delete(ctx context.Context, key string) error
+	// Serve responds with the file, or redirects to where it can be fetched
+	Serve(w http.ResponseWriter, r *http.Request, key string)
+}
+
+// Limits holds the largest accepted upload of each kind, in bytes
+type Limits struct {
+	MaxVideoSize int64
+	MaxImageSize int64
+}
+
+// DefaultLimits are used for limits left at zero
+var DefaultLimits = Limits{
+	MaxVideoSize: 50 << 20,
+	MaxImageSize: 5 << 20,
+}
+
+// Library validates uploads, puts them in a store and hands out their URLs
+type Library struct {
+	store     Store
+	publicURL string
+	limits    Limits
+}
+
+// NewLibrary creates a library whose files are reachable under publicURL,
+// such as "https://mafia.example.com/api/media"
+func NewLibrary(store Store, publicURL string, limits Limits) *Library {
+	if limits.MaxVideoSize <= 0 {
+		limits.MaxVideoSize = DefaultLimits.MaxVideoSize
+	}
+	if limits.MaxImageSize <= 0 {
+		limits.MaxImageSize = DefaultLimits.MaxImageSize
+	}
+	return &Library{store: store, publicURL: strings.TrimSuffix(publicURL, "/"), limits: limits}
+}
+
+// MaxSize returns the largest accepted upload of a kind
+func (l *Library) MaxSize(kind Kind) int64 {
+	if kind == Image {
+		return l.limits.MaxImageSize
+	}
+	return l.limits.MaxVideoSize
+}
+
+// Upload checks the size and content of a file, stores it under a new key
+// and returns its public URL. The content type is sniffed from the file
+// itself rather than trusted from the client.
+func (l *Library) Upload(ctx context.Context, kind Kind, body io.Reader, size int64) (string, error) {
+	if !kind.Valid() {
+		return "", ErrUnsupportedType
+	}
+	if size <= 0 {
+		return "", ErrEmptyFile
+	}
+	if size > l.MaxSize(kind) {
+		return "", ErrTooLarge
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	ext, ok := allowedTypes[kind][contentType]
+	if !ok {
+		return "", ErrUnsupportedType
+	}
+
+	key := string(kind) + "s/" + uuid.NewString() + ext
+	body = io.LimitReader(io.MultiReader(bytes.NewReader(head), body), size)
+	if err := l.store.Put(ctx, key, contentType, body, size); err != nil {
+		return "", err
+	}
+	return l.publicURL + "/" + key, nil
+}
+
+// Owns reports whether a URL points at a file of the library
+func (l *Library) Owns(url string) bool {
+	_, ok := l.key(url)
+	return ok
+}
+
+// Remove deletes the file behind a URL of the library. URLs pointing
+// elsewhere, such as videos hosted by a CDN, are left alone.
+func (l *Library) Remove(ctx context.Context, url string) error {
+	key, ok := l.key(url)
+	if !ok {
+		return nil
+	}
+	return l.store.Delete(ctx, key)
+}
+
+func (l *Library) key(url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, l.publicURL+"/")
+	return key, ok && validKey.MatchString(key)
+}
+
+// Serve handles GET requests for a key below the public URL
+func (l *Library) Serve(w http.ResponseWriter, r *http.Request, key string) {
+	if !validKey.MatchString(key) {
+		http.NotFound(w, r)
+		return
+	}
+	l.store.Serve(w, r, key)
+}
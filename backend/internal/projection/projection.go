@@ -0,0 +1,258 @@
+// Package projection builds the view of a game that a particular viewer may see.
+//
+// Every REST response and WebSocket payload that describes a game, its players
+// or their roles goes through this package, so visibility rules live in one place.
+package projection
+
+import (
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/role"
+)
+
+// Kind is the kind of viewer looking at a game
+type Kind string
+
+const (
+	// KindPublic is an anonymous viewer, such as someone who only knows the game code
+	KindPublic Kind = "public"
+	// KindPlayer is a player of the game, alive or dead
+	KindPlayer Kind = "player"
+	// KindSpectator is someone watching the game without playing
+	KindSpectator Kind = "spectator"
+	// KindModerator is the game owner or a co-moderator
+	KindModerator Kind = "moderator"
+)
+
+// Viewer identifies who a projection is built for
+type Viewer struct {
+	Kind        Kind
+	PlayerID    uuid.UUID
+	SpectatorID uuid.UUID
+	ModeratorID string
+	// CanViewRoles is set for moderators allowed to see role assignments
+	CanViewRoles bool
+}
+
+// PublicViewer returns an anonymous viewer
+func PublicViewer() Viewer {
+	return Viewer{Kind: KindPublic}
+}
+
+// PlayerViewer returns the viewer for a player of the game
+func PlayerViewer(playerID uuid.UUID) Viewer {
+	return Viewer{Kind: KindPlayer, PlayerID: playerID}
+}
+
+// SpectatorViewer returns the viewer for a spectator of the game
+func SpectatorViewer(spectatorID uuid.UUID) Viewer {
+	return Viewer{Kind: KindSpectator, SpectatorID: spectatorID}
+}
+
+// ModeratorViewer returns the viewer for a moderator of the game
+func ModeratorViewer(moderatorID string, canViewRoles bool) Viewer {
+	return Viewer{Kind: KindModerator, ModeratorID: moderatorID, CanViewRoles: canViewRoles}
+}
+
+// State is everything known about a game at a point in time
+type State struct {
+	Game *ent.Game
+	// Players are sorted by seat
+	Players []*ent.Player
+	// Roles are the role assignments with their role edge loaded
+	Roles []*ent.GameRole
+}
+
+// player returns the player with the given ID
+func (s *State) player(id uuid.UUID) *ent.Player {
+	for _, p := range s.Players {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// assignment returns the role assignment of a player
+func (s *State) assignment(playerID uuid.UUID) *ent.GameRole {
+	for _, gr := range s.Roles {
+		if gr.PlayerID == playerID {
+			return gr
+		}
+	}
+	return nil
+}
+
+// team returns the team of a player's assigned role
+func (s *State) team(playerID uuid.UUID) (role.Team, bool) {
+	gr := s.assignment(playerID)
+	if gr == nil || gr.Edges.Role == nil {
+		return "", false
+	}
+	return gr.Edges.Role.Team, true
+}
+
+// CanSeeRole reports whether a viewer may see the role assigned to a player
+//
+// Once a game is completed every role is revealed. Before that:
+//   - moderators see every role if they hold the view_roles permission
+//   - players see their own role
+//   - mafia players see the roles of their mafia teammates
+//   - dead players see the roles of other dead players
+//   - spectators and anonymous viewers see no roles
+func CanSeeRole(state *State, v Viewer, playerID uuid.UUID) bool {
+	if state.Game != nil && state.Game.Status == game.StatusCompleted {
+		return true
+	}
+
+	switch v.Kind {
+	case KindModerator:
+		return v.CanViewRoles
+	case KindPlayer:
+		if v.PlayerID == playerID {
+			return true
+		}
+
+		self := state.player(v.PlayerID)
+		target := state.player(playerID)
+		if self == nil || target == nil {
+			return false
+		}
+
+		selfTeam, ok := state.team(self.ID)
+		if ok && selfTeam == role.TeamMafia {
+			if targetTeam, ok := state.team(target.ID); ok && targetTeam == role.TeamMafia {
+				return true
+			}
+		}
+
+		return !self.Alive && !target.Alive
+	default:
+		return false
+	}
+}
+
+// Game projects the game itself.
+// The moderator ID lets its holder run the game, so only the owner sees it.
+func Game(g *ent.Game, v Viewer) map[string]any {
+	result := map[string]any{
+		"id":         g.ID,
+		"status":     g.Status,
+		"phase":      g.Phase,
+		"round":      g.Round,
+		"created_at": g.CreatedAt,
+
+		"spectator_mode":          g.SpectatorMode,
+		"spectator_delay_seconds": g.SpectatorDelaySeconds,
+	}
+
+	if v.Kind == KindModerator && v.ModeratorID == g.ModeratorID {
+		result["moderator_id"] = g.ModeratorID
+	}
+
+	return result
+}
+
+// Player projects a single player, including their role if the viewer may see it
+func Player(state *State, p *ent.Player, v Viewer) map[string]any {
+	result := map[string]any{
+		"id":         p.ID,
+		"name":       p.Name,
+		"game_id":    p.GameID,
+		"seat":       p.Seat,
+		"alive":      p.Alive,
+		"ready":      p.Ready,
+		"muted":      p.Muted,
+		"created_at": p.CreatedAt,
+	}
+
+	if CanSeeRole(state, v, p.ID) {
+		if gr := state.assignment(p.ID); gr != nil && gr.Edges.Role != nil {
+			result["role"] = Role(gr)
+		}
+	}
+
+	return result
+}
+
+// Players projects every player of the game in seat order
+func Players(state *State, v Viewer) []map[string]any {
+	players := make([]map[string]any, len(state.Players))
+	for i, p := range state.Players {
+		players[i] = Player(state, p, v)
+	}
+	return players
+}
+
+// Role projects a role assignment with the full role details
+func Role(gr *ent.GameRole) map[string]any {
+	r := gr.Edges.Role
+	return map[string]any{
+		"id":          r.ID,
+		"name":        r.Name,
+		"slug":        r.Slug,
+		"video":       r.Video,
+		"image":       r.Image,
+		"description": r.Description,
+		"team":        r.Team,
+		"abilities":   r.Abilities,
+		"assigned_at": gr.AssignedAt,
+	}
+}
+
+// PlayerRole projects the role of one player
+// It reports false if the player has no role or the viewer may not see it.
+func PlayerRole(state *State, v Viewer, playerID uuid.UUID) (map[string]any, bool) {
+	if !CanSeeRole(state, v, playerID) {
+		return nil, false
+	}
+
+	gr := state.assignment(playerID)
+	if gr == nil || gr.Edges.Role == nil {
+		return nil, false
+	}
+
+	return Role(gr), true
+}
+
+// Assignments projects the role assignments the viewer may see, in seat order
+func Assignments(state *State, v Viewer) []map[string]any {
+	assignments := make([]map[string]any, 0, len(state.Roles))
+	for _, p := range state.Players {
+		gr := state.assignment(p.ID)
+		if gr == nil || gr.Edges.Role == nil || !CanSeeRole(state, v, p.ID) {
+			continue
+		}
+
+		r := gr.Edges.Role
+		assignments = append(assignments, map[string]any{
+			"player_id":   p.ID,
+			"player_name": p.Name,
+			"role_id":     r.ID,
+			"role_name":   r.Name,
+			"role_slug":   r.Slug,
+			"video":       r.Video,
+			"team":        r.Team,
+			"assigned_at": gr.AssignedAt,
+		})
+	}
+	return assignments
+}
+
+// Snapshot projects the complete state of the game for a viewer
+func Snapshot(state *State, v Viewer) map[string]any {
+	snapshot := map[string]any{
+		"game":    Game(state.Game, v),
+		"players": Players(state, v),
+		"viewer":  v.Kind,
+	}
+
+	if v.Kind == KindPlayer {
+		if self := state.player(v.PlayerID); self != nil {
+			snapshot["you"] = Player(state, self, v)
+		}
+	}
+
+	return snapshot
+}
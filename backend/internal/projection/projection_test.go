@@ -0,0 +1,149 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testState builds a running game with two mafia players, a villager and a dead villager
+func testState() (*State, map[string]*ent.Player) {
+	g := &ent.Game{ID: "ABC123", ModeratorID: "mod-123", Status: game.StatusActive, CreatedAt: time.Now()}
+
+	mafiaRole := &ent.Role{ID: uuid.New(), Name: "Mafia", Slug: "mafia", Team: role.TeamMafia}
+	villagerRole := &ent.Role{ID: uuid.New(), Name: "Villager", Slug: "villager", Team: role.TeamVillage}
+
+	players := map[string]*ent.Player{
+		"don":      {ID: uuid.New(), Name: "don", GameID: g.ID, Seat: 0, Alive: true},
+		"mafioso":  {ID: uuid.New(), Name: "mafioso", GameID: g.ID, Seat: 1, Alive: true},
+		"villager": {ID: uuid.New(), Name: "villager", GameID: g.ID, Seat: 2, Alive: true},
+		"ghost":    {ID: uuid.New(), Name: "ghost", GameID: g.ID, Seat: 3, Alive: false},
+	}
+
+	assign := func(p *ent.Player, r *ent.Role) *ent.GameRole {
+		gr := &ent.GameRole{GameID: g.ID, PlayerID: p.ID, RoleID: r.ID}
+		gr.Edges.Role = r
+		return gr
+	}
+
+	state := &State{
+		Game:    g,
+		Players: []*ent.Player{players["don"], players["mafioso"], players["villager"], players["ghost"]},
+		Roles: []*ent.GameRole{
+			assign(players["don"], mafiaRole),
+			assign(players["mafioso"], mafiaRole),
+			assign(players["villager"], villagerRole),
+			assign(players["ghost"], villagerRole),
+		},
+	}
+	return state, players
+}
+
+// visibleRoles returns the names of the players whose role the viewer sees
+func visibleRoles(state *State, v Viewer) []string {
+	var names []string
+	for _, p := range Players(state, v) {
+		if _, ok := p["role"]; ok {
+			names = append(names, p["name"].(string))
+		}
+	}
+	return names
+}
+
+func TestCanSeeRole(t *testing.T) {
+	state, players := testState()
+
+	t.Run("villager sees only their own role", func(t *testing.T) {
+		v := PlayerViewer(players["villager"].ID)
+		assert.Equal(t, []string{"villager"}, visibleRoles(state, v))
+	})
+
+	t.Run("mafia see their teammates", func(t *testing.T) {
+		v := PlayerViewer(players["don"].ID)
+		assert.Equal(t, []string{"don", "mafioso"}, visibleRoles(state, v))
+	})
+
+	t.Run("dead players see other dead players", func(t *testing.T) {
+		state, players := testState()
+		players["villager"].Alive = false
+
+		v := PlayerViewer(players["ghost"].ID)
+		assert.Equal(t, []string{"villager", "ghost"}, visibleRoles(state, v))
+	})
+
+	t.Run("spectators and anonymous viewers see no roles", func(t *testing.T) {
+		assert.Empty(t, visibleRoles(state, SpectatorViewer(uuid.New())))
+		assert.Empty(t, visibleRoles(state, PublicViewer()))
+	})
+
+	t.Run("moderators see roles only with permission", func(t *testing.T) {
+		assert.Len(t, visibleRoles(state, ModeratorViewer("mod-123", true)), 4)
+		assert.Empty(t, visibleRoles(state, ModeratorViewer("co-mod", false)))
+	})
+
+	t.Run("every role is revealed once the game is completed", func(t *testing.T) {
+		state, _ := testState()
+		state.Game.Status = game.StatusCompleted
+
+		assert.Len(t, visibleRoles(state, PublicViewer()), 4)
+	})
+}
+
+func TestPlayerRole(t *testing.T) {
+	state, players := testState()
+
+	r, ok := PlayerRole(state, PlayerViewer(players["don"].ID), players["mafioso"].ID)
+	require.True(t, ok)
+	assert.Equal(t, "mafia", r["slug"])
+
+	_, ok = PlayerRole(state, PlayerViewer(players["villager"].ID), players["don"].ID)
+	assert.False(t, ok)
+}
+
+func TestAssignments(t *testing.T) {
+	state, players := testState()
+
+	assignments := Assignments(state, PlayerViewer(players["mafioso"].ID))
+	require.Len(t, assignments, 2)
+	assert.Equal(t, players["don"].ID, assignments[0]["player_id"])
+	assert.Equal(t, players["mafioso"].ID, assignments[1]["player_id"])
+}
+
+func TestSnapshot(t *testing.T) {
+	state, players := testState()
+
+	snapshot := Snapshot(state, PlayerViewer(players["villager"].ID))
+	assert.Equal(t, KindPlayer, snapshot["viewer"])
+	assert.Len(t, snapshot["players"], 4)
+
+	you, ok := snapshot["you"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "villager", you["name"])
+	assert.Contains(t, you, "role")
+
+	snapshot = Snapshot(state, PublicViewer())
+	assert.NotContains(t, snapshot, "you")
+}
+
+func TestGame(t *testing.T) {
+	state, players := testState()
+
+	viewers := map[string]Viewer{
+		"public":       PublicViewer(),
+		"player":       PlayerViewer(players["don"].ID),
+		"spectator":    SpectatorViewer(uuid.New()),
+		"co-moderator": ModeratorViewer("mod-456", true),
+	}
+	for name, v := range viewers {
+		assert.NotContains(t, Game(state.Game, v), "moderator_id", name)
+		assert.NotContains(t, Snapshot(state, v)["game"], "moderator_id", name)
+	}
+
+	assert.Equal(t, "mod-123", Game(state.Game, ModeratorViewer("mod-123", true))["moderator_id"])
+}
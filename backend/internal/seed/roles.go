@@ -3,6 +3,9 @@ package seed
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
+	"strings"
 
 	"github.com/mafia-night/backend/ent"
 	"github.com/mafia-night/backend/ent/role"
@@ -261,13 +264,43 @@ var Roles = []RoleData{
 	},
 }
 
+// Options changes where seeded roles get their videos
+type Options struct {
+	// VideoBaseURL replaces the host of the predefined videos, so a
+	// self-hosted install can serve copies of them, such as from
+	// http://localhost:8080/api/media/roles. Empty keeps the hosted videos.
+	VideoBaseURL string
+	// MediaURL is where uploaded media is served; videos uploaded by an
+	// admin below it are kept instead of being reset to the predefined ones
+	MediaURL string
+}
+
+// videoURL returns the URL of a predefined video, keeping its file name
+func (o Options) videoURL(video string) string {
+	if o.VideoBaseURL == "" {
+		return video
+	}
+	u, err := url.Parse(video)
+	if err != nil {
+		return video
+	}
+	return strings.TrimSuffix(o.VideoBaseURL, "/") + "/" + path.Base(u.Path)
+}
+
+// uploaded reports whether a video was uploaded through the admin API
+func (o Options) uploaded(video string) bool {
+	return o.MediaURL != "" && strings.HasPrefix(video, strings.TrimSuffix(o.MediaURL, "/")+"/")
+}
+
 // SeedRoles seeds the database with predefined roles
 // Uses upsert logic: creates new roles or updates existing ones based on slug
-func SeedRoles(ctx context.Context, client *ent.Client) error {
+func SeedRoles(ctx context.Context, client *ent.Client, opts Options) error {
 	created := 0
 	updated := 0
 
 	for _, r := range Roles {
+		video := opts.videoURL(r.Video)
+
 		// Check if role exists by slug
 		existingRole, err := client.Role.Query().
 			Where(role.SlugEQ(r.Slug)).
@@ -279,9 +312,12 @@ func SeedRoles(ctx context.Context, client *ent.Client) error {
 
 		if existingRole != nil {
 			// Update existing role
+			if opts.uploaded(existingRole.Video) {
+				video = existingRole.Video
+			}
 			err = client.Role.UpdateOne(existingRole).
 				SetName(r.Name).
-				SetVideo(r.Video).
+				SetVideo(video).
 				SetDescription(r.Description).
 				SetTeam(r.Team).
 				SetAbilities(r.Abilities).
@@ -295,7 +331,7 @@ func SeedRoles(ctx context.Context, client *ent.Client) error {
 			_, err = client.Role.Create().
 				SetName(r.Name).
 				SetSlug(r.Slug).
-				SetVideo(r.Video).
+				SetVideo(video).
 				SetDescription(r.Description).
 				SetTeam(r.Team).
 				SetAbilities(r.Abilities).
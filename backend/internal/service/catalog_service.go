@@ -0,0 +1,769 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+	"github.com/mafia-night/backend/ent/roletranslation"
+	"github.com/mafia-night/backend/internal/catalog"
+)
+
+var ErrCatalogConflict = errors.New("catalog conflicts with data in use")
+
+// CatalogService exports the role catalog to a document and imports it back
+type CatalogService struct {
+	client        *ent.Client
+	defaultLocale string
+}
+
+// NewCatalogService creates a new catalog service
+func NewCatalogService(client *ent.Client) *CatalogService {
+	return &CatalogService{client: client, defaultLocale: DefaultLocale}
+}
+
+// WithDefaultLocale sets the locale of the texts stored on templates and roles themselves
+func (s *CatalogService) WithDefaultLocale(locale string) *CatalogService {
+	s.defaultLocale = locale
+	return s
+}
+
+// ImportOptions changes how a catalog is imported
+type ImportOptions struct {
+	// DryRun reports the changes without keeping them
+	DryRun bool
+	// Prune deletes the roles and templates missing from the document,
+	// archiving the roles games or moderators' templates still use
+	Prune bool
+}
+
+// CatalogAction is what an import does to a role or template
+type CatalogAction string
+
+const (
+	CatalogCreate CatalogAction = "create"
+	CatalogUpdate CatalogAction = "update"
+	CatalogDelete CatalogAction = "delete"
+)
+
+// CatalogChange is a role or template changed by an import
+type CatalogChange struct {
+	// Kind is "role" or "template"
+	Kind string
+	// Key is the slug of a role or the name of a template
+	Key    string
+	Action CatalogAction
+	// Fields lists the fields changed by an update
+	Fields []string
+}
+
+// ImportReport lists what an import changed, or would change on a dry run
+type ImportReport struct {
+	DryRun    bool
+	Changes   []CatalogChange
+	Unchanged int
+}
+
+// Export returns every role, ordered by slug, and every curated template, ordered by player count.
+// Templates owned by moderators stay out of the catalog.
+func (s *CatalogService) Export(ctx context.Context) (*catalog.Document, error) {
+	roles, err := s.client.Role.
+		Query().
+		WithTranslations(func(q *ent.RoleTranslationQuery) {
+			q.Order(ent.Asc(roletranslation.FieldLocale))
+		}).
+		WithRules(func(q *ent.RoleRuleQuery) {
+			q.WithTargetRole().Order(ent.Asc(rolerule.FieldCreatedAt))
+		}).
+		Order(ent.Asc(role.FieldSlug)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := withAssignments(s.client.RoleTemplate.Query()).
+		Where(roletemplate.OwnerIDIsNil()).
+		Order(ent.Asc(roletemplate.FieldPlayerCount), ent.Asc(roletemplate.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &catalog.Document{
+		Version:   catalog.Version,
+		Roles:     make([]catalog.Role, len(roles)),
+		Templates: make([]catalog.Template, len(templates)),
+	}
+	for i, r := range roles {
+		doc.Roles[i] = exportRole(r)
+	}
+	for i, t := range templates {
+		doc.Templates[i] = exportTemplate(t)
+	}
+	return doc, nil
+}
+
+func exportRole(r *ent.Role) catalog.Role {
+	out := catalog.Role{
+		Slug:        r.Slug,
+		Name:        r.Name,
+		Team:        string(r.Team),
+		Description: r.Description,
+		Video:       r.Video,
+		Image:       r.Image,
+		Abilities:   r.Abilities,
+		Archived:    r.ArchivedAt != nil,
+	}
+	if len(r.Edges.Translations) > 0 {
+		out.Translations = make(map[string]catalog.RoleTranslation, len(r.Edges.Translations))
+		for _, tr := range r.Edges.Translations {
+			out.Translations[tr.Locale] = catalog.RoleTranslation{
+				Name:        tr.Name,
+				Description: tr.Description,
+				Abilities:   tr.Abilities,
+			}
+		}
+	}
+	for _, rule := range r.Edges.Rules {
+		out.Rules = append(out.Rules, exportRule(rule))
+	}
+	return out
+}
+
+// exportRule converts a rule loaded with its target role
+func exportRule(r *ent.RoleRule) catalog.Rule {
+	out := catalog.Rule{Kind: string(r.Kind)}
+	if r.Edges.TargetRole != nil {
+		out.Role = r.Edges.TargetRole.Slug
+	}
+	if r.TargetTeam != nil {
+		out.Team = string(*r.TargetTeam)
+	}
+	if r.MaxCount != nil {
+		out.MaxCount = *r.MaxCount
+	}
+	return out
+}
+
+// exportTemplate converts a template loaded with its roles and slots. Core
+// roles are sorted by slug; runs of slots with the same role are merged.
+func exportTemplate(t *ent.RoleTemplate) catalog.Template {
+	out := catalog.Template{
+		Name:        t.Name,
+		PlayerCount: t.PlayerCount,
+		Description: t.Description,
+	}
+	for _, tr := range t.Edges.TemplateRoles {
+		out.Roles = append(out.Roles, catalog.TemplateRole{Role: tr.Edges.Role.Slug, Count: tr.Count})
+	}
+	sort.Slice(out.Roles, func(i, j int) bool { return out.Roles[i].Role < out.Roles[j].Role })
+
+	core := len(out.Roles)
+	for _, slot := range t.Edges.OptionalSlots {
+		slug := slot.Edges.Role.Slug
+		if last := len(out.Roles) - 1; last >= core && out.Roles[last].Role == slug {
+			out.Roles[last].Count++
+			continue
+		}
+		out.Roles = append(out.Roles, catalog.TemplateRole{Role: slug, Count: 1, Optional: true})
+	}
+
+	if len(t.Edges.Translations) > 0 {
+		out.Translations = make(map[string]string, len(t.Edges.Translations))
+		for _, tr := range t.Edges.Translations {
+			out.Translations[tr.Locale] = tr.Description
+		}
+	}
+	return out
+}
+
+// Import brings the catalog in line with a document: roles are matched by
+// slug and templates by name, then created or updated, and with Prune the
+// curated ones missing from the document are deleted, or archived for roles
+// still in use. Everything happens in one transaction, which a dry run rolls
+// back after reporting the changes.
+func (s *CatalogService) Import(ctx context.Context, doc *catalog.Document, opts ImportOptions) (*ImportReport, error) {
+	if err := doc.Validate(opts.Prune); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	im := &catalogImport{
+		client:        tx.Client(),
+		defaultLocale: s.defaultLocale,
+		report:        &ImportReport{DryRun: opts.DryRun},
+		roleIDs:       make(map[string]uuid.UUID),
+	}
+	if err := im.run(ctx, doc, opts.Prune); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if opts.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return im.report, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return im.report, nil
+}
+
+// catalogImport applies a document within a transaction
+type catalogImport struct {
+	client        *ent.Client
+	defaultLocale string
+	report        *ImportReport
+	// roleIDs maps the slug of every role, imported or not, to its ID
+	roleIDs map[string]uuid.UUID
+}
+
+// invalidCatalog reports a document value the catalog cannot hold
+func invalidCatalog(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", catalog.ErrInvalidDocument, fmt.Sprintf(format, args...))
+}
+
+// record adds a change to the report, or counts an unchanged entry
+func (im *catalogImport) record(kind, key string, created bool, fields []string) {
+	switch {
+	case created:
+		im.report.Changes = append(im.report.Changes, CatalogChange{Kind: kind, Key: key, Action: CatalogCreate})
+	case len(fields) > 0:
+		im.report.Changes = append(im.report.Changes, CatalogChange{Kind: kind, Key: key, Action: CatalogUpdate, Fields: fields})
+	default:
+		im.report.Unchanged++
+	}
+}
+
+func (im *catalogImport) run(ctx context.Context, doc *catalog.Document, prune bool) error {
+	existingRoles, err := im.client.Role.
+		Query().
+		WithTranslations().
+		WithRules(func(q *ent.RoleRuleQuery) { q.WithTargetRole() }).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	rolesBySlug := make(map[string]*ent.Role, len(existingRoles))
+	for _, r := range existingRoles {
+		rolesBySlug[r.Slug] = r
+		im.roleIDs[r.Slug] = r.ID
+	}
+
+	// Roles go first so that rules and templates can name any of them
+	roleFields := make([][]string, len(doc.Roles))
+	for i, r := range doc.Roles {
+		roleFields[i], err = im.importRole(ctx, r, rolesBySlug[r.Slug])
+		if err != nil {
+			return err
+		}
+	}
+	for i, r := range doc.Roles {
+		changed, err := im.importRules(ctx, r, rolesBySlug[r.Slug])
+		if err != nil {
+			return err
+		}
+		if changed {
+			roleFields[i] = append(roleFields[i], "rules")
+		}
+		im.record("role", r.Slug, rolesBySlug[r.Slug] == nil, roleFields[i])
+	}
+
+	// Catalog templates are curated; moderators may own templates of the same names
+	existingTemplates, err := withAssignments(im.client.RoleTemplate.Query()).
+		Where(roletemplate.OwnerIDIsNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	templatesByName := make(map[string]*ent.RoleTemplate, len(existingTemplates))
+	for _, t := range existingTemplates {
+		templatesByName[t.Name] = t
+	}
+
+	for _, t := range doc.Templates {
+		fields, err := im.importTemplate(ctx, t, templatesByName[t.Name])
+		if err != nil {
+			return err
+		}
+		im.record("template", t.Name, templatesByName[t.Name] == nil, fields)
+	}
+
+	if prune {
+		return im.prune(ctx, doc, existingRoles, existingTemplates)
+	}
+	return nil
+}
+
+// importRole creates or updates a role and its translations, returning the
+// fields changed on an existing role
+func (im *catalogImport) importRole(ctx context.Context, r catalog.Role, existing *ent.Role) ([]string, error) {
+	team := role.Team(r.Team)
+	if err := role.TeamValidator(team); err != nil {
+		return nil, invalidCatalog("role %q: %v", r.Slug, ErrInvalidTeam)
+	}
+	abilities := r.Abilities
+	if abilities == nil {
+		abilities = []string{}
+	}
+
+	var fields []string
+	if existing == nil {
+		create := im.client.Role.
+			Create().
+			SetName(r.Name).
+			SetSlug(r.Slug).
+			SetVideo(r.Video).
+			SetTeam(team).
+			SetDescription(r.Description).
+			SetAbilities(abilities)
+		if r.Image != "" {
+			create.SetImage(r.Image)
+		}
+		if r.Archived {
+			create.SetArchivedAt(time.Now())
+		}
+		created, err := create.Save(ctx)
+		if err != nil {
+			return nil, roleError(r.Slug, err)
+		}
+		im.roleIDs[r.Slug] = created.ID
+	} else {
+		update := existing.Update()
+		if existing.Name != r.Name {
+			update.SetName(r.Name)
+			fields = append(fields, "name")
+		}
+		if existing.Team != team {
+			update.SetTeam(team)
+			fields = append(fields, "team")
+		}
+		if existing.Description != r.Description {
+			update.SetDescription(r.Description)
+			fields = append(fields, "description")
+		}
+		if existing.Video != r.Video {
+			update.SetVideo(r.Video)
+			fields = append(fields, "video")
+		}
+		if existing.Image != r.Image {
+			update.SetImage(r.Image)
+			fields = append(fields, "image")
+		}
+		if !slices.Equal(existing.Abilities, abilities) {
+			update.SetAbilities(abilities)
+			fields = append(fields, "abilities")
+		}
+		if (existing.ArchivedAt != nil) != r.Archived {
+			if r.Archived {
+				update.SetArchivedAt(time.Now())
+			} else {
+				update.ClearArchivedAt()
+			}
+			fields = append(fields, "archived")
+		}
+		if len(fields) > 0 {
+			if err := update.Exec(ctx); err != nil {
+				return nil, roleError(r.Slug, err)
+			}
+		}
+	}
+
+	changed, err := im.importRoleTranslations(ctx, r, existing)
+	if err != nil {
+		return nil, err
+	}
+	if changed && existing != nil {
+		fields = append(fields, "translations")
+	}
+	return fields, nil
+}
+
+// roleError explains why a role could not be saved
+func roleError(slug string, err error) error {
+	if ent.IsConstraintError(err) {
+		return invalidCatalog("role %q: %v", slug, ErrRoleNameExists)
+	}
+	if ent.IsValidationError(err) {
+		return invalidCatalog("role %q: %v", slug, err)
+	}
+	return err
+}
+
+// importRoleTranslations replaces the translations of a role when they differ
+func (im *catalogImport) importRoleTranslations(ctx context.Context, r catalog.Role, existing *ent.Role) (bool, error) {
+	want := make(map[string]catalog.RoleTranslation, len(r.Translations))
+	for locale, tr := range r.Translations {
+		normalized, err := NormalizeLocale(locale)
+		if err != nil {
+			return false, invalidCatalog("role %q: %v", r.Slug, err)
+		}
+		if normalized == im.defaultLocale {
+			return false, invalidCatalog("role %q: %v", r.Slug, ErrDefaultLocaleTranslation)
+		}
+		if tr.Name == "" {
+			return false, invalidCatalog("role %q: %s: %v", r.Slug, normalized, ErrEmptyTranslationName)
+		}
+		if tr.Abilities == nil {
+			tr.Abilities = []string{}
+		}
+		want[normalized] = tr
+	}
+
+	have := make(map[string]catalog.RoleTranslation)
+	if existing != nil {
+		for _, tr := range existing.Edges.Translations {
+			abilities := tr.Abilities
+			if abilities == nil {
+				abilities = []string{}
+			}
+			have[tr.Locale] = catalog.RoleTranslation{Name: tr.Name, Description: tr.Description, Abilities: abilities}
+		}
+	}
+	same := maps.EqualFunc(want, have, func(a, b catalog.RoleTranslation) bool {
+		return a.Name == b.Name && a.Description == b.Description && slices.Equal(a.Abilities, b.Abilities)
+	})
+	if same {
+		return false, nil
+	}
+
+	roleID := im.roleIDs[r.Slug]
+	if _, err := im.client.RoleTranslation.Delete().Where(roletranslation.RoleIDEQ(roleID)).Exec(ctx); err != nil {
+		return false, err
+	}
+	for _, locale := range slices.Sorted(maps.Keys(want)) {
+		tr := want[locale]
+		err := im.client.RoleTranslation.
+			Create().
+			SetRoleID(roleID).
+			SetLocale(locale).
+			SetName(tr.Name).
+			SetDescription(tr.Description).
+			SetAbilities(tr.Abilities).
+			Exec(ctx)
+		if err != nil {
+			return false, roleError(r.Slug, err)
+		}
+	}
+	return true, nil
+}
+
+// ruleKey identifies a rule by what it says
+func ruleKey(r catalog.Rule) string {
+	return fmt.Sprintf("%s|%s|%s|%d", r.Kind, r.Role, r.Team, r.MaxCount)
+}
+
+// importRules replaces the rules of a role when they differ
+func (im *catalogImport) importRules(ctx context.Context, r catalog.Role, existing *ent.Role) (bool, error) {
+	roleID := im.roleIDs[r.Slug]
+
+	inputs := make([]RoleRuleInput, len(r.Rules))
+	want := make([]string, len(r.Rules))
+	for i, rule := range r.Rules {
+		in := RoleRuleInput{Kind: rolerule.Kind(rule.Kind)}
+		if rule.Role != "" {
+			targetID, ok := im.roleIDs[rule.Role]
+			if !ok {
+				return false, invalidCatalog("role %q: rule names unknown role %q", r.Slug, rule.Role)
+			}
+			in.TargetRoleID = &targetID
+		}
+		if rule.Team != "" {
+			team := rolerule.TargetTeam(rule.Team)
+			in.TargetTeam = &team
+		}
+		if rule.MaxCount != 0 {
+			maxCount := rule.MaxCount
+			in.MaxCount = &maxCount
+		}
+		if err := in.validate(roleID); err != nil {
+			return false, invalidCatalog("role %q: %v", r.Slug, err)
+		}
+		inputs[i] = in
+		want[i] = ruleKey(rule)
+	}
+
+	var have []string
+	if existing != nil {
+		for _, rule := range existing.Edges.Rules {
+			have = append(have, ruleKey(exportRule(rule)))
+		}
+	}
+	slices.Sort(want)
+	slices.Sort(have)
+	if len(slices.Compact(slices.Clone(want))) != len(want) {
+		return false, invalidCatalog("role %q: %v", r.Slug, ErrRoleRuleExists)
+	}
+	if slices.Equal(want, have) {
+		return false, nil
+	}
+
+	if _, err := im.client.RoleRule.Delete().Where(rolerule.RoleIDEQ(roleID)).Exec(ctx); err != nil {
+		return false, err
+	}
+	for _, in := range inputs {
+		err := im.client.RoleRule.
+			Create().
+			SetRoleID(roleID).
+			SetKind(in.Kind).
+			SetNillableTargetRoleID(in.TargetRoleID).
+			SetNillableTargetTeam(in.TargetTeam).
+			SetNillableMaxCount(in.MaxCount).
+			Exec(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// templateShape is the composition of a template, naming roles by slug
+type templateShape struct {
+	core  map[string]int
+	slots []string
+}
+
+func (a templateShape) equal(b templateShape) bool {
+	return maps.Equal(a.core, b.core) && slices.Equal(a.slots, b.slots)
+}
+
+// importTemplate validates a template against the imported roles and rules,
+// then creates or updates it, returning the fields changed on an existing one
+func (im *catalogImport) importTemplate(ctx context.Context, t catalog.Template, existing *ent.RoleTemplate) ([]string, error) {
+	if t.PlayerCount <= 0 {
+		return nil, invalidCatalog("template %q: %v", t.Name, ErrInvalidPlayerCount)
+	}
+
+	assignments := make([]RoleAssignment, len(t.Roles))
+	want := templateShape{core: make(map[string]int)}
+	for i, tr := range t.Roles {
+		roleID, ok := im.roleIDs[tr.Role]
+		if !ok {
+			return nil, invalidCatalog("template %q: unknown role %q", t.Name, tr.Role)
+		}
+		assignments[i] = RoleAssignment{RoleID: roleID, Count: tr.Count, Optional: tr.Optional}
+		if tr.Optional {
+			for range tr.Count {
+				want.slots = append(want.slots, tr.Role)
+			}
+		} else {
+			want.core[tr.Role] += tr.Count
+		}
+	}
+
+	templates := &RoleTemplateService{client: im.client}
+	maxPlayerCount, err := templates.validateAssignments(ctx, t.PlayerCount, assignments)
+	if err != nil {
+		return nil, invalidCatalog("template %q: %v", t.Name, err)
+	}
+
+	var (
+		templateID uuid.UUID
+		fields     []string
+		replace    = true
+		revised    = true
+	)
+	if existing == nil {
+		created, err := im.client.RoleTemplate.
+			Create().
+			SetName(t.Name).
+			SetPlayerCount(t.PlayerCount).
+			SetMaxPlayerCount(maxPlayerCount).
+			SetDescription(t.Description).
+			Save(ctx)
+		if err != nil {
+			return nil, templateError(t.Name, err)
+		}
+		templateID = created.ID
+	} else {
+		templateID = existing.ID
+		have := templateShape{core: make(map[string]int)}
+		for _, tr := range existing.Edges.TemplateRoles {
+			have.core[tr.Edges.Role.Slug] += tr.Count
+		}
+		for _, slot := range existing.Edges.OptionalSlots {
+			have.slots = append(have.slots, slot.Edges.Role.Slug)
+		}
+
+		update := existing.Update()
+		if existing.PlayerCount != t.PlayerCount {
+			update.SetPlayerCount(t.PlayerCount)
+			fields = append(fields, "player_count")
+		}
+		if existing.Description != t.Description {
+			update.SetDescription(t.Description)
+			fields = append(fields, "description")
+		}
+		replace = !want.equal(have)
+		if replace {
+			fields = append(fields, "roles")
+		}
+		revised = len(fields) > 0
+		if len(fields) > 0 || existing.MaxPlayerCount != maxPlayerCount {
+			if err := update.SetMaxPlayerCount(maxPlayerCount).Exec(ctx); err != nil {
+				return nil, templateError(t.Name, err)
+			}
+		}
+	}
+
+	if replace {
+		if _, err := im.client.RoleTemplateRole.Delete().Where(roletemplaterole.RoleTemplateIDEQ(templateID)).Exec(ctx); err != nil {
+			return nil, err
+		}
+		if _, err := im.client.RoleTemplateSlot.Delete().Where(roletemplateslot.RoleTemplateIDEQ(templateID)).Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err := createAssignments(ctx, im.client, templateID, assignments); err != nil {
+			return nil, err
+		}
+	}
+	if revised {
+		change := revisionChange{Action: roletemplaterevision.ActionImport}
+		if err := recordRevision(ctx, im.client, templateID, existing, change); err != nil {
+			return nil, err
+		}
+	}
+
+	changed, err := im.importTemplateTranslations(ctx, t, templateID, existing)
+	if err != nil {
+		return nil, err
+	}
+	if changed && existing != nil {
+		fields = append(fields, "translations")
+	}
+	return fields, nil
+}
+
+// templateError explains why a template could not be saved
+func templateError(name string, err error) error {
+	if ent.IsConstraintError(err) {
+		return invalidCatalog("template %q: %v", name, ErrTemplateNameExists)
+	}
+	if ent.IsValidationError(err) {
+		return invalidCatalog("template %q: %v", name, err)
+	}
+	return err
+}
+
+// importTemplateTranslations replaces the translations of a template when they differ
+func (im *catalogImport) importTemplateTranslations(ctx context.Context, t catalog.Template, templateID uuid.UUID, existing *ent.RoleTemplate) (bool, error) {
+	want := make(map[string]string, len(t.Translations))
+	for locale, description := range t.Translations {
+		normalized, err := NormalizeLocale(locale)
+		if err != nil {
+			return false, invalidCatalog("template %q: %v", t.Name, err)
+		}
+		if normalized == im.defaultLocale {
+			return false, invalidCatalog("template %q: %v", t.Name, ErrDefaultLocaleTranslation)
+		}
+		if description == "" {
+			return false, invalidCatalog("template %q: %s: %v", t.Name, normalized, ErrEmptyTranslatedDescription)
+		}
+		want[normalized] = description
+	}
+
+	have := make(map[string]string)
+	if existing != nil {
+		for _, tr := range existing.Edges.Translations {
+			have[tr.Locale] = tr.Description
+		}
+	}
+	if maps.Equal(want, have) {
+		return false, nil
+	}
+
+	if _, err := im.client.RoleTemplateTranslation.Delete().Where(roletemplatetranslation.RoleTemplateIDEQ(templateID)).Exec(ctx); err != nil {
+		return false, err
+	}
+	for _, locale := range slices.Sorted(maps.Keys(want)) {
+		err := im.client.RoleTemplateTranslation.
+			Create().
+			SetRoleTemplateID(templateID).
+			SetLocale(locale).
+			SetDescription(want[locale]).
+			Exec(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// prune deletes the templates and then the roles missing from the document.
+// Roles still dealt in games or by templates outside the catalog are archived
+// instead, so they keep their history and the templates keep working.
+func (im *catalogImport) prune(ctx context.Context, doc *catalog.Document, roles []*ent.Role, templates []*ent.RoleTemplate) error {
+	keepTemplates := make(map[string]bool, len(doc.Templates))
+	for _, t := range doc.Templates {
+		keepTemplates[t.Name] = true
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	for _, t := range templates {
+		if keepTemplates[t.Name] {
+			continue
+		}
+		if _, err := im.client.RoleTemplateRole.Delete().Where(roletemplaterole.RoleTemplateIDEQ(t.ID)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := im.client.RoleTemplateSlot.Delete().Where(roletemplateslot.RoleTemplateIDEQ(t.ID)).Exec(ctx); err != nil {
+			return err
+		}
+		if err := im.client.RoleTemplate.DeleteOneID(t.ID).Exec(ctx); err != nil {
+			return err
+		}
+		im.report.Changes = append(im.report.Changes, CatalogChange{Kind: "template", Key: t.Name, Action: CatalogDelete})
+	}
+
+	keepRoles := make(map[string]bool, len(doc.Roles))
+	for _, r := range doc.Roles {
+		keepRoles[r.Slug] = true
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Slug < roles[j].Slug })
+	roleService := &RoleService{client: im.client}
+	for _, r := range roles {
+		if keepRoles[r.Slug] {
+			continue
+		}
+		usage, err := roleService.GetRoleUsage(ctx, r.ID)
+		if err != nil {
+			return err
+		}
+		if usage.InUse() {
+			if r.ArchivedAt != nil {
+				continue
+			}
+			if err := im.client.Role.UpdateOneID(r.ID).SetArchivedAt(time.Now()).Exec(ctx); err != nil {
+				return err
+			}
+			im.report.Changes = append(im.report.Changes, CatalogChange{Kind: "role", Key: r.Slug, Action: CatalogUpdate, Fields: []string{"archived"}})
+			continue
+		}
+		if err := im.client.Role.DeleteOneID(r.ID).Exec(ctx); err != nil {
+			if ent.IsConstraintError(err) {
+				return fmt.Errorf("%w: role %q is still in use", ErrCatalogConflict, r.Slug)
+			}
+			return err
+		}
+		im.report.Changes = append(im.report.Changes, CatalogChange{Kind: "role", Key: r.Slug, Action: CatalogDelete})
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+	"github.com/mafia-night/backend/internal/catalog"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogService_ExportImport(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := NewRoleService(client)
+	templateService := NewRoleTemplateService(client)
+	catalogService := NewCatalogService(client)
+	gameService := NewGameService(client)
+	ctx := context.Background()
+
+	mafia, err := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "Kills at night", role.TeamMafia, []string{"Kill"})
+	require.NoError(t, err)
+	villager, err := roleService.CreateRole(ctx, "Villager", "villager", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	_, err = roleService.SetRoleTranslation(ctx, mafia.ID, "fa", "مافیا", "", nil)
+	require.NoError(t, err)
+	three := 3
+	_, err = roleService.AddRoleRule(ctx, mafia.ID, RoleRuleInput{Kind: rolerule.KindMaxCount, MaxCount: &three})
+	require.NoError(t, err)
+	_, err = templateService.CreateRoleTemplate(ctx, "Classic", 3, "", []RoleAssignment{
+		{RoleID: mafia.ID, Count: 1},
+		{RoleID: villager.ID, Count: 2},
+		{RoleID: villager.ID, Count: 2, Optional: true},
+	})
+	require.NoError(t, err)
+
+	t.Run("exports roles and templates by slug and name", func(t *testing.T) {
+		doc, err := catalogService.Export(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, catalog.Version, doc.Version)
+		require.Len(t, doc.Roles, 2)
+		assert.Equal(t, "mafia", doc.Roles[0].Slug)
+		assert.Equal(t, "مافیا", doc.Roles[0].Translations["fa"].Name)
+		assert.Equal(t, []catalog.Rule{{Kind: "max_count", MaxCount: 3}}, doc.Roles[0].Rules)
+
+		require.Len(t, doc.Templates, 1)
+		assert.Equal(t, []catalog.TemplateRole{
+			{Role: "mafia", Count: 1},
+			{Role: "villager", Count: 2},
+			{Role: "villager", Count: 2, Optional: true},
+		}, doc.Templates[0].Roles)
+	})
+
+	t.Run("importing an export changes nothing", func(t *testing.T) {
+		doc, err := catalogService.Export(ctx)
+		require.NoError(t, err)
+
+		report, err := catalogService.Import(ctx, doc, ImportOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, report.Changes)
+		assert.Equal(t, 3, report.Unchanged)
+	})
+
+	t.Run("moderators' templates do not clash with catalog names", func(t *testing.T) {
+		owned, err := templateService.CreateModeratorTemplate(ctx, "alice", "Classic", 3, "", []RoleAssignment{
+			{RoleID: mafia.ID, Count: 1},
+			{RoleID: villager.ID, Count: 2},
+		})
+		require.NoError(t, err)
+		defer templateService.DeleteModeratorTemplate(ctx, "alice", owned.ID)
+
+		doc, err := catalogService.Export(ctx)
+		require.NoError(t, err)
+		doc.Templates[0].Description = "The usual"
+
+		report, err := catalogService.Import(ctx, doc, ImportOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []CatalogChange{
+			{Kind: "template", Key: "Classic", Action: CatalogUpdate, Fields: []string{"description"}},
+		}, report.Changes)
+
+		unchanged, err := templateService.GetRoleTemplateByID(ctx, owned.ID)
+		require.NoError(t, err)
+		assert.Empty(t, unchanged.Description)
+	})
+
+	t.Run("dry run reports the diff without writing", func(t *testing.T) {
+		doc, err := catalogService.Export(ctx)
+		require.NoError(t, err)
+		doc.Roles[1].Description = "Finds the mafia by day"
+		doc.Roles = append(doc.Roles, catalog.Role{
+			Slug: "doctor", Name: "Doctor", Team: "village",
+			Rules: []catalog.Rule{{Kind: "requires", Role: "mafia"}},
+		})
+		doc.Templates[0].Roles = append(doc.Templates[0].Roles, catalog.TemplateRole{Role: "doctor", Count: 1, Optional: true})
+		doc.Templates[0].Translations = map[string]string{"fa": "کلاسیک"}
+
+		report, err := catalogService.Import(ctx, doc, ImportOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []CatalogChange{
+			{Kind: "role", Key: "villager", Action: CatalogUpdate, Fields: []string{"description"}},
+			{Kind: "role", Key: "doctor", Action: CatalogCreate},
+			{Kind: "template", Key: "Classic", Action: CatalogUpdate, Fields: []string{"roles", "translations"}},
+		}, report.Changes)
+
+		_, err = roleService.GetRoleBySlug(ctx, "doctor")
+		assert.True(t, ent.IsNotFound(err), "a dry run writes nothing")
+
+		report, err = catalogService.Import(ctx, doc, ImportOptions{})
+		require.NoError(t, err)
+		assert.Len(t, report.Changes, 3)
+
+		doctor, err := roleService.GetRoleBySlug(ctx, "doctor")
+		require.NoError(t, err)
+		rules, err := roleService.GetRoleRules(ctx, doctor.ID)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, mafia.ID, *rules[0].TargetRoleID)
+
+		templates, err := templateService.GetAllRoleTemplates(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 6, templates[0].MaxPlayerCount)
+	})
+
+	t.Run("rejects templates breaking the imported rules", func(t *testing.T) {
+		doc, err := catalogService.Export(ctx)
+		require.NoError(t, err)
+		doc.Roles[1].Rules = []catalog.Rule{{Kind: "max_count", MaxCount: 1}}
+		doc.Templates[0].Roles[0] = catalog.TemplateRole{Role: "mafia", Count: 2}
+		doc.Templates[0].PlayerCount = 4
+
+		_, err = catalogService.Import(ctx, doc, ImportOptions{})
+		assert.ErrorIs(t, err, catalog.ErrInvalidDocument)
+		assert.ErrorContains(t, err, `template "Classic"`)
+
+		unchanged, err := roleService.GetRoleRules(ctx, mafia.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, *unchanged[0].MaxCount, "a failed import changes nothing")
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		doc := &catalog.Document{Version: catalog.Version, Roles: []catalog.Role{{Slug: "ghost", Name: "Ghost", Team: "spirits"}}}
+		_, err := catalogService.Import(ctx, doc, ImportOptions{})
+		assert.ErrorIs(t, err, catalog.ErrInvalidDocument)
+
+		doc.Roles[0].Team = "village"
+		doc.Roles[0].Translations = map[string]catalog.RoleTranslation{"en": {Name: "Ghost"}}
+		_, err = catalogService.Import(ctx, doc, ImportOptions{})
+		assert.ErrorIs(t, err, catalog.ErrInvalidDocument)
+	})
+
+	t.Run("prune deletes what the document leaves out", func(t *testing.T) {
+		doc := &catalog.Document{
+			Version: catalog.Version,
+			Roles:   []catalog.Role{{Slug: "mafia", Name: "Mafia", Team: "mafia", Description: "Kills at night", Video: "video", Abilities: []string{"Kill"}}},
+		}
+
+		report, err := catalogService.Import(ctx, doc, ImportOptions{Prune: true, DryRun: true})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []CatalogChange{
+			{Kind: "role", Key: "mafia", Action: CatalogUpdate, Fields: []string{"translations", "rules"}},
+			{Kind: "template", Key: "Classic", Action: CatalogDelete},
+			{Kind: "role", Key: "doctor", Action: CatalogDelete},
+			{Kind: "role", Key: "villager", Action: CatalogDelete},
+		}, report.Changes)
+
+		// Roles dealt in a game or by a moderator's template are archived instead
+		game, err := gameService.CreateGame(ctx, "moderator")
+		require.NoError(t, err)
+		for _, name := range []string{"A", "B"} {
+			_, err := gameService.JoinGame(ctx, game.ID, name)
+			require.NoError(t, err)
+		}
+		err = gameService.DistributeRoles(ctx, game.ID, "moderator", []RoleSelection{{RoleID: villager.ID.String(), Count: 2}})
+		require.NoError(t, err)
+		doctor, err := roleService.GetRoleBySlug(ctx, "doctor")
+		require.NoError(t, err)
+		owned, err := templateService.CreateModeratorTemplate(ctx, "alice", "Doctors", 2, "", []RoleAssignment{
+			{RoleID: mafia.ID, Count: 1},
+			{RoleID: doctor.ID, Count: 1},
+		})
+		require.NoError(t, err)
+
+		report, err = catalogService.Import(ctx, doc, ImportOptions{Prune: true})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []CatalogChange{
+			{Kind: "role", Key: "mafia", Action: CatalogUpdate, Fields: []string{"translations", "rules"}},
+			{Kind: "template", Key: "Classic", Action: CatalogDelete},
+			{Kind: "role", Key: "doctor", Action: CatalogUpdate, Fields: []string{"archived"}},
+			{Kind: "role", Key: "villager", Action: CatalogUpdate, Fields: []string{"archived"}},
+		}, report.Changes)
+
+		for _, id := range []uuid.UUID{doctor.ID, villager.ID} {
+			archived, err := roleService.GetRoleByID(ctx, id)
+			require.NoError(t, err)
+			assert.NotNil(t, archived.ArchivedAt)
+		}
+		_, err = templateService.GetRoleTemplateByID(ctx, owned.ID)
+		assert.NoError(t, err, "moderators' templates are not pruned")
+
+		report, err = catalogService.Import(ctx, doc, ImportOptions{Prune: true})
+		require.NoError(t, err)
+		assert.Empty(t, report.Changes, "archived roles stay archived")
+	})
+}
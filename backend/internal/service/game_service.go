@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"math/rand"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +11,9 @@ import (
 	"github.com/mafia-night/backend/ent/game"
 	"github.com/mafia-night/backend/ent/gamerole"
 	"github.com/mafia-night/backend/ent/player"
+	"github.com/mafia-night/backend/ent/spectator"
+	"github.com/mafia-night/backend/internal/events"
+	"github.com/mafia-night/backend/internal/projection"
 	"github.com/mafia-night/backend/pkg/gameid"
 )
 
@@ -22,19 +24,41 @@ var (
 	ErrEmptyUserID      = errors.New("user ID cannot be empty")
 	ErrEmptyPlayerID    = errors.New("player ID cannot be empty")
 	ErrPlayerNameExists = errors.New("player name already exists in this game")
+	ErrTableBusy        = errors.New("too many players are joining at once, try again")
 	ErrGameAlreadyStarted = errors.New("game has already started")
 	ErrInvalidRoleCount = errors.New("role count must match player count")
 	ErrRolesAlreadyAssigned = errors.New("roles have already been assigned")
+	ErrPlayerNotInGame      = errors.New("player does not belong to this game")
+	ErrInvalidSeatOrder     = errors.New("seat order must list every player in the game exactly once")
+	ErrSpectatorsDisabled   = errors.New("spectators are disabled for this game")
+	ErrSpectatorNotFound    = errors.New("spectator not found")
+	ErrEmptySpectatorID     = errors.New("spectator ID cannot be empty")
+	ErrInvalidSpectatorMode = errors.New("invalid spectator mode")
+	ErrInvalidSpectatorDelay = errors.New("spectator delay must be between 0 and 600 seconds")
 )
 
+// MaxSpectatorDelay is the longest delay a moderator can put on the spectator view
+const MaxSpectatorDelay = 10 * time.Minute
+
 // GameService handles game-related business logic
 type GameService struct {
-	client *ent.Client
+	client     *ent.Client
+	moderators *ModeratorService
+	events     *events.Bus
 }
 
 // NewGameService creates a new game service
 func NewGameService(client *ent.Client) *GameService {
-	return &GameService{client: client}
+	return &GameService{
+		client:     client,
+		moderators: NewModeratorService(client),
+	}
+}
+
+// WithEvents makes the service publish an event on bus after each change
+func (s *GameService) WithEvents(bus *events.Bus) *GameService {
+	s.events = bus
+	return s
 }
 
 // CreateGame creates a new game with a generated ID
@@ -56,6 +80,7 @@ func (s *GameService) CreateGame(ctx context.Context, moderatorID string) (*ent.
 		return nil, err
 	}
 
+	s.events.Publish(events.GameCreated{Game: game})
 	return game, nil
 }
 
@@ -89,25 +114,45 @@ func (s *GameService) UpdateGameStatus(ctx context.Context, gameID string, statu
 		return nil, err
 	}
 
-	// Check if moderator matches
-	if existingGame.ModeratorID != moderatorID {
-		return nil, ErrNotAuthorized
+	// Check moderator permissions
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermManageGame); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// Update the status
-	updated, err := existingGame.Update().
+	updated, err := tx.Game.
+		UpdateOneID(existingGame.ID).
 		SetStatus(status).
 		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, ActionUpdateStatus, map[string]any{
+		"from": existingGame.Status,
+		"to":   status,
+	})
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.GameStatusChanged{Game: updated})
 	return updated, nil
 }
 
 // DeleteGame deletes a game
-// Only the moderator who created the game can delete it
+// Only the moderator who owns the game can delete it
 func (s *GameService) DeleteGame(ctx context.Context, gameID string, moderatorID string) error {
 	if gameID == "" {
 		return ErrEmptyGameID
@@ -122,17 +167,35 @@ func (s *GameService) DeleteGame(ctx context.Context, gameID string, moderatorID
 		return err
 	}
 
-	// Check if moderator matches
+	// Only the owner may delete the game
 	if existingGame.ModeratorID != moderatorID {
 		return ErrNotAuthorized
 	}
 
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Delete the game
-	err = s.client.Game.DeleteOne(existingGame).Exec(ctx)
+	err = tx.Game.DeleteOne(existingGame).Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// The audit log is not tied to the game row, so this entry survives the delete
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, ActionDeleteGame, nil)
 	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return err
 	}
 
+	s.events.Publish(events.GameDeleted{GameID: gameID})
 	return nil
 }
 
@@ -156,26 +219,90 @@ func (s *GameService) JoinGame(ctx context.Context, gameID string, userName stri
 		return nil, ErrGameAlreadyStarted
 	}
 
-	// Create the player
-	player, err := s.client.Player.
+	// New players take the next free seat at the end of the table. Two
+	// players joining at once may both pick it; the seat index is unique, so
+	// the later one retries with the seat after.
+	var joined *ent.Player
+	for attempt := 1; attempt <= seatAttempts; attempt++ {
+		joined, err = s.seatPlayer(ctx, existingGame.ID, userName)
+		if !ent.IsConstraintError(err) {
+			break
+		}
+		// The name is unique as well, and retrying will not free it
+		taken, existsErr := s.client.Player.
+			Query().
+			Where(player.GameID(existingGame.ID), player.Name(userName)).
+			Exist(ctx)
+		if existsErr != nil {
+			return nil, existsErr
+		}
+		if taken {
+			return nil, ErrPlayerNameExists
+		}
+	}
+	if ent.IsConstraintError(err) {
+		return nil, ErrTableBusy
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.PlayerJoined{Player: joined})
+	return joined, nil
+}
+
+// seatAttempts bounds how often a join looks for a free seat
+const seatAttempts = 5
+
+// seatPlayer creates a player in the seat following the last occupied seat
+func (s *GameService) seatPlayer(ctx context.Context, gameID string, userName string) (*ent.Player, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seat, err := nextSeat(ctx, tx.Client(), gameID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	created, err := tx.Player.
 		Create().
 		SetID(uuid.New()).
 		SetName(userName).
-		SetGameID(existingGame.ID).
+		SetGameID(gameID).
+		SetSeat(seat).
 		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// nextSeat returns the seat index following the last occupied seat in a game
+func nextSeat(ctx context.Context, client *ent.Client, gameID string) (int, error) {
+	last, err := client.Player.
+		Query().
+		Where(player.GameID(gameID), player.SeatNotNil()).
+		Order(ent.Desc(player.FieldSeat)).
+		First(ctx)
 	if err != nil {
-		// Check if it's a duplicate key constraint error
-		if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "unique constraint") {
-			return nil, ErrPlayerNameExists
+		if ent.IsNotFound(err) {
+			return 0, nil
 		}
-		return nil, err
+		return 0, err
 	}
 
-	return player, nil
+	return last.Seat + 1, nil
 }
 
-// GetPlayers retrieves all players in a game
+// GetPlayers retrieves all players in a game in seat order
 func (s *GameService) GetPlayers(ctx context.Context, gameID string) ([]*ent.Player, error) {
 	if gameID == "" {
 		return nil, ErrEmptyGameID
@@ -187,10 +314,11 @@ func (s *GameService) GetPlayers(ctx context.Context, gameID string) ([]*ent.Pla
 		return nil, err
 	}
 
-	// Get all players for this game
+	// Get all players for this game, ordered around the table
 	players, err := s.client.Player.
 		Query().
 		Where(player.GameID(gameID)).
+		Order(ent.Asc(player.FieldSeat), ent.Asc(player.FieldCreatedAt)).
 		All(ctx)
 
 	if err != nil {
@@ -229,7 +357,7 @@ func (s *GameService) RemovePlayer(ctx context.Context, gameID string, playerID
 
 	// Verify player belongs to this game
 	if existingPlayer.GameID != gameID {
-		return errors.New("player does not belong to this game")
+		return ErrPlayerNotInGame
 	}
 
 	// Delete the player
@@ -238,9 +366,525 @@ func (s *GameService) RemovePlayer(ctx context.Context, gameID string, playerID
 		return err
 	}
 
+	s.events.Publish(events.PlayerLeft{GameID: gameID, PlayerID: existingPlayer.ID})
 	return nil
 }
 
+// SetPlayerAlive eliminates a player from the game or brings them back
+func (s *GameService) SetPlayerAlive(ctx context.Context, gameID string, moderatorID string, playerID string, alive bool) (*ent.Player, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+	if playerID == "" {
+		return nil, ErrEmptyPlayerID
+	}
+
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermManagePlayers); err != nil {
+		return nil, err
+	}
+
+	playerUUID, err := uuid.Parse(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingPlayer, err := s.client.Player.Get(ctx, playerUUID)
+	if err != nil {
+		return nil, err
+	}
+	if existingPlayer.GameID != gameID {
+		return nil, ErrPlayerNotInGame
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.Player.
+		UpdateOne(existingPlayer).
+		SetAlive(alive).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	action := ActionRevivePlayer
+	if !alive {
+		action = ActionEliminatePlayer
+	}
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, action, map[string]any{
+		"player_id": playerID,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.PlayerUpdated{Player: updated})
+	return updated, nil
+}
+
+// GetGameState loads a game with its players in seat order and its role assignments
+func (s *GameService) GetGameState(ctx context.Context, gameID string) (*projection.State, error) {
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	gameRoles, err := s.client.GameRole.
+		Query().
+		Where(gamerole.GameID(gameID)).
+		WithRole().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projection.State{
+		Game:    existingGame,
+		Players: players,
+		Roles:   gameRoles,
+	}, nil
+}
+
+// ResolveViewer works out who is looking at a game from the identities they present
+// A moderator ID takes precedence over a player ID, which takes precedence over a
+// spectator ID. Without any identity the viewer is anonymous. Player IDs are
+// public, so the identities must come from a validated game token.
+func (s *GameService) ResolveViewer(ctx context.Context, g *ent.Game, moderatorID string, playerID string, spectatorID string) (projection.Viewer, error) {
+	switch {
+	case moderatorID != "":
+		ok, err := s.moderators.IsModerator(ctx, g, moderatorID)
+		if err != nil {
+			return projection.Viewer{}, err
+		}
+		if !ok {
+			return projection.Viewer{}, ErrNotAuthorized
+		}
+
+		canViewRoles := true
+		if err := s.moderators.Authorize(ctx, g, moderatorID, PermViewRoles); err != nil {
+			if !errors.Is(err, ErrNotAuthorized) {
+				return projection.Viewer{}, err
+			}
+			canViewRoles = false
+		}
+		return projection.ModeratorViewer(moderatorID, canViewRoles), nil
+
+	case playerID != "":
+		playerUUID, err := uuid.Parse(playerID)
+		if err != nil {
+			return projection.Viewer{}, ErrPlayerNotInGame
+		}
+		exists, err := s.client.Player.
+			Query().
+			Where(player.ID(playerUUID), player.GameID(g.ID)).
+			Exist(ctx)
+		if err != nil {
+			return projection.Viewer{}, err
+		}
+		if !exists {
+			return projection.Viewer{}, ErrPlayerNotInGame
+		}
+		return projection.PlayerViewer(playerUUID), nil
+
+	case spectatorID != "":
+		existing, err := s.GetSpectator(ctx, g.ID, spectatorID)
+		if err != nil {
+			return projection.Viewer{}, err
+		}
+		return projection.SpectatorViewer(existing.ID), nil
+	}
+
+	return projection.PublicViewer(), nil
+}
+
+// ArrangeSeats seats the players of a game in the given order
+// The order must contain every player of the game exactly once
+func (s *GameService) ArrangeSeats(ctx context.Context, gameID string, moderatorID string, playerIDs []string) ([]*ent.Player, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermManagePlayers); err != nil {
+		return nil, err
+	}
+
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(playerIDs) != len(players) {
+		return nil, ErrInvalidSeatOrder
+	}
+
+	inGame := make(map[uuid.UUID]bool, len(players))
+	for _, p := range players {
+		inGame[p.ID] = true
+	}
+
+	order := make([]uuid.UUID, len(playerIDs))
+	seen := make(map[uuid.UUID]bool, len(playerIDs))
+	for i, id := range playerIDs {
+		playerUUID, err := uuid.Parse(id)
+		if err != nil || !inGame[playerUUID] || seen[playerUUID] {
+			return nil, ErrInvalidSeatOrder
+		}
+		seen[playerUUID] = true
+		order[i] = playerUUID
+	}
+
+	if err := s.saveSeats(ctx, gameID, moderatorID, ActionArrangeSeats, order); err != nil {
+		return nil, err
+	}
+
+	return s.seatsUpdated(ctx, gameID)
+}
+
+// RandomizeSeats shuffles the seating order of all players in a game
+func (s *GameService) RandomizeSeats(ctx context.Context, gameID string, moderatorID string) ([]*ent.Player, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermManagePlayers); err != nil {
+		return nil, err
+	}
+
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]uuid.UUID, len(players))
+	for i, p := range players {
+		order[i] = p.ID
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+
+	if err := s.saveSeats(ctx, gameID, moderatorID, ActionRandomizeSeats, order); err != nil {
+		return nil, err
+	}
+
+	return s.seatsUpdated(ctx, gameID)
+}
+
+// seatsUpdated loads the new seating order and publishes it
+func (s *GameService) seatsUpdated(ctx context.Context, gameID string) ([]*ent.Player, error) {
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.SeatsUpdated{GameID: gameID, Players: players})
+	return players, nil
+}
+
+// saveSeats assigns consecutive seat indexes to the given players in a transaction
+// and records the moderator action that changed them
+func (s *GameService) saveSeats(ctx context.Context, gameID string, moderatorID string, action string, order []uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Seats are unique, so move everyone past the highest seat first to keep
+	// the new seats from clashing with the old ones
+	last, err := nextSeat(ctx, tx.Client(), gameID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Player.
+		Update().
+		Where(player.GameID(gameID)).
+		AddSeat(last).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for seat, playerID := range order {
+		err := tx.Player.
+			UpdateOneID(playerID).
+			SetSeat(seat).
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	seating := make([]string, len(order))
+	for i, id := range order {
+		seating[i] = id.String()
+	}
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, action, map[string]any{
+		"player_ids": seating,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetNeighbors returns the players seated directly left and right of a player
+// Seating wraps around the table, so the first and last seats are neighbors.
+// Both neighbors are nil when the player is alone at the table.
+func (s *GameService) GetNeighbors(ctx context.Context, gameID string, playerID string) (left *ent.Player, right *ent.Player, err error) {
+	if gameID == "" {
+		return nil, nil, ErrEmptyGameID
+	}
+	if playerID == "" {
+		return nil, nil, ErrEmptyPlayerID
+	}
+
+	playerUUID, err := uuid.Parse(playerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	players, err := s.GetPlayers(ctx, gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	left, right, ok := SeatNeighbors(players, playerUUID)
+	if !ok {
+		return nil, nil, ErrPlayerNotInGame
+	}
+
+	return left, right, nil
+}
+
+// SeatNeighbors finds the left and right neighbors of a player in a list of
+// players sorted by seat. The left neighbor sits in the previous seat and the
+// right neighbor in the next one. It reports false if the player is not listed.
+func SeatNeighbors(players []*ent.Player, playerID uuid.UUID) (left *ent.Player, right *ent.Player, ok bool) {
+	for i, p := range players {
+		if p.ID != playerID {
+			continue
+		}
+		if len(players) < 2 {
+			return nil, nil, true
+		}
+		n := len(players)
+		return players[(i-1+n)%n], players[(i+1)%n], true
+	}
+
+	return nil, nil, false
+}
+
+// JoinAsSpectator lets someone watch a game without becoming a player
+// Spectators never count towards role distribution.
+func (s *GameService) JoinAsSpectator(ctx context.Context, gameID string, name string) (*ent.Spectator, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if name == "" {
+		return nil, ErrEmptyUserID
+	}
+
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingGame.SpectatorMode == game.SpectatorModeDisabled {
+		return nil, ErrSpectatorsDisabled
+	}
+
+	return s.client.Spectator.
+		Create().
+		SetName(name).
+		SetGameID(existingGame.ID).
+		Save(ctx)
+}
+
+// GetSpectator retrieves a spectator and verifies it belongs to the game
+func (s *GameService) GetSpectator(ctx context.Context, gameID string, spectatorID string) (*ent.Spectator, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if spectatorID == "" {
+		return nil, ErrEmptySpectatorID
+	}
+
+	spectatorUUID, err := uuid.Parse(spectatorID)
+	if err != nil {
+		return nil, ErrSpectatorNotFound
+	}
+
+	existing, err := s.client.Spectator.Get(ctx, spectatorUUID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSpectatorNotFound
+		}
+		return nil, err
+	}
+
+	if existing.GameID != gameID {
+		return nil, ErrSpectatorNotFound
+	}
+
+	return existing, nil
+}
+
+// GetSpectators retrieves all spectators of a game for a moderator who may manage its players
+func (s *GameService) GetSpectators(ctx context.Context, gameID string, moderatorID string) ([]*ent.Spectator, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermManagePlayers); err != nil {
+		return nil, err
+	}
+
+	return s.client.Spectator.
+		Query().
+		Where(spectator.GameID(gameID)).
+		Order(ent.Asc(spectator.FieldCreatedAt)).
+		All(ctx)
+}
+
+// RemoveSpectator lets a moderator who may manage players remove a spectator from a game
+func (s *GameService) RemoveSpectator(ctx context.Context, gameID string, moderatorID string, spectatorID string) error {
+	if gameID == "" {
+		return ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return ErrEmptyModeratorID
+	}
+
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermManagePlayers); err != nil {
+		return err
+	}
+
+	return s.LeaveAsSpectator(ctx, gameID, spectatorID)
+}
+
+// LeaveAsSpectator removes a spectator who stops watching a game
+func (s *GameService) LeaveAsSpectator(ctx context.Context, gameID string, spectatorID string) error {
+	existing, err := s.GetSpectator(ctx, gameID, spectatorID)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Spectator.DeleteOne(existing).Exec(ctx)
+}
+
+// UpdateSpectatorSettings enables, disables or delays the spectator view of a game
+func (s *GameService) UpdateSpectatorSettings(ctx context.Context, gameID string, moderatorID string, mode game.SpectatorMode, delay time.Duration) (*ent.Game, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+	if err := game.SpectatorModeValidator(mode); err != nil {
+		return nil, ErrInvalidSpectatorMode
+	}
+	if delay < 0 || delay > MaxSpectatorDelay {
+		return nil, ErrInvalidSpectatorDelay
+	}
+
+	existingGame, err := s.GetGameByID(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermManageGame); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.Game.
+		UpdateOneID(gameID).
+		SetSpectatorMode(mode).
+		SetSpectatorDelaySeconds(int(delay / time.Second)).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, ActionUpdateSpectators, map[string]any{
+		"spectator_mode":          mode,
+		"spectator_delay_seconds": updated.SpectatorDelaySeconds,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.SpectatorSettingsChanged{Game: updated})
+	return updated, nil
+}
+
 // RoleSelection represents a role and the count to assign
 type RoleSelection struct {
 	RoleID string `json:"role_id"`
@@ -248,6 +892,7 @@ type RoleSelection struct {
 }
 
 // DistributeRoles assigns roles to players randomly
+// The selected roles must not be archived and must follow their role rules.
 func (s *GameService) DistributeRoles(ctx context.Context, gameID string, moderatorID string, roleSelections []RoleSelection) error {
 	if gameID == "" {
 		return ErrEmptyGameID
@@ -262,8 +907,8 @@ func (s *GameService) DistributeRoles(ctx context.Context, gameID string, modera
 		return err
 	}
 
-	if existingGame.ModeratorID != moderatorID {
-		return ErrNotAuthorized
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermDistributeRoles); err != nil {
+		return err
 	}
 
 	// Check if roles are already assigned
@@ -297,6 +942,7 @@ func (s *GameService) DistributeRoles(ctx context.Context, gameID string, modera
 
 	// Build a list of role IDs based on counts
 	roleList := make([]uuid.UUID, 0, totalRoles)
+	counts := make(map[uuid.UUID]int, len(roleSelections))
 	for _, selection := range roleSelections {
 		roleUUID, err := uuid.Parse(selection.RoleID)
 		if err != nil {
@@ -305,6 +951,14 @@ func (s *GameService) DistributeRoles(ctx context.Context, gameID string, modera
 		for i := 0; i < selection.Count; i++ {
 			roleList = append(roleList, roleUUID)
 		}
+		counts[roleUUID] += selection.Count
+	}
+
+	if err := checkRolesActive(ctx, s.client, roleList); err != nil {
+		return err
+	}
+	if err := checkRoleRules(ctx, s.client, counts); err != nil {
+		return err
 	}
 
 	// Shuffle roles for random distribution
@@ -342,7 +996,20 @@ func (s *GameService) DistributeRoles(ctx context.Context, gameID string, modera
 		return err
 	}
 
-	return tx.Commit()
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, ActionDistributeRoles, map[string]any{
+		"roles": roleSelections,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.events.Publish(events.RolesDistributed{GameID: gameID})
+	return nil
 }
 
 // GetPlayerRole retrieves the assigned role for a player
@@ -386,14 +1053,14 @@ func (s *GameService) GetGameRoles(ctx context.Context, gameID string, moderator
 		return nil, ErrEmptyModeratorID
 	}
 
-	// Verify game exists and moderator owns it
+	// Verify game exists and moderator may see roles
 	existingGame, err := s.GetGameByID(ctx, gameID)
 	if err != nil {
 		return nil, err
 	}
 
-	if existingGame.ModeratorID != moderatorID {
-		return nil, ErrNotAuthorized
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermViewRoles); err != nil {
+		return nil, err
 	}
 
 	// Get all game roles with player and role details
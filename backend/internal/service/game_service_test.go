@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/role"
 	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/events"
+	"github.com/mafia-night/backend/internal/projection"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -227,8 +232,7 @@ func TestGameService_JoinGame(t *testing.T) {
 
 		// Second player with same name should fail
 		_, err = service.JoinGame(ctx, created.ID, "player1")
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "already exists")
+		assert.ErrorIs(t, err, ErrPlayerNameExists)
 	})
 
 	t.Run("allows same name in different games", func(t *testing.T) {
@@ -382,3 +386,466 @@ func TestGameService_RemovePlayer(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestGameService_Seating(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewGameService(client)
+	ctx := context.Background()
+
+	t.Run("assigns seats in join order", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		for i, name := range []string{"player1", "player2", "player3"} {
+			player, err := service.JoinGame(ctx, created.ID, name)
+			require.NoError(t, err)
+			assert.Equal(t, i, player.Seat)
+		}
+
+		players, err := service.GetPlayers(ctx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, players, 3)
+		assert.Equal(t, "player1", players[0].Name)
+		assert.Equal(t, "player2", players[1].Name)
+		assert.Equal(t, "player3", players[2].Name)
+	})
+
+	t.Run("players joining at once get different seats", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		names := []string{"player1", "player2", "player3", "player4"}
+		var wg sync.WaitGroup
+		errs := make([]error, len(names))
+		for i, name := range names {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = service.JoinGame(ctx, created.ID, name)
+			}()
+		}
+		wg.Wait()
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+
+		players, err := service.GetPlayers(ctx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, players, len(names))
+		for i, p := range players {
+			assert.Equal(t, i, p.Seat)
+		}
+	})
+
+	t.Run("players without a seat do not take one", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = client.Player.Create().SetName("legacy").SetGameID(created.ID).Save(ctx)
+		require.NoError(t, err)
+
+		joined, err := service.JoinGame(ctx, created.ID, "player1")
+		require.NoError(t, err)
+		assert.Equal(t, 0, joined.Seat)
+	})
+
+	t.Run("rearranges seats left with gaps", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		p1, err := service.JoinGame(ctx, created.ID, "player1")
+		require.NoError(t, err)
+		p2, err := service.JoinGame(ctx, created.ID, "player2")
+		require.NoError(t, err)
+		p3, err := service.JoinGame(ctx, created.ID, "player3")
+		require.NoError(t, err)
+		require.NoError(t, service.RemovePlayer(ctx, created.ID, p2.ID.String()))
+
+		players, err := service.ArrangeSeats(ctx, created.ID, "mod-123", []string{p3.ID.String(), p1.ID.String()})
+		require.NoError(t, err)
+		require.Len(t, players, 2)
+		assert.Equal(t, p3.ID, players[0].ID)
+		assert.Equal(t, 0, players[0].Seat)
+		assert.Equal(t, 1, players[1].Seat)
+	})
+
+	t.Run("arranges seats in the given order", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		p1, err := service.JoinGame(ctx, created.ID, "player1")
+		require.NoError(t, err)
+		p2, err := service.JoinGame(ctx, created.ID, "player2")
+		require.NoError(t, err)
+		p3, err := service.JoinGame(ctx, created.ID, "player3")
+		require.NoError(t, err)
+
+		players, err := service.ArrangeSeats(ctx, created.ID, "mod-123", []string{p3.ID.String(), p1.ID.String(), p2.ID.String()})
+		require.NoError(t, err)
+		require.Len(t, players, 3)
+		assert.Equal(t, p3.ID, players[0].ID)
+		assert.Equal(t, p1.ID, players[1].ID)
+		assert.Equal(t, p2.ID, players[2].ID)
+		for i, p := range players {
+			assert.Equal(t, i, p.Seat)
+		}
+	})
+
+	t.Run("rejects incomplete or duplicate seat order", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		p1, err := service.JoinGame(ctx, created.ID, "player1")
+		require.NoError(t, err)
+		_, err = service.JoinGame(ctx, created.ID, "player2")
+		require.NoError(t, err)
+
+		_, err = service.ArrangeSeats(ctx, created.ID, "mod-123", []string{p1.ID.String()})
+		assert.ErrorIs(t, err, ErrInvalidSeatOrder)
+
+		_, err = service.ArrangeSeats(ctx, created.ID, "mod-123", []string{p1.ID.String(), p1.ID.String()})
+		assert.ErrorIs(t, err, ErrInvalidSeatOrder)
+	})
+
+	t.Run("only the moderator can arrange seats", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.RandomizeSeats(ctx, created.ID, "different-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+
+	t.Run("randomizes seats keeping every player", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		for _, name := range []string{"player1", "player2", "player3", "player4"} {
+			_, err := service.JoinGame(ctx, created.ID, name)
+			require.NoError(t, err)
+		}
+
+		players, err := service.RandomizeSeats(ctx, created.ID, "mod-123")
+		require.NoError(t, err)
+		require.Len(t, players, 4)
+		for i, p := range players {
+			assert.Equal(t, i, p.Seat)
+		}
+	})
+
+	t.Run("returns neighbors wrapping around the table", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		p1, err := service.JoinGame(ctx, created.ID, "player1")
+		require.NoError(t, err)
+		p2, err := service.JoinGame(ctx, created.ID, "player2")
+		require.NoError(t, err)
+		p3, err := service.JoinGame(ctx, created.ID, "player3")
+		require.NoError(t, err)
+
+		left, right, err := service.GetNeighbors(ctx, created.ID, p1.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, p3.ID, left.ID)
+		assert.Equal(t, p2.ID, right.ID)
+	})
+
+	t.Run("fails for player from another game", func(t *testing.T) {
+		game1, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		game2, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		player, err := service.JoinGame(ctx, game1.ID, "player1")
+		require.NoError(t, err)
+
+		_, _, err = service.GetNeighbors(ctx, game2.ID, player.ID.String())
+		assert.ErrorIs(t, err, ErrPlayerNotInGame)
+	})
+}
+
+func TestGameService_Spectators(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewGameService(client)
+	roleService := NewRoleService(client)
+	ctx := context.Background()
+
+	t.Run("joins as spectator without creating a player", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		spectator, err := service.JoinAsSpectator(ctx, created.ID, "friend")
+		require.NoError(t, err)
+		assert.Equal(t, "friend", spectator.Name)
+		assert.Equal(t, created.ID, spectator.GameID)
+
+		players, err := service.GetPlayers(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Empty(t, players)
+
+		spectators, err := service.GetSpectators(ctx, created.ID, "mod-123")
+		require.NoError(t, err)
+		assert.Len(t, spectators, 1)
+	})
+
+	t.Run("only moderators list and remove spectators", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		spectator, err := service.JoinAsSpectator(ctx, created.ID, "friend")
+		require.NoError(t, err)
+
+		_, err = service.GetSpectators(ctx, created.ID, "different-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+		err = service.RemoveSpectator(ctx, created.ID, "different-mod", spectator.ID.String())
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+
+		require.NoError(t, service.RemoveSpectator(ctx, created.ID, "mod-123", spectator.ID.String()))
+		spectators, err := service.GetSpectators(ctx, created.ID, "mod-123")
+		require.NoError(t, err)
+		assert.Empty(t, spectators)
+	})
+
+	t.Run("spectators leave by themselves", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		spectator, err := service.JoinAsSpectator(ctx, created.ID, "friend")
+		require.NoError(t, err)
+
+		require.NoError(t, service.LeaveAsSpectator(ctx, created.ID, spectator.ID.String()))
+		_, err = service.GetSpectator(ctx, created.ID, spectator.ID.String())
+		assert.ErrorIs(t, err, ErrSpectatorNotFound)
+	})
+
+	t.Run("spectators do not count towards role distribution", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		villager, err := roleService.CreateRole(ctx, "Spectated Villager", "spectated-villager", "video", "", role.TeamVillage, nil)
+		require.NoError(t, err)
+
+		_, err = service.JoinGame(ctx, created.ID, "player1")
+		require.NoError(t, err)
+		_, err = service.JoinAsSpectator(ctx, created.ID, "friend")
+		require.NoError(t, err)
+
+		err = service.DistributeRoles(ctx, created.ID, "mod-123", []RoleSelection{
+			{RoleID: villager.ID.String(), Count: 1},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("spectators can join after the game started", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.UpdateGameStatus(ctx, created.ID, game.StatusActive, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.JoinAsSpectator(ctx, created.ID, "eliminated-player")
+		assert.NoError(t, err)
+	})
+
+	t.Run("fails when spectators are disabled", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.UpdateSpectatorSettings(ctx, created.ID, "mod-123", game.SpectatorModeDisabled, 0)
+		require.NoError(t, err)
+
+		_, err = service.JoinAsSpectator(ctx, created.ID, "friend")
+		assert.ErrorIs(t, err, ErrSpectatorsDisabled)
+	})
+
+	t.Run("fails for spectator of another game", func(t *testing.T) {
+		game1, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		game2, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		spectator, err := service.JoinAsSpectator(ctx, game1.ID, "friend")
+		require.NoError(t, err)
+
+		_, err = service.GetSpectator(ctx, game2.ID, spectator.ID.String())
+		assert.ErrorIs(t, err, ErrSpectatorNotFound)
+	})
+}
+
+func TestGameService_UpdateSpectatorSettings(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewGameService(client)
+	ctx := context.Background()
+
+	t.Run("delays spectator view", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		assert.Equal(t, game.SpectatorModeEnabled, created.SpectatorMode)
+
+		updated, err := service.UpdateSpectatorSettings(ctx, created.ID, "mod-123", game.SpectatorModeDelayed, 30*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, game.SpectatorModeDelayed, updated.SpectatorMode)
+		assert.Equal(t, 30, updated.SpectatorDelaySeconds)
+	})
+
+	t.Run("fails with invalid mode", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.UpdateSpectatorSettings(ctx, created.ID, "mod-123", "sometimes", 0)
+		assert.ErrorIs(t, err, ErrInvalidSpectatorMode)
+	})
+
+	t.Run("fails with too long delay", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.UpdateSpectatorSettings(ctx, created.ID, "mod-123", game.SpectatorModeDelayed, time.Hour)
+		assert.ErrorIs(t, err, ErrInvalidSpectatorDelay)
+	})
+
+	t.Run("fails when moderator ID doesn't match", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.UpdateSpectatorSettings(ctx, created.ID, "different-mod", game.SpectatorModeDisabled, 0)
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+}
+
+func TestGameService_SetPlayerAlive(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewGameService(client)
+	ctx := context.Background()
+
+	t.Run("eliminates and revives a player", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		p, err := service.JoinGame(ctx, created.ID, "Alice")
+		require.NoError(t, err)
+		assert.True(t, p.Alive)
+
+		updated, err := service.SetPlayerAlive(ctx, created.ID, "mod-123", p.ID.String(), false)
+		require.NoError(t, err)
+		assert.False(t, updated.Alive)
+
+		updated, err = service.SetPlayerAlive(ctx, created.ID, "mod-123", p.ID.String(), true)
+		require.NoError(t, err)
+		assert.True(t, updated.Alive)
+
+		actions, err := service.moderators.GetActions(ctx, created.ID, "mod-123")
+		require.NoError(t, err)
+		require.Len(t, actions, 2)
+		assert.Equal(t, ActionEliminatePlayer, actions[0].Action)
+		assert.Equal(t, ActionRevivePlayer, actions[1].Action)
+	})
+
+	t.Run("fails when moderator ID doesn't match", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		p, err := service.JoinGame(ctx, created.ID, "Alice")
+		require.NoError(t, err)
+
+		_, err = service.SetPlayerAlive(ctx, created.ID, "different-mod", p.ID.String(), false)
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+
+	t.Run("fails for a player of another game", func(t *testing.T) {
+		created, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		other, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		p, err := service.JoinGame(ctx, other.ID, "Alice")
+		require.NoError(t, err)
+
+		_, err = service.SetPlayerAlive(ctx, created.ID, "mod-123", p.ID.String(), false)
+		assert.ErrorIs(t, err, ErrPlayerNotInGame)
+	})
+}
+
+func TestGameService_ResolveViewer(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewGameService(client)
+	ctx := context.Background()
+
+	created, err := service.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	p, err := service.JoinGame(ctx, created.ID, "Alice")
+	require.NoError(t, err)
+	s, err := service.JoinAsSpectator(ctx, created.ID, "friend")
+	require.NoError(t, err)
+	_, err = service.moderators.AddModerator(ctx, created.ID, "mod-123", "co-mod", []Permission{PermManagePlayers})
+	require.NoError(t, err)
+
+	t.Run("resolves each kind of viewer", func(t *testing.T) {
+		v, err := service.ResolveViewer(ctx, created, "mod-123", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, projection.KindModerator, v.Kind)
+		assert.True(t, v.CanViewRoles)
+
+		v, err = service.ResolveViewer(ctx, created, "co-mod", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, projection.KindModerator, v.Kind)
+		assert.False(t, v.CanViewRoles)
+
+		v, err = service.ResolveViewer(ctx, created, "", p.ID.String(), "")
+		require.NoError(t, err)
+		assert.Equal(t, projection.KindPlayer, v.Kind)
+		assert.Equal(t, p.ID, v.PlayerID)
+
+		v, err = service.ResolveViewer(ctx, created, "", "", s.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, projection.KindSpectator, v.Kind)
+
+		v, err = service.ResolveViewer(ctx, created, "", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, projection.KindPublic, v.Kind)
+	})
+
+	t.Run("rejects unknown identities", func(t *testing.T) {
+		_, err := service.ResolveViewer(ctx, created, "someone-else", "", "")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+
+		other, err := service.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		stranger, err := service.JoinGame(ctx, other.ID, "Bob")
+		require.NoError(t, err)
+
+		_, err = service.ResolveViewer(ctx, created, "", stranger.ID.String(), "")
+		assert.ErrorIs(t, err, ErrPlayerNotInGame)
+	})
+}
+
+func TestGameService_PublishesEvents(t *testing.T) {
+	client := database.SetupTestDB(t)
+	bus := events.NewBus()
+	service := NewGameService(client).WithEvents(bus)
+	ctx := context.Background()
+
+	var published []events.Event
+	bus.Subscribe(func(e events.Event) { published = append(published, e) })
+
+	created, err := service.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	alice, err := service.JoinGame(ctx, created.ID, "Alice")
+	require.NoError(t, err)
+	_, err = service.UpdateGameStatus(ctx, created.ID, game.StatusActive, "mod-123")
+	require.NoError(t, err)
+	bus.Flush()
+
+	require.Len(t, published, 3)
+	assert.Equal(t, events.TypeGameCreated, published[0].EventType())
+	assert.Equal(t, alice.ID, published[1].(events.PlayerJoined).Player.ID)
+	assert.Equal(t, game.StatusActive, published[2].(events.GameStatusChanged).Game.Status)
+	for _, e := range published {
+		assert.Equal(t, created.ID, e.EventGameID())
+	}
+
+	t.Run("failed changes publish nothing", func(t *testing.T) {
+		published = nil
+
+		_, err := service.UpdateGameStatus(ctx, created.ID, game.StatusCompleted, "different-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+		bus.Flush()
+		assert.Empty(t, published)
+	})
+}
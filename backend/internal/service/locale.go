@@ -0,0 +1,137 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/mafia-night/backend/ent"
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the locale of the texts stored on roles and templates
+// themselves, unless the services are configured with another one
+const DefaultLocale = "en"
+
+var (
+	ErrInvalidLocale              = errors.New("locale must be a language tag such as en or fa-IR")
+	ErrDefaultLocaleTranslation   = errors.New("texts in the default locale are edited on the role or template itself")
+	ErrTranslationNotFound        = errors.New("translation not found")
+	ErrEmptyTranslationName       = errors.New("translated name cannot be empty")
+	ErrEmptyTranslatedDescription = errors.New("translated description cannot be empty")
+)
+
+// NormalizeLocale returns the canonical form of a language tag, such as "fa-IR" for "fa_ir"
+func NormalizeLocale(locale string) (string, error) {
+	tag, err := language.Parse(locale)
+	if err != nil || tag == language.Und {
+		return "", ErrInvalidLocale
+	}
+	return tag.String(), nil
+}
+
+// LocalePreferences lists the locales a reader asked for, most preferred first
+type LocalePreferences []string
+
+// ParseLocalePreferences reads the locales from an explicit locale, such as a
+// query parameter, falling back to an Accept-Language header. Tags that do
+// not parse are skipped, so a bad header never fails a request.
+func ParseLocalePreferences(locale, acceptLanguage string) LocalePreferences {
+	if locale != "" {
+		if normalized, err := NormalizeLocale(locale); err == nil {
+			return LocalePreferences{normalized}
+		}
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil
+	}
+
+	prefs := make(LocalePreferences, 0, len(tags))
+	for _, tag := range tags {
+		if tag != language.Und {
+			prefs = append(prefs, tag.String())
+		}
+	}
+	return prefs
+}
+
+// candidates lists the locales to look for translations in: each preferred
+// locale followed by its base language, so "fa-IR" also finds "fa".
+// It stops at the default locale, whose texts are stored on the entity itself.
+func (p LocalePreferences) candidates(defaultLocale string) []string {
+	var candidates []string
+	seen := make(map[string]bool)
+	add := func(locale string) bool {
+		if locale == defaultLocale {
+			return false
+		}
+		if !seen[locale] {
+			seen[locale] = true
+			candidates = append(candidates, locale)
+		}
+		return true
+	}
+
+	for _, locale := range p {
+		if !add(locale) {
+			return candidates
+		}
+		tag, err := language.Parse(locale)
+		if err != nil {
+			continue
+		}
+		if base, confidence := tag.Base(); confidence != language.No {
+			if !add(base.String()) {
+				return candidates
+			}
+		}
+	}
+	return candidates
+}
+
+// Localizer picks the texts of roles and templates in the locales a reader
+// prefers, falling back to the default locale
+type Localizer struct {
+	DefaultLocale string
+	Preferences   LocalePreferences
+}
+
+// LocalizedRole holds the texts of a role in one locale
+type LocalizedRole struct {
+	Locale      string
+	Name        string
+	Description string
+	Abilities   []string
+}
+
+// Role picks the texts of a role in the first preferred locale it is
+// translated to. The role's translations must be loaded.
+func (l Localizer) Role(r *ent.Role) LocalizedRole {
+	for _, locale := range l.Preferences.candidates(l.DefaultLocale) {
+		for _, t := range r.Edges.Translations {
+			if t.Locale != locale {
+				continue
+			}
+			abilities := t.Abilities
+			if len(abilities) == 0 {
+				abilities = r.Abilities
+			}
+			return LocalizedRole{Locale: locale, Name: t.Name, Description: t.Description, Abilities: abilities}
+		}
+	}
+	return LocalizedRole{Locale: l.DefaultLocale, Name: r.Name, Description: r.Description, Abilities: r.Abilities}
+}
+
+// TemplateDescription picks the description of a template in the first
+// preferred locale it is translated to, returning the locale and description.
+// The template's translations must be loaded.
+func (l Localizer) TemplateDescription(t *ent.RoleTemplate) (string, string) {
+	for _, locale := range l.Preferences.candidates(l.DefaultLocale) {
+		for _, tr := range t.Edges.Translations {
+			if tr.Locale == locale {
+				return locale, tr.Description
+			}
+		}
+	}
+	return l.DefaultLocale, t.Description
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/mafia-night/backend/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	locale, err := NormalizeLocale("fa-ir")
+	require.NoError(t, err)
+	assert.Equal(t, "fa-IR", locale)
+
+	_, err = NormalizeLocale("not a locale")
+	assert.ErrorIs(t, err, ErrInvalidLocale)
+}
+
+func TestParseLocalePreferences(t *testing.T) {
+	assert.Equal(t, LocalePreferences{"fa", "en-GB", "en"}, ParseLocalePreferences("", "en-GB;q=0.8, fa, en;q=0.5"))
+	assert.Equal(t, LocalePreferences{"de"}, ParseLocalePreferences("de", "fa"), "the query parameter wins over the header")
+	assert.Equal(t, LocalePreferences{"fa"}, ParseLocalePreferences("!!", "fa"), "an invalid query parameter is ignored")
+	assert.Empty(t, ParseLocalePreferences("", ""))
+}
+
+func TestLocalizer_Role(t *testing.T) {
+	r := &ent.Role{
+		Name:        "Doctor",
+		Description: "Saves a player each night",
+		Abilities:   []string{"Save"},
+		Edges: ent.RoleEdges{Translations: []*ent.RoleTranslation{
+			{Locale: "fa", Name: "دکتر", Description: "هر شب یک نفر را نجات می‌دهد"},
+		}},
+	}
+
+	tests := []struct {
+		name  string
+		prefs LocalePreferences
+		want  string
+	}{
+		{name: "no preference", want: "en"},
+		{name: "translated locale", prefs: LocalePreferences{"fa"}, want: "fa"},
+		{name: "regional variant", prefs: LocalePreferences{"fa-IR"}, want: "fa"},
+		{name: "first available preference", prefs: LocalePreferences{"de", "fa"}, want: "fa"},
+		{name: "default locale preferred over later ones", prefs: LocalePreferences{"en", "fa"}, want: "en"},
+		{name: "untranslated locale", prefs: LocalePreferences{"de"}, want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := Localizer{DefaultLocale: "en", Preferences: tt.prefs}.Role(r)
+			assert.Equal(t, tt.want, text.Locale)
+		})
+	}
+
+	text := Localizer{DefaultLocale: "en", Preferences: LocalePreferences{"fa"}}.Role(r)
+	assert.Equal(t, "دکتر", text.Name)
+	assert.Equal(t, []string{"Save"}, text.Abilities, "untranslated abilities fall back to the role's own")
+}
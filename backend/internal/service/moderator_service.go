@@ -0,0 +1,558 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/gamemoderator"
+	"github.com/mafia-night/backend/ent/moderatoraction"
+	"github.com/mafia-night/backend/internal/events"
+)
+
+var (
+	ErrInvalidPermission      = errors.New("invalid moderator permission")
+	ErrModeratorNotFound      = errors.New("moderator not found")
+	ErrModeratorExists        = errors.New("moderator already moderates this game")
+	ErrCannotChangeOwner      = errors.New("the game owner cannot be changed this way")
+	ErrEmptyTargetModeratorID = errors.New("target moderator ID cannot be empty")
+)
+
+// Permission is an action a co-moderator may be allowed to perform
+type Permission string
+
+const (
+	// PermManageGame allows changing the game status
+	PermManageGame Permission = "manage_game"
+	// PermManagePlayers allows removing and seating players
+	PermManagePlayers Permission = "manage_players"
+	// PermDistributeRoles allows distributing roles to players
+	PermDistributeRoles Permission = "distribute_roles"
+	// PermViewRoles allows seeing which role each player has
+	PermViewRoles Permission = "view_roles"
+	// PermRunVotes allows running day votes, which open and close as the
+	// game moves between day and night
+	PermRunVotes Permission = "run_votes"
+)
+
+// AllPermissions lists every permission that can be granted to a co-moderator
+var AllPermissions = []Permission{
+	PermManageGame,
+	PermManagePlayers,
+	PermDistributeRoles,
+	PermViewRoles,
+	PermRunVotes,
+}
+
+// Moderator action names recorded in the audit log
+const (
+	ActionUpdateStatus      = "update_status"
+	ActionDeleteGame        = "delete_game"
+	ActionDistributeRoles   = "distribute_roles"
+	ActionArrangeSeats      = "arrange_seats"
+	ActionRandomizeSeats    = "randomize_seats"
+	ActionUpdateSpectators  = "update_spectators"
+	ActionEliminatePlayer   = "eliminate_player"
+	ActionRevivePlayer      = "revive_player"
+	ActionMutePlayer        = "mute_player"
+	ActionUnmutePlayer      = "unmute_player"
+	ActionAdvancePhase      = "advance_phase"
+	ActionAddModerator      = "add_moderator"
+	ActionUpdateModerator   = "update_moderator"
+	ActionRemoveModerator   = "remove_moderator"
+	ActionTransferOwnership = "transfer_ownership"
+)
+
+// GameModeratorInfo describes a moderator of a game and what they may do
+type GameModeratorInfo struct {
+	ModeratorID string
+	Owner       bool
+	Permissions []Permission
+}
+
+// ModeratorService handles game owners, co-moderators and the moderator audit log
+type ModeratorService struct {
+	client *ent.Client
+	events *events.Bus
+}
+
+// NewModeratorService creates a new moderator service
+func NewModeratorService(client *ent.Client) *ModeratorService {
+	return &ModeratorService{client: client}
+}
+
+// WithEvents makes the service publish an event on bus after each change
+func (s *ModeratorService) WithEvents(bus *events.Bus) *ModeratorService {
+	s.events = bus
+	return s
+}
+
+// Authorize checks that a moderator may perform an action requiring perm on a game
+// The game owner holds every permission.
+func (s *ModeratorService) Authorize(ctx context.Context, g *ent.Game, moderatorID string, perm Permission) error {
+	if g.ModeratorID == moderatorID {
+		return nil
+	}
+
+	coModerator, err := s.client.GameModerator.
+		Query().
+		Where(
+			gamemoderator.GameID(g.ID),
+			gamemoderator.ModeratorID(moderatorID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotAuthorized
+		}
+		return err
+	}
+
+	for _, p := range coModerator.Permissions {
+		if Permission(p) == perm {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: missing %s permission", ErrNotAuthorized, perm)
+}
+
+// IsModerator reports whether the moderator owns or co-moderates the game
+func (s *ModeratorService) IsModerator(ctx context.Context, g *ent.Game, moderatorID string) (bool, error) {
+	if g.ModeratorID == moderatorID {
+		return true, nil
+	}
+
+	return s.client.GameModerator.
+		Query().
+		Where(
+			gamemoderator.GameID(g.ID),
+			gamemoderator.ModeratorID(moderatorID),
+		).
+		Exist(ctx)
+}
+
+// ListModerators returns the owner followed by all co-moderators of a game
+// Any moderator of the game may list them, but only the owner sees the IDs
+// of the others; co-moderators get a label standing in for each.
+func (s *ModeratorService) ListModerators(ctx context.Context, gameID string, moderatorID string) ([]GameModeratorInfo, error) {
+	g, err := s.authorizedGame(ctx, gameID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.IsModerator(ctx, g, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotAuthorized
+	}
+
+	coModerators, err := s.client.GameModerator.
+		Query().
+		Where(gamemoderator.GameID(gameID)).
+		Order(ent.Asc(gamemoderator.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	moderators := make([]GameModeratorInfo, 0, len(coModerators)+1)
+	moderators = append(moderators, GameModeratorInfo{
+		ModeratorID: g.ModeratorID,
+		Owner:       true,
+		Permissions: AllPermissions,
+	})
+	for _, m := range coModerators {
+		moderators = append(moderators, coModeratorInfo(m))
+	}
+
+	if moderatorID != g.ModeratorID {
+		for i := range moderators {
+			moderators[i].ModeratorID = maskModeratorID(g.ID, moderators[i].ModeratorID, moderatorID)
+		}
+	}
+
+	return moderators, nil
+}
+
+// AddModerator grants a co-moderator scoped permissions on a game
+// Only the game owner can add co-moderators.
+func (s *ModeratorService) AddModerator(ctx context.Context, gameID string, ownerID string, moderatorID string, permissions []Permission) (*GameModeratorInfo, error) {
+	if moderatorID == "" {
+		return nil, ErrEmptyTargetModeratorID
+	}
+	if err := validatePermissions(permissions); err != nil {
+		return nil, err
+	}
+
+	g, err := s.ownedGame(ctx, gameID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if g.ModeratorID == moderatorID {
+		return nil, ErrCannotChangeOwner
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := tx.GameModerator.
+		Create().
+		SetGameID(gameID).
+		SetModeratorID(moderatorID).
+		SetPermissions(permissionStrings(permissions)).
+		SetAddedBy(ownerID).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, ErrModeratorExists
+		}
+		return nil, err
+	}
+
+	err = recordModeratorAction(ctx, tx.Client(), gameID, ownerID, ActionAddModerator, map[string]any{
+		"moderator_id": moderatorID,
+		"permissions":  created.Permissions,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.ModeratorAdded{GameID: gameID, ModeratorID: moderatorID, Permissions: created.Permissions})
+	info := coModeratorInfo(created)
+	return &info, nil
+}
+
+// UpdateModeratorPermissions replaces the permissions of a co-moderator
+// Only the game owner can change permissions.
+func (s *ModeratorService) UpdateModeratorPermissions(ctx context.Context, gameID string, ownerID string, moderatorID string, permissions []Permission) (*GameModeratorInfo, error) {
+	if moderatorID == "" {
+		return nil, ErrEmptyTargetModeratorID
+	}
+	if err := validatePermissions(permissions); err != nil {
+		return nil, err
+	}
+
+	g, err := s.ownedGame(ctx, gameID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if g.ModeratorID == moderatorID {
+		return nil, ErrCannotChangeOwner
+	}
+
+	existing, err := s.getCoModerator(ctx, gameID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.GameModerator.
+		UpdateOne(existing).
+		SetPermissions(permissionStrings(permissions)).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = recordModeratorAction(ctx, tx.Client(), gameID, ownerID, ActionUpdateModerator, map[string]any{
+		"moderator_id": moderatorID,
+		"permissions":  updated.Permissions,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.ModeratorUpdated{GameID: gameID, ModeratorID: moderatorID, Permissions: updated.Permissions})
+	info := coModeratorInfo(updated)
+	return &info, nil
+}
+
+// RemoveModerator revokes a co-moderator's access to a game
+// The owner can remove anyone, and co-moderators can remove themselves.
+func (s *ModeratorService) RemoveModerator(ctx context.Context, gameID string, actorID string, moderatorID string) error {
+	if moderatorID == "" {
+		return ErrEmptyTargetModeratorID
+	}
+
+	g, err := s.authorizedGame(ctx, gameID, actorID)
+	if err != nil {
+		return err
+	}
+	if g.ModeratorID == moderatorID {
+		return ErrCannotChangeOwner
+	}
+	if g.ModeratorID != actorID && actorID != moderatorID {
+		return ErrNotAuthorized
+	}
+
+	existing, err := s.getCoModerator(ctx, gameID, moderatorID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.GameModerator.DeleteOne(existing).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = recordModeratorAction(ctx, tx.Client(), gameID, actorID, ActionRemoveModerator, map[string]any{
+		"moderator_id": moderatorID,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	s.events.Publish(events.ModeratorRemoved{GameID: gameID, ModeratorID: moderatorID})
+	return nil
+}
+
+// TransferOwnership hands a game over to another moderator
+// The previous owner stays on as a co-moderator with every permission.
+func (s *ModeratorService) TransferOwnership(ctx context.Context, gameID string, ownerID string, newOwnerID string) (*ent.Game, error) {
+	if newOwnerID == "" {
+		return nil, ErrEmptyTargetModeratorID
+	}
+
+	g, err := s.ownedGame(ctx, gameID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if newOwnerID == ownerID {
+		return g, nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new owner no longer needs a co-moderator entry
+	_, err = tx.GameModerator.
+		Delete().
+		Where(
+			gamemoderator.GameID(gameID),
+			gamemoderator.ModeratorID(newOwnerID),
+		).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	updated, err := tx.Game.
+		UpdateOneID(gameID).
+		SetModeratorID(newOwnerID).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	_, err = tx.GameModerator.
+		Create().
+		SetGameID(gameID).
+		SetModeratorID(ownerID).
+		SetPermissions(permissionStrings(AllPermissions)).
+		SetAddedBy(ownerID).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = recordModeratorAction(ctx, tx.Client(), gameID, ownerID, ActionTransferOwnership, map[string]any{
+		"from": ownerID,
+		"to":   newOwnerID,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.OwnershipTransferred{Game: updated, PreviousOwner: ownerID})
+	return updated, nil
+}
+
+// GetActions returns the moderator audit log of a game, oldest first
+// Any moderator of the game may read it; co-moderators see the other
+// moderators by label, as in ListModerators.
+func (s *ModeratorService) GetActions(ctx context.Context, gameID string, moderatorID string) ([]*ent.ModeratorAction, error) {
+	g, err := s.authorizedGame(ctx, gameID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.IsModerator(ctx, g, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotAuthorized
+	}
+
+	actions, err := s.client.ModeratorAction.
+		Query().
+		Where(moderatoraction.GameID(gameID)).
+		Order(ent.Asc(moderatoraction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if moderatorID != g.ModeratorID {
+		for _, a := range actions {
+			a.ModeratorID = maskModeratorID(g.ID, a.ModeratorID, moderatorID)
+			if target, ok := a.Details["moderator_id"].(string); ok {
+				a.Details["moderator_id"] = maskModeratorID(g.ID, target, moderatorID)
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// maskModeratorID returns a label standing in for a moderator ID shown to
+// viewerID. Moderator IDs let their holder act as that moderator, so viewers
+// other than the owner only see their own.
+func maskModeratorID(gameID string, moderatorID string, viewerID string) string {
+	if moderatorID == viewerID {
+		return moderatorID
+	}
+	sum := sha256.Sum256([]byte(gameID + "/" + moderatorID))
+	return "moderator-" + hex.EncodeToString(sum[:4])
+}
+
+// authorizedGame validates the IDs and loads the game
+func (s *ModeratorService) authorizedGame(ctx context.Context, gameID string, moderatorID string) (*ent.Game, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	return s.client.Game.Get(ctx, gameID)
+}
+
+// ownedGame loads a game and verifies that the moderator owns it
+func (s *ModeratorService) ownedGame(ctx context.Context, gameID string, ownerID string) (*ent.Game, error) {
+	g, err := s.authorizedGame(ctx, gameID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if g.ModeratorID != ownerID {
+		return nil, ErrNotAuthorized
+	}
+
+	return g, nil
+}
+
+// getCoModerator loads the co-moderator entry of a moderator in a game
+func (s *ModeratorService) getCoModerator(ctx context.Context, gameID string, moderatorID string) (*ent.GameModerator, error) {
+	existing, err := s.client.GameModerator.
+		Query().
+		Where(
+			gamemoderator.GameID(gameID),
+			gamemoderator.ModeratorID(moderatorID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrModeratorNotFound
+		}
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// recordModeratorAction writes an audit log entry using the given client,
+// which may be bound to a transaction
+func recordModeratorAction(ctx context.Context, client *ent.Client, gameID string, moderatorID string, action string, details map[string]any) error {
+	create := client.ModeratorAction.
+		Create().
+		SetGameID(gameID).
+		SetModeratorID(moderatorID).
+		SetAction(action)
+
+	if details != nil {
+		create.SetDetails(details)
+	}
+
+	return create.Exec(ctx)
+}
+
+// validatePermissions rejects unknown permissions
+func validatePermissions(permissions []Permission) error {
+	for _, p := range permissions {
+		known := false
+		for _, candidate := range AllPermissions {
+			if p == candidate {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("%w: %s", ErrInvalidPermission, p)
+		}
+	}
+	return nil
+}
+
+func permissionStrings(permissions []Permission) []string {
+	result := make([]string, len(permissions))
+	for i, p := range permissions {
+		result[i] = string(p)
+	}
+	return result
+}
+
+func coModeratorInfo(m *ent.GameModerator) GameModeratorInfo {
+	permissions := make([]Permission, len(m.Permissions))
+	for i, p := range m.Permissions {
+		permissions[i] = Permission(p)
+	}
+	return GameModeratorInfo{
+		ModeratorID: m.ModeratorID,
+		Owner:       false,
+		Permissions: permissions,
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModeratorService_AddModerator(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := NewGameService(client)
+	service := NewModeratorService(client)
+	ctx := context.Background()
+
+	t.Run("owner adds a co-moderator", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		moderator, err := service.AddModerator(ctx, created.ID, "mod-123", "co-mod", []Permission{PermRunVotes})
+		require.NoError(t, err)
+		assert.Equal(t, "co-mod", moderator.ModeratorID)
+		assert.False(t, moderator.Owner)
+		assert.Equal(t, []Permission{PermRunVotes}, moderator.Permissions)
+
+		moderators, err := service.ListModerators(ctx, created.ID, "co-mod")
+		require.NoError(t, err)
+		require.Len(t, moderators, 2)
+		assert.True(t, moderators[0].Owner)
+		assert.NotEqual(t, "mod-123", moderators[0].ModeratorID, "co-moderators do not see the owner's ID")
+		assert.Equal(t, "co-mod", moderators[1].ModeratorID)
+	})
+
+	t.Run("co-moderator cannot add moderators", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", AllPermissions)
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "co-mod", "another-mod", nil)
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+
+	t.Run("rejects unknown permissions", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", []Permission{"launch_rockets"})
+		assert.ErrorIs(t, err, ErrInvalidPermission)
+	})
+
+	t.Run("rejects duplicate co-moderator", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", nil)
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", nil)
+		assert.ErrorIs(t, err, ErrModeratorExists)
+	})
+}
+
+func TestModeratorService_Permissions(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := NewGameService(client)
+	service := NewModeratorService(client)
+	ctx := context.Background()
+
+	t.Run("co-moderator acts within granted permissions", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", []Permission{PermManageGame})
+		require.NoError(t, err)
+
+		updated, err := gameService.UpdateGameStatus(ctx, created.ID, game.StatusActive, "co-mod")
+		require.NoError(t, err)
+		assert.Equal(t, game.StatusActive, updated.Status)
+
+		_, err = gameService.GetGameRoles(ctx, created.ID, "co-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+		assert.Contains(t, err.Error(), string(PermViewRoles))
+	})
+
+	t.Run("co-moderator cannot delete the game", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", AllPermissions)
+		require.NoError(t, err)
+
+		err = gameService.DeleteGame(ctx, created.ID, "co-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+
+	t.Run("updated permissions take effect", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", nil)
+		require.NoError(t, err)
+
+		_, err = gameService.GetGameRoles(ctx, created.ID, "co-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+
+		_, err = service.UpdateModeratorPermissions(ctx, created.ID, "mod-123", "co-mod", []Permission{PermViewRoles})
+		require.NoError(t, err)
+
+		_, err = gameService.GetGameRoles(ctx, created.ID, "co-mod")
+		assert.NoError(t, err)
+	})
+
+	t.Run("removed co-moderator loses access", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", AllPermissions)
+		require.NoError(t, err)
+
+		err = service.RemoveModerator(ctx, created.ID, "co-mod", "co-mod")
+		require.NoError(t, err)
+
+		_, err = gameService.UpdateGameStatus(ctx, created.ID, game.StatusActive, "co-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+}
+
+func TestModeratorService_TransferOwnership(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := NewGameService(client)
+	service := NewModeratorService(client)
+	ctx := context.Background()
+
+	t.Run("transfers ownership and keeps previous owner as co-moderator", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", []Permission{PermRunVotes})
+		require.NoError(t, err)
+
+		updated, err := service.TransferOwnership(ctx, created.ID, "mod-123", "co-mod")
+		require.NoError(t, err)
+		assert.Equal(t, "co-mod", updated.ModeratorID)
+
+		moderators, err := service.ListModerators(ctx, created.ID, "co-mod")
+		require.NoError(t, err)
+		require.Len(t, moderators, 2)
+		assert.Equal(t, "co-mod", moderators[0].ModeratorID)
+		assert.True(t, moderators[0].Owner)
+		assert.Equal(t, "mod-123", moderators[1].ModeratorID)
+		assert.ElementsMatch(t, AllPermissions, moderators[1].Permissions)
+
+		// The previous owner can no longer delete the game
+		err = gameService.DeleteGame(ctx, created.ID, "mod-123")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+
+	t.Run("only the owner can transfer", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.TransferOwnership(ctx, created.ID, "someone-else", "someone-else")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+}
+
+func TestModeratorService_GetActions(t *testing.T) {
+	client := database.SetupTestDB(t)
+	gameService := NewGameService(client)
+	service := NewModeratorService(client)
+	ctx := context.Background()
+
+	t.Run("records which moderator performed each action", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", []Permission{PermManageGame})
+		require.NoError(t, err)
+
+		_, err = gameService.UpdateGameStatus(ctx, created.ID, game.StatusActive, "co-mod")
+		require.NoError(t, err)
+
+		actions, err := service.GetActions(ctx, created.ID, "mod-123")
+		require.NoError(t, err)
+		require.Len(t, actions, 2)
+		assert.Equal(t, ActionAddModerator, actions[0].Action)
+		assert.Equal(t, "mod-123", actions[0].ModeratorID)
+		assert.Equal(t, ActionUpdateStatus, actions[1].Action)
+		assert.Equal(t, "co-mod", actions[1].ModeratorID)
+	})
+
+	t.Run("co-moderators do not see the other moderators' IDs", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "co-mod", nil)
+		require.NoError(t, err)
+		_, err = service.AddModerator(ctx, created.ID, "mod-123", "other-mod", []Permission{PermManageGame})
+		require.NoError(t, err)
+
+		moderators, err := service.ListModerators(ctx, created.ID, "co-mod")
+		require.NoError(t, err)
+		require.Len(t, moderators, 3)
+		assert.True(t, moderators[0].Owner)
+		assert.NotEqual(t, "mod-123", moderators[0].ModeratorID)
+		assert.Equal(t, "co-mod", moderators[1].ModeratorID)
+		assert.NotEqual(t, "other-mod", moderators[2].ModeratorID)
+
+		actions, err := service.GetActions(ctx, created.ID, "co-mod")
+		require.NoError(t, err)
+		require.Len(t, actions, 2)
+		for _, a := range actions {
+			assert.NotEqual(t, "mod-123", a.ModeratorID)
+			assert.NotEqual(t, "other-mod", a.Details["moderator_id"])
+		}
+		assert.Equal(t, moderators[0].ModeratorID, actions[0].ModeratorID, "labels are stable")
+
+		owned, err := service.ListModerators(ctx, created.ID, "mod-123")
+		require.NoError(t, err)
+		assert.Equal(t, "mod-123", owned[0].ModeratorID)
+	})
+
+	t.Run("non-moderators cannot read the log", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.GetActions(ctx, created.ID, "stranger")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+}
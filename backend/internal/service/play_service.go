@@ -0,0 +1,735 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/nightaction"
+	"github.com/mafia-night/backend/ent/player"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/vote"
+	"github.com/mafia-night/backend/internal/events"
+)
+
+var (
+	ErrGameNotActive       = errors.New("game is not active")
+	ErrWrongPhase          = errors.New("action is not allowed in the current phase")
+	ErrPlayerDead          = errors.New("player has been eliminated")
+	ErrInvalidTarget       = errors.New("target must be a living player of this game")
+	ErrNoRoleAssigned      = errors.New("player has no role assigned")
+	ErrEmptyChatMessage    = errors.New("chat message cannot be empty")
+	ErrChatMessageTooLong  = errors.New("chat message is too long")
+	ErrInvalidChannel      = errors.New("invalid chat channel")
+	ErrChannelNotAllowed   = errors.New("not allowed in this chat channel")
+	ErrPlayerMuted         = errors.New("player has been muted")
+	ErrChatRateLimited     = errors.New("too many chat messages, slow down")
+	ErrEmptyTargetPlayerID = errors.New("target player ID cannot be empty")
+)
+
+const (
+	// MaxChatMessageLength is the longest chat message a player may send, in characters
+	MaxChatMessageLength = 500
+
+	// ChatRateLimit is how many messages a player may send within ChatRateWindow
+	ChatRateLimit  = 5
+	ChatRateWindow = 10 * time.Second
+
+	// ChatHistoryLimit is how many of the latest messages of a channel are returned
+	ChatHistoryLimit = 100
+
+	// announcementSender is the sender name shown on moderator announcements
+	announcementSender = "Moderator"
+)
+
+// ChatReader identifies who is reading a chat channel
+// A reader with neither ID set is the public, who may only read announcements.
+type ChatReader struct {
+	PlayerID    string
+	ModeratorID string
+}
+
+// PlayService handles what players do during a game: getting ready, voting,
+// night actions and chat, and the moderator moving the game between phases
+type PlayService struct {
+	client     *ent.Client
+	moderators *ModeratorService
+	events     *events.Bus
+}
+
+// NewPlayService creates a new play service
+func NewPlayService(client *ent.Client) *PlayService {
+	return &PlayService{
+		client:     client,
+		moderators: NewModeratorService(client),
+	}
+}
+
+// WithEvents makes the service publish an event on bus after each change
+func (s *PlayService) WithEvents(bus *events.Bus) *PlayService {
+	s.events = bus
+	return s
+}
+
+// SetReady marks a player as ready, or not, for the game to start
+func (s *PlayService) SetReady(ctx context.Context, gameID string, playerID string, ready bool) (*ent.Player, error) {
+	g, p, err := s.gamePlayer(ctx, gameID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.Status != game.StatusPending {
+		return nil, ErrGameAlreadyStarted
+	}
+
+	updated, err := s.client.Player.
+		UpdateOne(p).
+		SetReady(ready).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.PlayerUpdated{Player: updated})
+	return updated, nil
+}
+
+// AdvancePhase moves an active game from day to night, or from night to the next day
+// Each day holds a vote, so moving between phases runs the votes.
+func (s *PlayService) AdvancePhase(ctx context.Context, gameID string, moderatorID string) (*ent.Game, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	existingGame, err := s.client.Game.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, existingGame, moderatorID, PermRunVotes); err != nil {
+		return nil, err
+	}
+
+	if existingGame.Status != game.StatusActive {
+		return nil, ErrGameNotActive
+	}
+
+	phase := game.PhaseNight
+	round := existingGame.Round
+	if existingGame.Phase == game.PhaseNight {
+		phase = game.PhaseDay
+		round++
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.Game.
+		UpdateOne(existingGame).
+		SetPhase(phase).
+		SetRound(round).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, ActionAdvancePhase, map[string]any{
+		"phase": phase,
+		"round": round,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.PhaseChanged{Game: updated})
+	return updated, nil
+}
+
+// CastVote records a living player's day vote against another living player
+// Voting again on the same day replaces the earlier vote.
+func (s *PlayService) CastVote(ctx context.Context, gameID string, playerID string, targetID string) (*ent.Vote, error) {
+	g, voter, target, err := s.actorAndTarget(ctx, gameID, playerID, targetID, game.PhaseDay)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.saveVote(ctx, g, voter, target)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.VoteCast{Vote: v})
+	return v, nil
+}
+
+// saveVote stores a vote, replacing the voter's earlier vote of the day
+func (s *PlayService) saveVote(ctx context.Context, g *ent.Game, voter *ent.Player, target *ent.Player) (*ent.Vote, error) {
+	existing, err := s.client.Vote.
+		Query().
+		Where(
+			vote.GameID(g.ID),
+			vote.Round(g.Round),
+			vote.VoterID(voter.ID),
+		).
+		Only(ctx)
+	if err == nil {
+		return s.client.Vote.
+			UpdateOne(existing).
+			SetTargetID(target.ID).
+			Save(ctx)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return s.client.Vote.
+		Create().
+		SetGameID(g.ID).
+		SetRound(g.Round).
+		SetVoterID(voter.ID).
+		SetTargetID(target.ID).
+		Save(ctx)
+}
+
+// GetVotes returns the votes cast on the current day
+func (s *PlayService) GetVotes(ctx context.Context, gameID string) ([]*ent.Vote, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+
+	g, err := s.client.Game.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Vote.
+		Query().
+		Where(vote.GameID(gameID), vote.Round(g.Round)).
+		Order(ent.Asc(vote.FieldCreatedAt)).
+		All(ctx)
+}
+
+// SubmitNightAction records the target a living player with a role chose for the night
+// Submitting again on the same night replaces the earlier choice.
+func (s *PlayService) SubmitNightAction(ctx context.Context, gameID string, playerID string, targetID string, action string) (*ent.NightAction, error) {
+	g, p, target, err := s.actorAndTarget(ctx, gameID, playerID, targetID, game.PhaseNight)
+	if err != nil {
+		return nil, err
+	}
+
+	hasRole, err := s.client.GameRole.
+		Query().
+		Where(gamerole.GameID(g.ID), gamerole.PlayerID(p.ID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !hasRole {
+		return nil, ErrNoRoleAssigned
+	}
+
+	a, err := s.saveNightAction(ctx, g, p, target, action)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.NightActionSubmitted{Action: a})
+	return a, nil
+}
+
+// saveNightAction stores a night action, replacing the player's earlier choice of the night
+func (s *PlayService) saveNightAction(ctx context.Context, g *ent.Game, p *ent.Player, target *ent.Player, action string) (*ent.NightAction, error) {
+	existing, err := s.client.NightAction.
+		Query().
+		Where(
+			nightaction.GameID(g.ID),
+			nightaction.Round(g.Round),
+			nightaction.PlayerID(p.ID),
+		).
+		Only(ctx)
+	if err == nil {
+		return s.client.NightAction.
+			UpdateOne(existing).
+			SetTargetID(target.ID).
+			SetAction(action).
+			Save(ctx)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return s.client.NightAction.
+		Create().
+		SetGameID(g.ID).
+		SetRound(g.Round).
+		SetPlayerID(p.ID).
+		SetTargetID(target.ID).
+		SetAction(action).
+		Save(ctx)
+}
+
+// GetNightActions returns the night actions of the current round
+// Night actions reveal roles, so only moderators who may view roles can see them.
+func (s *PlayService) GetNightActions(ctx context.Context, gameID string, moderatorID string) ([]*ent.NightAction, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	g, err := s.client.Game.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, g, moderatorID, PermViewRoles); err != nil {
+		return nil, err
+	}
+
+	return s.client.NightAction.
+		Query().
+		Where(nightaction.GameID(gameID), nightaction.Round(g.Round)).
+		Order(ent.Asc(nightaction.FieldCreatedAt)).
+		All(ctx)
+}
+
+// SendChat stores a chat message from a player and delivers it to the channel
+//
+// Who may write depends on the channel: living players talk in the day
+// channel outside the night, the living mafia in the mafia channel at night
+// and eliminated players in the dead channel. Muted players may not write at all.
+func (s *PlayService) SendChat(ctx context.Context, gameID string, playerID string, channel chatmessage.Channel, text string) (*ent.ChatMessage, error) {
+	text, err := chatText(text)
+	if err != nil {
+		return nil, err
+	}
+	if err := chatmessage.ChannelValidator(channel); err != nil {
+		return nil, ErrInvalidChannel
+	}
+
+	g, p, err := s.gamePlayer(ctx, gameID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Muted {
+		return nil, ErrPlayerMuted
+	}
+
+	team, err := s.playerTeam(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if !canWriteChat(g, p, team, channel) {
+		return nil, ErrChannelNotAllowed
+	}
+
+	message, err := s.saveChat(ctx, g, p, channel, text)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := s.chatRecipients(ctx, g.ID, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.ChatMessageSent{Message: message, Recipients: recipients})
+	return message, nil
+}
+
+// saveChat stores a player's chat message unless it goes over the rate limit
+func (s *PlayService) saveChat(ctx context.Context, g *ent.Game, p *ent.Player, channel chatmessage.Channel, text string) (*ent.ChatMessage, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Updating the player row locks it until the message is stored, so messages
+	// the player sends at once are counted one after another. The update also
+	// catches the player being muted meanwhile.
+	locked, err := tx.Player.
+		Update().
+		Where(player.ID(p.ID), player.Muted(false)).
+		SetMuted(false).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if locked == 0 {
+		tx.Rollback()
+		return nil, ErrPlayerMuted
+	}
+
+	recent, err := tx.ChatMessage.
+		Query().
+		Where(
+			chatmessage.PlayerID(p.ID),
+			chatmessage.CreatedAtGT(time.Now().Add(-ChatRateWindow)),
+		).
+		Count(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if recent >= ChatRateLimit {
+		tx.Rollback()
+		return nil, ErrChatRateLimited
+	}
+
+	message, err := tx.ChatMessage.
+		Create().
+		SetGameID(g.ID).
+		SetChannel(channel).
+		SetRound(g.Round).
+		SetPlayerID(p.ID).
+		SetSenderName(p.Name).
+		SetText(text).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// SendAnnouncement stores a moderator announcement, which everyone in the game can read
+func (s *PlayService) SendAnnouncement(ctx context.Context, gameID string, moderatorID string, text string) (*ent.ChatMessage, error) {
+	text, err := chatText(text)
+	if err != nil {
+		return nil, err
+	}
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	g, err := s.client.Game.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, g, moderatorID, PermManageGame); err != nil {
+		return nil, err
+	}
+
+	message, err := s.client.ChatMessage.
+		Create().
+		SetGameID(g.ID).
+		SetChannel(chatmessage.ChannelAnnouncements).
+		SetRound(g.Round).
+		SetModeratorID(moderatorID).
+		SetSenderName(announcementSender).
+		SetText(text).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.ChatMessageSent{Message: message})
+	return message, nil
+}
+
+// GetChat returns the latest messages of a channel, oldest first, if reader may read it
+// Moderators read every channel, though the mafia and dead channels need the
+// view_roles permission since they reveal who is who.
+func (s *PlayService) GetChat(ctx context.Context, gameID string, channel chatmessage.Channel, reader ChatReader) ([]*ent.ChatMessage, error) {
+	if gameID == "" {
+		return nil, ErrEmptyGameID
+	}
+	if err := chatmessage.ChannelValidator(channel); err != nil {
+		return nil, ErrInvalidChannel
+	}
+
+	g, err := s.client.Game.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case reader.ModeratorID != "":
+		if channel == chatmessage.ChannelMafia || channel == chatmessage.ChannelDead {
+			if err := s.moderators.Authorize(ctx, g, reader.ModeratorID, PermViewRoles); err != nil {
+				return nil, err
+			}
+			break
+		}
+		isModerator, err := s.moderators.IsModerator(ctx, g, reader.ModeratorID)
+		if err != nil {
+			return nil, err
+		}
+		if !isModerator {
+			return nil, ErrNotAuthorized
+		}
+	case reader.PlayerID != "":
+		_, p, err := s.gamePlayer(ctx, gameID, reader.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		team, err := s.playerTeam(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if !canReadChat(p, team, channel) {
+			return nil, ErrChannelNotAllowed
+		}
+	case channel != chatmessage.ChannelAnnouncements:
+		return nil, ErrChannelNotAllowed
+	}
+
+	messages, err := s.client.ChatMessage.
+		Query().
+		Where(chatmessage.GameID(gameID), chatmessage.ChannelEQ(channel)).
+		Order(ent.Desc(chatmessage.FieldCreatedAt)).
+		Limit(ChatHistoryLimit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(messages)
+	return messages, nil
+}
+
+// SetMuted mutes a player in every chat channel, or lets them talk again
+func (s *PlayService) SetMuted(ctx context.Context, gameID string, moderatorID string, playerID string, muted bool) (*ent.Player, error) {
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	g, p, err := s.gamePlayer(ctx, gameID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moderators.Authorize(ctx, g, moderatorID, PermManagePlayers); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.Player.
+		UpdateOne(p).
+		SetMuted(muted).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	action := ActionUnmutePlayer
+	if muted {
+		action = ActionMutePlayer
+	}
+	err = recordModeratorAction(ctx, tx.Client(), gameID, moderatorID, action, map[string]any{
+		"player_id": playerID,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.events.Publish(events.PlayerUpdated{Player: updated})
+	return updated, nil
+}
+
+// chatText trims a chat message and checks its length
+func chatText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", ErrEmptyChatMessage
+	}
+	if len([]rune(text)) > MaxChatMessageLength {
+		return "", ErrChatMessageTooLong
+	}
+	return text, nil
+}
+
+// canWriteChat reports whether a player may send a message to a channel
+func canWriteChat(g *ent.Game, p *ent.Player, team role.Team, channel chatmessage.Channel) bool {
+	active := g.Status == game.StatusActive
+	switch channel {
+	case chatmessage.ChannelDay:
+		// Anyone may chat before and after the game; during it only the living, by day
+		return !active || (p.Alive && g.Phase == game.PhaseDay)
+	case chatmessage.ChannelMafia:
+		return active && p.Alive && team == role.TeamMafia && g.Phase == game.PhaseNight
+	case chatmessage.ChannelDead:
+		return !p.Alive
+	default:
+		return false
+	}
+}
+
+// canReadChat reports whether a player may read a channel
+func canReadChat(p *ent.Player, team role.Team, channel chatmessage.Channel) bool {
+	switch channel {
+	case chatmessage.ChannelDay, chatmessage.ChannelAnnouncements:
+		return true
+	case chatmessage.ChannelMafia:
+		return p.Alive && team == role.TeamMafia
+	case chatmessage.ChannelDead:
+		return !p.Alive
+	default:
+		return false
+	}
+}
+
+// chatRecipients returns the players who may read a private channel, or nil
+// for channels everyone in the game may read
+func (s *PlayService) chatRecipients(ctx context.Context, gameID string, channel chatmessage.Channel) ([]uuid.UUID, error) {
+	if channel == chatmessage.ChannelDay || channel == chatmessage.ChannelAnnouncements {
+		return nil, nil
+	}
+
+	players, err := s.client.Player.
+		Query().
+		Where(player.GameID(gameID)).
+		WithGameRole(func(q *ent.GameRoleQuery) { q.WithRole() }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]uuid.UUID, 0)
+	for _, p := range players {
+		var team role.Team
+		if gr := p.Edges.GameRole; gr != nil && gr.Edges.Role != nil {
+			team = gr.Edges.Role.Team
+		}
+		if canReadChat(p, team, channel) {
+			recipients = append(recipients, p.ID)
+		}
+	}
+	return recipients, nil
+}
+
+// playerTeam returns the team of a player's role, or "" before roles are distributed
+func (s *PlayService) playerTeam(ctx context.Context, p *ent.Player) (role.Team, error) {
+	gr, err := s.client.GameRole.
+		Query().
+		Where(gamerole.GameID(p.GameID), gamerole.PlayerID(p.ID)).
+		WithRole().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if gr.Edges.Role == nil {
+		return "", nil
+	}
+	return gr.Edges.Role.Team, nil
+}
+
+// gamePlayer loads a game and one of its players
+func (s *PlayService) gamePlayer(ctx context.Context, gameID string, playerID string) (*ent.Game, *ent.Player, error) {
+	if gameID == "" {
+		return nil, nil, ErrEmptyGameID
+	}
+	if playerID == "" {
+		return nil, nil, ErrEmptyPlayerID
+	}
+
+	g, err := s.client.Game.Get(ctx, gameID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p, err := s.player(ctx, gameID, playerID)
+	if err != nil {
+		if errors.Is(err, ErrInvalidTarget) {
+			return nil, nil, ErrPlayerNotInGame
+		}
+		return nil, nil, err
+	}
+
+	return g, p, nil
+}
+
+// actorAndTarget loads an active game in the given phase together with a living
+// player acting on another living player
+func (s *PlayService) actorAndTarget(ctx context.Context, gameID string, playerID string, targetID string, phase game.Phase) (*ent.Game, *ent.Player, *ent.Player, error) {
+	if targetID == "" {
+		return nil, nil, nil, ErrEmptyTargetPlayerID
+	}
+
+	g, actor, err := s.gamePlayer(ctx, gameID, playerID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if g.Status != game.StatusActive {
+		return nil, nil, nil, ErrGameNotActive
+	}
+	if g.Phase != phase {
+		return nil, nil, nil, ErrWrongPhase
+	}
+	if !actor.Alive {
+		return nil, nil, nil, ErrPlayerDead
+	}
+
+	target, err := s.player(ctx, gameID, targetID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !target.Alive {
+		return nil, nil, nil, ErrInvalidTarget
+	}
+
+	return g, actor, target, nil
+}
+
+// player loads a player of the game, reporting ErrInvalidTarget if there is none
+func (s *PlayService) player(ctx context.Context, gameID string, playerID string) (*ent.Player, error) {
+	playerUUID, err := uuid.Parse(playerID)
+	if err != nil {
+		return nil, ErrInvalidTarget
+	}
+
+	p, err := s.client.Player.Get(ctx, playerUUID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidTarget
+		}
+		return nil, err
+	}
+	if p.GameID != gameID {
+		return nil, ErrInvalidTarget
+	}
+
+	return p, nil
+}
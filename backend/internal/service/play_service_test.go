@@ -0,0 +1,452 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/chatmessage"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startTestGame creates an active game with three players who all have a role
+func startTestGame(t *testing.T, client *ent.Client, slug string) (*ent.Game, []*ent.Player) {
+	ctx := context.Background()
+	gameService := NewGameService(client)
+	roleService := NewRoleService(client)
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+
+	var players []*ent.Player
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		p, err := gameService.JoinGame(ctx, created.ID, name)
+		require.NoError(t, err)
+		players = append(players, p)
+	}
+
+	villager, err := roleService.CreateRole(ctx, "Villager "+slug, slug, "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	err = gameService.DistributeRoles(ctx, created.ID, "mod-123", []RoleSelection{
+		{RoleID: villager.ID.String(), Count: 3},
+	})
+	require.NoError(t, err)
+
+	// Distributing roles starts the game
+	started, err := gameService.GetGameByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, game.StatusActive, started.Status)
+
+	return started, players
+}
+
+func TestPlayService_SetReady(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewPlayService(client)
+	gameService := NewGameService(client)
+	ctx := context.Background()
+
+	t.Run("marks player ready before the game starts", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		p, err := gameService.JoinGame(ctx, created.ID, "Alice")
+		require.NoError(t, err)
+
+		updated, err := service.SetReady(ctx, created.ID, p.ID.String(), true)
+		require.NoError(t, err)
+		assert.True(t, updated.Ready)
+	})
+
+	t.Run("fails once the game has started", func(t *testing.T) {
+		started, players := startTestGame(t, client, "ready-villager")
+
+		_, err := service.SetReady(ctx, started.ID, players[0].ID.String(), true)
+		assert.ErrorIs(t, err, ErrGameAlreadyStarted)
+	})
+}
+
+func TestPlayService_AdvancePhase(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewPlayService(client)
+	gameService := NewGameService(client)
+	ctx := context.Background()
+
+	t.Run("alternates day and night", func(t *testing.T) {
+		started, _ := startTestGame(t, client, "phase-villager")
+		assert.Equal(t, game.PhaseDay, started.Phase)
+		assert.Equal(t, 1, started.Round)
+
+		night, err := service.AdvancePhase(ctx, started.ID, "mod-123")
+		require.NoError(t, err)
+		assert.Equal(t, game.PhaseNight, night.Phase)
+		assert.Equal(t, 1, night.Round)
+
+		day, err := service.AdvancePhase(ctx, started.ID, "mod-123")
+		require.NoError(t, err)
+		assert.Equal(t, game.PhaseDay, day.Phase)
+		assert.Equal(t, 2, day.Round)
+	})
+
+	t.Run("fails before the game starts", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AdvancePhase(ctx, created.ID, "mod-123")
+		assert.ErrorIs(t, err, ErrGameNotActive)
+	})
+
+	t.Run("fails when moderator ID doesn't match", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.AdvancePhase(ctx, created.ID, "different-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+
+	t.Run("co-moderators need the run votes permission", func(t *testing.T) {
+		started, _ := startTestGame(t, client, "phase-co-mod")
+		_, err := service.moderators.AddModerator(ctx, started.ID, "mod-123", "vote-runner", []Permission{PermRunVotes})
+		require.NoError(t, err)
+		_, err = service.moderators.AddModerator(ctx, started.ID, "mod-123", "role-viewer", []Permission{PermViewRoles})
+		require.NoError(t, err)
+
+		_, err = service.AdvancePhase(ctx, started.ID, "role-viewer")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+
+		night, err := service.AdvancePhase(ctx, started.ID, "vote-runner")
+		require.NoError(t, err)
+		assert.Equal(t, game.PhaseNight, night.Phase)
+	})
+}
+
+func TestPlayService_CastVote(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewPlayService(client)
+	gameService := NewGameService(client)
+	ctx := context.Background()
+
+	t.Run("replaces an earlier vote on the same day", func(t *testing.T) {
+		started, players := startTestGame(t, client, "vote-villager")
+
+		_, err := service.CastVote(ctx, started.ID, players[0].ID.String(), players[1].ID.String())
+		require.NoError(t, err)
+		v, err := service.CastVote(ctx, started.ID, players[0].ID.String(), players[2].ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, players[2].ID, v.TargetID)
+
+		votes, err := service.GetVotes(ctx, started.ID)
+		require.NoError(t, err)
+		require.Len(t, votes, 1)
+		assert.Equal(t, players[2].ID, votes[0].TargetID)
+	})
+
+	t.Run("fails at night", func(t *testing.T) {
+		started, players := startTestGame(t, client, "night-vote-villager")
+		_, err := service.AdvancePhase(ctx, started.ID, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.CastVote(ctx, started.ID, players[0].ID.String(), players[1].ID.String())
+		assert.ErrorIs(t, err, ErrWrongPhase)
+	})
+
+	t.Run("fails for eliminated players and targets", func(t *testing.T) {
+		started, players := startTestGame(t, client, "dead-vote-villager")
+		_, err := gameService.SetPlayerAlive(ctx, started.ID, "mod-123", players[1].ID.String(), false)
+		require.NoError(t, err)
+
+		_, err = service.CastVote(ctx, started.ID, players[1].ID.String(), players[0].ID.String())
+		assert.ErrorIs(t, err, ErrPlayerDead)
+
+		_, err = service.CastVote(ctx, started.ID, players[0].ID.String(), players[1].ID.String())
+		assert.ErrorIs(t, err, ErrInvalidTarget)
+	})
+}
+
+func TestPlayService_SubmitNightAction(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewPlayService(client)
+	ctx := context.Background()
+
+	t.Run("records a night action visible to the moderator", func(t *testing.T) {
+		started, players := startTestGame(t, client, "night-villager")
+		_, err := service.AdvancePhase(ctx, started.ID, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.SubmitNightAction(ctx, started.ID, players[0].ID.String(), players[1].ID.String(), "protect")
+		require.NoError(t, err)
+
+		actions, err := service.GetNightActions(ctx, started.ID, "mod-123")
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		assert.Equal(t, "protect", actions[0].Action)
+
+		_, err = service.GetNightActions(ctx, started.ID, "different-mod")
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+	})
+
+	t.Run("fails during the day", func(t *testing.T) {
+		started, players := startTestGame(t, client, "day-night-villager")
+
+		_, err := service.SubmitNightAction(ctx, started.ID, players[0].ID.String(), players[1].ID.String(), "")
+		assert.ErrorIs(t, err, ErrWrongPhase)
+	})
+}
+
+func TestPlayService_SendChat(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewPlayService(client)
+	gameService := NewGameService(client)
+	ctx := context.Background()
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	p, err := gameService.JoinGame(ctx, created.ID, "Alice")
+	require.NoError(t, err)
+
+	t.Run("stores a trimmed message", func(t *testing.T) {
+		message, err := service.SendChat(ctx, created.ID, p.ID.String(), chatmessage.ChannelDay, "  hello  ")
+		require.NoError(t, err)
+		assert.Equal(t, "hello", message.Text)
+		assert.Equal(t, "Alice", message.SenderName)
+
+		history, err := service.GetChat(ctx, created.ID, chatmessage.ChannelDay, ChatReader{PlayerID: p.ID.String()})
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, message.ID, history[0].ID)
+	})
+
+	t.Run("fails with empty or long messages", func(t *testing.T) {
+		_, err := service.SendChat(ctx, created.ID, p.ID.String(), chatmessage.ChannelDay, "   ")
+		assert.ErrorIs(t, err, ErrEmptyChatMessage)
+
+		_, err = service.SendChat(ctx, created.ID, p.ID.String(), chatmessage.ChannelDay, strings.Repeat("a", MaxChatMessageLength+1))
+		assert.ErrorIs(t, err, ErrChatMessageTooLong)
+	})
+
+	t.Run("fails with an unknown channel", func(t *testing.T) {
+		_, err := service.SendChat(ctx, created.ID, p.ID.String(), "lobby", "hello")
+		assert.ErrorIs(t, err, ErrInvalidChannel)
+	})
+
+	t.Run("fails for players of another game", func(t *testing.T) {
+		other, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.SendChat(ctx, other.ID, p.ID.String(), chatmessage.ChannelDay, "hello")
+		assert.ErrorIs(t, err, ErrPlayerNotInGame)
+	})
+
+	t.Run("limits how fast a player may send", func(t *testing.T) {
+		chatty, err := gameService.JoinGame(ctx, created.ID, "Chatty")
+		require.NoError(t, err)
+
+		for i := 0; i < ChatRateLimit; i++ {
+			_, err := service.SendChat(ctx, created.ID, chatty.ID.String(), chatmessage.ChannelDay, "hello")
+			require.NoError(t, err)
+		}
+		_, err = service.SendChat(ctx, created.ID, chatty.ID.String(), chatmessage.ChannelDay, "hello")
+		assert.ErrorIs(t, err, ErrChatRateLimited)
+	})
+
+	t.Run("limits messages sent at once", func(t *testing.T) {
+		burst, err := gameService.JoinGame(ctx, created.ID, "Burst")
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		errs := make([]error, ChatRateLimit+3)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = service.SendChat(ctx, created.ID, burst.ID.String(), chatmessage.ChannelDay, "hello")
+			}()
+		}
+		wg.Wait()
+
+		sent := 0
+		for _, err := range errs {
+			if err == nil {
+				sent++
+				continue
+			}
+			assert.ErrorIs(t, err, ErrChatRateLimited)
+		}
+		assert.Equal(t, ChatRateLimit, sent)
+	})
+
+	t.Run("muted players cannot send", func(t *testing.T) {
+		rude, err := gameService.JoinGame(ctx, created.ID, "Rude")
+		require.NoError(t, err)
+
+		_, err = service.SetMuted(ctx, created.ID, "different-mod", rude.ID.String(), true)
+		assert.ErrorIs(t, err, ErrNotAuthorized)
+
+		muted, err := service.SetMuted(ctx, created.ID, "mod-123", rude.ID.String(), true)
+		require.NoError(t, err)
+		assert.True(t, muted.Muted)
+
+		_, err = service.SendChat(ctx, created.ID, rude.ID.String(), chatmessage.ChannelDay, "hello")
+		assert.ErrorIs(t, err, ErrPlayerMuted)
+
+		_, err = service.SetMuted(ctx, created.ID, "mod-123", rude.ID.String(), false)
+		require.NoError(t, err)
+		_, err = service.SendChat(ctx, created.ID, rude.ID.String(), chatmessage.ChannelDay, "sorry")
+		assert.NoError(t, err)
+	})
+}
+
+func TestPlayService_ChatChannels(t *testing.T) {
+	client := database.SetupTestDB(t)
+	bus := events.NewBus()
+	service := NewPlayService(client).WithEvents(bus)
+	gameService := NewGameService(client)
+	roleService := NewRoleService(client)
+	ctx := context.Background()
+
+	var sent []events.ChatMessageSent
+	bus.Subscribe(func(e events.Event) {
+		if e, ok := e.(events.ChatMessageSent); ok {
+			sent = append(sent, e)
+		}
+	})
+
+	created, err := gameService.CreateGame(ctx, "mod-123")
+	require.NoError(t, err)
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		_, err := gameService.JoinGame(ctx, created.ID, name)
+		require.NoError(t, err)
+	}
+
+	mafia, err := roleService.CreateRole(ctx, "Chat Mafia", "chat-mafia", "video", "", role.TeamMafia, nil)
+	require.NoError(t, err)
+	villager, err := roleService.CreateRole(ctx, "Chat Villager", "chat-villager", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	err = gameService.DistributeRoles(ctx, created.ID, "mod-123", []RoleSelection{
+		{RoleID: mafia.ID.String(), Count: 1},
+		{RoleID: villager.ID.String(), Count: 2},
+	})
+	require.NoError(t, err)
+
+	// Roles are dealt at random, so look up who got which
+	assignments, err := client.GameRole.Query().Where(gamerole.GameID(created.ID)).All(ctx)
+	require.NoError(t, err)
+	var mafioso string
+	var villagers []string
+	for _, a := range assignments {
+		if a.RoleID == mafia.ID {
+			mafioso = a.PlayerID.String()
+		} else {
+			villagers = append(villagers, a.PlayerID.String())
+		}
+	}
+
+	t.Run("the mafia only talk at night", func(t *testing.T) {
+		_, err := service.SendChat(ctx, created.ID, mafioso, chatmessage.ChannelMafia, "who tonight?")
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+
+		_, err = service.AdvancePhase(ctx, created.ID, "mod-123")
+		require.NoError(t, err)
+
+		_, err = service.SendChat(ctx, created.ID, mafioso, chatmessage.ChannelMafia, "who tonight?")
+		require.NoError(t, err)
+		bus.Flush()
+
+		last := sent[len(sent)-1]
+		assert.Equal(t, []uuid.UUID{uuid.MustParse(mafioso)}, last.Recipients)
+	})
+
+	t.Run("villagers cannot use the mafia channel or talk at night", func(t *testing.T) {
+		_, err := service.SendChat(ctx, created.ID, villagers[0], chatmessage.ChannelMafia, "hello?")
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+
+		_, err = service.SendChat(ctx, created.ID, villagers[0], chatmessage.ChannelDay, "anyone awake?")
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+
+		_, err = service.GetChat(ctx, created.ID, chatmessage.ChannelMafia, ChatReader{PlayerID: villagers[0]})
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+	})
+
+	t.Run("the dead talk among themselves", func(t *testing.T) {
+		_, err := service.SendChat(ctx, created.ID, villagers[0], chatmessage.ChannelDead, "hello?")
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+
+		_, err = gameService.SetPlayerAlive(ctx, created.ID, "mod-123", villagers[0], false)
+		require.NoError(t, err)
+
+		_, err = service.SendChat(ctx, created.ID, villagers[0], chatmessage.ChannelDead, "it was Bob")
+		require.NoError(t, err)
+		bus.Flush()
+		assert.Equal(t, []uuid.UUID{uuid.MustParse(villagers[0])}, sent[len(sent)-1].Recipients)
+
+		_, err = service.GetChat(ctx, created.ID, chatmessage.ChannelDead, ChatReader{PlayerID: villagers[1]})
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+	})
+
+	t.Run("moderators read every channel and announce", func(t *testing.T) {
+		history, err := service.GetChat(ctx, created.ID, chatmessage.ChannelMafia, ChatReader{ModeratorID: "mod-123"})
+		require.NoError(t, err)
+		assert.Len(t, history, 1)
+
+		announcement, err := service.SendAnnouncement(ctx, created.ID, "mod-123", "Night falls")
+		require.NoError(t, err)
+		assert.Nil(t, announcement.PlayerID)
+		bus.Flush()
+		assert.Nil(t, sent[len(sent)-1].Recipients)
+
+		_, err = service.SendChat(ctx, created.ID, mafioso, chatmessage.ChannelAnnouncements, "I am the moderator")
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+	})
+
+	t.Run("the public only reads announcements", func(t *testing.T) {
+		history, err := service.GetChat(ctx, created.ID, chatmessage.ChannelAnnouncements, ChatReader{})
+		require.NoError(t, err)
+		assert.Len(t, history, 1)
+
+		_, err = service.GetChat(ctx, created.ID, chatmessage.ChannelDay, ChatReader{})
+		assert.ErrorIs(t, err, ErrChannelNotAllowed)
+	})
+}
+
+func TestCanWriteChat(t *testing.T) {
+	pending := &ent.Game{Status: game.StatusPending, Phase: game.PhaseDay}
+	day := &ent.Game{Status: game.StatusActive, Phase: game.PhaseDay}
+	night := &ent.Game{Status: game.StatusActive, Phase: game.PhaseNight}
+	alive := &ent.Player{Alive: true}
+	dead := &ent.Player{Alive: false}
+
+	tests := []struct {
+		name    string
+		game    *ent.Game
+		player  *ent.Player
+		team    role.Team
+		channel chatmessage.Channel
+		want    bool
+	}{
+		{"lobby chat", pending, alive, "", chatmessage.ChannelDay, true},
+		{"day chat", day, alive, role.TeamVillage, chatmessage.ChannelDay, true},
+		{"day chat at night", night, alive, role.TeamVillage, chatmessage.ChannelDay, false},
+		{"day chat when dead", day, dead, role.TeamVillage, chatmessage.ChannelDay, false},
+		{"mafia at night", night, alive, role.TeamMafia, chatmessage.ChannelMafia, true},
+		{"mafia by day", day, alive, role.TeamMafia, chatmessage.ChannelMafia, false},
+		{"villager in mafia channel", night, alive, role.TeamVillage, chatmessage.ChannelMafia, false},
+		{"dead mafia in mafia channel", night, dead, role.TeamMafia, chatmessage.ChannelMafia, false},
+		{"dead channel", day, dead, role.TeamVillage, chatmessage.ChannelDead, true},
+		{"living in dead channel", day, alive, role.TeamVillage, chatmessage.ChannelDead, false},
+		{"player announcing", day, alive, role.TeamVillage, chatmessage.ChannelAnnouncements, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canWriteChat(tt.game, tt.player, tt.team, tt.channel))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/game"
+	"github.com/mafia-night/backend/ent/gamerole"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+)
+
+var (
+	ErrRoleArchived = errors.New("role is archived")
+	ErrRoleInUse    = errors.New("role is used by games or templates; archive it instead")
+)
+
+// RoleUsage reports what still references a role
+type RoleUsage struct {
+	// Templates lists the templates dealing the role, by name
+	Templates []TemplateUsage
+	// Games counts the games the role was dealt in
+	Games int
+}
+
+// InUse tells whether anything references the role
+func (u *RoleUsage) InUse() bool {
+	return u.Games > 0 || len(u.Templates) > 0
+}
+
+// TemplateUsage is how many copies of a role a template deals
+type TemplateUsage struct {
+	Template *ent.RoleTemplate
+	// Count is the number of core copies
+	Count int
+	// OptionalCount is the number of optional slots holding the role
+	OptionalCount int
+}
+
+// GetRoleUsage reports the templates and games referencing a role, so an
+// admin can see what archiving it affects
+func (s *RoleService) GetRoleUsage(ctx context.Context, id uuid.UUID) (*RoleUsage, error) {
+	if _, err := s.GetRoleByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	templates, err := s.client.RoleTemplate.
+		Query().
+		Where(roletemplate.Or(
+			roletemplate.HasTemplateRolesWith(roletemplaterole.RoleIDEQ(id)),
+			roletemplate.HasOptionalSlotsWith(roletemplateslot.RoleIDEQ(id)),
+		)).
+		WithTemplateRoles(func(q *ent.RoleTemplateRoleQuery) {
+			q.Where(roletemplaterole.RoleIDEQ(id))
+		}).
+		WithOptionalSlots(func(q *ent.RoleTemplateSlotQuery) {
+			q.Where(roletemplateslot.RoleIDEQ(id))
+		}).
+		Order(ent.Asc(roletemplate.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := s.client.Game.
+		Query().
+		Where(game.HasGameRolesWith(gamerole.RoleIDEQ(id))).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &RoleUsage{Templates: make([]TemplateUsage, len(templates)), Games: games}
+	for i, t := range templates {
+		usage.Templates[i] = TemplateUsage{Template: t, OptionalCount: len(t.Edges.OptionalSlots)}
+		for _, tr := range t.Edges.TemplateRoles {
+			usage.Templates[i].Count += tr.Count
+		}
+	}
+	return usage, nil
+}
+
+// ArchiveRole hides a role from new templates and distributions. Past games
+// and the templates already dealing it keep the role as it is.
+func (s *RoleService) ArchiveRole(ctx context.Context, id uuid.UUID) (*ent.Role, error) {
+	existingRole, err := s.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existingRole.ArchivedAt != nil {
+		return existingRole, nil
+	}
+	return existingRole.Update().SetArchivedAt(time.Now()).Save(ctx)
+}
+
+// RestoreRole makes an archived role available again
+func (s *RoleService) RestoreRole(ctx context.Context, id uuid.UUID) (*ent.Role, error) {
+	existingRole, err := s.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return existingRole.Update().ClearArchivedAt().Save(ctx)
+}
+
+// checkRolesActive fails when any of the roles is archived.
+// The error wraps ErrRoleArchived and names the archived roles.
+func checkRolesActive(ctx context.Context, client *ent.Client, ids []uuid.UUID) error {
+	archived, err := client.Role.
+		Query().
+		Where(role.IDIn(ids...), role.ArchivedAtNotNil()).
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(archived) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(archived))
+	for i, r := range archived {
+		names[i] = r.Name
+	}
+	return fmt.Errorf("%w: %s", ErrRoleArchived, strings.Join(names, ", "))
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+)
+
+var (
+	ErrRoleRuleViolated = errors.New("role rule broken")
+	ErrInvalidRoleRule  = errors.New("invalid role rule")
+	ErrRoleRuleNotFound = errors.New("role rule not found")
+	ErrRoleRuleExists   = errors.New("role already has this rule")
+)
+
+// RoleRuleInput describes a new rule of a role.
+// A requires rule names either a target role or a target team; an excludes
+// rule names a target role; a max_count rule sets MaxCount.
+type RoleRuleInput struct {
+	Kind         rolerule.Kind
+	TargetRoleID *uuid.UUID
+	TargetTeam   *rolerule.TargetTeam
+	MaxCount     *int
+}
+
+// validate checks that the input has exactly the fields its kind needs
+func (in RoleRuleInput) validate(roleID uuid.UUID) error {
+	switch in.Kind {
+	case rolerule.KindRequires:
+		if (in.TargetRoleID == nil) == (in.TargetTeam == nil) {
+			return fmt.Errorf("%w: requires needs either a target role or a target team", ErrInvalidRoleRule)
+		}
+		if in.TargetTeam != nil && rolerule.TargetTeamValidator(*in.TargetTeam) != nil {
+			return fmt.Errorf("%w: unknown team %q", ErrInvalidRoleRule, *in.TargetTeam)
+		}
+	case rolerule.KindExcludes:
+		if in.TargetRoleID == nil || in.TargetTeam != nil {
+			return fmt.Errorf("%w: excludes needs a target role", ErrInvalidRoleRule)
+		}
+	case rolerule.KindMaxCount:
+		if in.MaxCount == nil || *in.MaxCount <= 0 {
+			return fmt.Errorf("%w: max_count needs a positive count", ErrInvalidRoleRule)
+		}
+		if in.TargetRoleID != nil || in.TargetTeam != nil {
+			return fmt.Errorf("%w: max_count takes no target", ErrInvalidRoleRule)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: kind must be requires, excludes or max_count", ErrInvalidRoleRule)
+	}
+
+	if in.MaxCount != nil {
+		return fmt.Errorf("%w: only max_count rules take a count", ErrInvalidRoleRule)
+	}
+	if in.TargetRoleID != nil && *in.TargetRoleID == roleID {
+		return fmt.Errorf("%w: a role cannot require or exclude itself", ErrInvalidRoleRule)
+	}
+	return nil
+}
+
+// GetRoleRules lists the rules of a role with the roles they name
+func (s *RoleService) GetRoleRules(ctx context.Context, roleID uuid.UUID) ([]*ent.RoleRule, error) {
+	if _, err := s.GetRoleByID(ctx, roleID); err != nil {
+		return nil, err
+	}
+
+	return s.client.RoleRule.
+		Query().
+		Where(rolerule.RoleIDEQ(roleID)).
+		WithRole().
+		WithTargetRole().
+		Order(ent.Asc(rolerule.FieldCreatedAt)).
+		All(ctx)
+}
+
+// AddRoleRule adds a rule to a role. A role has at most one max_count rule,
+// and names each target once per kind.
+func (s *RoleService) AddRoleRule(ctx context.Context, roleID uuid.UUID, in RoleRuleInput) (*ent.RoleRule, error) {
+	if err := in.validate(roleID); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetRoleByID(ctx, roleID); err != nil {
+		return nil, err
+	}
+	if in.TargetRoleID != nil {
+		if _, err := s.GetRoleByID(ctx, *in.TargetRoleID); err != nil {
+			return nil, err
+		}
+	}
+
+	duplicate := s.client.RoleRule.
+		Query().
+		Where(rolerule.RoleIDEQ(roleID), rolerule.KindEQ(in.Kind))
+	switch {
+	case in.TargetRoleID != nil:
+		duplicate.Where(rolerule.TargetRoleIDEQ(*in.TargetRoleID))
+	case in.TargetTeam != nil:
+		duplicate.Where(rolerule.TargetTeamEQ(*in.TargetTeam))
+	}
+	exists, err := duplicate.Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrRoleRuleExists
+	}
+
+	created, err := s.client.RoleRule.
+		Create().
+		SetRoleID(roleID).
+		SetKind(in.Kind).
+		SetNillableTargetRoleID(in.TargetRoleID).
+		SetNillableTargetTeam(in.TargetTeam).
+		SetNillableMaxCount(in.MaxCount).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.RoleRule.
+		Query().
+		Where(rolerule.IDEQ(created.ID)).
+		WithRole().
+		WithTargetRole().
+		Only(ctx)
+}
+
+// DeleteRoleRule removes a rule of a role
+func (s *RoleService) DeleteRoleRule(ctx context.Context, roleID uuid.UUID, ruleID uuid.UUID) error {
+	deleted, err := s.client.RoleRule.
+		Delete().
+		Where(rolerule.IDEQ(ruleID), rolerule.RoleIDEQ(roleID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrRoleRuleNotFound
+	}
+	return nil
+}
+
+// DescribeRoleRule states a rule in words, such as `"Ocean" requires "Ocean's Friend"`.
+// The rule's role and target role must be loaded.
+func DescribeRoleRule(r *ent.RoleRule) string {
+	name := func(role *ent.Role, id *uuid.UUID) string {
+		if role != nil {
+			return fmt.Sprintf("%q", role.Name)
+		}
+		if id != nil {
+			return "role " + id.String()
+		}
+		return "unknown role"
+	}
+	subject := name(r.Edges.Role, &r.RoleID)
+
+	switch r.Kind {
+	case rolerule.KindRequires:
+		if r.TargetTeam != nil {
+			return fmt.Sprintf("%s requires a %s role", subject, *r.TargetTeam)
+		}
+		return fmt.Sprintf("%s requires %s", subject, name(r.Edges.TargetRole, r.TargetRoleID))
+	case rolerule.KindExcludes:
+		return fmt.Sprintf("%s excludes %s", subject, name(r.Edges.TargetRole, r.TargetRoleID))
+	case rolerule.KindMaxCount:
+		count := 0
+		if r.MaxCount != nil {
+			count = *r.MaxCount
+		}
+		return fmt.Sprintf("%s appears at most %d time(s) per game", subject, count)
+	}
+	return subject + " has an unknown rule"
+}
+
+// checkRoleRules checks the rules of every role in a set of role counts.
+// The error wraps ErrRoleRuleViolated and names each broken rule.
+func checkRoleRules(ctx context.Context, client *ent.Client, counts map[uuid.UUID]int) error {
+	present := make([]uuid.UUID, 0, len(counts))
+	for id, count := range counts {
+		if count > 0 {
+			present = append(present, id)
+		}
+	}
+	if len(present) == 0 {
+		return nil
+	}
+
+	rules, err := client.RoleRule.
+		Query().
+		Where(rolerule.RoleIDIn(present...)).
+		WithRole().
+		WithTargetRole().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	roles, err := client.Role.
+		Query().
+		Where(role.IDIn(present...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	teams := make(map[role.Team]int)
+	for _, r := range roles {
+		teams[r.Team] += counts[r.ID]
+	}
+
+	var broken []string
+	for _, r := range rules {
+		ok := true
+		switch r.Kind {
+		case rolerule.KindRequires:
+			if r.TargetTeam != nil {
+				ok = teams[role.Team(*r.TargetTeam)] > 0
+			} else {
+				ok = counts[*r.TargetRoleID] > 0
+			}
+		case rolerule.KindExcludes:
+			ok = counts[*r.TargetRoleID] == 0
+		case rolerule.KindMaxCount:
+			ok = counts[r.RoleID] <= *r.MaxCount
+		}
+		if !ok {
+			broken = append(broken, DescribeRoleRule(r))
+		}
+	}
+	if len(broken) == 0 {
+		return nil
+	}
+
+	sort.Strings(broken)
+	return fmt.Errorf("%w: %s", ErrRoleRuleViolated, strings.Join(broken, "; "))
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+	"github.com/mafia-night/backend/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleService_RoleRules(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := NewRoleService(client)
+	templateService := NewRoleTemplateService(client)
+	gameService := NewGameService(client)
+	ctx := context.Background()
+
+	ocean, err := roleService.CreateRole(ctx, "Ocean", "ocean", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	friend, err := roleService.CreateRole(ctx, "Ocean's Friend", "oceans-friend", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	sherlock, err := roleService.CreateRole(ctx, "Sherlock", "sherlock", "video", "", role.TeamIndependent, nil)
+	require.NoError(t, err)
+	mafia, err := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "", role.TeamMafia, nil)
+	require.NoError(t, err)
+	godfather, err := roleService.CreateRole(ctx, "Godfather", "godfather", "video", "", role.TeamMafia, nil)
+	require.NoError(t, err)
+
+	mafiaTeam := rolerule.TargetTeamMafia
+	two := 2
+
+	requiresFriend, err := roleService.AddRoleRule(ctx, ocean.ID, RoleRuleInput{Kind: rolerule.KindRequires, TargetRoleID: &friend.ID})
+	require.NoError(t, err)
+	assert.Equal(t, `"Ocean" requires "Ocean's Friend"`, DescribeRoleRule(requiresFriend))
+
+	_, err = roleService.AddRoleRule(ctx, sherlock.ID, RoleRuleInput{Kind: rolerule.KindRequires, TargetTeam: &mafiaTeam})
+	require.NoError(t, err)
+	_, err = roleService.AddRoleRule(ctx, godfather.ID, RoleRuleInput{Kind: rolerule.KindExcludes, TargetRoleID: &sherlock.ID})
+	require.NoError(t, err)
+	_, err = roleService.AddRoleRule(ctx, mafia.ID, RoleRuleInput{Kind: rolerule.KindMaxCount, MaxCount: &two})
+	require.NoError(t, err)
+
+	t.Run("validates rules", func(t *testing.T) {
+		_, err := roleService.AddRoleRule(ctx, ocean.ID, RoleRuleInput{Kind: rolerule.KindRequires})
+		assert.ErrorIs(t, err, ErrInvalidRoleRule)
+
+		_, err = roleService.AddRoleRule(ctx, ocean.ID, RoleRuleInput{Kind: rolerule.KindExcludes, TargetRoleID: &ocean.ID})
+		assert.ErrorIs(t, err, ErrInvalidRoleRule)
+
+		_, err = roleService.AddRoleRule(ctx, ocean.ID, RoleRuleInput{Kind: rolerule.KindMaxCount, MaxCount: &two, TargetTeam: &mafiaTeam})
+		assert.ErrorIs(t, err, ErrInvalidRoleRule)
+
+		_, err = roleService.AddRoleRule(ctx, ocean.ID, RoleRuleInput{Kind: "likes", TargetRoleID: &friend.ID})
+		assert.ErrorIs(t, err, ErrInvalidRoleRule)
+
+		missing := uuid.New()
+		_, err = roleService.AddRoleRule(ctx, ocean.ID, RoleRuleInput{Kind: rolerule.KindRequires, TargetRoleID: &missing})
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+
+		_, err = roleService.AddRoleRule(ctx, ocean.ID, RoleRuleInput{Kind: rolerule.KindRequires, TargetRoleID: &friend.ID})
+		assert.ErrorIs(t, err, ErrRoleRuleExists)
+
+		rules, err := roleService.GetRoleRules(ctx, ocean.ID)
+		require.NoError(t, err)
+		assert.Len(t, rules, 1)
+	})
+
+	t.Run("templates must follow the rules", func(t *testing.T) {
+		_, err := templateService.CreateRoleTemplate(ctx, "Lonely Ocean", 3, "", []RoleAssignment{
+			{RoleID: ocean.ID, Count: 1},
+			{RoleID: mafia.ID, Count: 2},
+		})
+		require.ErrorIs(t, err, ErrRoleRuleViolated)
+		assert.Contains(t, err.Error(), `"Ocean" requires "Ocean's Friend"`)
+
+		_, err = templateService.CreateRoleTemplate(ctx, "Mafia Heavy", 4, "", []RoleAssignment{
+			{RoleID: mafia.ID, Count: 3},
+			{RoleID: sherlock.ID, Count: 1},
+		})
+		require.ErrorIs(t, err, ErrRoleRuleViolated)
+		assert.Contains(t, err.Error(), `"Mafia" appears at most 2 time(s) per game`)
+
+		created, err := templateService.CreateRoleTemplate(ctx, "Heist", 4, "", []RoleAssignment{
+			{RoleID: ocean.ID, Count: 1},
+			{RoleID: friend.ID, Count: 1},
+			{RoleID: sherlock.ID, Count: 1},
+			{RoleID: mafia.ID, Count: 1},
+		})
+		require.NoError(t, err)
+
+		_, err = templateService.UpdateRoleTemplate(ctx, created.ID, nil, nil, nil, []RoleAssignment{
+			{RoleID: ocean.ID, Count: 1},
+			{RoleID: friend.ID, Count: 1},
+			{RoleID: sherlock.ID, Count: 1},
+			{RoleID: godfather.ID, Count: 1},
+		})
+		require.ErrorIs(t, err, ErrRoleRuleViolated)
+		assert.Contains(t, err.Error(), `"Godfather" excludes "Sherlock"`)
+	})
+
+	t.Run("distribution must follow the rules", func(t *testing.T) {
+		created, err := gameService.CreateGame(ctx, "mod-123")
+		require.NoError(t, err)
+		for _, name := range []string{"alice", "bob"} {
+			_, err := gameService.JoinGame(ctx, created.ID, name)
+			require.NoError(t, err)
+		}
+
+		err = gameService.DistributeRoles(ctx, created.ID, "mod-123", []RoleSelection{
+			{RoleID: sherlock.ID.String(), Count: 1},
+			{RoleID: friend.ID.String(), Count: 1},
+		})
+		require.ErrorIs(t, err, ErrRoleRuleViolated)
+		assert.Contains(t, err.Error(), `"Sherlock" requires a mafia role`)
+
+		err = gameService.DistributeRoles(ctx, created.ID, "mod-123", []RoleSelection{
+			{RoleID: sherlock.ID.String(), Count: 1},
+			{RoleID: mafia.ID.String(), Count: 1},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("deletes rules", func(t *testing.T) {
+		require.NoError(t, roleService.DeleteRoleRule(ctx, ocean.ID, requiresFriend.ID))
+		assert.ErrorIs(t, roleService.DeleteRoleRule(ctx, ocean.ID, requiresFriend.ID), ErrRoleRuleNotFound)
+
+		_, err := templateService.CreateRoleTemplate(ctx, "Lonely Ocean", 1, "", []RoleAssignment{{RoleID: ocean.ID, Count: 1}})
+		assert.NoError(t, err)
+	})
+}
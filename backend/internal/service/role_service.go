@@ -2,11 +2,19 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"io"
+	"strings"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/predicate"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletranslation"
+	"github.com/mafia-night/backend/internal/media"
 )
 
 var (
@@ -15,33 +23,225 @@ var (
 	ErrRoleNotFound   = errors.New("role not found")
 	ErrRoleNameExists = errors.New("role name already exists")
 	ErrRoleSlugExists = errors.New("role slug already exists")
+
+	ErrMediaNotConfigured = errors.New("media uploads are not configured")
+
+	ErrInvalidRoleSort = errors.New("sort must be one of name, slug or team, optionally prefixed with -")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrInvalidLimit    = errors.New("limit must be between 1 and 100")
+	ErrInvalidTeam     = errors.New("team must be mafia, village or independent")
 )
 
+// MaxRolePageSize is the largest page of roles returned at once
+const MaxRolePageSize = 100
+
+// RoleListOptions filters, sorts and pages a role listing.
+// The zero value lists every role by name.
+type RoleListOptions struct {
+	// Teams keeps roles of any of the teams; empty keeps all
+	Teams []role.Team
+	// Ability keeps roles with an ability mentioning it, such as "protect"
+	Ability string
+	// Search keeps roles whose name or description contains it, in any locale
+	Search string
+	// Sort is name, slug or team, prefixed with - to sort descending
+	Sort string
+	// Limit is the page size; zero returns every matching role
+	Limit int
+	// Cursor continues from the page that returned it
+	Cursor string
+	// IncludeArchived also lists archived roles, which are left out by default
+	IncludeArchived bool
+}
+
+// RolePage is one page of a role listing
+type RolePage struct {
+	Roles []*ent.Role
+	// NextCursor fetches the following page; empty on the last one
+	NextCursor string
+}
+
+// roleSortFields maps the sort options to their columns
+var roleSortFields = map[string]string{
+	"name": role.FieldName,
+	"slug": role.FieldSlug,
+	"team": role.FieldTeam,
+}
+
+// roleCursor is the position after the last role of a page
+type roleCursor struct {
+	Sort  string    `json:"s"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
 // RoleService handles role-related business logic
 type RoleService struct {
-	client *ent.Client
+	client        *ent.Client
+	defaultLocale string
+	media         *media.Library
 }
 
 // NewRoleService creates a new role service
 func NewRoleService(client *ent.Client) *RoleService {
-	return &RoleService{client: client}
+	return &RoleService{client: client, defaultLocale: DefaultLocale}
+}
+
+// WithDefaultLocale sets the locale of the texts stored on roles themselves
+func (s *RoleService) WithDefaultLocale(locale string) *RoleService {
+	s.defaultLocale = locale
+	return s
+}
+
+// WithMedia sets where uploaded role videos and images are stored
+func (s *RoleService) WithMedia(library *media.Library) *RoleService {
+	s.media = library
+	return s
 }
 
-// GetAllRoles retrieves all roles ordered by name
+// Localizer picks the texts of roles in the locales a reader prefers
+func (s *RoleService) Localizer(prefs LocalePreferences) Localizer {
+	return Localizer{DefaultLocale: s.defaultLocale, Preferences: prefs}
+}
+
+// GetAllRoles retrieves all roles ordered by name, with their translations
 func (s *RoleService) GetAllRoles(ctx context.Context) ([]*ent.Role, error) {
-	roles, err := s.client.Role.
-		Query().
-		Order(ent.Asc(role.FieldName)).
-		All(ctx)
+	page, err := s.ListRoles(ctx, RoleListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Roles, nil
+}
+
+// ListRoles retrieves the roles matching the options, with their translations.
+// Pages are ordered by the sort field and then by ID, so a cursor stays valid
+// while roles are added or removed.
+func (s *RoleService) ListRoles(ctx context.Context, opts RoleListOptions) (*RolePage, error) {
+	sortName := opts.Sort
+	if sortName == "" {
+		sortName = "name"
+	}
+	field, ok := roleSortFields[strings.TrimPrefix(sortName, "-")]
+	if !ok {
+		return nil, ErrInvalidRoleSort
+	}
+	descending := strings.HasPrefix(sortName, "-")
+
+	if opts.Limit < 0 || opts.Limit > MaxRolePageSize {
+		return nil, ErrInvalidLimit
+	}
+
+	query := s.client.Role.Query().WithTranslations()
 
+	if !opts.IncludeArchived {
+		query.Where(role.ArchivedAtIsNil())
+	}
+
+	if len(opts.Teams) > 0 {
+		for _, team := range opts.Teams {
+			if role.TeamValidator(team) != nil {
+				return nil, ErrInvalidTeam
+			}
+		}
+		query.Where(role.TeamIn(opts.Teams...))
+	}
+	if ability := strings.TrimSpace(opts.Ability); ability != "" {
+		query.Where(abilityContains(ability))
+	}
+	if search := strings.TrimSpace(opts.Search); search != "" {
+		query.Where(role.Or(
+			role.NameContainsFold(search),
+			role.DescriptionContainsFold(search),
+			role.HasTranslationsWith(roletranslation.Or(
+				roletranslation.NameContainsFold(search),
+				roletranslation.DescriptionContainsFold(search),
+			)),
+		))
+	}
+
+	if opts.Cursor != "" {
+		cursor, err := decodeRoleCursor(opts.Cursor)
+		if err != nil || cursor.Sort != sortName {
+			return nil, ErrInvalidCursor
+		}
+		if descending {
+			query.Where(predicate.Role(sql.OrPredicates(
+				sql.FieldLT(field, cursor.Value),
+				sql.AndPredicates(sql.FieldEQ(field, cursor.Value), sql.FieldLT(role.FieldID, cursor.ID)),
+			)))
+		} else {
+			query.Where(predicate.Role(sql.OrPredicates(
+				sql.FieldGT(field, cursor.Value),
+				sql.AndPredicates(sql.FieldEQ(field, cursor.Value), sql.FieldGT(role.FieldID, cursor.ID)),
+			)))
+		}
+	}
+
+	if descending {
+		query.Order(ent.Desc(field), ent.Desc(role.FieldID))
+	} else {
+		query.Order(ent.Asc(field), ent.Asc(role.FieldID))
+	}
+
+	if opts.Limit > 0 {
+		// One extra role tells whether there is another page
+		query.Limit(opts.Limit + 1)
+	}
+
+	roles, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return roles, nil
+	page := &RolePage{Roles: roles}
+	if opts.Limit > 0 && len(roles) > opts.Limit {
+		page.Roles = roles[:opts.Limit]
+		last := page.Roles[opts.Limit-1]
+		page.NextCursor = encodeRoleCursor(roleCursor{Sort: sortName, Value: roleSortValue(last, field), ID: last.ID})
+	}
+
+	return page, nil
+}
+
+// abilityContains matches roles with an ability containing the text, ignoring case.
+// Abilities are stored as a JSON array, which is searched as text.
+func abilityContains(text string) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("LOWER(CAST(").Ident(s.C(role.FieldAbilities)).WriteString(" AS TEXT)) LIKE ")
+			b.Arg("%" + strings.ToLower(text) + "%")
+		}))
+	})
+}
+
+func roleSortValue(r *ent.Role, field string) string {
+	switch field {
+	case role.FieldSlug:
+		return r.Slug
+	case role.FieldTeam:
+		return string(r.Team)
+	default:
+		return r.Name
+	}
+}
+
+func encodeRoleCursor(c roleCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
 }
 
-// GetRoleBySlug retrieves a role by its slug
+func decodeRoleCursor(raw string) (roleCursor, error) {
+	var c roleCursor
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+// GetRoleBySlug retrieves a role by its slug, with its translations
 func (s *RoleService) GetRoleBySlug(ctx context.Context, slug string) (*ent.Role, error) {
 	if slug == "" {
 		return nil, ErrEmptySlug
@@ -50,6 +250,7 @@ func (s *RoleService) GetRoleBySlug(ctx context.Context, slug string) (*ent.Role
 	role, err := s.client.Role.
 		Query().
 		Where(role.SlugEQ(slug)).
+		WithTranslations().
 		Only(ctx)
 
 	if err != nil {
@@ -149,16 +350,184 @@ func (s *RoleService) UpdateRole(ctx context.Context, id uuid.UUID, name, slug,
 		return nil, err
 	}
 
+	if updated.Video != existingRole.Video {
+		s.removeMedia(ctx, existingRole.Video)
+	}
+
 	return updated, nil
 }
 
-// DeleteRole deletes a role
+// DeleteRole deletes a role nothing references. A role dealt in games or
+// templates must be archived instead, so past games keep it.
 func (s *RoleService) DeleteRole(ctx context.Context, id uuid.UUID) error {
 	existingRole, err := s.GetRoleByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	return s.client.Role.DeleteOne(existingRole).Exec(ctx)
+	usage, err := s.GetRoleUsage(ctx, id)
+	if err != nil {
+		return err
+	}
+	if usage.InUse() {
+		return ErrRoleInUse
+	}
+
+	if err := s.client.Role.DeleteOne(existingRole).Exec(ctx); err != nil {
+		return err
+	}
+
+	s.removeMedia(ctx, existingRole.Video)
+	s.removeMedia(ctx, existingRole.Image)
+	return nil
+}
+
+// MaxMediaSize returns the largest accepted upload of a kind, or zero when uploads are not configured
+func (s *RoleService) MaxMediaSize(kind media.Kind) int64 {
+	if s.media == nil {
+		return 0
+	}
+	return s.media.MaxSize(kind)
+}
+
+// UploadRoleMedia stores an uploaded video or image and sets it on the role,
+// removing the uploaded file it replaces
+func (s *RoleService) UploadRoleMedia(ctx context.Context, id uuid.UUID, kind media.Kind, body io.Reader, size int64) (*ent.Role, error) {
+	if s.media == nil {
+		return nil, ErrMediaNotConfigured
+	}
+	existingRole, err := s.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := s.media.Upload(ctx, kind, body, size)
+	if err != nil {
+		return nil, err
+	}
+
+	update := existingRole.Update()
+	previous := existingRole.Video
+	if kind == media.Image {
+		update.SetImage(url)
+		previous = existingRole.Image
+	} else {
+		update.SetVideo(url)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		s.removeMedia(ctx, url)
+		return nil, err
+	}
+
+	s.removeMedia(ctx, previous)
+	return updated, nil
+}
+
+// RemoveRoleImage clears the image of a role, removing it if it was uploaded
+func (s *RoleService) RemoveRoleImage(ctx context.Context, id uuid.UUID) (*ent.Role, error) {
+	existingRole, err := s.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := existingRole.Update().ClearImage().Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeMedia(ctx, existingRole.Image)
+	return updated, nil
 }
 
+// removeMedia deletes an uploaded file that no role uses any more.
+// Failures are ignored: a leftover file wastes space but breaks nothing.
+func (s *RoleService) removeMedia(ctx context.Context, url string) {
+	if s.media != nil && url != "" {
+		_ = s.media.Remove(ctx, url)
+	}
+}
+
+// GetRoleTranslations lists the translations of a role ordered by locale
+func (s *RoleService) GetRoleTranslations(ctx context.Context, roleID uuid.UUID) ([]*ent.RoleTranslation, error) {
+	if _, err := s.GetRoleByID(ctx, roleID); err != nil {
+		return nil, err
+	}
+
+	return s.client.RoleTranslation.
+		Query().
+		Where(roletranslation.RoleIDEQ(roleID)).
+		Order(ent.Asc(roletranslation.FieldLocale)).
+		All(ctx)
+}
+
+// SetRoleTranslation creates or replaces the texts of a role in a locale.
+// Empty abilities fall back to the role's own.
+func (s *RoleService) SetRoleTranslation(ctx context.Context, roleID uuid.UUID, locale, name, description string, abilities []string) (*ent.RoleTranslation, error) {
+	locale, err := NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if locale == s.defaultLocale {
+		return nil, ErrDefaultLocaleTranslation
+	}
+	if name == "" {
+		return nil, ErrEmptyTranslationName
+	}
+	if _, err := s.GetRoleByID(ctx, roleID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.client.RoleTranslation.
+		Query().
+		Where(roletranslation.RoleIDEQ(roleID), roletranslation.LocaleEQ(locale)).
+		Only(ctx)
+
+	var translation *ent.RoleTranslation
+	switch {
+	case err == nil:
+		translation, err = existing.Update().
+			SetName(name).
+			SetDescription(description).
+			SetAbilities(abilities).
+			Save(ctx)
+	case ent.IsNotFound(err):
+		translation, err = s.client.RoleTranslation.
+			Create().
+			SetRoleID(roleID).
+			SetLocale(locale).
+			SetName(name).
+			SetDescription(description).
+			SetAbilities(abilities).
+			Save(ctx)
+	}
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrRoleNameExists
+		}
+		return nil, err
+	}
+
+	return translation, nil
+}
+
+// DeleteRoleTranslation removes the texts of a role in a locale
+func (s *RoleService) DeleteRoleTranslation(ctx context.Context, roleID uuid.UUID, locale string) error {
+	locale, err := NormalizeLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.client.RoleTranslation.
+		Delete().
+		Where(roletranslation.RoleIDEQ(roleID), roletranslation.LocaleEQ(locale)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrTranslationNotFound
+	}
+	return nil
+}
@@ -1,11 +1,17 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent/gamerole"
 	"github.com/mafia-night/backend/ent/role"
 	"github.com/mafia-night/backend/internal/database"
+	"github.com/mafia-night/backend/internal/media"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -468,3 +474,355 @@ func TestRoleService_GetRoleBySlug(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestRoleService_Translations(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewRoleService(client)
+	ctx := context.Background()
+
+	doctor, err := service.CreateRole(ctx, "Doctor", "doctor", "video", "Saves a player", role.TeamVillage, []string{"Save"})
+	require.NoError(t, err)
+
+	t.Run("adds and replaces a translation", func(t *testing.T) {
+		_, err := service.SetRoleTranslation(ctx, doctor.ID, "fa", "پزشک", "", nil)
+		require.NoError(t, err)
+
+		translation, err := service.SetRoleTranslation(ctx, doctor.ID, "FA", "دکتر", "یک نفر را نجات می‌دهد", []string{"نجات"})
+		require.NoError(t, err)
+		assert.Equal(t, "fa", translation.Locale)
+		assert.Equal(t, "دکتر", translation.Name)
+
+		translations, err := service.GetRoleTranslations(ctx, doctor.ID)
+		require.NoError(t, err)
+		assert.Len(t, translations, 1)
+	})
+
+	t.Run("localizes listed roles", func(t *testing.T) {
+		roles, err := service.GetAllRoles(ctx)
+		require.NoError(t, err)
+		require.Len(t, roles, 1)
+
+		text := service.Localizer(LocalePreferences{"fa-IR"}).Role(roles[0])
+		assert.Equal(t, "fa", text.Locale)
+		assert.Equal(t, "دکتر", text.Name)
+		assert.Equal(t, []string{"نجات"}, text.Abilities)
+
+		text = service.Localizer(nil).Role(roles[0])
+		assert.Equal(t, "Doctor", text.Name)
+	})
+
+	t.Run("rejects the default locale and invalid locales", func(t *testing.T) {
+		_, err := service.SetRoleTranslation(ctx, doctor.ID, "en", "Doctor", "", nil)
+		assert.ErrorIs(t, err, ErrDefaultLocaleTranslation)
+
+		_, err = service.SetRoleTranslation(ctx, doctor.ID, "not a locale", "Doctor", "", nil)
+		assert.ErrorIs(t, err, ErrInvalidLocale)
+
+		_, err = service.SetRoleTranslation(ctx, doctor.ID, "de", "", "", nil)
+		assert.ErrorIs(t, err, ErrEmptyTranslationName)
+	})
+
+	t.Run("keeps names unique within a locale", func(t *testing.T) {
+		nurse, err := service.CreateRole(ctx, "Nurse", "nurse", "video", "", role.TeamVillage, nil)
+		require.NoError(t, err)
+
+		_, err = service.SetRoleTranslation(ctx, nurse.ID, "fa", "دکتر", "", nil)
+		assert.ErrorIs(t, err, ErrRoleNameExists)
+	})
+
+	t.Run("deletes a translation", func(t *testing.T) {
+		require.NoError(t, service.DeleteRoleTranslation(ctx, doctor.ID, "fa"))
+
+		err := service.DeleteRoleTranslation(ctx, doctor.ID, "fa")
+		assert.ErrorIs(t, err, ErrTranslationNotFound)
+	})
+}
+
+func TestRoleService_UploadRoleMedia(t *testing.T) {
+	client := database.SetupTestDB(t)
+	dir := t.TempDir()
+	store, err := media.NewLocalStore(dir)
+	require.NoError(t, err)
+	service := NewRoleService(client).WithMedia(media.NewLibrary(store, "http://localhost:8080/api/media", media.Limits{}))
+	ctx := context.Background()
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	stored := func(url string) string {
+		return filepath.Join(dir, strings.TrimPrefix(url, "http://localhost:8080/api/media/"))
+	}
+
+	created, err := service.CreateRole(ctx, "Painter", "painter", "", "", role.TeamVillage, nil)
+	require.NoError(t, err, "roles can be created before their video is uploaded")
+
+	t.Run("sets and replaces the image", func(t *testing.T) {
+		first, err := service.UploadRoleMedia(ctx, created.ID, media.Image, bytes.NewReader(png), int64(len(png)))
+		require.NoError(t, err)
+		assert.FileExists(t, stored(first.Image))
+
+		second, err := service.UploadRoleMedia(ctx, created.ID, media.Image, bytes.NewReader(png), int64(len(png)))
+		require.NoError(t, err)
+		assert.NotEqual(t, first.Image, second.Image)
+		assert.FileExists(t, stored(second.Image))
+		assert.NoFileExists(t, stored(first.Image), "the replaced upload is removed")
+
+		cleared, err := service.RemoveRoleImage(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Empty(t, cleared.Image)
+		assert.NoFileExists(t, stored(second.Image))
+	})
+
+	t.Run("rejects invalid uploads", func(t *testing.T) {
+		_, err := service.UploadRoleMedia(ctx, created.ID, media.Video, bytes.NewReader(png), int64(len(png)))
+		assert.ErrorIs(t, err, media.ErrUnsupportedType)
+
+		_, err = service.UploadRoleMedia(ctx, uuid.New(), media.Image, bytes.NewReader(png), int64(len(png)))
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+
+		_, err = NewRoleService(client).UploadRoleMedia(ctx, created.ID, media.Image, bytes.NewReader(png), int64(len(png)))
+		assert.ErrorIs(t, err, ErrMediaNotConfigured)
+	})
+
+	t.Run("keeps videos hosted elsewhere", func(t *testing.T) {
+		uploaded, err := service.UploadRoleMedia(ctx, created.ID, media.Image, bytes.NewReader(png), int64(len(png)))
+		require.NoError(t, err)
+
+		cdn := "https://cdn.example.com/painter.webm"
+		_, err = service.UpdateRole(ctx, created.ID, nil, nil, &cdn, nil, nil, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, service.DeleteRole(ctx, created.ID))
+		assert.NoFileExists(t, stored(uploaded.Image), "deleting the role removes its uploads")
+	})
+}
+
+func TestRoleService_ListRoles(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewRoleService(client)
+	ctx := context.Background()
+
+	roles := []struct {
+		name        string
+		team        role.Team
+		description string
+		abilities   []string
+	}{
+		{"Bodyguard", role.TeamVillage, "Shields a player", []string{"Protect one player each night"}},
+		{"Doctor", role.TeamVillage, "Heals the wounded", []string{"Protect one player each night"}},
+		{"Godfather", role.TeamMafia, "Leads the family", []string{"Appears innocent"}},
+		{"Mafia", role.TeamMafia, "A member of the family", []string{"Kill one player each night"}},
+		{"Serial Killer", role.TeamIndependent, "Works alone", []string{"Kill one player each night"}},
+		{"Villager", role.TeamVillage, "An ordinary citizen", nil},
+	}
+	for _, r := range roles {
+		_, err := service.CreateRole(ctx, r.name, strings.ToLower(strings.ReplaceAll(r.name, " ", "-")), "video", r.description, r.team, r.abilities)
+		require.NoError(t, err)
+	}
+
+	names := func(page *RolePage) []string {
+		var names []string
+		for _, r := range page.Roles {
+			names = append(names, r.Name)
+		}
+		return names
+	}
+
+	t.Run("filters by team", func(t *testing.T) {
+		page, err := service.ListRoles(ctx, RoleListOptions{Teams: []role.Team{role.TeamMafia, role.TeamIndependent}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Godfather", "Mafia", "Serial Killer"}, names(page))
+		assert.Empty(t, page.NextCursor)
+
+		_, err = service.ListRoles(ctx, RoleListOptions{Teams: []role.Team{"pirates"}})
+		assert.ErrorIs(t, err, ErrInvalidTeam)
+	})
+
+	t.Run("filters by ability", func(t *testing.T) {
+		page, err := service.ListRoles(ctx, RoleListOptions{Ability: "PROTECT"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bodyguard", "Doctor"}, names(page))
+	})
+
+	t.Run("searches names, descriptions and translations", func(t *testing.T) {
+		page, err := service.ListRoles(ctx, RoleListOptions{Search: "family"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Godfather", "Mafia"}, names(page))
+
+		page, err = service.ListRoles(ctx, RoleListOptions{Search: "killer", Teams: []role.Team{role.TeamIndependent}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Serial Killer"}, names(page))
+
+		doctor, err := service.GetRoleBySlug(ctx, "doctor")
+		require.NoError(t, err)
+		_, err = service.SetRoleTranslation(ctx, doctor.ID, "de", "Arzt", "", nil)
+		require.NoError(t, err)
+
+		page, err = service.ListRoles(ctx, RoleListOptions{Search: "arzt"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Doctor"}, names(page))
+	})
+
+	t.Run("pages through sorted roles", func(t *testing.T) {
+		var all []string
+		opts := RoleListOptions{Sort: "-team", Limit: 4}
+		for {
+			page, err := service.ListRoles(ctx, opts)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(page.Roles), 4)
+			all = append(all, names(page)...)
+			if page.NextCursor == "" {
+				break
+			}
+			opts.Cursor = page.NextCursor
+		}
+
+		require.Len(t, all, 6)
+		assert.ElementsMatch(t, []string{"Bodyguard", "Doctor", "Villager"}, all[:3], "village sorts first descending")
+		assert.ElementsMatch(t, []string{"Godfather", "Mafia"}, all[3:5])
+		assert.Equal(t, "Serial Killer", all[5])
+	})
+
+	t.Run("rejects bad options", func(t *testing.T) {
+		_, err := service.ListRoles(ctx, RoleListOptions{Sort: "power"})
+		assert.ErrorIs(t, err, ErrInvalidRoleSort)
+
+		_, err = service.ListRoles(ctx, RoleListOptions{Limit: MaxRolePageSize + 1})
+		assert.ErrorIs(t, err, ErrInvalidLimit)
+
+		_, err = service.ListRoles(ctx, RoleListOptions{Cursor: "not a cursor"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+
+		page, err := service.ListRoles(ctx, RoleListOptions{Limit: 1})
+		require.NoError(t, err)
+		_, err = service.ListRoles(ctx, RoleListOptions{Limit: 1, Sort: "slug", Cursor: page.NextCursor})
+		assert.ErrorIs(t, err, ErrInvalidCursor, "a cursor only continues the sort it came from")
+	})
+}
+
+func TestRoleService_ArchiveRole(t *testing.T) {
+	client := database.SetupTestDB(t)
+	service := NewRoleService(client)
+	templateService := NewRoleTemplateService(client)
+	gameService := NewGameService(client)
+	ctx := context.Background()
+
+	mafia, err := service.CreateRole(ctx, "Mafia", "mafia", "video", "", role.TeamMafia, nil)
+	require.NoError(t, err)
+	villager, err := service.CreateRole(ctx, "Villager", "villager", "video", "", role.TeamVillage, nil)
+	require.NoError(t, err)
+	jester, err := service.CreateRole(ctx, "Jester", "jester", "video", "", role.TeamIndependent, nil)
+	require.NoError(t, err)
+
+	classic, err := templateService.CreateRoleTemplate(ctx, "Classic", 3, "", []RoleAssignment{
+		{RoleID: mafia.ID, Count: 1},
+		{RoleID: jester.ID, Count: 2},
+		{RoleID: jester.ID, Count: 1, Optional: true},
+	})
+	require.NoError(t, err)
+
+	game, err := gameService.CreateGame(ctx, "moderator")
+	require.NoError(t, err)
+	for _, name := range []string{"A", "B"} {
+		_, err := gameService.JoinGame(ctx, game.ID, name)
+		require.NoError(t, err)
+	}
+	require.NoError(t, gameService.DistributeRoles(ctx, game.ID, "moderator", []RoleSelection{
+		{RoleID: mafia.ID.String(), Count: 1},
+		{RoleID: villager.ID.String(), Count: 1},
+	}))
+
+	t.Run("reports the templates and games using a role", func(t *testing.T) {
+		usage, err := service.GetRoleUsage(ctx, jester.ID)
+		require.NoError(t, err)
+		assert.True(t, usage.InUse())
+		assert.Equal(t, 0, usage.Games)
+		require.Len(t, usage.Templates, 1)
+		assert.Equal(t, "Classic", usage.Templates[0].Template.Name)
+		assert.Equal(t, 2, usage.Templates[0].Count)
+		assert.Equal(t, 1, usage.Templates[0].OptionalCount)
+
+		usage, err = service.GetRoleUsage(ctx, villager.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, usage.Games)
+		assert.Empty(t, usage.Templates)
+	})
+
+	t.Run("refuses to delete a role in use", func(t *testing.T) {
+		assert.ErrorIs(t, service.DeleteRole(ctx, villager.ID), ErrRoleInUse)
+		assert.ErrorIs(t, service.DeleteRole(ctx, jester.ID), ErrRoleInUse)
+
+		_, err := service.GetRoleByID(ctx, villager.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("archived roles are hidden from new templates and distributions", func(t *testing.T) {
+		archived, err := service.ArchiveRole(ctx, villager.ID)
+		require.NoError(t, err)
+		require.NotNil(t, archived.ArchivedAt)
+
+		roles, err := service.GetAllRoles(ctx)
+		require.NoError(t, err)
+		assert.Len(t, roles, 2)
+		page, err := service.ListRoles(ctx, RoleListOptions{IncludeArchived: true})
+		require.NoError(t, err)
+		assert.Len(t, page.Roles, 3)
+
+		_, err = templateService.CreateRoleTemplate(ctx, "Quiet", 2, "", []RoleAssignment{
+			{RoleID: mafia.ID, Count: 1},
+			{RoleID: villager.ID, Count: 1},
+		})
+		assert.ErrorIs(t, err, ErrRoleArchived)
+		assert.ErrorContains(t, err, "Villager")
+
+		next, err := gameService.CreateGame(ctx, "moderator")
+		require.NoError(t, err)
+		for _, name := range []string{"A", "B"} {
+			_, err := gameService.JoinGame(ctx, next.ID, name)
+			require.NoError(t, err)
+		}
+		err = gameService.DistributeRoles(ctx, next.ID, "moderator", []RoleSelection{
+			{RoleID: mafia.ID.String(), Count: 1},
+			{RoleID: villager.ID.String(), Count: 1},
+		})
+		assert.ErrorIs(t, err, ErrRoleArchived)
+
+		// The past game keeps its role
+		dealt, err := client.GameRole.Query().Where(gamerole.GameID(game.ID), gamerole.RoleID(villager.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, dealt)
+	})
+
+	t.Run("templates keep roles archived since they were added", func(t *testing.T) {
+		_, err := service.ArchiveRole(ctx, jester.ID)
+		require.NoError(t, err)
+
+		renamed := "Classic Night"
+		_, err = templateService.UpdateRoleTemplate(ctx, classic.ID, &renamed, nil, nil, []RoleAssignment{
+			{RoleID: mafia.ID, Count: 1},
+			{RoleID: jester.ID, Count: 2},
+		})
+		require.NoError(t, err)
+
+		_, err = templateService.RollbackTemplate(ctx, classic.ID, 1)
+		require.NoError(t, err)
+
+		_, err = templateService.UpdateRoleTemplate(ctx, classic.ID, nil, nil, nil, []RoleAssignment{
+			{RoleID: mafia.ID, Count: 1},
+			{RoleID: jester.ID, Count: 1},
+			{RoleID: villager.ID, Count: 1},
+		})
+		assert.ErrorIs(t, err, ErrRoleArchived)
+		assert.ErrorContains(t, err, "Villager")
+	})
+
+	t.Run("restores an archived role", func(t *testing.T) {
+		restored, err := service.RestoreRole(ctx, villager.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.ArchivedAt)
+
+		_, err = templateService.CreateRoleTemplate(ctx, "Quiet", 2, "", []RoleAssignment{
+			{RoleID: mafia.ID, Count: 1},
+			{RoleID: villager.ID, Count: 1},
+		})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+)
+
+var (
+	ErrTemplateNotPrivate = errors.New("only private templates can be submitted for review")
+	ErrTemplateNotPending = errors.New("template is not awaiting review")
+)
+
+// shareCodeEncoding writes share codes without padding or easily confused letters
+var shareCodeEncoding = base32.NewEncoding("ABCDEFGHJKLMNPQRSTUVWXYZ23456789").WithPadding(base32.NoPadding)
+
+// newShareCode returns a random 10 character code
+func newShareCode() string {
+	b := make([]byte, 6)
+	rand.Read(b)
+	return shareCodeEncoding.EncodeToString(b)
+}
+
+// moderatorAuthor records changes as made by a moderator
+func moderatorAuthor(moderatorID string) RevisionAuthor {
+	return RevisionAuthor{Kind: roletemplaterevision.AuthorKindModerator, ID: moderatorID}
+}
+
+// GetModeratorTemplates lists the templates a moderator owns, whatever their status
+func (s *RoleTemplateService) GetModeratorTemplates(ctx context.Context, moderatorID string) ([]*ent.RoleTemplate, error) {
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+
+	return withAssignments(s.client.RoleTemplate.Query()).
+		Where(roletemplate.OwnerIDEQ(moderatorID)).
+		Order(ent.Asc(roletemplate.FieldPlayerCount), ent.Asc(roletemplate.FieldName)).
+		All(ctx)
+}
+
+// getOwnedTemplate returns a template of a moderator. Templates of others
+// are reported as not found, so their IDs reveal nothing.
+func (s *RoleTemplateService) getOwnedTemplate(ctx context.Context, moderatorID string, id uuid.UUID) (*ent.RoleTemplate, error) {
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+	template, err := s.GetRoleTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if template.OwnerID == nil || *template.OwnerID != moderatorID {
+		return nil, ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+// GetVisibleRoleTemplate returns a template that is published or owned by
+// the moderator; moderatorID may be empty for anonymous readers
+func (s *RoleTemplateService) GetVisibleRoleTemplate(ctx context.Context, id uuid.UUID, moderatorID string) (*ent.RoleTemplate, error) {
+	template, err := s.GetRoleTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if template.Status == roletemplate.StatusPublished {
+		return template, nil
+	}
+	if moderatorID != "" && template.OwnerID != nil && *template.OwnerID == moderatorID {
+		return template, nil
+	}
+	return nil, ErrTemplateNotFound
+}
+
+// CreateModeratorTemplate creates a private template owned by a moderator
+func (s *RoleTemplateService) CreateModeratorTemplate(ctx context.Context, moderatorID string, name string, playerCount int, description string, roles []RoleAssignment) (*ent.RoleTemplate, error) {
+	if moderatorID == "" {
+		return nil, ErrEmptyModeratorID
+	}
+	ctx = WithRevisionAuthor(ctx, moderatorAuthor(moderatorID))
+	return s.createRoleTemplate(ctx, &moderatorID, name, playerCount, description, roles, revisionChange{Action: roletemplaterevision.ActionCreate})
+}
+
+// UpdateModeratorTemplate updates a template of a moderator. A pending or
+// published template goes back to private, as the changes were not reviewed.
+func (s *RoleTemplateService) UpdateModeratorTemplate(ctx context.Context, moderatorID string, id uuid.UUID, name *string, playerCount *int, description *string, roles []RoleAssignment) (*ent.RoleTemplate, error) {
+	existingTemplate, err := s.getOwnedTemplate(ctx, moderatorID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = WithRevisionAuthor(ctx, moderatorAuthor(moderatorID))
+	return s.updateRoleTemplate(ctx, existingTemplate, name, playerCount, description, roles, revisionChange{Action: roletemplaterevision.ActionUpdate}, func(update *ent.RoleTemplateUpdateOne) {
+		update.SetStatus(roletemplate.StatusPrivate)
+	})
+}
+
+// DeleteModeratorTemplate deletes a template of a moderator
+func (s *RoleTemplateService) DeleteModeratorTemplate(ctx context.Context, moderatorID string, id uuid.UUID) error {
+	if _, err := s.getOwnedTemplate(ctx, moderatorID, id); err != nil {
+		return err
+	}
+	return s.DeleteRoleTemplate(ctx, id)
+}
+
+// ShareTemplate gives a template of a moderator a share code, keeping the one it has
+func (s *RoleTemplateService) ShareTemplate(ctx context.Context, moderatorID string, id uuid.UUID) (*ent.RoleTemplate, error) {
+	template, err := s.getOwnedTemplate(ctx, moderatorID, id)
+	if err != nil {
+		return nil, err
+	}
+	if template.ShareCode != nil {
+		return template, nil
+	}
+
+	if err := template.Update().SetShareCode(newShareCode()).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetRoleTemplateByID(ctx, id)
+}
+
+// UnshareTemplate removes the share code of a template, so links to it stop working
+func (s *RoleTemplateService) UnshareTemplate(ctx context.Context, moderatorID string, id uuid.UUID) error {
+	template, err := s.getOwnedTemplate(ctx, moderatorID, id)
+	if err != nil {
+		return err
+	}
+	return template.Update().ClearShareCode().Exec(ctx)
+}
+
+// GetSharedTemplate returns the template with a share code
+func (s *RoleTemplateService) GetSharedTemplate(ctx context.Context, code string) (*ent.RoleTemplate, error) {
+	template, err := withAssignments(s.client.RoleTemplate.Query()).
+		Where(roletemplate.ShareCodeEQ(code)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+// SubmitTemplate asks the admins to publish a private template of a moderator
+func (s *RoleTemplateService) SubmitTemplate(ctx context.Context, moderatorID string, id uuid.UUID) (*ent.RoleTemplate, error) {
+	template, err := s.getOwnedTemplate(ctx, moderatorID, id)
+	if err != nil {
+		return nil, err
+	}
+	if template.Status != roletemplate.StatusPrivate {
+		return nil, ErrTemplateNotPrivate
+	}
+
+	err = template.Update().
+		SetStatus(roletemplate.StatusPending).
+		ClearReviewNote().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRoleTemplateByID(ctx, id)
+}
+
+// GetPendingTemplates lists the templates awaiting review, oldest first
+func (s *RoleTemplateService) GetPendingTemplates(ctx context.Context) ([]*ent.RoleTemplate, error) {
+	return withAssignments(s.client.RoleTemplate.Query()).
+		Where(roletemplate.StatusEQ(roletemplate.StatusPending)).
+		Order(ent.Asc(roletemplate.FieldUpdatedAt)).
+		All(ctx)
+}
+
+// ReviewTemplate publishes a pending template as a community template, or
+// returns it to its owner as private with a note on what to change
+func (s *RoleTemplateService) ReviewTemplate(ctx context.Context, id uuid.UUID, approve bool, note string) (*ent.RoleTemplate, error) {
+	template, err := s.GetRoleTemplateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if template.Status != roletemplate.StatusPending {
+		return nil, ErrTemplateNotPending
+	}
+
+	status := roletemplate.StatusPrivate
+	if approve {
+		status = roletemplate.StatusPublished
+	}
+	err = template.Update().
+		SetStatus(status).
+		SetReviewNote(note).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRoleTemplateByID(ctx, id)
+}
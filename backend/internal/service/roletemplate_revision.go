@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mafia-night/backend/ent"
+	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
+	"github.com/mafia-night/backend/ent/schema"
+)
+
+var ErrRevisionNotFound = errors.New("template revision not found")
+
+// RevisionAuthor is who changed a template
+type RevisionAuthor struct {
+	Kind roletemplaterevision.AuthorKind
+	// ID is the admin or moderator ID
+	ID string
+	// Name is the admin's username
+	Name string
+}
+
+type revisionAuthorKey struct{}
+
+// WithRevisionAuthor returns a context whose template changes are recorded
+// as made by the author. Changes without one are recorded as made by the system.
+func WithRevisionAuthor(ctx context.Context, author RevisionAuthor) context.Context {
+	return context.WithValue(ctx, revisionAuthorKey{}, author)
+}
+
+func revisionAuthor(ctx context.Context) RevisionAuthor {
+	if author, ok := ctx.Value(revisionAuthorKey{}).(RevisionAuthor); ok {
+		return author
+	}
+	return RevisionAuthor{Kind: roletemplaterevision.AuthorKindSystem}
+}
+
+// revisionChange describes a template change to record
+type revisionChange struct {
+	Action roletemplaterevision.Action
+	// From is the revision a rollback restored
+	From *int
+}
+
+// revisionRoles lists the composition of a template with its roles and slots
+// loaded: core roles by slug, then optional roles in the order they switch
+// on, with runs of the same role merged
+func revisionRoles(t *ent.RoleTemplate) []schema.RevisionRole {
+	roles := make([]schema.RevisionRole, 0, len(t.Edges.TemplateRoles)+len(t.Edges.OptionalSlots))
+	for _, tr := range t.Edges.TemplateRoles {
+		roles = append(roles, schema.RevisionRole{RoleID: tr.RoleID, Slug: tr.Edges.Role.Slug, Count: tr.Count})
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Slug < roles[j].Slug })
+
+	core := len(roles)
+	for _, slot := range t.Edges.OptionalSlots {
+		if last := len(roles) - 1; last >= core && roles[last].RoleID == slot.RoleID {
+			roles[last].Count++
+			continue
+		}
+		roles = append(roles, schema.RevisionRole{RoleID: slot.RoleID, Slug: slot.Edges.Role.Slug, Count: 1, Optional: true})
+	}
+	return roles
+}
+
+// recordRevision stores the template as it is now, read through client so a
+// transaction sees its own changes. A template changed for the first time
+// since history was kept gets a baseline revision of before, its old state.
+func recordRevision(ctx context.Context, client *ent.Client, templateID uuid.UUID, before *ent.RoleTemplate, change revisionChange) error {
+	last, err := client.RoleTemplateRevision.
+		Query().
+		Where(roletemplaterevision.RoleTemplateIDEQ(templateID)).
+		Order(ent.Desc(roletemplaterevision.FieldNumber)).
+		First(ctx)
+	number := 0
+	switch {
+	case err == nil:
+		number = last.Number
+	case !ent.IsNotFound(err):
+		return err
+	case before != nil:
+		number = 1
+		err := client.RoleTemplateRevision.
+			Create().
+			SetRoleTemplateID(templateID).
+			SetNumber(number).
+			SetName(before.Name).
+			SetPlayerCount(before.PlayerCount).
+			SetDescription(before.Description).
+			SetRoles(revisionRoles(before)).
+			SetAction(roletemplaterevision.ActionBaseline).
+			SetCreatedAt(before.UpdatedAt).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	template, err := withAssignments(client.RoleTemplate.Query()).
+		Where(roletemplate.IDEQ(templateID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	author := revisionAuthor(ctx)
+	return client.RoleTemplateRevision.
+		Create().
+		SetRoleTemplateID(templateID).
+		SetNumber(number + 1).
+		SetName(template.Name).
+		SetPlayerCount(template.PlayerCount).
+		SetDescription(template.Description).
+		SetRoles(revisionRoles(template)).
+		SetAction(change.Action).
+		SetNillableSourceNumber(change.From).
+		SetAuthorKind(author.Kind).
+		SetAuthorID(author.ID).
+		SetAuthorName(author.Name).
+		Exec(ctx)
+}
+
+// GetTemplateRevisions lists the revisions of a template, newest first
+func (s *RoleTemplateService) GetTemplateRevisions(ctx context.Context, templateID uuid.UUID) ([]*ent.RoleTemplateRevision, error) {
+	if _, err := s.GetRoleTemplateByID(ctx, templateID); err != nil {
+		return nil, err
+	}
+
+	return s.client.RoleTemplateRevision.
+		Query().
+		Where(roletemplaterevision.RoleTemplateIDEQ(templateID)).
+		Order(ent.Desc(roletemplaterevision.FieldNumber)).
+		All(ctx)
+}
+
+// GetTemplateRevision returns one revision of a template
+func (s *RoleTemplateService) GetTemplateRevision(ctx context.Context, templateID uuid.UUID, number int) (*ent.RoleTemplateRevision, error) {
+	revision, err := s.client.RoleTemplateRevision.
+		Query().
+		Where(
+			roletemplaterevision.RoleTemplateIDEQ(templateID),
+			roletemplaterevision.NumberEQ(number),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return revision, nil
+}
+
+// RevisionDiff is what changed in a template between two revisions
+type RevisionDiff struct {
+	From *ent.RoleTemplateRevision
+	To   *ent.RoleTemplateRevision
+	// Fields lists the changed fields among name, player_count and description
+	Fields []string
+	// Roles lists the roles dealt a different number of times, core roles first
+	Roles []RoleCountChange
+	// OptionalOrderChanged tells whether the optional roles switch on in
+	// another order, even where their counts are the same
+	OptionalOrderChanged bool
+}
+
+// RoleCountChange is how many copies of a role a template deals, as a core or optional role
+type RoleCountChange struct {
+	RoleID   uuid.UUID
+	Slug     string
+	Optional bool
+	From     int
+	To       int
+}
+
+// DiffTemplateRevisions compares two revisions of a template
+func (s *RoleTemplateService) DiffTemplateRevisions(ctx context.Context, templateID uuid.UUID, from, to int) (*RevisionDiff, error) {
+	fromRevision, err := s.GetTemplateRevision(ctx, templateID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := s.GetTemplateRevision(ctx, templateID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &RevisionDiff{From: fromRevision, To: toRevision}
+	if fromRevision.Name != toRevision.Name {
+		diff.Fields = append(diff.Fields, "name")
+	}
+	if fromRevision.PlayerCount != toRevision.PlayerCount {
+		diff.Fields = append(diff.Fields, "player_count")
+	}
+	if fromRevision.Description != toRevision.Description {
+		diff.Fields = append(diff.Fields, "description")
+	}
+
+	type roleKey struct {
+		id       uuid.UUID
+		optional bool
+	}
+	changes := make(map[roleKey]*RoleCountChange)
+	count := func(roles []schema.RevisionRole, to bool) {
+		for _, r := range roles {
+			key := roleKey{r.RoleID, r.Optional}
+			c, ok := changes[key]
+			if !ok {
+				c = &RoleCountChange{RoleID: r.RoleID, Slug: r.Slug, Optional: r.Optional}
+				changes[key] = c
+			}
+			if to {
+				c.To += r.Count
+				c.Slug = r.Slug
+			} else {
+				c.From += r.Count
+			}
+		}
+	}
+	count(fromRevision.Roles, false)
+	count(toRevision.Roles, true)
+
+	for _, c := range changes {
+		if c.From != c.To {
+			diff.Roles = append(diff.Roles, *c)
+		}
+	}
+	sort.Slice(diff.Roles, func(i, j int) bool {
+		if diff.Roles[i].Optional != diff.Roles[j].Optional {
+			return !diff.Roles[i].Optional
+		}
+		return diff.Roles[i].Slug < diff.Roles[j].Slug
+	})
+
+	diff.OptionalOrderChanged = !slices.Equal(optionalSequence(fromRevision.Roles), optionalSequence(toRevision.Roles))
+	return diff, nil
+}
+
+// optionalSequence lists the optional roles of a revision slot by slot
+func optionalSequence(roles []schema.RevisionRole) []uuid.UUID {
+	var slots []uuid.UUID
+	for _, r := range roles {
+		if r.Optional {
+			for range r.Count {
+				slots = append(slots, r.RoleID)
+			}
+		}
+	}
+	return slots
+}
+
+// revisionAssignments turns the roles of a revision back into assignments,
+// failing when one of them was deleted since
+func (s *RoleTemplateService) revisionAssignments(ctx context.Context, revision *ent.RoleTemplateRevision) ([]RoleAssignment, error) {
+	roles := make([]RoleAssignment, len(revision.Roles))
+	ids := make([]uuid.UUID, len(revision.Roles))
+	for i, r := range revision.Roles {
+		roles[i] = RoleAssignment{RoleID: r.RoleID, Count: r.Count, Optional: r.Optional}
+		ids[i] = r.RoleID
+	}
+
+	found, err := s.client.Role.Query().Where(role.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for _, r := range revision.Roles {
+		if !slices.Contains(found, r.RoleID) && !slices.Contains(missing, r.Slug) {
+			missing = append(missing, r.Slug)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRoleNotFound, strings.Join(missing, ", "))
+	}
+	return roles, nil
+}
+
+// RollbackTemplate restores a template to one of its revisions. The rollback
+// is recorded as a new revision, so it can be undone in turn.
+func (s *RoleTemplateService) RollbackTemplate(ctx context.Context, templateID uuid.UUID, number int) (*ent.RoleTemplate, error) {
+	existingTemplate, err := s.GetRoleTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.GetTemplateRevision(ctx, templateID, number)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.revisionAssignments(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateRoleTemplate(ctx, existingTemplate, &revision.Name, &revision.PlayerCount, &revision.Description, roles,
+		revisionChange{Action: roletemplaterevision.ActionRollback, From: &number}, nil)
+}
+
+// CloneTemplate copies a template, with its translations, into a new curated
+// template under another name
+func (s *RoleTemplateService) CloneTemplate(ctx context.Context, templateID uuid.UUID, name string) (*ent.RoleTemplate, error) {
+	source, err := s.GetRoleTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := s.createRoleTemplate(ctx, nil, name, source.PlayerCount, source.Description, templateAssignments(source),
+		revisionChange{Action: roletemplaterevision.ActionClone})
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.client.RoleTemplateTranslation.
+		Query().
+		Where(roletemplatetranslation.RoleTemplateIDEQ(templateID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(translations) == 0 {
+		return clone, nil
+	}
+	creates := make([]*ent.RoleTemplateTranslationCreate, len(translations))
+	for i, tr := range translations {
+		creates[i] = s.client.RoleTemplateTranslation.
+			Create().
+			SetRoleTemplateID(clone.ID).
+			SetLocale(tr.Locale).
+			SetDescription(tr.Description)
+	}
+	if err := s.client.RoleTemplateTranslation.CreateBulk(creates...).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetRoleTemplateByID(ctx, clone.ID)
+}
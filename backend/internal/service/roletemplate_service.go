@@ -3,11 +3,16 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent"
 	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
 	"github.com/mafia-night/backend/ent/roletemplaterole"
+	"github.com/mafia-night/backend/ent/roletemplateslot"
+	"github.com/mafia-night/backend/ent/roletemplatetranslation"
 )
 
 var (
@@ -19,47 +24,245 @@ var (
 	ErrInvalidTemplateRoleCount  = errors.New("role count must be positive")
 	ErrPlayerCountMismatch       = errors.New("sum of role counts must equal player count")
 	ErrRoleTemplateRoleNotFound  = errors.New("role template role not found")
+	ErrPlayerCountOutOfRange     = errors.New("template does not cover this player count")
+	ErrInvalidTemplateSource     = errors.New("source must be curated or community")
 )
 
 // RoleTemplateService handles role template-related business logic
 type RoleTemplateService struct {
-	client *ent.Client
+	client        *ent.Client
+	defaultLocale string
 }
 
 // NewRoleTemplateService creates a new role template service
 func NewRoleTemplateService(client *ent.Client) *RoleTemplateService {
-	return &RoleTemplateService{client: client}
+	return &RoleTemplateService{client: client, defaultLocale: DefaultLocale}
 }
 
-// RoleAssignment represents a role and its count in a template
+// WithDefaultLocale sets the locale of the texts stored on templates and roles themselves
+func (s *RoleTemplateService) WithDefaultLocale(locale string) *RoleTemplateService {
+	s.defaultLocale = locale
+	return s
+}
+
+// Localizer picks the texts of templates and their roles in the locales a reader prefers
+func (s *RoleTemplateService) Localizer(prefs LocalePreferences) Localizer {
+	return Localizer{DefaultLocale: s.defaultLocale, Preferences: prefs}
+}
+
+// RoleAssignment represents a role and its count in a template.
+// Optional assignments become optional slots, one per count, that switch on
+// in order as the lobby grows past the template's player count.
 type RoleAssignment struct {
-	RoleID uuid.UUID
-	Count  int
+	RoleID   uuid.UUID
+	Count    int
+	Optional bool
 }
 
-// CreateRoleTemplate creates a new role template with role assignments
-func (s *RoleTemplateService) CreateRoleTemplate(ctx context.Context, name string, playerCount int, description string, roles []RoleAssignment) (*ent.RoleTemplate, error) {
-	if name == "" {
-		return nil, ErrEmptyTemplateName
+// assignmentCounts sums the counts of each role a template deals to a lobby
+// of the given size: its core roles plus the optional slots the size switches on
+func assignmentCounts(roles []RoleAssignment, players int) map[uuid.UUID]int {
+	counts := make(map[uuid.UUID]int, len(roles))
+	extra := players
+	for _, r := range roles {
+		if !r.Optional {
+			counts[r.RoleID] += r.Count
+			extra -= r.Count
+		}
 	}
-	if playerCount <= 0 {
-		return nil, ErrInvalidPlayerCount
+	for _, r := range roles {
+		if r.Optional && extra > 0 {
+			n := min(r.Count, extra)
+			counts[r.RoleID] += n
+			extra -= n
+		}
 	}
+	return counts
+}
+
+// validateAssignments checks the roles of a template against its player
+// count and returns the largest player count the template covers. The roles
+// must follow their role rules at every player count in the range.
+func (s *RoleTemplateService) validateAssignments(ctx context.Context, playerCount int, roles []RoleAssignment) (int, error) {
 	if len(roles) == 0 {
-		return nil, ErrEmptyRoles
+		return 0, ErrEmptyRoles
 	}
 
-	// Validate role counts
-	totalCount := 0
+	coreCount, optionalCount := 0, 0
 	for _, r := range roles {
 		if r.Count <= 0 {
-			return nil, ErrInvalidTemplateRoleCount
+			return 0, ErrInvalidTemplateRoleCount
+		}
+		if r.Optional {
+			optionalCount += r.Count
+		} else {
+			coreCount += r.Count
+		}
+	}
+
+	if coreCount != playerCount {
+		return 0, ErrPlayerCountMismatch
+	}
+
+	maxPlayerCount := playerCount + optionalCount
+	for players := playerCount; players <= maxPlayerCount; players++ {
+		if err := checkRoleRules(ctx, s.client, assignmentCounts(roles, players)); err != nil {
+			if optionalCount > 0 {
+				return 0, fmt.Errorf("%w (with %d players)", err, players)
+			}
+			return 0, err
 		}
-		totalCount += r.Count
 	}
+	return maxPlayerCount, nil
+}
 
-	if totalCount != playerCount {
-		return nil, ErrPlayerCountMismatch
+// assignedRoleIDs lists the roles of a template's assignments
+func assignedRoleIDs(roles []RoleAssignment) []uuid.UUID {
+	ids := make([]uuid.UUID, len(roles))
+	for i, r := range roles {
+		ids[i] = r.RoleID
+	}
+	return ids
+}
+
+// addedRoleIDs lists the roles of the assignments that a template loaded with
+// its assignments does not have yet
+func addedRoleIDs(t *ent.RoleTemplate, roles []RoleAssignment) []uuid.UUID {
+	existing := make(map[uuid.UUID]bool)
+	for _, id := range assignedRoleIDs(templateAssignments(t)) {
+		existing[id] = true
+	}
+
+	var added []uuid.UUID
+	for _, id := range assignedRoleIDs(roles) {
+		if !existing[id] {
+			added = append(added, id)
+		}
+	}
+	return added
+}
+
+// templateAssignments lists the core roles and optional slots of a template
+// loaded with its assignments
+func templateAssignments(t *ent.RoleTemplate) []RoleAssignment {
+	roles := make([]RoleAssignment, 0, len(t.Edges.TemplateRoles)+len(t.Edges.OptionalSlots))
+	for _, r := range revisionRoles(t) {
+		roles = append(roles, RoleAssignment{RoleID: r.RoleID, Count: r.Count, Optional: r.Optional})
+	}
+	return roles
+}
+
+// createAssignments stores the core roles and optional slots of a template
+func createAssignments(ctx context.Context, client *ent.Client, templateID uuid.UUID, roles []RoleAssignment) error {
+	core := make(map[uuid.UUID]int)
+	var order []uuid.UUID
+	position := 0
+	for _, r := range roles {
+		if !r.Optional {
+			if _, ok := core[r.RoleID]; !ok {
+				order = append(order, r.RoleID)
+			}
+			core[r.RoleID] += r.Count
+			continue
+		}
+		for range r.Count {
+			_, err := client.RoleTemplateSlot.
+				Create().
+				SetRoleTemplateID(templateID).
+				SetRoleID(r.RoleID).
+				SetPosition(position).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			position++
+		}
+	}
+
+	for _, roleID := range order {
+		_, err := client.RoleTemplateRole.
+			Create().
+			SetRoleTemplateID(templateID).
+			SetRoleID(roleID).
+			SetCount(core[roleID]).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MaxPlayerCount returns the largest player count a template covers
+func MaxPlayerCount(t *ent.RoleTemplate) int {
+	// Templates saved before optional slots existed have no maximum stored
+	return max(t.MaxPlayerCount, t.PlayerCount)
+}
+
+// TemplateLineup returns the roles a template deals to a lobby of the given
+// size: its core roles followed by the roles of the optional slots that the
+// size switches on. The template must be loaded with its roles and slots.
+func TemplateLineup(t *ent.RoleTemplate, players int) ([]RoleAssignment, error) {
+	if players < t.PlayerCount || players > MaxPlayerCount(t) {
+		return nil, fmt.Errorf("%w: %q covers %d to %d players", ErrPlayerCountOutOfRange, t.Name, t.PlayerCount, MaxPlayerCount(t))
+	}
+
+	var lineup []RoleAssignment
+	index := make(map[uuid.UUID]int)
+	add := func(roleID uuid.UUID, count int) {
+		if i, ok := index[roleID]; ok {
+			lineup[i].Count += count
+			return
+		}
+		index[roleID] = len(lineup)
+		lineup = append(lineup, RoleAssignment{RoleID: roleID, Count: count})
+	}
+
+	for _, tr := range t.Edges.TemplateRoles {
+		add(tr.RoleID, tr.Count)
+	}
+	for _, slot := range t.Edges.OptionalSlots[:players-t.PlayerCount] {
+		add(slot.RoleID, 1)
+	}
+	return lineup, nil
+}
+
+// withAssignments loads the roles and optional slots of templates
+func withAssignments(query *ent.RoleTemplateQuery) *ent.RoleTemplateQuery {
+	return query.
+		WithTemplateRoles(func(q *ent.RoleTemplateRoleQuery) {
+			q.WithRole(func(q *ent.RoleQuery) { q.WithTranslations() })
+		}).
+		WithOptionalSlots(func(q *ent.RoleTemplateSlotQuery) {
+			q.WithRole(func(q *ent.RoleQuery) { q.WithTranslations() }).
+				Order(ent.Asc(roletemplateslot.FieldPosition))
+		}).
+		WithTranslations()
+}
+
+// CreateRoleTemplate creates a new role template with role assignments.
+// The core roles fill playerCount; optional roles extend the template to
+// larger lobbies. The roles must follow their role rules.
+func (s *RoleTemplateService) CreateRoleTemplate(ctx context.Context, name string, playerCount int, description string, roles []RoleAssignment) (*ent.RoleTemplate, error) {
+	return s.createRoleTemplate(ctx, nil, name, playerCount, description, roles, revisionChange{Action: roletemplaterevision.ActionCreate})
+}
+
+// createRoleTemplate creates a curated template, or a private one when it
+// has an owner, and records its first revision
+func (s *RoleTemplateService) createRoleTemplate(ctx context.Context, ownerID *string, name string, playerCount int, description string, roles []RoleAssignment, change revisionChange) (*ent.RoleTemplate, error) {
+	if name == "" {
+		return nil, ErrEmptyTemplateName
+	}
+	if playerCount <= 0 {
+		return nil, ErrInvalidPlayerCount
+	}
+
+	maxPlayerCount, err := s.validateAssignments(ctx, playerCount, roles)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRolesActive(ctx, s.client, assignedRoleIDs(roles)); err != nil {
+		return nil, err
 	}
 
 	// Start a transaction
@@ -72,11 +275,15 @@ func (s *RoleTemplateService) CreateRoleTemplate(ctx context.Context, name strin
 	create := tx.RoleTemplate.
 		Create().
 		SetName(name).
-		SetPlayerCount(playerCount)
+		SetPlayerCount(playerCount).
+		SetMaxPlayerCount(maxPlayerCount)
 
 	if description != "" {
 		create.SetDescription(description)
 	}
+	if ownerID != nil {
+		create.SetOwnerID(*ownerID).SetStatus(roletemplate.StatusPrivate)
+	}
 
 	template, err := create.Save(ctx)
 	if err != nil {
@@ -88,18 +295,14 @@ func (s *RoleTemplateService) CreateRoleTemplate(ctx context.Context, name strin
 	}
 
 	// Create role assignments
-	for _, r := range roles {
-		_, err := tx.RoleTemplateRole.
-			Create().
-			SetRoleTemplateID(template.ID).
-			SetRoleID(r.RoleID).
-			SetCount(r.Count).
-			Save(ctx)
+	if err := createAssignments(ctx, tx.Client(), template.ID, roles); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
-		if err != nil {
-			tx.Rollback()
-			return nil, err
-		}
+	if err := recordRevision(ctx, tx.Client(), template.ID, nil, change); err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 
 	// Commit transaction
@@ -111,17 +314,54 @@ func (s *RoleTemplateService) CreateRoleTemplate(ctx context.Context, name strin
 	return s.GetRoleTemplateByID(ctx, template.ID)
 }
 
-// GetAllRoleTemplates retrieves all role templates ordered by player count
+// TemplateSource tells curated templates, made by admins, from community
+// templates published by moderators
+type TemplateSource string
+
+const (
+	TemplateSourceCurated   TemplateSource = "curated"
+	TemplateSourceCommunity TemplateSource = "community"
+)
+
+// SourceOf returns where a template comes from
+func SourceOf(t *ent.RoleTemplate) TemplateSource {
+	if t.OwnerID == nil {
+		return TemplateSourceCurated
+	}
+	return TemplateSourceCommunity
+}
+
+// GetAllRoleTemplates retrieves all published role templates ordered by player count.
+// With a player count, only the templates covering it are returned.
 func (s *RoleTemplateService) GetAllRoleTemplates(ctx context.Context, playerCount *int) ([]*ent.RoleTemplate, error) {
-	query := s.client.RoleTemplate.
-		Query().
-		WithTemplateRoles(func(q *ent.RoleTemplateRoleQuery) {
-			q.WithRole()
-		}).
+	return s.ListRoleTemplates(ctx, playerCount, "")
+}
+
+// ListRoleTemplates retrieves the published templates of a source, or of
+// both with curated ones first, each ordered by player count
+func (s *RoleTemplateService) ListRoleTemplates(ctx context.Context, playerCount *int, source TemplateSource) ([]*ent.RoleTemplate, error) {
+	query := withAssignments(s.client.RoleTemplate.Query()).
+		Where(roletemplate.StatusEQ(roletemplate.StatusPublished)).
 		Order(ent.Asc(roletemplate.FieldPlayerCount), ent.Asc(roletemplate.FieldName))
 
+	switch source {
+	case "":
+	case TemplateSourceCurated:
+		query = query.Where(roletemplate.OwnerIDIsNil())
+	case TemplateSourceCommunity:
+		query = query.Where(roletemplate.OwnerIDNotNil())
+	default:
+		return nil, ErrInvalidTemplateSource
+	}
+
 	if playerCount != nil {
-		query = query.Where(roletemplate.PlayerCountEQ(*playerCount))
+		query = query.Where(roletemplate.Or(
+			roletemplate.PlayerCountEQ(*playerCount),
+			roletemplate.And(
+				roletemplate.PlayerCountLTE(*playerCount),
+				roletemplate.MaxPlayerCountGTE(*playerCount),
+			),
+		))
 	}
 
 	templates, err := query.All(ctx)
@@ -129,17 +369,16 @@ func (s *RoleTemplateService) GetAllRoleTemplates(ctx context.Context, playerCou
 		return nil, err
 	}
 
+	sort.SliceStable(templates, func(i, j int) bool {
+		return SourceOf(templates[i]) == TemplateSourceCurated && SourceOf(templates[j]) != TemplateSourceCurated
+	})
 	return templates, nil
 }
 
 // GetRoleTemplateByID retrieves a role template by its ID with role assignments
 func (s *RoleTemplateService) GetRoleTemplateByID(ctx context.Context, id uuid.UUID) (*ent.RoleTemplate, error) {
-	template, err := s.client.RoleTemplate.
-		Query().
+	template, err := withAssignments(s.client.RoleTemplate.Query()).
 		Where(roletemplate.IDEQ(id)).
-		WithTemplateRoles(func(q *ent.RoleTemplateRoleQuery) {
-			q.WithRole()
-		}).
 		Only(ctx)
 
 	if err != nil {
@@ -159,29 +398,36 @@ func (s *RoleTemplateService) UpdateRoleTemplate(ctx context.Context, id uuid.UU
 		return nil, err
 	}
 
+	return s.updateRoleTemplate(ctx, existingTemplate, name, playerCount, description, roles, revisionChange{Action: roletemplaterevision.ActionUpdate}, nil)
+}
+
+// updateRoleTemplate updates a template and records the change as a revision;
+// extra may change further fields in the same transaction
+func (s *RoleTemplateService) updateRoleTemplate(ctx context.Context, existingTemplate *ent.RoleTemplate, name *string, playerCount *int, description *string, roles []RoleAssignment, change revisionChange, extra func(*ent.RoleTemplateUpdateOne)) (*ent.RoleTemplate, error) {
+	var err error
+
+	// Determine the player count to validate against
+	validatePlayerCount := existingTemplate.PlayerCount
+	if playerCount != nil && *playerCount > 0 {
+		validatePlayerCount = *playerCount
+	}
+
 	// If roles are being updated, validate them
+	maxPlayerCount := validatePlayerCount + len(existingTemplate.Edges.OptionalSlots)
 	if roles != nil {
-		if len(roles) == 0 {
-			return nil, ErrEmptyRoles
-		}
-
-		// Determine the player count to validate against
-		validatePlayerCount := existingTemplate.PlayerCount
-		if playerCount != nil {
-			validatePlayerCount = *playerCount
+		maxPlayerCount, err = s.validateAssignments(ctx, validatePlayerCount, roles)
+		if err != nil {
+			return nil, err
 		}
-
-		// Validate role counts
-		totalCount := 0
-		for _, r := range roles {
-			if r.Count <= 0 {
-				return nil, ErrInvalidTemplateRoleCount
-			}
-			totalCount += r.Count
+		// Roles archived since they were added stay usable in this template
+		if err := checkRolesActive(ctx, s.client, addedRoleIDs(existingTemplate, roles)); err != nil {
+			return nil, err
 		}
-
-		if totalCount != validatePlayerCount {
-			return nil, ErrPlayerCountMismatch
+	} else if validatePlayerCount != existingTemplate.PlayerCount {
+		// The roles the template already has must still fit the new player count
+		maxPlayerCount, err = s.validateAssignments(ctx, validatePlayerCount, templateAssignments(existingTemplate))
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -200,9 +446,13 @@ func (s *RoleTemplateService) UpdateRoleTemplate(ctx context.Context, id uuid.UU
 	if playerCount != nil && *playerCount > 0 {
 		update.SetPlayerCount(*playerCount)
 	}
+	update.SetMaxPlayerCount(maxPlayerCount)
 	if description != nil {
 		update.SetDescription(*description)
 	}
+	if extra != nil {
+		extra(update)
+	}
 
 	_, err = update.Save(ctx)
 	if err != nil {
@@ -226,22 +476,28 @@ func (s *RoleTemplateService) UpdateRoleTemplate(ctx context.Context, id uuid.UU
 			return nil, err
 		}
 
-		// Create new role assignments
-		for _, r := range roles {
-			_, err := tx.RoleTemplateRole.
-				Create().
-				SetRoleTemplateID(existingTemplate.ID).
-				SetRoleID(r.RoleID).
-				SetCount(r.Count).
-				Save(ctx)
+		_, err = tx.RoleTemplateSlot.
+			Delete().
+			Where(roletemplateslot.RoleTemplateIDEQ(existingTemplate.ID)).
+			Exec(ctx)
 
-			if err != nil {
-				tx.Rollback()
-				return nil, err
-			}
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		// Create new role assignments
+		if err := createAssignments(ctx, tx.Client(), existingTemplate.ID, roles); err != nil {
+			tx.Rollback()
+			return nil, err
 		}
 	}
 
+	if err := recordRevision(ctx, tx.Client(), existingTemplate.ID, existingTemplate, change); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return nil, err
@@ -284,3 +540,71 @@ func (s *RoleTemplateService) DeleteRoleTemplate(ctx context.Context, id uuid.UU
 
 	return tx.Commit()
 }
+
+// GetTemplateTranslations lists the translations of a template ordered by locale
+func (s *RoleTemplateService) GetTemplateTranslations(ctx context.Context, templateID uuid.UUID) ([]*ent.RoleTemplateTranslation, error) {
+	if _, err := s.GetRoleTemplateByID(ctx, templateID); err != nil {
+		return nil, err
+	}
+
+	return s.client.RoleTemplateTranslation.
+		Query().
+		Where(roletemplatetranslation.RoleTemplateIDEQ(templateID)).
+		Order(ent.Asc(roletemplatetranslation.FieldLocale)).
+		All(ctx)
+}
+
+// SetTemplateTranslation creates or replaces the description of a template in a locale
+func (s *RoleTemplateService) SetTemplateTranslation(ctx context.Context, templateID uuid.UUID, locale, description string) (*ent.RoleTemplateTranslation, error) {
+	locale, err := NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if locale == s.defaultLocale {
+		return nil, ErrDefaultLocaleTranslation
+	}
+	if description == "" {
+		return nil, ErrEmptyTranslatedDescription
+	}
+	if _, err := s.GetRoleTemplateByID(ctx, templateID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.client.RoleTemplateTranslation.
+		Query().
+		Where(roletemplatetranslation.RoleTemplateIDEQ(templateID), roletemplatetranslation.LocaleEQ(locale)).
+		Only(ctx)
+	if err == nil {
+		return existing.Update().SetDescription(description).Save(ctx)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return s.client.RoleTemplateTranslation.
+		Create().
+		SetRoleTemplateID(templateID).
+		SetLocale(locale).
+		SetDescription(description).
+		Save(ctx)
+}
+
+// DeleteTemplateTranslation removes the description of a template in a locale
+func (s *RoleTemplateService) DeleteTemplateTranslation(ctx context.Context, templateID uuid.UUID, locale string) error {
+	locale, err := NormalizeLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.client.RoleTemplateTranslation.
+		Delete().
+		Where(roletemplatetranslation.RoleTemplateIDEQ(templateID), roletemplatetranslation.LocaleEQ(locale)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrTranslationNotFound
+	}
+	return nil
+}
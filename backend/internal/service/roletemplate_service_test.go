@@ -4,7 +4,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/mafia-night/backend/ent/role"
+	"github.com/mafia-night/backend/ent/rolerule"
+	"github.com/mafia-night/backend/ent/roletemplate"
+	"github.com/mafia-night/backend/ent/roletemplaterevision"
+	"github.com/mafia-night/backend/ent/schema"
 	"github.com/mafia-night/backend/internal/database"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -343,6 +348,20 @@ func TestRoleTemplateService_UpdateRoleTemplate(t *testing.T) {
 		assert.Equal(t, ErrPlayerCountMismatch, err)
 	})
 
+	t.Run("fails changing only the player count away from the roles", func(t *testing.T) {
+		roles := []RoleAssignment{{RoleID: mafia.ID, Count: 2}, {RoleID: villager.ID, Count: 4}}
+		created, err := templateService.CreateRoleTemplate(ctx, "Count Only Update", 6, "desc", roles)
+		require.NoError(t, err)
+
+		newPlayerCount := 8
+		_, err = templateService.UpdateRoleTemplate(ctx, created.ID, nil, &newPlayerCount, nil, nil)
+		assert.ErrorIs(t, err, ErrPlayerCountMismatch)
+
+		unchanged, err := templateService.GetRoleTemplateByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 6, unchanged.PlayerCount)
+	})
+
 	t.Run("fails for non-existent template", func(t *testing.T) {
 		roles := []RoleAssignment{{RoleID: mafia.ID, Count: 2}, {RoleID: villager.ID, Count: 4}}
 		created, err := templateService.CreateRoleTemplate(ctx, "To Delete 2", 6, "desc", roles)
@@ -398,3 +417,411 @@ func TestRoleTemplateService_DeleteRoleTemplate(t *testing.T) {
 		assert.Equal(t, ErrTemplateNotFound, err)
 	})
 }
+
+func TestRoleTemplateService_Translations(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := NewRoleService(client)
+	templateService := NewRoleTemplateService(client).WithDefaultLocale("fa")
+	ctx := context.Background()
+
+	mafia, err := roleService.CreateRole(ctx, "مافیا", "mafia-t", "video", "desc", role.TeamMafia, nil)
+	require.NoError(t, err)
+	_, err = roleService.WithDefaultLocale("fa").SetRoleTranslation(ctx, mafia.ID, "en", "Mafia", "", nil)
+	require.NoError(t, err)
+
+	created, err := templateService.CreateRoleTemplate(ctx, "Classic", 3, "سناریوی کلاسیک", []RoleAssignment{{RoleID: mafia.ID, Count: 3}})
+	require.NoError(t, err)
+
+	_, err = templateService.SetTemplateTranslation(ctx, created.ID, "en", "The classic scenario")
+	require.NoError(t, err)
+
+	_, err = templateService.SetTemplateTranslation(ctx, created.ID, "fa", "کلاسیک")
+	assert.ErrorIs(t, err, ErrDefaultLocaleTranslation)
+
+	templates, err := templateService.GetAllRoleTemplates(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+
+	localizer := templateService.Localizer(LocalePreferences{"en-US"})
+	locale, description := localizer.TemplateDescription(templates[0])
+	assert.Equal(t, "en", locale)
+	assert.Equal(t, "The classic scenario", description)
+	assert.Equal(t, "Mafia", localizer.Role(templates[0].Edges.TemplateRoles[0].Edges.Role).Name)
+
+	require.NoError(t, templateService.DeleteRoleTemplate(ctx, created.ID))
+	_, err = templateService.GetTemplateTranslations(ctx, created.ID)
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestRoleTemplateService_OptionalSlots(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := NewRoleService(client)
+	templateService := NewRoleTemplateService(client)
+	ctx := context.Background()
+
+	mafia, err := roleService.CreateRole(ctx, "Mafia Range", "mafia-range", "video", "desc", role.TeamMafia, nil)
+	require.NoError(t, err)
+	villager, err := roleService.CreateRole(ctx, "Villager Range", "villager-range", "video", "desc", role.TeamVillage, nil)
+	require.NoError(t, err)
+	doctor, err := roleService.CreateRole(ctx, "Doctor Range", "doctor-range", "video", "desc", role.TeamVillage, nil)
+	require.NoError(t, err)
+
+	roles := []RoleAssignment{
+		{RoleID: mafia.ID, Count: 2},
+		{RoleID: villager.ID, Count: 4},
+		{RoleID: doctor.ID, Count: 1, Optional: true},
+		{RoleID: mafia.ID, Count: 1, Optional: true},
+		{RoleID: villager.ID, Count: 2, Optional: true},
+	}
+	template, err := templateService.CreateRoleTemplate(ctx, "Growing Setup", 6, "", roles)
+	require.NoError(t, err)
+
+	t.Run("covers the core count plus the optional slots", func(t *testing.T) {
+		assert.Equal(t, 6, template.PlayerCount)
+		assert.Equal(t, 10, template.MaxPlayerCount)
+		assert.Len(t, template.Edges.TemplateRoles, 2)
+		require.Len(t, template.Edges.OptionalSlots, 4)
+
+		order := []uuid.UUID{doctor.ID, mafia.ID, villager.ID, villager.ID}
+		for i, slot := range template.Edges.OptionalSlots {
+			assert.Equal(t, i, slot.Position)
+			assert.Equal(t, order[i], slot.RoleID)
+		}
+	})
+
+	t.Run("switches slots on in order", func(t *testing.T) {
+		lineup, err := TemplateLineup(template, 6)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []RoleAssignment{{RoleID: mafia.ID, Count: 2}, {RoleID: villager.ID, Count: 4}}, lineup)
+
+		lineup, err = TemplateLineup(template, 8)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []RoleAssignment{
+			{RoleID: mafia.ID, Count: 3},
+			{RoleID: villager.ID, Count: 4},
+			{RoleID: doctor.ID, Count: 1},
+		}, lineup)
+		assert.Equal(t, doctor.ID, lineup[2].RoleID)
+
+		lineup, err = TemplateLineup(template, 10)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []RoleAssignment{
+			{RoleID: mafia.ID, Count: 3},
+			{RoleID: villager.ID, Count: 6},
+			{RoleID: doctor.ID, Count: 1},
+		}, lineup)
+
+		_, err = TemplateLineup(template, 11)
+		assert.ErrorIs(t, err, ErrPlayerCountOutOfRange)
+		_, err = TemplateLineup(template, 5)
+		assert.ErrorIs(t, err, ErrPlayerCountOutOfRange)
+	})
+
+	t.Run("lists every template covering a player count", func(t *testing.T) {
+		fixed := []RoleAssignment{{RoleID: mafia.ID, Count: 2}, {RoleID: villager.ID, Count: 6}}
+		_, err := templateService.CreateRoleTemplate(ctx, "Fixed Eight", 8, "", fixed)
+		require.NoError(t, err)
+
+		names := func(playerCount int) []string {
+			templates, err := templateService.GetAllRoleTemplates(ctx, &playerCount)
+			require.NoError(t, err)
+			var result []string
+			for _, tmpl := range templates {
+				result = append(result, tmpl.Name)
+			}
+			return result
+		}
+
+		assert.Equal(t, []string{"Growing Setup"}, names(6))
+		assert.Equal(t, []string{"Growing Setup", "Fixed Eight"}, names(8))
+		assert.Equal(t, []string{"Growing Setup"}, names(10))
+		assert.Empty(t, names(11))
+	})
+
+	t.Run("requires the core roles to fill the player count", func(t *testing.T) {
+		_, err := templateService.CreateRoleTemplate(ctx, "Short Core", 7, "", roles)
+		assert.ErrorIs(t, err, ErrPlayerCountMismatch)
+	})
+
+	t.Run("replaces the slots on update", func(t *testing.T) {
+		updated, err := templateService.UpdateRoleTemplate(ctx, template.ID, nil, nil, nil, []RoleAssignment{
+			{RoleID: mafia.ID, Count: 2},
+			{RoleID: villager.ID, Count: 4},
+			{RoleID: doctor.ID, Count: 1, Optional: true},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 7, updated.MaxPlayerCount)
+		require.Len(t, updated.Edges.OptionalSlots, 1)
+		assert.Equal(t, doctor.ID, updated.Edges.OptionalSlots[0].RoleID)
+
+		updated, err = templateService.UpdateRoleTemplate(ctx, template.ID, nil, nil, nil, []RoleAssignment{
+			{RoleID: mafia.ID, Count: 2},
+			{RoleID: villager.ID, Count: 4},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 6, updated.MaxPlayerCount)
+		assert.Empty(t, updated.Edges.OptionalSlots)
+	})
+
+	t.Run("checks role rules at every player count", func(t *testing.T) {
+		two := 2
+		_, err := roleService.AddRoleRule(ctx, mafia.ID, RoleRuleInput{Kind: rolerule.KindMaxCount, MaxCount: &two})
+		require.NoError(t, err)
+
+		_, err = templateService.CreateRoleTemplate(ctx, "Too Much Mafia", 6, "", roles)
+		assert.ErrorIs(t, err, ErrRoleRuleViolated)
+		assert.Contains(t, err.Error(), "with 8 players")
+	})
+}
+
+func TestRoleTemplateService_ModeratorTemplates(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := NewRoleService(client)
+	templateService := NewRoleTemplateService(client)
+	ctx := context.Background()
+
+	mafia, _ := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "desc", role.TeamMafia, nil)
+	villager, _ := roleService.CreateRole(ctx, "Villager", "villager", "video", "desc", role.TeamVillage, nil)
+	roles := []RoleAssignment{{RoleID: mafia.ID, Count: 1}, {RoleID: villager.ID, Count: 3}}
+
+	curated, err := templateService.CreateRoleTemplate(ctx, "Curated", 4, "", roles)
+	require.NoError(t, err)
+	assert.Equal(t, TemplateSourceCurated, SourceOf(curated))
+
+	mine, err := templateService.CreateModeratorTemplate(ctx, "alice", "Alice's Night", 4, "", roles)
+	require.NoError(t, err)
+	assert.Equal(t, roletemplate.StatusPrivate, mine.Status)
+	assert.Equal(t, TemplateSourceCommunity, SourceOf(mine))
+
+	t.Run("private templates stay out of the listing", func(t *testing.T) {
+		templates, err := templateService.ListRoleTemplates(ctx, nil, "")
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+		assert.Equal(t, curated.ID, templates[0].ID)
+
+		_, err = templateService.GetVisibleRoleTemplate(ctx, mine.ID, "")
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+		_, err = templateService.GetVisibleRoleTemplate(ctx, mine.ID, "alice")
+		assert.NoError(t, err)
+
+		owned, err := templateService.GetModeratorTemplates(ctx, "alice")
+		require.NoError(t, err)
+		assert.Len(t, owned, 1)
+	})
+
+	t.Run("other moderators cannot touch the template", func(t *testing.T) {
+		name := "Stolen"
+		_, err := templateService.UpdateModeratorTemplate(ctx, "bob", mine.ID, &name, nil, nil, nil)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+		assert.ErrorIs(t, templateService.DeleteModeratorTemplate(ctx, "bob", mine.ID), ErrTemplateNotFound)
+		_, err = templateService.ShareTemplate(ctx, "bob", mine.ID)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+		_, err = templateService.CreateModeratorTemplate(ctx, "", "Nobody's", 4, "", roles)
+		assert.ErrorIs(t, err, ErrEmptyModeratorID)
+	})
+
+	t.Run("shares by code until unshared", func(t *testing.T) {
+		shared, err := templateService.ShareTemplate(ctx, "alice", mine.ID)
+		require.NoError(t, err)
+		require.NotNil(t, shared.ShareCode)
+		assert.Len(t, *shared.ShareCode, 10)
+
+		again, err := templateService.ShareTemplate(ctx, "alice", mine.ID)
+		require.NoError(t, err)
+		assert.Equal(t, *shared.ShareCode, *again.ShareCode, "sharing twice keeps the code")
+
+		found, err := templateService.GetSharedTemplate(ctx, *shared.ShareCode)
+		require.NoError(t, err)
+		assert.Equal(t, mine.ID, found.ID)
+
+		require.NoError(t, templateService.UnshareTemplate(ctx, "alice", mine.ID))
+		_, err = templateService.GetSharedTemplate(ctx, *shared.ShareCode)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+	})
+
+	t.Run("publishes after review", func(t *testing.T) {
+		_, err := templateService.ReviewTemplate(ctx, mine.ID, true, "")
+		assert.ErrorIs(t, err, ErrTemplateNotPending)
+
+		submitted, err := templateService.SubmitTemplate(ctx, "alice", mine.ID)
+		require.NoError(t, err)
+		assert.Equal(t, roletemplate.StatusPending, submitted.Status)
+		_, err = templateService.SubmitTemplate(ctx, "alice", mine.ID)
+		assert.ErrorIs(t, err, ErrTemplateNotPrivate)
+
+		pending, err := templateService.GetPendingTemplates(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		rejected, err := templateService.ReviewTemplate(ctx, mine.ID, false, "Too many villagers")
+		require.NoError(t, err)
+		assert.Equal(t, roletemplate.StatusPrivate, rejected.Status)
+		assert.Equal(t, "Too many villagers", rejected.ReviewNote)
+
+		_, err = templateService.SubmitTemplate(ctx, "alice", mine.ID)
+		require.NoError(t, err)
+		published, err := templateService.ReviewTemplate(ctx, mine.ID, true, "")
+		require.NoError(t, err)
+		assert.Equal(t, roletemplate.StatusPublished, published.Status)
+
+		templates, err := templateService.ListRoleTemplates(ctx, nil, "")
+		require.NoError(t, err)
+		require.Len(t, templates, 2)
+		assert.Equal(t, curated.ID, templates[0].ID, "curated templates come first")
+		assert.Equal(t, mine.ID, templates[1].ID)
+
+		community, err := templateService.ListRoleTemplates(ctx, nil, TemplateSourceCommunity)
+		require.NoError(t, err)
+		require.Len(t, community, 1)
+		assert.Equal(t, mine.ID, community[0].ID)
+
+		_, err = templateService.ListRoleTemplates(ctx, nil, "secret")
+		assert.ErrorIs(t, err, ErrInvalidTemplateSource)
+	})
+
+	t.Run("editing a published template takes it back to private", func(t *testing.T) {
+		description := "Now with a story"
+		updated, err := templateService.UpdateModeratorTemplate(ctx, "alice", mine.ID, nil, nil, &description, nil)
+		require.NoError(t, err)
+		assert.Equal(t, roletemplate.StatusPrivate, updated.Status)
+
+		require.NoError(t, templateService.DeleteModeratorTemplate(ctx, "alice", mine.ID))
+		_, err = templateService.GetRoleTemplateByID(ctx, mine.ID)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+	})
+
+	t.Run("names are unique per owner and among curated templates", func(t *testing.T) {
+		_, err := templateService.CreateModeratorTemplate(ctx, "alice", "Friday Night", 4, "", roles)
+		require.NoError(t, err)
+		_, err = templateService.CreateModeratorTemplate(ctx, "bob", "Friday Night", 4, "", roles)
+		assert.NoError(t, err)
+		_, err = templateService.CreateModeratorTemplate(ctx, "bob", "Curated", 4, "", roles)
+		assert.NoError(t, err)
+
+		_, err = templateService.CreateModeratorTemplate(ctx, "alice", "Friday Night", 4, "", roles)
+		assert.ErrorIs(t, err, ErrTemplateNameExists)
+		_, err = templateService.CreateRoleTemplate(ctx, "Curated", 4, "", roles)
+		assert.ErrorIs(t, err, ErrTemplateNameExists)
+	})
+}
+
+func TestRoleTemplateService_Revisions(t *testing.T) {
+	client := database.SetupTestDB(t)
+	roleService := NewRoleService(client)
+	templateService := NewRoleTemplateService(client)
+	ctx := context.Background()
+
+	mafia, _ := roleService.CreateRole(ctx, "Mafia", "mafia", "video", "desc", role.TeamMafia, nil)
+	villager, _ := roleService.CreateRole(ctx, "Villager", "villager", "video", "desc", role.TeamVillage, nil)
+	doctor, _ := roleService.CreateRole(ctx, "Doctor", "doctor", "video", "desc", role.TeamVillage, nil)
+
+	admin := WithRevisionAuthor(ctx, RevisionAuthor{Kind: roletemplaterevision.AuthorKindAdmin, ID: "admin-1", Name: "root"})
+	template, err := templateService.CreateRoleTemplate(admin, "Classic", 4, "First try", []RoleAssignment{
+		{RoleID: mafia.ID, Count: 1},
+		{RoleID: villager.ID, Count: 3},
+	})
+	require.NoError(t, err)
+
+	playerCount := 5
+	description := "With a doctor"
+	_, err = templateService.UpdateRoleTemplate(ctx, template.ID, nil, &playerCount, &description, []RoleAssignment{
+		{RoleID: mafia.ID, Count: 1},
+		{RoleID: villager.ID, Count: 3},
+		{RoleID: doctor.ID, Count: 1},
+		{RoleID: mafia.ID, Count: 1, Optional: true},
+	})
+	require.NoError(t, err)
+
+	t.Run("records every change with its author", func(t *testing.T) {
+		revisions, err := templateService.GetTemplateRevisions(ctx, template.ID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+
+		latest, first := revisions[0], revisions[1]
+		assert.Equal(t, 2, latest.Number)
+		assert.Equal(t, roletemplaterevision.ActionUpdate, latest.Action)
+		assert.Equal(t, roletemplaterevision.AuthorKindSystem, latest.AuthorKind)
+		assert.Equal(t, 5, latest.PlayerCount)
+		assert.Equal(t, []schema.RevisionRole{
+			{RoleID: doctor.ID, Slug: "doctor", Count: 1},
+			{RoleID: mafia.ID, Slug: "mafia", Count: 1},
+			{RoleID: villager.ID, Slug: "villager", Count: 3},
+			{RoleID: mafia.ID, Slug: "mafia", Count: 1, Optional: true},
+		}, latest.Roles)
+
+		assert.Equal(t, 1, first.Number)
+		assert.Equal(t, roletemplaterevision.ActionCreate, first.Action)
+		assert.Equal(t, roletemplaterevision.AuthorKindAdmin, first.AuthorKind)
+		assert.Equal(t, "root", first.AuthorName)
+	})
+
+	t.Run("diffs two revisions", func(t *testing.T) {
+		diff, err := templateService.DiffTemplateRevisions(ctx, template.ID, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"player_count", "description"}, diff.Fields)
+		assert.Equal(t, []RoleCountChange{
+			{RoleID: doctor.ID, Slug: "doctor", From: 0, To: 1},
+			{RoleID: mafia.ID, Slug: "mafia", Optional: true, From: 0, To: 1},
+		}, diff.Roles)
+		assert.True(t, diff.OptionalOrderChanged)
+
+		_, err = templateService.DiffTemplateRevisions(ctx, template.ID, 1, 9)
+		assert.ErrorIs(t, err, ErrRevisionNotFound)
+	})
+
+	t.Run("rolls back to an earlier revision", func(t *testing.T) {
+		restored, err := templateService.RollbackTemplate(ctx, template.ID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 4, restored.PlayerCount)
+		assert.Equal(t, "First try", restored.Description)
+		assert.Empty(t, restored.Edges.OptionalSlots)
+		assert.Len(t, restored.Edges.TemplateRoles, 2)
+
+		latest, err := templateService.GetTemplateRevision(ctx, template.ID, 3)
+		require.NoError(t, err)
+		assert.Equal(t, roletemplaterevision.ActionRollback, latest.Action)
+		assert.Equal(t, 1, *latest.SourceNumber)
+
+		diff, err := templateService.DiffTemplateRevisions(ctx, template.ID, 1, 3)
+		require.NoError(t, err)
+		assert.Empty(t, diff.Fields)
+		assert.Empty(t, diff.Roles)
+	})
+
+	t.Run("clones under a new name", func(t *testing.T) {
+		_, err := templateService.SetTemplateTranslation(ctx, template.ID, "fa", "تلاش اول")
+		require.NoError(t, err)
+
+		clone, err := templateService.CloneTemplate(ctx, template.ID, "Classic Copy")
+		require.NoError(t, err)
+		assert.NotEqual(t, template.ID, clone.ID)
+		assert.Equal(t, 4, clone.PlayerCount)
+		assert.Len(t, clone.Edges.TemplateRoles, 2)
+		require.Len(t, clone.Edges.Translations, 1)
+		assert.Equal(t, "تلاش اول", clone.Edges.Translations[0].Description)
+
+		revisions, err := templateService.GetTemplateRevisions(ctx, clone.ID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		assert.Equal(t, roletemplaterevision.ActionClone, revisions[0].Action)
+
+		_, err = templateService.CloneTemplate(ctx, template.ID, "Classic")
+		assert.ErrorIs(t, err, ErrTemplateNameExists)
+	})
+
+	t.Run("keeps a baseline of templates older than their history", func(t *testing.T) {
+		_, err := client.RoleTemplateRevision.Delete().Where(roletemplaterevision.RoleTemplateIDEQ(template.ID)).Exec(ctx)
+		require.NoError(t, err)
+
+		name := "Classic Renamed"
+		_, err = templateService.UpdateRoleTemplate(ctx, template.ID, &name, nil, nil, nil)
+		require.NoError(t, err)
+
+		revisions, err := templateService.GetTemplateRevisions(ctx, template.ID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, roletemplaterevision.ActionBaseline, revisions[1].Action)
+		assert.Equal(t, "Classic", revisions[1].Name)
+		assert.Equal(t, "Classic Renamed", revisions[0].Name)
+	})
+}
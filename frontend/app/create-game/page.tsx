@@ -21,6 +21,7 @@ interface Game {
   moderator_id: string;
   status: string;
   created_at: string;
+  token?: string;
 }
 
 type GamePhase = 'not-created' | 'waiting-for-players' | 'selecting-roles' | 'game-started';
@@ -29,6 +30,7 @@ export default function CreateGamePage() {
   const [game, setGame] = useState<Game | null>(null);
   const [players, setPlayers] = useState<Player[]>([]);
   const [moderatorId, setModeratorId] = useState<string>('');
+  const [gameToken, setGameToken] = useState<string>('');
   const [loading, setLoading] = useState(false);
   const [closing, setClosing] = useState(false);
   const [removingPlayerId, setRemovingPlayerId] = useState<string | null>(null);
@@ -53,6 +55,7 @@ export default function CreateGamePage() {
             const gameData = await res.json();
             setGame(gameData);
             setModeratorId(validatedState.moderatorId);
+            setGameToken(validatedState.token);
             // check if the roles are distributed by api
             const roles = await getGameRoles(validatedState.gameId, validatedState.moderatorId);
             if (roles && roles.length > 0) {
@@ -80,6 +83,7 @@ export default function CreateGamePage() {
   // WebSocket connection for real-time updates
   useGameWebSocket({
     gameId: game?.id || '',
+    token: gameToken,
     enabled: !!game && gamePhase !== 'game-started',
     onPlayerJoined: (player) => {
       setPlayers(prev => {
@@ -126,10 +130,11 @@ export default function CreateGamePage() {
 
       const gameData = await response.json();
       setGame(gameData);
+      setGameToken(gameData.token);
       setGamePhase('waiting-for-players');
       
       // Save to localStorage
-      saveModeratorGame(gameData.id, moderatorId, 'waiting-for-players');
+      saveModeratorGame(gameData.id, moderatorId, gameData.token, 'waiting-for-players');
     } catch (err) {
       setError(err instanceof Error ? err.message : 'Failed to create game');
     } finally {
@@ -153,7 +158,7 @@ export default function CreateGamePage() {
   const handleStartRoleSelection = () => {
     if (players.length > 0 && game) {
       setGamePhase('selecting-roles');
-      saveModeratorGame(game.id, moderatorId, 'selecting-roles');
+      saveModeratorGame(game.id, moderatorId, gameToken, 'selecting-roles');
     }
   };
 
@@ -176,7 +181,7 @@ export default function CreateGamePage() {
       setRoleAssignments(assignments);
       
       setGamePhase('game-started');
-      saveModeratorGame(game.id, moderatorId, 'game-started');
+      saveModeratorGame(game.id, moderatorId, gameToken, 'game-started');
     } catch (err) {
       setError(err instanceof Error ? err.message : 'Failed to distribute roles');
       setDistributingRoles(false);
@@ -188,7 +193,7 @@ export default function CreateGamePage() {
   const handleCancelRoleSelection = () => {
     setGamePhase('waiting-for-players');
     if (game) {
-      saveModeratorGame(game.id, moderatorId, 'waiting-for-players');
+      saveModeratorGame(game.id, moderatorId, gameToken, 'waiting-for-players');
     }
   };
 
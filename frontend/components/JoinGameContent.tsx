@@ -13,6 +13,7 @@ export function JoinGameContent() {
   const router = useRouter(); const [gameCode, setGameCode] = useState('');
   const [playerName, setPlayerName] = useState('');
   const [playerId, setPlayerId] = useState('');
+  const [playerToken, setPlayerToken] = useState('');
   const [joined, setJoined] = useState(false); const [players, setPlayers] = useState<Player[]>([]);
   const [leaving, setLeaving] = useState(false);
   const [assignedRole, setAssignedRole] = useState<Role | null>(null);
@@ -28,10 +29,11 @@ export function JoinGameContent() {
           setGameCode(validatedState.gameId);
           setPlayerName(validatedState.playerName);
           setPlayerId(validatedState.playerId);
+          setPlayerToken(validatedState.token);
           setJoined(true);
 
           try {
-            const role = await getPlayerRole(validatedState.gameId, validatedState.playerId);
+            const role = await getPlayerRole(validatedState.gameId, validatedState.playerId, validatedState.token);
             if (role) {
               setAssignedRole(role);
             }
@@ -59,6 +61,7 @@ export function JoinGameContent() {
   // WebSocket connection for real-time updates
   useGameWebSocket({
     gameId: gameCode,
+    token: playerToken,
     enabled: joined && !assignedRole,
     onPlayerJoined: (player) => {
       setPlayers(prev => {
@@ -71,9 +74,9 @@ export function JoinGameContent() {
     },
     onRolesDistributed: async () => {
       // Check if we got a role
-      if (gameCode && playerId) {
+      if (gameCode && playerId && playerToken) {
         try {
-          const role = await getPlayerRole(gameCode, playerId);
+          const role = await getPlayerRole(gameCode, playerId, playerToken);
           setAssignedRole(role);
         } catch (err) {
           console.error('Error fetching role after distribution:', err);
@@ -96,11 +99,12 @@ export function JoinGameContent() {
     if (!player) return;
 
     setPlayerId(player.id);
+    setPlayerToken(player.token || '');
     setPlayerName(player.name);
     setJoined(true);
     // Save to localStorage
     setGameCode(gameId);
-    savePlayerGame(gameId, player.id, player.name);
+    savePlayerGame(gameId, player.id, player.token || '', player.name);
   };
 
   const leaveGameHandler = async () => {
@@ -1,4 +1,5 @@
 import { useEffect, useRef, useCallback, useState } from 'react';
+import { refreshGameToken } from '@/lib/api';
 
 export type GameUpdateType = 
   | 'initial_state'
@@ -15,6 +16,8 @@ export interface GameUpdate {
 
 interface UseGameWebSocketOptions {
   gameId: string;
+  // The game token handed out when creating or joining the game
+  token?: string;
   onUpdate?: (update: GameUpdate) => void;
   onPlayerJoined?: (player: any) => void;
   onPlayerLeft?: (playerId: string) => void;
@@ -25,6 +28,7 @@ interface UseGameWebSocketOptions {
 
 export function useGameWebSocket({
   gameId,
+  token,
   onUpdate,
   onPlayerJoined,
   onPlayerLeft,
@@ -37,6 +41,13 @@ export function useGameWebSocket({
   const [isConnected, setIsConnected] = useState(false);
   const [error, setError] = useState<string | null>(null);
 
+  // The token is refreshed on every connection, so it lives in a ref
+  const tokenRef = useRef(token);
+
+  useEffect(() => {
+    tokenRef.current = token;
+  }, [token]);
+
   // Store callbacks in refs to avoid reconnecting when they change
   const onUpdateRef = useRef(onUpdate);
   const onPlayerJoinedRef = useRef(onPlayerJoined);
@@ -52,13 +63,20 @@ export function useGameWebSocket({
     onGameDeletedRef.current = onGameDeleted;
   }, [onUpdate, onPlayerJoined, onPlayerLeft, onRolesDistributed, onGameDeleted]);
 
-  const connect = useCallback(() => {
+  const connect = useCallback(async () => {
     if (!enabled || !gameId) return;
 
     try {
+      // The server only accepts connections carrying a game token
+      if (!tokenRef.current) {
+        setError('Missing game token');
+        return;
+      }
+      tokenRef.current = await refreshGameToken(gameId, tokenRef.current);
+
       const API_BASE_URL = process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8080';
       const wsUrl = API_BASE_URL.replace(/^http/, 'ws');
-      const ws = new WebSocket(`${wsUrl}/api/games/${gameId}/ws`);
+      const ws = new WebSocket(`${wsUrl}/api/games/${gameId}/ws?token=${encodeURIComponent(tokenRef.current)}`);
 
       ws.onopen = () => {
         setIsConnected(true);
@@ -58,7 +58,8 @@ export async function fetchRoleBySlug(slug: string): Promise<Role> {
 
 export interface Game {
   id: string;
-  moderator_id: string;
+  // Only returned to the game's owner
+  moderator_id?: string;
   status: 'pending' | 'active' | 'completed';
   created_at: string;
 }
@@ -68,6 +69,8 @@ export interface Player {
   name: string;
   game_id: string;
   created_at: string;
+  // Only returned to the player who joined
+  token?: string;
 }
 
 /**
@@ -109,6 +112,26 @@ export async function fetchPlayers(gameId: string): Promise<Player[]> {
   return response.json();
 }
 
+/**
+ * Exchanges the game token handed out when creating or joining a game for a
+ * fresh one, before connecting to the game's live updates
+ */
+export async function refreshGameToken(gameId: string, token: string): Promise<string> {
+  const response = await fetch(`${API_BASE_URL}/api/games/${gameId}/token`, {
+    method: 'POST',
+    headers: {
+      Authorization: `Bearer ${token}`,
+    },
+  });
+
+  if (!response.ok) {
+    throw new APIError(response.status, 'Failed to refresh game token');
+  }
+
+  const data = await response.json();
+  return data.token;
+}
+
 /**
  * Deletes a game (moderator only)
  */
@@ -192,10 +215,15 @@ export async function getGameRoles(gameId: string, moderatorId: string): Promise
 }
 
 /**
- * Gets the assigned role for a specific player
+ * Gets the assigned role for a specific player, authenticated by the game
+ * token handed out when joining
  */
-export async function getPlayerRole(gameId: string, playerId: string): Promise<Role | null> {
-  const response = await fetch(`${API_BASE_URL}/api/games/${gameId}/players/${playerId}/role`);
+export async function getPlayerRole(gameId: string, playerId: string, token: string): Promise<Role | null> {
+  const response = await fetch(`${API_BASE_URL}/api/games/${gameId}/players/${playerId}/role`, {
+    headers: {
+      Authorization: `Bearer ${token}`,
+    },
+  });
 
   if (!response.ok) {
     return null;
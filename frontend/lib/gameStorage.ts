@@ -5,6 +5,7 @@
 interface ModeratorGameState {
   gameId: string;
   moderatorId: string;
+  token: string;
   phase: 'waiting-for-players' | 'selecting-roles' | 'game-started';
   timestamp: number;
 }
@@ -12,6 +13,7 @@ interface ModeratorGameState {
 interface PlayerGameState {
   gameId: string;
   playerId: string;
+  token: string;
   playerName: string;
   timestamp: number;
 }
@@ -23,11 +25,12 @@ const EXPIRY_TIME = 24 * 60 * 60 * 1000; // 24 hours
 /**
  * Save moderator game state
  */
-export function saveModeratorGame(gameId: string, moderatorId: string, phase: ModeratorGameState['phase']) {
+export function saveModeratorGame(gameId: string, moderatorId: string, token: string, phase: ModeratorGameState['phase']) {
   try {
     const state: ModeratorGameState = {
       gameId,
       moderatorId,
+      token,
       phase,
       timestamp: Date.now(),
     };
@@ -74,11 +77,12 @@ export function clearModeratorGame() {
 /**
  * Save player game state
  */
-export function savePlayerGame(gameId: string, playerId: string, playerName: string) {
+export function savePlayerGame(gameId: string, playerId: string, token: string, playerName: string) {
   try {
     const state: PlayerGameState = {
       gameId,
       playerId,
+      token,
       playerName,
       timestamp: Date.now(),
     };